@@ -0,0 +1,72 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/bytesparadise/libasciidoc/pkg/formatter"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+// NewFmtCmd returns the fmt command
+func NewFmtCmd() *cobra.Command {
+
+	var write bool
+	var check bool
+	var sentencePerLine bool
+
+	fmtCmd := &cobra.Command{
+		Use:   "fmt [flags] FILE...",
+		Short: "Rewrite the given Asciidoc files in a canonical style",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			unformatted := 0
+			for _, sourcePath := range args {
+				source, err := ioutil.ReadFile(sourcePath)
+				if err != nil {
+					return errors.Wrapf(err, "unable to read file '%s'", sourcePath)
+				}
+				result := &bytes.Buffer{}
+				if err := formatter.Format(bytes.NewReader(source), result, formatter.WithSentencePerLine(sentencePerLine)); err != nil {
+					return errors.Wrapf(err, "unable to format file '%s'", sourcePath)
+				}
+				switch {
+				case check:
+					if !bytes.Equal(source, result.Bytes()) {
+						fmt.Fprintln(cmd.OutOrStdout(), sourcePath)
+						unformatted++
+					}
+				case write:
+					if bytes.Equal(source, result.Bytes()) {
+						continue
+					}
+					info, err := os.Stat(sourcePath)
+					if err != nil {
+						return errors.Wrapf(err, "unable to write file '%s'", sourcePath)
+					}
+					if err := ioutil.WriteFile(sourcePath, result.Bytes(), info.Mode()); err != nil {
+						return errors.Wrapf(err, "unable to write file '%s'", sourcePath)
+					}
+				default:
+					if _, err := cmd.OutOrStdout().Write(result.Bytes()); err != nil {
+						return errors.Wrap(err, "unable to write formatted content")
+					}
+				}
+			}
+			if unformatted > 0 {
+				return errors.Errorf("%d file(s) not formatted", unformatted)
+			}
+			return nil
+		},
+	}
+	fmtCmd.SilenceUsage = true
+	flags := fmtCmd.Flags()
+	flags.BoolVarP(&write, "write", "w", false, "write the result to the source file instead of STDOUT")
+	flags.BoolVar(&check, "check", false, "list the files which are not formatted and exit with a non-zero code if any")
+	flags.BoolVar(&sentencePerLine, "sentence-per-line", false, "write each sentence of a paragraph on its own line")
+	return fmtCmd
+}
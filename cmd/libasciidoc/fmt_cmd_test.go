@@ -0,0 +1,68 @@
+package main_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("fmt cmd", func() {
+
+	It("format to STDOUT", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"test/unformatted.adoc"})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal("== Section\n\ncontent\n"))
+	})
+
+	It("check unformatted file", func() {
+		// given
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"--check", "test/unformatted.adoc"})
+		// when
+		err := fmtCmd.Execute()
+		// then
+		Expect(err).To(MatchError("1 file(s) not formatted"))
+		Expect(buf.String()).To(ContainSubstring("test/unformatted.adoc\n"))
+	})
+
+	It("write formatted file", func() {
+		// given
+		dir, err := ioutil.TempDir("", "libasciidoc-fmt")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(dir)
+		path := filepath.Join(dir, "doc.adoc")
+		err = ioutil.WriteFile(path, []byte("== Section\ncontent   \n"), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		fmtCmd := main.NewFmtCmd()
+		buf := new(bytes.Buffer)
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"-w", path})
+		// when
+		err = fmtCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		content, err := ioutil.ReadFile(path)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("== Section\n\ncontent\n"))
+		// also, check that the file is now formatted
+		fmtCmd = main.NewFmtCmd()
+		fmtCmd.SetOutput(buf)
+		fmtCmd.SetArgs([]string{"--check", path})
+		Expect(fmtCmd.Execute()).To(Succeed())
+	})
+})
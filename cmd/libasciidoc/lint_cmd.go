@@ -0,0 +1,311 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/bytesparadise/libasciidoc"
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	logsupport "github.com/bytesparadise/libasciidoc/pkg/log"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+// NewLintCmd returns the lint command
+func NewLintCmd() *cobra.Command {
+
+	var format string
+	var failureLevel string
+	var logLevel string
+	var ruleNames []string
+	var attributes []string
+
+	lintCmd := &cobra.Command{
+		Use:   "lint [flags] FILE...",
+		Short: "Check the given Asciidoc files against a set of rules",
+		Args:  cobra.MinimumNArgs(1),
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			lvl, err := log.ParseLevel(logLevel)
+			if err != nil {
+				fmt.Fprintf(cmd.OutOrStderr(), "unable to parse log level '%v'", logLevel)
+				return err
+			}
+			logsupport.Setup(lvl)
+			// keep the standard output for the lint report
+			log.SetOutput(cmd.ErrOrStderr())
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			threshold, err := validator.ParseSeverity(failureLevel)
+			if err != nil {
+				return err
+			}
+			rules, err := selectRules(ruleNames)
+			if err != nil {
+				return err
+			}
+			attrs := parseAttributes(attributes)
+			results := []lintResult{}
+			for _, sourcePath := range args {
+				problems, err := lintFile(sourcePath, attrs, rules)
+				if err != nil {
+					return err
+				}
+				results = append(results, lintResult{
+					Filename: sourcePath,
+					Problems: problems,
+				})
+			}
+			switch format {
+			case "text":
+				err = writeTextReport(cmd.OutOrStdout(), results)
+			case "json":
+				err = writeJSONReport(cmd.OutOrStdout(), results)
+			case "sarif":
+				err = writeSARIFReport(cmd.OutOrStdout(), results, rules)
+			default:
+				return errors.Errorf("unknown output format '%s'", format)
+			}
+			if err != nil {
+				return errors.Wrap(err, "unable to write lint report")
+			}
+			count := 0
+			for _, r := range results {
+				count += len(validator.AtLeast(r.Problems, threshold))
+			}
+			if count > 0 {
+				return errors.Errorf("found %d problem(s) at '%s' level or above", count, threshold)
+			}
+			return nil
+		},
+	}
+	lintCmd.SilenceUsage = true
+	flags := lintCmd.Flags()
+	flags.StringVarP(&format, "format", "f", "text", "output format [text|json|sarif]")
+	flags.StringVar(&failureLevel, "failure-level", "error", "minimum severity of the problems which cause a non-zero exit code [warning|error]")
+	flags.StringVar(&logLevel, "log", "error", "log level to set [debug|info|warning|error|fatal|panic]")
+	flags.StringSliceVarP(&ruleNames, "rule", "r", []string{}, "the rules to apply (default: all)")
+	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
+	return lintCmd
+}
+
+type lintResult struct {
+	Filename string
+	Problems []validator.Problem
+}
+
+// selectRules returns the rules matching the given names, or all the rules if no name was given
+func selectRules(names []string) ([]validator.Rule, error) {
+	all := validator.DefaultRules()
+	if len(names) == 0 {
+		return all, nil
+	}
+	rules := make([]validator.Rule, 0, len(names))
+names:
+	for _, name := range names {
+		for _, rule := range all {
+			if rule.Name == name {
+				rules = append(rules, rule)
+				continue names
+			}
+		}
+		return nil, errors.Errorf("unknown rule '%s'", name)
+	}
+	return rules, nil
+}
+
+func lintFile(sourcePath string, attrs map[string]string, rules []validator.Rule) ([]validator.Problem, error) {
+	path, _ := filepath.Abs(sourcePath)
+	log.Debugf("Starting to lint file %v", path)
+	f, err := os.Open(sourcePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to open file '%s'", sourcePath)
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.WithError(err).Errorf("failed to close file '%s'", sourcePath)
+		}
+	}()
+	config := configuration.NewConfiguration(
+		configuration.WithFilename(sourcePath),
+		configuration.WithAttributes(attrs))
+	return validator.Lint(f, config, rules...)
+}
+
+// filename returns the file in which the given problem was found (eg: an included file), or the linted file by default
+func (r lintResult) filename(p validator.Problem) string {
+	if p.Filename != "" {
+		return p.Filename
+	}
+	return r.Filename
+}
+
+func writeTextReport(out io.Writer, results []lintResult) error {
+	for _, r := range results {
+		for _, p := range r.Problems {
+			location := r.filename(p)
+			if p.Line > 0 {
+				location = fmt.Sprintf("%s:%d", location, p.Line)
+			}
+			if _, err := fmt.Fprintf(out, "%s: %s: %s [%s]\n", location, p.Severity, p.Message, p.Rule); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type jsonProblem struct {
+	File     string `json:"file"`
+	Line     int    `json:"line,omitempty"`
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+func writeJSONReport(out io.Writer, results []lintResult) error {
+	problems := []jsonProblem{}
+	for _, r := range results {
+		for _, p := range r.Problems {
+			problems = append(problems, jsonProblem{
+				File:     r.filename(p),
+				Line:     p.Line,
+				Rule:     p.Rule,
+				Severity: string(p.Severity),
+				Message:  p.Message,
+			})
+		}
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(problems)
+}
+
+// see https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifReport struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+func writeSARIFReport(out io.Writer, results []lintResult, rules []validator.Rule) error {
+	driver := sarifDriver{
+		Name:           "libasciidoc",
+		Version:        libasciidoc.BuildTag,
+		InformationURI: "https://github.com/bytesparadise/libasciidoc",
+		Rules:          make([]sarifRule, 0, len(rules)+1),
+	}
+	for _, rule := range rules {
+		driver.Rules = append(driver.Rules, sarifRule{
+			ID:               rule.Name,
+			ShortDescription: sarifMessage{Text: rule.Description},
+		})
+	}
+	driver.Rules = append(driver.Rules, sarifRule{
+		ID:               validator.ParseErrorRule,
+		ShortDescription: sarifMessage{Text: "documents must be parseable"},
+	})
+	declared := map[string]bool{}
+	for _, rule := range driver.Rules {
+		declared[rule.ID] = true
+	}
+	sarifResults := []sarifResult{}
+	for _, r := range results {
+		for _, p := range r.Problems {
+			// also declare the codes of the diagnostics reported by the parser
+			if !declared[p.Rule] {
+				driver.Rules = append(driver.Rules, sarifRule{
+					ID:               p.Rule,
+					ShortDescription: sarifMessage{Text: "documents must be processed without diagnostics"},
+				})
+				declared[p.Rule] = true
+			}
+			level := "warning"
+			if p.Severity == validator.Error {
+				level = "error"
+			}
+			location := sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{
+					URI: filepath.ToSlash(r.filename(p)),
+				},
+			}
+			if p.Line > 0 {
+				location.Region = &sarifRegion{
+					StartLine: p.Line,
+				}
+			}
+			sarifResults = append(sarifResults, sarifResult{
+				RuleID:    p.Rule,
+				Level:     level,
+				Message:   sarifMessage{Text: p.Message},
+				Locations: []sarifLocation{{PhysicalLocation: location}},
+			})
+		}
+	}
+	run := sarifRun{
+		Tool:    sarifTool{Driver: driver},
+		Results: sarifResults,
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(sarifReport{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	})
+}
@@ -0,0 +1,140 @@
+package main_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	main "github.com/bytesparadise/libasciidoc/cmd/libasciidoc"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("lint cmd", func() {
+
+	It("report problems in text format", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"test/lint.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred()) // only warnings
+		Expect(buf.String()).To(Equal("test/lint.adoc:5: Warning: cross reference to unknown element 'unknown' [broken-xref]\n"))
+	})
+
+	It("fail with warnings", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"--failure-level", "warning", "test/lint.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		Expect(err).To(MatchError("found 1 problem(s) at 'Warning' level or above"))
+	})
+
+	It("report problems in JSON format", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"-f", "json", "test/lint.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		result := []map[string]interface{}{}
+		Expect(json.Unmarshal(buf.Bytes(), &result)).To(Succeed())
+		Expect(result).To(Equal([]map[string]interface{}{
+			{
+				"file":     "test/lint.adoc",
+				"line":     float64(5),
+				"rule":     "broken-xref",
+				"severity": "Warning",
+				"message":  "cross reference to unknown element 'unknown'",
+			},
+		}))
+	})
+
+	It("report problems in SARIF format", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"-f", "sarif", "-r", "broken-xref", "test/lint.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		result := map[string]interface{}{}
+		Expect(json.Unmarshal(buf.Bytes(), &result)).To(Succeed())
+		Expect(result).To(HaveKeyWithValue("version", "2.1.0"))
+		runs := result["runs"].([]interface{})
+		Expect(runs).To(HaveLen(1))
+		results := runs[0].(map[string]interface{})["results"].([]interface{})
+		Expect(results).To(HaveLen(1))
+		Expect(results[0]).To(HaveKeyWithValue("ruleId", "broken-xref"))
+		Expect(results[0]).To(HaveKeyWithValue("level", "warning"))
+		Expect(results[0]).To(HaveKeyWithValue("locations", []interface{}{
+			map[string]interface{}{
+				"physicalLocation": map[string]interface{}{
+					"artifactLocation": map[string]interface{}{
+						"uri": "test/lint.adoc",
+					},
+					"region": map[string]interface{}{
+						"startLine": float64(5),
+					},
+				},
+			},
+		}))
+	})
+
+	It("report parser diagnostics", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"test/lint_table.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred()) // only warnings
+		Expect(buf.String()).To(Equal("test/lint_table.adoc:3: Warning: dropping 1 cell(s) from incomplete table line [invalid-table-cells]\n"))
+	})
+
+	It("declare the parser diagnostics in SARIF format", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"-f", "sarif", "test/lint_table.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		result := map[string]interface{}{}
+		Expect(json.Unmarshal(buf.Bytes(), &result)).To(Succeed())
+		run := result["runs"].([]interface{})[0].(map[string]interface{})
+		results := run["results"].([]interface{})
+		Expect(results).To(HaveLen(1))
+		Expect(results[0]).To(HaveKeyWithValue("ruleId", "invalid-table-cells"))
+		rules := run["tool"].(map[string]interface{})["driver"].(map[string]interface{})["rules"].([]interface{})
+		Expect(rules).To(ContainElement(HaveKeyWithValue("id", "invalid-table-cells")))
+	})
+
+	It("fail with unknown rule", func() {
+		// given
+		lintCmd := main.NewLintCmd()
+		buf := new(bytes.Buffer)
+		lintCmd.SetOutput(buf)
+		lintCmd.SetArgs([]string{"-r", "unknown", "test/lint.adoc"})
+		// when
+		err := lintCmd.Execute()
+		// then
+		Expect(err).To(MatchError("unknown rule 'unknown'"))
+	})
+})
@@ -13,6 +13,10 @@ func main() {
 	rootCmd := NewRootCmd()
 	versionCmd := NewVersionCmd()
 	rootCmd.AddCommand(versionCmd)
+	lintCmd := NewLintCmd()
+	rootCmd.AddCommand(lintCmd)
+	fmtCmd := NewFmtCmd()
+	rootCmd.AddCommand(fmtCmd)
 	rootCmd.SetHelpCommand(helpCommand)
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
@@ -11,7 +11,10 @@ import (
 	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	logsupport "github.com/bytesparadise/libasciidoc/pkg/log"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
 
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 )
@@ -24,6 +27,8 @@ func NewRootCmd() *cobra.Command {
 	var logLevel string
 	var css string
 	var backend string
+	var failureLevel string
+	var safeModeName string
 	var attributes []string
 
 	rootCmd := &cobra.Command{
@@ -45,9 +50,23 @@ func NewRootCmd() *cobra.Command {
 			if len(args) == 0 {
 				return helpCommand.RunE(cmd, args)
 			}
+			var threshold validator.Severity
+			if failureLevel != "" {
+				var err error
+				if threshold, err = validator.ParseSeverity(failureLevel); err != nil {
+					return err
+				}
+			}
+			safeMode, err := configuration.ParseSafeMode(safeModeName)
+			if err != nil {
+				return err
+			}
+			// log and count the problems which are reported during the conversion
+			counter := newProblemCounter()
 			attrs := parseAttributes(attributes)
+			var problems validator.Problems
 			for _, sourcePath := range args {
-				out, close := getOut(cmd, sourcePath, outputName)
+				out, close := getOut(cmd, sourcePath, outputName, counter.Report)
 				if out != nil {
 					defer close()
 					path, _ := filepath.Abs(sourcePath)
@@ -57,13 +76,27 @@ func NewRootCmd() *cobra.Command {
 						configuration.WithAttributes(attrs),
 						configuration.WithCSS(css),
 						configuration.WithBackEnd(backend),
-						configuration.WithHeaderFooter(!noHeaderFooter))
+						configuration.WithHeaderFooter(!noHeaderFooter),
+						configuration.WithFailureLevel(failureLevel),
+						configuration.WithSafeMode(safeMode),
+						configuration.WithDiagnosticSink(counter.Report))
 					_, err := libasciidoc.ConvertFile(out, config)
-					if err != nil {
+					if p, ok := err.(validator.Problems); ok {
+						// keep processing the other files
+						problems = append(problems, p...)
+						continue
+					} else if err != nil {
 						return err
 					}
 				}
 			}
+			// the problems returned by the conversions were also reported to (and counted by) the diagnostic sink
+			if failureLevel != "" && counter.count(threshold) > 0 {
+				return errors.Errorf("%d problem(s) at '%s' level or above were found", counter.count(threshold), failureLevel)
+			}
+			if len(problems) > 0 {
+				return problems
+			}
 			return nil
 		},
 	}
@@ -75,6 +108,8 @@ func NewRootCmd() *cobra.Command {
 	flags.StringVar(&css, "css", "", "the path to the CSS file to link to the document")
 	flags.StringArrayVarP(&attributes, "attribute", "a", []string{}, "a document attribute to set in the form of name, name!, or name=value pair")
 	flags.StringVarP(&backend, "backend", "b", "html5", "backend to format the file")
+	flags.StringVar(&failureLevel, "failure-level", "", "minimum severity of the problems which cause a non-zero exit code [WARN|ERROR] (default: none)")
+	flags.StringVarP(&safeModeName, "safe-mode", "S", "unsafe", "security level of the conversion [unsafe|safe|server|secure]")
 	return rootCmd
 }
 
@@ -90,7 +125,7 @@ func newCloseFileFunc(c io.Closer) closeFunc {
 	}
 }
 
-func getOut(cmd *cobra.Command, sourcePath, outputName string) (io.Writer, closeFunc) {
+func getOut(cmd *cobra.Command, sourcePath, outputName string, report types.DiagnosticSink) (io.Writer, closeFunc) {
 	if outputName == "-" {
 		// outfile is STDOUT
 		return cmd.OutOrStdout(), defaultCloseFunc()
@@ -98,7 +133,7 @@ func getOut(cmd *cobra.Command, sourcePath, outputName string) (io.Writer, close
 		// outfile is specified in the command line
 		outfile, e := os.Create(outputName)
 		if e != nil {
-			log.Warnf("Cannot create output file - %v, skipping", outputName)
+			report(outputFileDiagnostic(sourcePath, outputName))
 		}
 		return outfile, newCloseFileFunc(outfile)
 	} else if sourcePath != "" {
@@ -107,7 +142,7 @@ func getOut(cmd *cobra.Command, sourcePath, outputName string) (io.Writer, close
 		outname := strings.TrimSuffix(path, filepath.Ext(path)) + ".html"
 		outfile, err := os.Create(outname)
 		if err != nil {
-			log.Warnf("Cannot create output file - %v, skipping", outname)
+			report(outputFileDiagnostic(sourcePath, outname))
 			return nil, nil
 		}
 		return outfile, newCloseFileFunc(outfile)
@@ -122,9 +157,49 @@ func parseAttributes(attributes []string) map[string]string {
 		data := strings.Split(attr, "=")
 		if len(data) > 1 {
 			result[data[0]] = data[1]
+		} else if strings.HasSuffix(data[0], "!") {
+			// `name!` is an alternate form of `!name` to reset an attribute
+			result["!"+strings.TrimSuffix(data[0], "!")] = ""
 		} else {
 			result[data[0]] = ""
 		}
 	}
 	return result
 }
+
+func outputFileDiagnostic(sourcePath, outputName string) types.Diagnostic {
+	return types.Diagnostic{
+		Severity: types.SeverityWarning,
+		Code:     types.OutputFileCode,
+		Message:  fmt.Sprintf("Cannot create output file - %v, skipping", outputName),
+		Filename: sourcePath,
+	}
+}
+
+// problemCounter a diagnostic sink which logs and counts the problems reported during the conversion
+type problemCounter struct {
+	counts map[validator.Severity]int
+}
+
+func newProblemCounter() *problemCounter {
+	return &problemCounter{
+		counts: map[validator.Severity]int{},
+	}
+}
+
+// Report logs and counts the given diagnostic
+func (c *problemCounter) Report(d types.Diagnostic) {
+	c.counts[d.Severity]++
+	types.DiagnosticSink(nil).Report(d)
+}
+
+// count returns the number of problems reported with the given severity (or above)
+func (c *problemCounter) count(severity validator.Severity) int {
+	result := 0
+	for s, count := range c.counts {
+		if s.Rank() >= severity.Rank() {
+			result += count
+		}
+	}
+	return result
+}
@@ -104,6 +104,25 @@ var _ = Describe("root cmd", func() {
 `))
 	})
 
+	It("render with attribute reset with trailing bang", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-afigure-caption!", "test/image_with_title.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+		Expect(buf.String()).To(Equal(`<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="Foo">
+</div>
+<div class="title">A title</div>
+</div>
+`))
+	})
+
 	It("render multiple files", func() {
 		// given
 		root := main.NewRootCmd()
@@ -140,4 +159,51 @@ var _ = Describe("root cmd", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("fail when warnings were logged", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-a!foo2", "--failure-level", "WARN", "test/doc_with_attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("2 problem(s) at 'WARN' level or above were found"))
+	})
+
+	It("do not fail when only warnings were logged", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "-a!foo2", "--failure-level", "ERROR", "test/doc_with_attributes.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("fail given bogus failure level", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "--failure-level", "FATAL", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("unknown severity 'FATAL'"))
+	})
+
+	It("fail given bogus safe mode", func() {
+		// given
+		root := main.NewRootCmd()
+		buf := new(bytes.Buffer)
+		root.SetOutput(buf)
+		root.SetArgs([]string{"-s", "-o", "-", "--safe-mode", "paranoid", "test/test.adoc"})
+		// when
+		err := root.Execute()
+		// then
+		Expect(err).To(MatchError("unknown safe mode 'paranoid'"))
+	})
 })
@@ -3,8 +3,11 @@
 package libasciidoc
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
 	"time"
 
@@ -30,10 +33,24 @@ var (
 	BuildTime = ""
 )
 
+// Generator returns the name of the library, followed by its version when the build matches a tag (eg: `libasciidoc v0.6.0`)
+func Generator() string {
+	if BuildTag != "" {
+		return "libasciidoc " + BuildTag
+	}
+	return "libasciidoc"
+}
+
 // ConvertFile converts the content of the given filename into an output document.
 // The conversion result is written in the given writer `output`, whereas the document metadata (title, etc.) (or an error if a problem occurred) is returned
 // as the result of the function call.  The output format is determined by config.Backend (HTML5 default).
 func ConvertFile(output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertFileContext(context.Background(), output, config)
+}
+
+// ConvertFileContext converts the content of the given filename into an output document, as `ConvertFile` does,
+// but stops as soon as the given context is done
+func ConvertFileContext(ctx context.Context, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
 	file, err := os.Open(config.Filename)
 	if err != nil {
 		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
@@ -45,12 +62,24 @@ func ConvertFile(output io.Writer, config configuration.Configuration) (types.Me
 		return types.Metadata{}, errors.Wrapf(err, "error opening %s", config.Filename)
 	}
 	config.LastUpdated = stat.ModTime()
-	return Convert(file, output, config)
+	return ConvertContext(ctx, file, output, config)
 }
 
 // Convert converts the content of the given reader `r` into a full output document, written in the given writer `output`.
 // Returns an error if a problem occurred. The default will be HTML5, but depends on the config.BackEnd value.
+// If `config.FailureLevel` is set and problems with this severity (or above) were found in the document,
+// the output document is written and the returned error is of type `validator.Problems`.
+// Also, a missing file to include is always reported as an error of type `validator.Problems`.
+// The problems found during the conversion are sent to `config.Diagnostics` (or logged if no sink was configured)
+// and are also returned in the metadata.
 func Convert(r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
+	return ConvertContext(context.Background(), r, output, config)
+}
+
+// ConvertContext converts the content of the given reader `r` into a full output document, as `Convert` does,
+// but stops as soon as the given context is done, in which case the context's error is returned.
+// Also, returns an error if the content is larger than `config.MaxInputSize`.
+func ConvertContext(ctx context.Context, r io.Reader, output io.Writer, config configuration.Configuration) (types.Metadata, error) {
 
 	var render func(*renderer.Context, types.Document, io.Writer) (types.Metadata, error)
 	switch config.BackEnd {
@@ -61,6 +90,33 @@ func Convert(r io.Reader, output io.Writer, config configuration.Configuration)
 	default:
 		return types.Metadata{}, fmt.Errorf("backend '%s' not supported", config.BackEnd)
 	}
+	var failureLevel validator.Severity
+	if config.FailureLevel != "" {
+		var err error
+		if failureLevel, err = validator.ParseSeverity(config.FailureLevel); err != nil {
+			return types.Metadata{}, errors.Wrap(err, "invalid failure level")
+		}
+	}
+
+	// collect the diagnostics reported during the conversion, while still sending them to the configured sink
+	var diagnostics []types.Diagnostic
+	sink := config.Diagnostics
+	config.Diagnostics = func(d types.Diagnostic) {
+		diagnostics = append(diagnostics, d)
+		sink.Report(d)
+	}
+
+	if config.MaxInputSize > 0 {
+		// read one more byte than allowed to detect content that is too large
+		content, err := ioutil.ReadAll(io.LimitReader(r, config.MaxInputSize+1))
+		if err != nil {
+			return types.Metadata{}, errors.Wrap(err, "unable to read the content to convert")
+		}
+		if int64(len(content)) > config.MaxInputSize {
+			return types.Metadata{}, errors.Errorf("content to convert exceeds the maximum size of %d bytes", config.MaxInputSize)
+		}
+		r = bytes.NewReader(content)
+	}
 
 	start := time.Now()
 	defer func() {
@@ -68,27 +124,57 @@ func Convert(r io.Reader, output io.Writer, config configuration.Configuration)
 		log.Debugf("rendered the output in %v", duration)
 	}()
 	log.Debugf("parsing the asciidoc source...")
-	doc, err := parser.ParseDocument(r, config)
-	if err != nil {
-		return types.Metadata{}, err
+	doc, err := parser.ParseDocumentContext(ctx, r, config)
+	if e, ok := err.(parser.UnresolvedDirectiveError); ok {
+		return types.Metadata{Diagnostics: diagnostics}, validator.Problems{
+			{
+				Severity: validator.Error,
+				Message:  e.Error(),
+			},
+		}
+	} else if err != nil {
+		return types.Metadata{Diagnostics: diagnostics}, err
 	}
 	// validate the document
 	problems := validator.Validate(&doc)
 	for _, problem := range problems {
-		switch problem.Severity {
-		case validator.Error:
-			log.Error(problem.Message)
-		case validator.Warning:
-			log.Warn(problem.Message)
-		}
+		config.Report(types.Diagnostic{
+			Severity: problem.Severity,
+			Code:     types.InvalidDocumentCode,
+			Message:  problem.Message,
+			Filename: problem.Filename,
+			Line:     problem.Line,
+			Kind:     "Document",
+		})
 	}
 	// render
-	ctx := renderer.NewContext(doc, config)
-	metadata, err := render(ctx, doc, output)
+	rctx := renderer.NewContext(doc, config)
+	rctx.Context = ctx
+	rctx.Generator = Generator()
+	metadata, err := render(rctx, doc, output)
 	if err != nil {
-		return types.Metadata{}, err
+		return types.Metadata{Diagnostics: diagnostics}, err
 	}
+	metadata.Diagnostics = diagnostics
 	log.Debugf("Done processing document")
+	if failureLevel != "" {
+		if failures := validator.AtLeast(toProblems(diagnostics), failureLevel); len(failures) > 0 {
+			return metadata, validator.Problems(failures)
+		}
+	}
 	return metadata, nil
 
 }
+
+// toProblems converts the diagnostics reported during the conversion into problems,
+// so that the failure level applies to all of them, not only to the document validation
+func toProblems(diagnostics []types.Diagnostic) []validator.Problem {
+	problems := make([]validator.Problem, len(diagnostics))
+	for i, d := range diagnostics {
+		problems[i] = validator.Problem{
+			Severity: d.Severity,
+			Message:  d.Message,
+		}
+	}
+	return problems
+}
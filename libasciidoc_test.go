@@ -1,11 +1,16 @@
 package libasciidoc_test
 
 import (
+	"context"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/bytesparadise/libasciidoc"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo" //nolint golint
@@ -238,6 +243,25 @@ a paragraph with _italic content_`
 					},
 				}))
 			})
+
+			It("checklist items", func() {
+				source := `[%interactive]
+* [x] done
+* [ ] todo
+** [*] nested done
+** not a checklist item`
+				Expect(DocumentMetadata(source, lastUpdated)).To(Equal(types.Metadata{
+					Title:       "",
+					LastUpdated: lastUpdated.Format(configuration.LastUpdatedFormat),
+					TableOfContents: types.TableOfContents{
+						Sections: []types.ToCSection{},
+					},
+					Checklist: types.ChecklistProgress{
+						Checked:   2,
+						Unchecked: 1,
+					},
+				}))
+			})
 		})
 
 		Context("full", func() {
@@ -275,6 +299,18 @@ Last updated {{.LastUpdated}}
 				Expect(err).NotTo(HaveOccurred())
 				Expect(RenderHTML5Document(filename, configuration.WithCSS("path/to/style.css"), configuration.WithHeaderFooter(true))).To(MatchHTMLTemplate(expectedContent, stat.ModTime()))
 			})
+
+			It("with version in generator", func() {
+				buildTag := libasciidoc.BuildTag
+				libasciidoc.BuildTag = "v1.2.3"
+				defer func() {
+					libasciidoc.BuildTag = buildTag
+				}()
+				output := &strings.Builder{}
+				_, err := libasciidoc.Convert(strings.NewReader("a paragraph"), output, configuration.NewConfiguration(configuration.WithHeaderFooter(true)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.String()).To(ContainSubstring(`<meta name="generator" content="libasciidoc v1.2.3">`))
+			})
 		})
 	})
 
@@ -565,6 +601,406 @@ Our story begins.`
 			})
 
 		})
+	})
+
+	Context("failure level", func() {
+
+		invalidManpage := `= eve(1)
+
+== Foo
+
+eve - analyzes an image to determine if it's a picture of a life form`
+
+		It("should not fail on problems by default", func() {
+			_, err := Render(invalidManpage,
+				configuration.WithAttribute(types.AttrDocType, "manpage"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail on problems at error level", func() {
+			_, err := Render(invalidManpage,
+				configuration.WithAttribute(types.AttrDocType, "manpage"),
+				configuration.WithFailureLevel("ERROR"))
+			Expect(err).To(Equal(validator.Problems{
+				{
+					Severity: validator.Error,
+					Message:  "manpage document is missing the 'Name' section'",
+				},
+			}))
+		})
+
+		It("should write output despite problems", func() {
+			output := &strings.Builder{}
+			metadata, err := libasciidoc.Convert(strings.NewReader(invalidManpage), output, configuration.NewConfiguration(
+				configuration.WithAttribute(types.AttrDocType, "manpage"),
+				configuration.WithFailureLevel("warning")))
+			Expect(err).To(BeAssignableToTypeOf(validator.Problems{}))
+			Expect(metadata.Title).To(Equal("eve(1)"))
+			Expect(output.String()).NotTo(BeEmpty())
+		})
+
+		It("should fail on all diagnostics at warning level", func() {
+			_, err := libasciidoc.Convert(strings.NewReader(`see <<nowhere>> and {missing}`), &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithFailureLevel("warning")))
+			Expect(err).To(Equal(validator.Problems{
+				{
+					Severity: validator.Warning,
+					Message:  "unable to find attribute 'missing'",
+				},
+				{
+					Severity: validator.Warning,
+					Message:  "cross reference to unknown element 'nowhere'",
+				},
+			}))
+		})
+
+		It("should report missing file to include as a problem", func() {
+			_, err := Render(`include::../../test/includes/unknown.adoc[]`,
+				configuration.WithFilename("test.adoc"))
+			Expect(err).To(Equal(validator.Problems{
+				{
+					Severity: validator.Error,
+					Message:  "Unresolved directive in test.adoc - include::../../test/includes/unknown.adoc[]",
+				},
+			}))
+		})
+
+		It("should fail given bogus failure level", func() {
+			_, err := Render(invalidManpage,
+				configuration.WithFailureLevel("fatal"))
+			Expect(err).To(MatchError("invalid failure level: unknown severity 'fatal'"))
+		})
+	})
+
+	Context("diagnostics", func() {
+
+		convert := func(source string, settings ...configuration.Setting) (types.Metadata, []types.Diagnostic, error) {
+			reported := []types.Diagnostic{}
+			config := configuration.NewConfiguration(append(settings, configuration.WithDiagnosticSink(func(d types.Diagnostic) {
+				reported = append(reported, d)
+			}))...)
+			metadata, err := libasciidoc.Convert(strings.NewReader(source), &strings.Builder{}, config)
+			return metadata, reported, err
+		}
+
+		It("should report unresolved attributes and broken cross references", func() {
+			metadata, reported, err := convert(`{unknown} and <<missing>>`, configuration.WithFilename("test.adoc"))
+			Expect(err).NotTo(HaveOccurred())
+			expected := []types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.UnresolvedAttributeCode,
+					Message:  "unable to find attribute 'unknown'",
+					Filename: "test.adoc",
+					Line:     1,
+					Kind:     "AttributeSubstitution",
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.BrokenCrossReferenceCode,
+					Message:  "cross reference to unknown element 'missing'",
+					Filename: "test.adoc",
+					Line:     1,
+					Kind:     "InternalCrossReference",
+				},
+			}
+			Expect(reported).To(Equal(expected))
+			Expect(metadata.Diagnostics).To(Equal(expected))
+		})
+
+		It("should report the lines of unresolved attributes and broken cross references", func() {
+			_, reported, err := convert(`= Document
+
+== Section with {unknown-in-title}
+
+[.role]
+.a title
+a paragraph with
+an {unknown} attribute
+and a <<missing>> reference.
+
+====
+an example block with
+a *<<missing-in-quoted-text>>* reference
+====
+
+|===
+| a cell
+| a <<missing-in-table-cell>> reference
+|===`, configuration.WithFilename("test.adoc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reported).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.UnresolvedAttributeCode,
+					Message:  "unable to find attribute 'unknown-in-title'",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "AttributeSubstitution",
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.UnresolvedAttributeCode,
+					Message:  "unable to find attribute 'unknown'",
+					Filename: "test.adoc",
+					Line:     8,
+					Kind:     "AttributeSubstitution",
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.BrokenCrossReferenceCode,
+					Message:  "cross reference to unknown element 'missing'",
+					Filename: "test.adoc",
+					Line:     9,
+					Kind:     "InternalCrossReference",
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.BrokenCrossReferenceCode,
+					Message:  "cross reference to unknown element 'missing-in-quoted-text'",
+					Filename: "test.adoc",
+					Line:     13,
+					Kind:     "InternalCrossReference",
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.BrokenCrossReferenceCode,
+					Message:  "cross reference to unknown element 'missing-in-table-cell'",
+					Filename: "test.adoc",
+					Line:     18,
+					Kind:     "InternalCrossReference",
+				},
+			}))
+		})
+
+		It("should report the lines and the included file of the diagnostics", func() {
+			_, reported, err := convert(`:data-uri:
+
+a paragraph
+
+include::includes/diagnostics-include.adoc[]
+
+image::unknown.png[]`, configuration.WithFilename("test/test.adoc"))
+			Expect(err).NotTo(HaveOccurred())
+			path, _ := filepath.Abs("test/includes/diagnostics-include.adoc")
+			Expect(reported).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.UnresolvedAttributeCode,
+					Message:  "unable to find attribute 'unknown-in-include'",
+					Filename: path,
+					Line:     3,
+					Kind:     "AttributeSubstitution",
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.MissingImageCode,
+					Message:  "unable to embed image 'unknown-in-include.png' as a data URI: stat test/unknown-in-include.png: no such file or directory",
+					Filename: path,
+					Line:     7,
+					Kind:     "Image",
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.BrokenCrossReferenceCode,
+					Message:  "cross reference to unknown element 'missing-in-include'",
+					Filename: path,
+					Line:     9,
+					Kind:     "InternalCrossReference",
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.MissingImageCode,
+					Message:  "unable to embed image 'unknown.png' as a data URI: stat test/unknown.png: no such file or directory",
+					Filename: "test/test.adoc",
+					Line:     7,
+					Kind:     "Image",
+				},
+			}))
+		})
+
+		It("should report unclosed tag in included file", func() {
+			_, reported, err := convert(`include::includes/tag-include-unclosed.adoc[tag=unclosed]`,
+				configuration.WithFilename("test/test.adoc"))
+			Expect(err).NotTo(HaveOccurred())
+			path, _ := filepath.Abs("test/includes/tag-include-unclosed.adoc")
+			Expect(reported).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.UnclosedTagCode,
+					Message:  "detected unclosed tag 'unclosed' starting at line 6 of include file: includes/tag-include-unclosed.adoc",
+					Filename: path,
+					Line:     6,
+					Kind:     "FileInclusion",
+				},
+			}))
+		})
+
+		It("should report missing file to include", func() {
+			metadata, reported, err := convert(`include::unknown.adoc[]`, configuration.WithFilename("test.adoc"))
+			Expect(err).To(BeAssignableToTypeOf(validator.Problems{}))
+			expected := []types.Diagnostic{
+				{
+					Severity: types.SeverityError,
+					Code:     types.MissingIncludeCode,
+					Message:  "Unresolved directive in test.adoc - include::unknown.adoc[]",
+					Filename: "test.adoc",
+					Kind:     "FileInclusion",
+				},
+			}
+			Expect(reported).To(Equal(expected))
+			Expect(metadata.Diagnostics).To(Equal(expected))
+		})
+
+		It("should report missing SVG image to embed", func() {
+			_, reported, err := convert(`image::unknown.svg[opts=inline]`, configuration.WithFilename("test.adoc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reported).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.MissingImageCode,
+					Message:  "unable to embed SVG image 'unknown.svg': open unknown.svg: no such file or directory",
+					Filename: "test.adoc",
+					Line:     1,
+					Kind:     "Image",
+				},
+			}))
+		})
+
+		It("should report missing image to embed as a data URI", func() {
+			_, reported, err := convert(`:data-uri:
+
+image::unknown.png[]`, configuration.WithFilename("test.adoc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reported).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.MissingImageCode,
+					Message:  "unable to embed image 'unknown.png' as a data URI: stat unknown.png: no such file or directory",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "Image",
+				},
+			}))
+		})
+
+		It("should report image too large to embed as a data URI", func() {
+			_, reported, err := convert(`:data-uri:
+
+image::test/images/dot.png[]`, configuration.WithFilename("test.adoc"), configuration.WithMaxDataURISize(10))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reported).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.ImageDataURICode,
+					Message:  "unable to embed image 'test/images/dot.png' as a data URI: image size (69 bytes) exceeds the limit of 10 bytes",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "Image",
+				},
+			}))
+		})
+
+		It("should report file with unsupported content to embed as a data URI", func() {
+			_, reported, err := convert(`:data-uri:
+
+image::test/images/fake.png[]
+
+image::test/images/fake.svg[]`, configuration.WithFilename("test.adoc"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reported).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.ImageDataURICode,
+					Message:  "unable to embed image 'test/images/fake.png' as a data URI: unsupported image type: 'text/plain; charset=utf-8'",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "Image",
+				},
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.ImageDataURICode,
+					Message:  "unable to embed image 'test/images/fake.svg' as a data URI: unsupported image type: not a valid SVG image",
+					Filename: "test.adoc",
+					Line:     5,
+					Kind:     "Image",
+				},
+			}))
+		})
+
+		It("should report invalid document", func() {
+			_, reported, err := convert(`= eve(1)
+
+== Foo
+
+eve - analyzes an image`, configuration.WithAttribute(types.AttrDocType, "manpage"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(reported).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityError,
+					Code:     types.InvalidDocumentCode,
+					Message:  "manpage document is missing the 'Name' section'",
+					Line:     3,
+					Kind:     "Document",
+				},
+			}))
+		})
+	})
+
+	Context("with context and limits", func() {
+
+		It("should stop when context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+			_, err := libasciidoc.ConvertContext(ctx, strings.NewReader("a paragraph"), &strings.Builder{}, configuration.NewConfiguration())
+			Expect(err).To(Equal(context.Canceled))
+		})
+
+		It("should stop when deadline is exceeded", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), -1*time.Second)
+			defer cancel()
+			_, err := libasciidoc.ConvertFileContext(ctx, &strings.Builder{}, configuration.NewConfiguration(
+				configuration.WithFilename("test/includes/parent-include.adoc")))
+			Expect(err).To(Equal(context.DeadlineExceeded))
+		})
 
+		It("should stop converting deeply nested quoted texts when deadline is exceeded", func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+			defer cancel()
+			// eg: "*_`#*_`#...content"
+			source := strings.Repeat("*_`#", 8) + "content"
+			result := make(chan error, 1)
+			go func() {
+				_, err := libasciidoc.ConvertContext(ctx, strings.NewReader(source), &strings.Builder{}, configuration.NewConfiguration())
+				result <- err
+			}()
+			Eventually(result, 5*time.Second).Should(Receive(Equal(context.DeadlineExceeded)))
+		})
+
+		It("should convert content within the maximum size", func() {
+			_, err := Render("a paragraph", configuration.WithMaxInputSize(11))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not convert content larger than the maximum size", func() {
+			_, err := Render("a paragraph", configuration.WithMaxInputSize(10))
+			Expect(err).To(MatchError("content to convert exceeds the maximum size of 10 bytes"))
+		})
+
+		It("should include files within the maximum depth", func() {
+			_, err := Render(`include::includes/parent-include.adoc[]`,
+				configuration.WithFilename("test/test.adoc"),
+				configuration.WithMaxIncludeDepth(3))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not include files beyond the maximum depth", func() {
+			_, err := Render(`include::includes/parent-include.adoc[]`,
+				configuration.WithFilename("test/test.adoc"),
+				configuration.WithMaxIncludeDepth(2),
+				configuration.WithDiagnosticSink(func(types.Diagnostic) {}))
+			path, _ := filepath.Abs("test/includes/child-include.adoc")
+			Expect(err).To(MatchError("maximum include depth of 2 exceeded in " + path))
+		})
 	})
 })
@@ -0,0 +1,403 @@
+// Package asciimath converts AsciiMath expressions (see http://asciimath.org) into MathML, so that
+// the math notations can be rendered without any JavaScript library.
+package asciimath
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ToMathML converts the given AsciiMath expression into a MathML `<math>` element,
+// which is displayed as a block if `block` is true, inline otherwise.
+func ToMathML(source string, block bool) string {
+	p := &parser{
+		tokens: tokenize(source),
+	}
+	result := &strings.Builder{}
+	result.WriteString(`<math xmlns="http://www.w3.org/1998/Math/MathML"`)
+	if block {
+		result.WriteString(` display="block"`)
+	}
+	result.WriteString(">")
+	result.WriteString(p.parseExpression(false))
+	result.WriteString("</math>")
+	return result.String()
+}
+
+// ------------------------------------------
+// Symbols
+// ------------------------------------------
+
+type symbolKind int
+
+const (
+	constant symbolKind = iota
+	// a constant whose subscript and superscript are rendered under and over it (eg: `sum`)
+	underOver
+	leftBracket
+	rightBracket
+	unary
+	binary
+	// the `_`, `^` and `/` symbols
+	infix
+	// the `text` unary function, whose argument is not parsed
+	text
+)
+
+type symbol struct {
+	input  string
+	tag    string
+	output string
+	kind   symbolKind
+	// render the unary or binary function with the given (MathML) arguments
+	render func(args ...string) string
+}
+
+// element returns the MathML element for the (constant) symbol
+func (s symbol) element() string {
+	if s.output == "" { // eg: invisible bracket
+		return ""
+	}
+	return "<" + s.tag + ">" + escape(s.output) + "</" + s.tag + ">"
+}
+
+var xmlEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	`"`, "&quot;",
+)
+
+func escape(s string) string {
+	return xmlEscaper.Replace(s)
+}
+
+func mi(input, output string) symbol {
+	return symbol{input: input, tag: "mi", output: output}
+}
+
+func mo(input, output string) symbol {
+	return symbol{input: input, tag: "mo", output: output}
+}
+
+func left(input, output string) symbol {
+	return symbol{input: input, tag: "mo", output: output, kind: leftBracket}
+}
+
+func right(input, output string) symbol {
+	return symbol{input: input, tag: "mo", output: output, kind: rightBracket}
+}
+
+func over(input, accent string) symbol {
+	return symbol{input: input, kind: unary, render: func(args ...string) string {
+		return "<mover>" + args[0] + "<mo>" + accent + "</mo></mover>"
+	}}
+}
+
+func under(input, accent string) symbol {
+	return symbol{input: input, kind: unary, render: func(args ...string) string {
+		return "<munder>" + args[0] + "<mo>" + accent + "</mo></munder>"
+	}}
+}
+
+func font(input, variant string) symbol {
+	return symbol{input: input, kind: unary, render: func(args ...string) string {
+		return `<mstyle mathvariant="` + variant + `">` + args[0] + "</mstyle>"
+	}}
+}
+
+func fenced(input, open, close string) symbol {
+	return symbol{input: input, kind: unary, render: func(args ...string) string {
+		return "<mrow><mo>" + open + "</mo>" + args[0] + "<mo>" + close + "</mo></mrow>"
+	}}
+}
+
+var symbols = []symbol{
+	// greek letters
+	mi("alpha", "α"), mi("beta", "β"), mi("gamma", "γ"), mi("Gamma", "Γ"), mi("delta", "δ"), mi("Delta", "Δ"),
+	mi("epsilon", "ε"), mi("varepsilon", "ɛ"), mi("zeta", "ζ"), mi("eta", "η"), mi("theta", "θ"), mi("Theta", "Θ"),
+	mi("vartheta", "ϑ"), mi("iota", "ι"), mi("kappa", "κ"), mi("lambda", "λ"), mi("Lambda", "Λ"), mi("mu", "μ"),
+	mi("nu", "ν"), mi("xi", "ξ"), mi("Xi", "Ξ"), mi("pi", "π"), mi("Pi", "Π"), mi("rho", "ρ"), mi("sigma", "σ"),
+	mi("Sigma", "Σ"), mi("tau", "τ"), mi("upsilon", "υ"), mi("phi", "φ"), mi("Phi", "Φ"), mi("varphi", "ϕ"),
+	mi("chi", "χ"), mi("psi", "ψ"), mi("Psi", "Ψ"), mi("omega", "ω"), mi("Omega", "Ω"),
+	// operation symbols
+	mo("+", "+"), mo("-", "−"), mo("*", "⋅"), mo("**", "∗"), mo("***", "⋆"), mo("//", "/"), mo("\\\\", "\\"),
+	mo("xx", "×"), mo("-:", "÷"), mo("@", "∘"), mo("o+", "⊕"), mo("ox", "⊗"), mo("o.", "⊙"), mo("^^", "∧"),
+	mo("vv", "∨"), mo("nn", "∩"), mo("uu", "∪"), mo("+-", "±"),
+	{input: "sum", tag: "mo", output: "∑", kind: underOver},
+	{input: "prod", tag: "mo", output: "∏", kind: underOver},
+	{input: "^^^", tag: "mo", output: "⋀", kind: underOver},
+	{input: "vvv", tag: "mo", output: "⋁", kind: underOver},
+	{input: "nnn", tag: "mo", output: "⋂", kind: underOver},
+	{input: "uuu", tag: "mo", output: "⋃", kind: underOver},
+	{input: "lim", tag: "mo", output: "lim", kind: underOver},
+	// relation symbols
+	mo("=", "="), mo("!=", "≠"), mo("<", "<"), mo(">", ">"), mo("<=", "≤"), mo(">=", "≥"), mo("-<", "≺"),
+	mo(">-", "≻"), mo("in", "∈"), mo("!in", "∉"), mo("sub", "⊂"), mo("sup", "⊃"), mo("sube", "⊆"),
+	mo("supe", "⊇"), mo("-=", "≡"), mo("~=", "≅"), mo("~~", "≈"), mo("prop", "∝"),
+	// logical symbols
+	mo("not", "¬"), mo("=>", "⇒"), mo("<=>", "⇔"), mo("AA", "∀"), mo("EE", "∃"), mo("_|_", "⊥"), mo("TT", "⊤"),
+	mo("|--", "⊢"), mo("|==", "⊨"),
+	// arrows
+	mo("uarr", "↑"), mo("darr", "↓"), mo("rarr", "→"), mo("->", "→"), mo("|->", "↦"), mo("larr", "←"),
+	mo("harr", "↔"), mo("rArr", "⇒"), mo("lArr", "⇐"), mo("hArr", "⇔"),
+	// miscellaneous symbols
+	mo("int", "∫"), mo("oint", "∮"), mo("del", "∂"), mo("grad", "∇"), mo("O/", "∅"), mo("oo", "∞"),
+	mo("aleph", "ℵ"), mo("/_", "∠"), mo(":.", "∴"), mo("...", "…"), mo("cdots", "⋯"), mo("vdots", "⋮"),
+	mo("ddots", "⋱"), mo("|", "|"),
+	mo("CC", "ℂ"), mo("NN", "ℕ"), mo("QQ", "ℚ"), mo("RR", "ℝ"), mo("ZZ", "ℤ"),
+	// standard functions
+	mi("sin", "sin"), mi("cos", "cos"), mi("tan", "tan"), mi("sec", "sec"), mi("csc", "csc"), mi("cot", "cot"),
+	mi("arcsin", "arcsin"), mi("arccos", "arccos"), mi("arctan", "arctan"), mi("sinh", "sinh"),
+	mi("cosh", "cosh"), mi("tanh", "tanh"), mi("log", "log"), mi("ln", "ln"), mi("exp", "exp"), mi("det", "det"),
+	mi("dim", "dim"), mi("mod", "mod"), mi("gcd", "gcd"), mi("lcm", "lcm"), mi("min", "min"), mi("max", "max"),
+	// brackets
+	left("(", "("), right(")", ")"), left("[", "["), right("]", "]"), left("{", "{"), right("}", "}"),
+	left("(:", "⟨"), right(":)", "⟩"), left("<<", "⟨"), right(">>", "⟩"),
+	left("{:", ""), right(":}", ""), // invisible brackets
+	// unary functions
+	{input: "sqrt", kind: unary, render: func(args ...string) string {
+		return "<msqrt>" + args[0] + "</msqrt>"
+	}},
+	{input: "cancel", kind: unary, render: func(args ...string) string {
+		return `<menclose notation="updiagonalstrike">` + args[0] + "</menclose>"
+	}},
+	fenced("abs", "|", "|"), fenced("floor", "⌊", "⌋"), fenced("ceil", "⌈", "⌉"), fenced("norm", "∥", "∥"),
+	over("hat", "^"), over("bar", "¯"), over("vec", "→"), over("tilde", "~"), over("dot", "."), over("ddot", ".."),
+	over("obrace", "⏞"), under("ul", "̲"), under("ubrace", "⏟"),
+	font("bb", "bold"), font("bbb", "double-struck"), font("cc", "script"), font("tt", "monospace"),
+	font("fr", "fraktur"), font("sf", "sans-serif"),
+	{input: "text", kind: text},
+	// binary functions
+	{input: "frac", kind: binary, render: func(args ...string) string {
+		return "<mfrac>" + args[0] + args[1] + "</mfrac>"
+	}},
+	{input: "root", kind: binary, render: func(args ...string) string {
+		return "<mroot>" + args[1] + args[0] + "</mroot>"
+	}},
+	{input: "stackrel", kind: binary, render: func(args ...string) string {
+		return "<mover>" + args[1] + args[0] + "</mover>"
+	}},
+	{input: "overset", kind: binary, render: func(args ...string) string {
+		return "<mover>" + args[1] + args[0] + "</mover>"
+	}},
+	{input: "underset", kind: binary, render: func(args ...string) string {
+		return "<munder>" + args[1] + args[0] + "</munder>"
+	}},
+	// sub/superscripts and fractions
+	{input: "_", kind: infix},
+	{input: "^", kind: infix},
+	{input: "/", kind: infix},
+}
+
+// ------------------------------------------
+// Tokenizer
+// ------------------------------------------
+
+// tokenize splits the given source into symbols, using the longest matching input at each position
+func tokenize(source string) []symbol {
+	tokens := []symbol{}
+	for i := 0; i < len(source); {
+		r, size := utf8.DecodeRuneInString(source[i:])
+		switch {
+		case unicode.IsSpace(r):
+			i += size
+			continue
+		case r == '"':
+			if end := strings.IndexByte(source[i+1:], '"'); end >= 0 {
+				tokens = append(tokens, symbol{tag: "mtext", output: source[i+1 : i+1+end]})
+				i += end + 2
+				continue
+			}
+		case unicode.IsDigit(r):
+			n := scanNumber(source[i:])
+			tokens = append(tokens, symbol{tag: "mn", output: n})
+			i += len(n)
+			continue
+		}
+		if s, found := longestMatch(source[i:]); found {
+			i += len(s.input)
+			if s.kind == text {
+				// the argument of the `text` function is taken as-is
+				rest := strings.TrimLeftFunc(source[i:], unicode.IsSpace)
+				if strings.HasPrefix(rest, "(") {
+					if end := strings.IndexByte(rest, ')'); end >= 0 {
+						tokens = append(tokens, symbol{tag: "mtext", output: rest[1:end]})
+						i = len(source) - len(rest) + end + 1
+						continue
+					}
+				}
+				s = mi(s.input, s.input)
+			}
+			tokens = append(tokens, s)
+			continue
+		}
+		if unicode.IsLetter(r) {
+			tokens = append(tokens, mi(string(r), string(r)))
+		} else {
+			tokens = append(tokens, mo(string(r), string(r)))
+		}
+		i += size
+	}
+	return tokens
+}
+
+func scanNumber(s string) string {
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	// decimal part
+	if end+1 < len(s) && s[end] == '.' && s[end+1] >= '0' && s[end+1] <= '9' {
+		end++
+		for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+			end++
+		}
+	}
+	return s[:end]
+}
+
+func longestMatch(s string) (symbol, bool) {
+	var result symbol
+	found := false
+	for _, sym := range symbols {
+		if strings.HasPrefix(s, sym.input) && len(sym.input) > len(result.input) {
+			result = sym
+			found = true
+		}
+	}
+	return result, found
+}
+
+// ------------------------------------------
+// Parser
+// ------------------------------------------
+
+// the grammar (see http://asciimath.org/#grammar):
+//
+//	S ::= v | lEr | uS | bSS    simple expression
+//	I ::= S_S | S^S | S_S^S | S  intermediate expression
+//	E ::= IE | I/I               expression
+type parser struct {
+	tokens []symbol
+	pos    int
+}
+
+// node a MathML element
+type node struct {
+	xml string
+	// the content of the element without its brackets, if it is a group in brackets
+	inner     string
+	bracketed bool
+}
+
+// unwrapped returns the MathML element without its brackets, which are not displayed
+// when the group is the argument of a function, a fraction or a sub/superscript
+func (n node) unwrapped() string {
+	if n.bracketed {
+		return "<mrow>" + n.inner + "</mrow>"
+	}
+	return n.xml
+}
+
+func (p *parser) peek() (symbol, bool) {
+	if p.pos >= len(p.tokens) {
+		return symbol{}, false
+	}
+	return p.tokens[p.pos], true
+}
+
+func (p *parser) next() (symbol, bool) {
+	s, ok := p.peek()
+	if ok {
+		p.pos++
+	}
+	return s, ok
+}
+
+// parseExpression parses the expressions until the end of the source or, if `nested` is true, until a right bracket
+func (p *parser) parseExpression(nested bool) string {
+	result := &strings.Builder{}
+	for {
+		s, ok := p.peek()
+		if !ok || (nested && s.kind == rightBracket) {
+			return result.String()
+		}
+		n := p.parseIntermediate()
+		if s, ok := p.peek(); ok && s.input == "/" {
+			p.pos++
+			d := p.parseIntermediate()
+			n = node{
+				xml: "<mfrac>" + n.unwrapped() + d.unwrapped() + "</mfrac>",
+			}
+		}
+		result.WriteString(n.xml)
+	}
+}
+
+func (p *parser) parseIntermediate() node {
+	base, isUnderOver := p.parseSimple()
+	var sub, sup *node
+	if s, ok := p.peek(); ok && s.input == "_" {
+		p.pos++
+		n, _ := p.parseSimple()
+		sub = &n
+	}
+	if s, ok := p.peek(); ok && s.input == "^" {
+		p.pos++
+		n, _ := p.parseSimple()
+		sup = &n
+	}
+	subTag, supTag, subSupTag := "msub", "msup", "msubsup"
+	if isUnderOver {
+		subTag, supTag, subSupTag = "munder", "mover", "munderover"
+	}
+	switch {
+	case sub != nil && sup != nil:
+		return node{xml: "<" + subSupTag + ">" + base.xml + sub.unwrapped() + sup.unwrapped() + "</" + subSupTag + ">"}
+	case sub != nil:
+		return node{xml: "<" + subTag + ">" + base.xml + sub.unwrapped() + "</" + subTag + ">"}
+	case sup != nil:
+		return node{xml: "<" + supTag + ">" + base.xml + sup.unwrapped() + "</" + supTag + ">"}
+	default:
+		return base
+	}
+}
+
+// parseSimple parses a simple expression, and also returns `true` if it is a symbol
+// whose subscript and superscript should be rendered under and over it
+func (p *parser) parseSimple() (node, bool) {
+	s, ok := p.next()
+	if !ok {
+		return node{xml: "<mrow></mrow>"}, false
+	}
+	switch s.kind {
+	case leftBracket:
+		inner := p.parseExpression(true)
+		closing := ""
+		if r, ok := p.next(); ok { // necessarily a right bracket
+			closing = r.element()
+		}
+		return node{
+			xml:       "<mrow>" + s.element() + inner + closing + "</mrow>",
+			inner:     inner,
+			bracketed: true,
+		}, false
+	case unary:
+		arg, _ := p.parseSimple()
+		return node{xml: s.render(arg.unwrapped())}, false
+	case binary:
+		arg1, _ := p.parseSimple()
+		arg2, _ := p.parseSimple()
+		return node{xml: s.render(arg1.unwrapped(), arg2.unwrapped())}, false
+	case underOver:
+		return node{xml: s.element()}, true
+	default:
+		// also includes the unexpected infix symbols and right brackets, which are rendered as operators
+		if s.tag == "" {
+			s = mo(s.input, s.input)
+		}
+		return node{xml: s.element()}, false
+	}
+}
@@ -0,0 +1,13 @@
+package asciimath_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+func TestAsciiMath(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "AsciiMath Suite")
+}
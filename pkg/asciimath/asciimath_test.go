@@ -0,0 +1,39 @@
+package asciimath_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/asciimath"
+
+	. "github.com/onsi/ginkgo/extensions/table" //nolint golint
+	. "github.com/onsi/gomega"                  //nolint golint
+)
+
+var _ = DescribeTable("asciimath to mathml",
+	func(source string, expected string) {
+		Expect(asciimath.ToMathML(source, false)).To(Equal(`<math xmlns="http://www.w3.org/1998/Math/MathML">` + expected + `</math>`))
+	},
+	Entry("identifiers and numbers", `x = 1.5`, `<mi>x</mi><mo>=</mo><mn>1.5</mn>`),
+	Entry("symbols", `alpha != oo`, `<mi>α</mi><mo>≠</mo><mo>∞</mo>`),
+	Entry("special characters", `x < y & "a > b"`, `<mi>x</mi><mo>&lt;</mo><mi>y</mi><mo>&amp;</mo><mtext>a &gt; b</mtext>`),
+	Entry("superscript", `x^2`, `<msup><mi>x</mi><mn>2</mn></msup>`),
+	Entry("subscript and superscript", `a_1^2`, `<msubsup><mi>a</mi><mn>1</mn><mn>2</mn></msubsup>`),
+	Entry("fraction with brackets", `(x+1)/2`, `<mfrac><mrow><mi>x</mi><mo>+</mo><mn>1</mn></mrow><mn>2</mn></mfrac>`),
+	Entry("fraction function", `frac(a)(b)`, `<mfrac><mrow><mi>a</mi></mrow><mrow><mi>b</mi></mrow></mfrac>`),
+	Entry("sum with under and over scripts", `sum_(i=1)^n i`,
+		`<munderover><mo>∑</mo><mrow><mi>i</mi><mo>=</mo><mn>1</mn></mrow><mi>n</mi></munderover><mi>i</mi>`),
+	Entry("square root", `sqrt x`, `<msqrt><mi>x</mi></msqrt>`),
+	Entry("root", `root(3)(x)`, `<mroot><mrow><mi>x</mi></mrow><mrow><mn>3</mn></mrow></mroot>`),
+	Entry("brackets", `[a, b)`, `<mrow><mo>[</mo><mi>a</mi><mo>,</mo><mi>b</mi><mo>)</mo></mrow>`),
+	Entry("invisible brackets", `{:x:}`, `<mrow><mi>x</mi></mrow>`),
+	Entry("unmatched right bracket", `x)`, `<mi>x</mi><mo>)</mo>`),
+	Entry("text", `text(if) x`, `<mtext>if</mtext><mi>x</mi>`),
+	Entry("font", `bb(A)`, `<mstyle mathvariant="bold"><mrow><mi>A</mi></mrow></mstyle>`),
+	Entry("accent", `vec v`, `<mover><mi>v</mi><mo>→</mo></mover>`),
+	Entry("function", `sin x`, `<mi>sin</mi><mi>x</mi>`),
+)
+
+var _ = DescribeTable("asciimath to mathml block",
+	func(source string, expected string) {
+		Expect(asciimath.ToMathML(source, true)).To(Equal(expected))
+	},
+	Entry("block", `x^2`, `<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><msup><mi>x</mi><mn>2</mn></msup></math>`),
+)
@@ -2,14 +2,21 @@ package configuration
 
 import (
 	"errors"
+	"strings"
 	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
 
 // NewConfiguration returns a new configuration
 func NewConfiguration(settings ...Setting) Configuration {
 	config := Configuration{
 		AttributeOverrides: make(map[string]string),
+		MaxIncludeDepth:    DefaultMaxIncludeDepth,
+		MaxDataURISize:     DefaultMaxDataURISize,
+		MaxTableCellFactor: DefaultMaxTableCellFactor,
 		macros:             make(map[string]MacroTemplate),
+		admonitions:        make(map[string]CustomAdmonition),
 	}
 	for _, set := range settings {
 		set(&config)
@@ -25,7 +32,15 @@ type Configuration struct {
 	IncludeHeaderFooter bool
 	CSS                 string
 	BackEnd             string
+	FailureLevel        string
+	Diagnostics         types.DiagnosticSink
+	MaxInputSize        int64 // in bytes, `0` means no limit
+	MaxIncludeDepth     int   // `0` means no limit
+	MaxDataURISize      int64 // in bytes, `0` means no limit
+	MaxTableCellFactor  int   // `0` means no limit
+	SafeMode            SafeMode
 	macros              map[string]MacroTemplate
+	admonitions         map[string]CustomAdmonition
 }
 
 // Clone return a clone of the current configuration
@@ -36,6 +51,14 @@ func (c Configuration) Clone() Configuration {
 		Filename:            c.Filename,
 		IncludeHeaderFooter: c.IncludeHeaderFooter,
 		LastUpdated:         c.LastUpdated,
+		FailureLevel:        c.FailureLevel,
+		Diagnostics:         c.Diagnostics,
+		MaxInputSize:        c.MaxInputSize,
+		MaxIncludeDepth:     c.MaxIncludeDepth,
+		MaxDataURISize:      c.MaxDataURISize,
+		MaxTableCellFactor:  c.MaxTableCellFactor,
+		SafeMode:            c.SafeMode,
+		admonitions:         c.admonitions,
 	}
 }
 
@@ -48,9 +71,30 @@ func (c Configuration) MacroTemplate(name string) (MacroTemplate, error) {
 	return nil, errors.New("unknown user macro: " + name)
 }
 
+// CustomAdmonition finds and returns the user-defined admonition of the given kind (case insensitive, eg: `DANGER` or `danger`)
+func (c Configuration) CustomAdmonition(kind string) (CustomAdmonition, bool) {
+	a, ok := c.admonitions[strings.ToLower(kind)]
+	return a, ok
+}
+
+// Report reports the given diagnostic to the configured sink (or logs it if no sink was configured).
+// The diagnostic's filename defaults to the configured filename
+func (c Configuration) Report(d types.Diagnostic) {
+	if d.Filename == "" {
+		d.Filename = c.Filename
+	}
+	c.Diagnostics.Report(d)
+}
+
 const (
 	// LastUpdatedFormat key to the time format for the `last updated` document attribute
 	LastUpdatedFormat string = "2006-01-02 15:04:05 -0700"
+	// DefaultMaxIncludeDepth the default maximum depth of nested file inclusions (same as Asciidoctor)
+	DefaultMaxIncludeDepth int = 64
+	// DefaultMaxDataURISize the default maximum size (in bytes) of the images embedded in the document with the `data-uri` attribute
+	DefaultMaxDataURISize int64 = 1024 * 1024
+	// DefaultMaxTableCellFactor the default maximum duplication factor (eg: `3*|`) and column span (eg: `3+|`) of the table cells
+	DefaultMaxTableCellFactor int = 1000
 )
 
 // Setting a setting to customize the configuration used during parsing and rendering of a document
@@ -98,6 +142,59 @@ func WithBackEnd(backend string) Setting {
 	}
 }
 
+// WithFailureLevel sets the minimum severity of the problems which cause the conversion to fail.
+// Valid values are "warning" (or "warn"), "error" and "" (the default, ie, problems never cause a failure)
+func WithFailureLevel(level string) Setting {
+	return func(config *Configuration) {
+		config.FailureLevel = level
+	}
+}
+
+// WithDiagnosticSink sets the sink which receives the diagnostics reported while processing a document,
+// instead of logging them
+func WithDiagnosticSink(sink types.DiagnosticSink) Setting {
+	return func(config *Configuration) {
+		config.Diagnostics = sink
+	}
+}
+
+// WithMaxInputSize sets the maximum size (in bytes) of the content to convert. `0` means no limit (the default)
+func WithMaxInputSize(size int64) Setting {
+	return func(config *Configuration) {
+		config.MaxInputSize = size
+	}
+}
+
+// WithMaxIncludeDepth sets the maximum depth of nested file inclusions. `0` means no limit
+func WithMaxIncludeDepth(depth int) Setting {
+	return func(config *Configuration) {
+		config.MaxIncludeDepth = depth
+	}
+}
+
+// WithMaxDataURISize sets the maximum size (in bytes) of the images embedded in the document with the `data-uri` attribute.
+// `0` means no limit
+func WithMaxDataURISize(size int64) Setting {
+	return func(config *Configuration) {
+		config.MaxDataURISize = size
+	}
+}
+
+// WithMaxTableCellFactor sets the maximum duplication factor (eg: `3*|`) and column span (eg: `3+|`) of the table cells.
+// Larger values are reduced to this maximum. `0` means no limit
+func WithMaxTableCellFactor(factor int) Setting {
+	return func(config *Configuration) {
+		config.MaxTableCellFactor = factor
+	}
+}
+
+// WithSafeMode sets the security level of the conversion (default is `Unsafe`)
+func WithSafeMode(mode SafeMode) Setting {
+	return func(config *Configuration) {
+		config.SafeMode = mode
+	}
+}
+
 // WithFilename function to set the `filename` setting in the config
 func WithFilename(filename string) Setting {
 	return func(config *Configuration) {
@@ -111,3 +208,10 @@ func WithMacroTemplate(name string, t MacroTemplate) Setting {
 		config.macros[name] = t
 	}
 }
+
+// WithCustomAdmonition registers a user-defined kind of admonition (eg: `DANGER`)
+func WithCustomAdmonition(a CustomAdmonition) Setting {
+	return func(config *Configuration) {
+		config.admonitions[a.Kind()] = a
+	}
+}
@@ -0,0 +1,41 @@
+package configuration
+
+import "strings"
+
+// CustomAdmonition a user-defined kind of admonition (eg: `DANGER`), which can be used in the same manner as the
+// built-in ones, ie, in a paragraph (`DANGER: ...`) or as a block style (`[DANGER]`)
+type CustomAdmonition struct {
+	Name    string // the name of the admonition in the document, in upper case (eg: `DANGER`)
+	Caption string // the default caption, unless the `<name>-caption` document attribute is set (default: `Danger`)
+	Icon    string // the name of the font icon and of the icon image (default: `danger`)
+	Class   string // the CSS class of the admonition block (default: `danger`)
+}
+
+// Kind returns the kind of the admonition, ie, its name in lower case (eg: `danger`)
+func (a CustomAdmonition) Kind() string {
+	return strings.ToLower(a.Name)
+}
+
+// CaptionOrDefault returns the caption of the admonition, or its name in title case if no caption was set
+func (a CustomAdmonition) CaptionOrDefault() string {
+	if a.Caption != "" {
+		return a.Caption
+	}
+	return strings.Title(a.Kind())
+}
+
+// IconOrDefault returns the icon of the admonition, or its kind if no icon was set
+func (a CustomAdmonition) IconOrDefault() string {
+	if a.Icon != "" {
+		return a.Icon
+	}
+	return a.Kind()
+}
+
+// ClassOrDefault returns the CSS class of the admonition, or its kind if no class was set
+func (a CustomAdmonition) ClassOrDefault() string {
+	if a.Class != "" {
+		return a.Class
+	}
+	return a.Kind()
+}
@@ -0,0 +1,47 @@
+package configuration
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SafeMode the security level of the conversion, which restricts the access to the files referenced in the document.
+// The levels (and their values) are the same as in Asciidoctor
+type SafeMode int
+
+const (
+	// Unsafe no restriction (the default)
+	Unsafe SafeMode = 0
+	// Safe prevents access to the files outside of the directory of the document
+	Safe SafeMode = 1
+	// Server same restrictions as `Safe`
+	Server SafeMode = 10
+	// Secure same restrictions as `Server`, and also prevents the embedding of images in the document
+	// (ie, with the `data-uri` attribute or the `inline` SVG option)
+	Secure SafeMode = 20
+)
+
+var safeModeNames = map[SafeMode]string{
+	Unsafe: "unsafe",
+	Safe:   "safe",
+	Server: "server",
+	Secure: "secure",
+}
+
+// String returns the name of the safe mode
+func (m SafeMode) String() string {
+	if name, found := safeModeNames[m]; found {
+		return name
+	}
+	return fmt.Sprintf("SafeMode(%d)", int(m))
+}
+
+// ParseSafeMode returns the safe mode matching the given (case insensitive) name
+func ParseSafeMode(name string) (SafeMode, error) {
+	for mode, n := range safeModeNames {
+		if strings.EqualFold(name, n) {
+			return mode, nil
+		}
+	}
+	return Unsafe, fmt.Errorf("unknown safe mode '%s'", name)
+}
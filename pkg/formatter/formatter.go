@@ -0,0 +1,566 @@
+package formatter
+
+import (
+	"bufio"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Format reads the Asciidoc source from the given reader and writes it in a canonical style in the given output:
+// - trailing whitespace is removed and consecutive blank lines are merged
+// - delimited blocks, block macros and sections are surrounded with blank lines
+// - block delimiters have a length of 4 characters (unless nested in a block with the same delimiter, or unless
+//   the content of a verbatim block contains a line which matches the canonical delimiter)
+// - unordered list items use the `*` marker, repeated according to their level
+// - the block attribute lists have no space around their commas and equal signs
+// - (optionally) each sentence of a paragraph is written on its own line
+//
+// The formatter works on the lines of the source document (not on the parsed document),
+// which means that comments and file inclusions are preserved as-is.
+func Format(r io.Reader, output io.Writer, settings ...Setting) error {
+	config := newConfiguration(settings...)
+	f := newFormatter(config)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		f.process(scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return errors.Wrap(err, "unable to read source to format")
+	}
+	for _, l := range f.result() {
+		if _, err := io.WriteString(output, l+"\n"); err != nil {
+			return errors.Wrap(err, "unable to write formatted source")
+		}
+	}
+	return nil
+}
+
+// Setting a setting to customize the formatter
+type Setting func(config *configuration)
+
+// WithSentencePerLine function to set the `sentence per line` setting in the config (default is `false`)
+func WithSentencePerLine(value bool) Setting {
+	return func(config *configuration) {
+		config.sentencePerLine = value
+	}
+}
+
+type configuration struct {
+	sentencePerLine bool
+}
+
+func newConfiguration(settings ...Setting) configuration {
+	config := configuration{}
+	for _, set := range settings {
+		set(&config)
+	}
+	return config
+}
+
+// frame a delimited block (or the document itself) in which lines are being processed
+type frame struct {
+	closing   string   // the delimiter which closes the block, as found in the source
+	char      byte     // the character used in the delimiter, if applicable
+	verbatim  bool     // lines of verbatim blocks are not formatted
+	table     bool     // lines of tables are not formatted (except for the trailing spaces)
+	start     int      // the index of the first line of the block in the output
+	markers   []string // the markers of the unordered list items, in order of their level
+	inList    bool     // true if the current element is a list item
+	paragraph bool     // true if the current element is a paragraph (or the text of a list item)
+	literal   bool     // true if the current element is a literal paragraph
+	splitting bool     // true if the sentences of the current paragraph can be split
+}
+
+type formatter struct {
+	config configuration
+	out    []string
+	frames []*frame
+	// the index in `out` of the first line of the prefix (attributes, title, comments) of the next block, or `-1`
+	prefixStart int
+	// true if the current prefix contains attributes which do not allow for splitting the sentences of the next paragraph
+	prefixVerbatim bool
+	// true if blank lines were found before the current line
+	pendingBlank bool
+	// true if a blank line must be inserted before the next element
+	blankAfter bool
+	// true while processing the document header
+	inHeader bool
+}
+
+func newFormatter(config configuration) *formatter {
+	return &formatter{
+		config:      config,
+		out:         []string{},
+		frames:      []*frame{{}},
+		prefixStart: -1,
+	}
+}
+
+var (
+	delimiterLine     = regexp.MustCompile("^(-{4,}|\\.{4,}|\\+{4,}|/{4,}|={4,}|\\*{4,}|_{4,}|--|```.*|[|!,:]={3,})$")
+	attributesLine    = regexp.MustCompile(`^\[.*\]$`)
+	blockTitleLine    = regexp.MustCompile(`^\.[^.\s]`)
+	sectionTitleLine  = regexp.MustCompile(`^(={1,6})\s+\S`)
+	blockMacroLine    = regexp.MustCompile(`^[a-z]+::\S*\[.*\]$`)
+	includeLine       = regexp.MustCompile(`^include::\S*\[.*\]$`)
+	attributeDeclLine = regexp.MustCompile(`^:!?[\w-]+!?:`)
+	unorderedItemLine = regexp.MustCompile(`^\s*(-|\*{1,5})\s+(\S.*)$`)
+	orderedItemLine   = regexp.MustCompile(`^\s*(\.{1,5}|[0-9]+\.|[a-zA-Z]\.|[ivxIVX]+\))\s+(\S.*)$`)
+	calloutItemLine   = regexp.MustCompile(`^<([0-9]+|\.)>\s+(\S.*)$`)
+	labeledItemLine   = regexp.MustCompile(`^\s*\S.*?(:{2,4}|;;)(\s+.*)?$`)
+)
+
+// verbatim styles of blocks and paragraphs, whose content must not be reformatted
+var verbatimStyles = map[string]bool{
+	"source":    true,
+	"listing":   true,
+	"literal":   true,
+	"verse":     true,
+	"pass":      true,
+	"stem":      true,
+	"latexmath": true,
+	"asciimath": true,
+}
+
+func (f *formatter) currentFrame() *frame {
+	return f.frames[len(f.frames)-1]
+}
+
+func (f *formatter) process(line string) {
+	fr := f.currentFrame()
+	if fr.verbatim {
+		if strings.TrimRight(line, " \t") == fr.closing {
+			f.closeBlock()
+			return
+		}
+		if fr.char == '+' { // passthrough content is kept as-is
+			f.out = append(f.out, line)
+			return
+		}
+		f.out = append(f.out, strings.TrimRight(line, " \t"))
+		return
+	}
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" {
+		f.pendingBlank = true
+		f.prefixStart = -1
+		f.prefixVerbatim = false
+		f.inHeader = false
+		fr.paragraph = false
+		fr.literal = false
+		return
+	}
+	if fr.closing != "" && line == fr.closing {
+		f.pendingBlank = false // no blank line before the closing delimiter
+		f.closeBlock()
+		return
+	}
+	if fr.table {
+		f.flushBlank(line)
+		f.emit(line)
+		return
+	}
+	afterBlank := f.pendingBlank
+	f.flushBlank(line)
+	boundary := !fr.paragraph && !fr.literal
+	if afterBlank && !isListItem(line) && line != "+" && !strings.HasPrefix(line, "//") {
+		// any other element after a blank line ends the current list
+		f.endList()
+	}
+	switch {
+	case delimiterLine.MatchString(line):
+		f.openBlock(line)
+	case strings.HasPrefix(line, "//"):
+		// single line comment
+		if boundary {
+			f.startPrefix()
+		}
+		f.emit(line)
+	case fr.literal:
+		f.emit(line)
+	case boundary && strings.HasPrefix(line, "[") && attributesLine.MatchString(line):
+		f.startPrefix()
+		if !strings.HasPrefix(line, "[[") {
+			line = normalizeAttributes(line)
+			if style := firstAttribute(line); verbatimStyles[style] {
+				f.prefixVerbatim = true
+			}
+		}
+		f.emit(line)
+	case boundary && blockTitleLine.MatchString(line):
+		f.startPrefix()
+		f.emit(line)
+	case boundary && len(f.frames) == 1 && sectionTitleLine.MatchString(line):
+		f.endList()
+		if m := sectionTitleLine.FindStringSubmatch(line); len(m[1]) == 1 {
+			// document title
+			f.emit(line)
+			f.inHeader = true
+			f.resetPrefix()
+			return
+		}
+		f.ensureBlankBefore()
+		f.emit(line)
+		f.resetPrefix()
+		f.blankAfter = true
+	case boundary && includeLine.MatchString(line):
+		f.emit(line)
+		f.resetPrefix()
+	case boundary && blockMacroLine.MatchString(line):
+		f.ensureBlankBefore()
+		f.emit(line)
+		f.resetPrefix()
+		f.blankAfter = true
+	case boundary && attributeDeclLine.MatchString(line):
+		f.emit(line)
+		f.resetPrefix()
+	case line == "+":
+		// list item continuation
+		f.emit(line)
+		f.resetPrefix()
+		fr.paragraph = false
+	case (boundary || fr.inList) && unorderedItemLine.MatchString(line):
+		m := unorderedItemLine.FindStringSubmatch(line)
+		f.emit(strings.Repeat("*", f.levelOf(m[1])) + " " + m[2])
+		f.listItem()
+	case (boundary || fr.inList) && orderedItemLine.MatchString(line):
+		m := orderedItemLine.FindStringSubmatch(line)
+		f.emit(m[1] + " " + m[2])
+		f.listItem()
+	case (boundary || fr.inList) && calloutItemLine.MatchString(line):
+		m := calloutItemLine.FindStringSubmatch(line)
+		f.emit("<" + m[1] + "> " + m[2])
+		f.listItem()
+	case (boundary || fr.inList) && labeledItemLine.MatchString(line):
+		f.emit(line)
+		f.listItem()
+	case boundary && (line[0] == ' ' || line[0] == '\t'):
+		// literal paragraph
+		f.emit(line)
+		f.resetPrefix()
+		fr.literal = true
+	case boundary:
+		// first line of a paragraph
+		fr.splitting = f.config.sentencePerLine && !f.inHeader && !fr.inList && !f.prefixVerbatim
+		f.resetPrefix()
+		f.emitParagraphLine(line)
+		fr.paragraph = true
+	default:
+		// other line in the current paragraph or list item
+		f.emitParagraphLine(line)
+	}
+}
+
+func isListItem(line string) bool {
+	return unorderedItemLine.MatchString(line) ||
+		orderedItemLine.MatchString(line) ||
+		calloutItemLine.MatchString(line) ||
+		labeledItemLine.MatchString(line)
+}
+
+// emit appends the given line to the output
+func (f *formatter) emit(line string) {
+	f.out = append(f.out, line)
+}
+
+func (f *formatter) emitParagraphLine(line string) {
+	if f.currentFrame().splitting {
+		f.out = append(f.out, splitSentences(line)...)
+		return
+	}
+	f.emit(line)
+}
+
+// flushBlank adds the blank line(s) found in the source or required after the previous element,
+// except at the start of a delimited block, or before a list item continuation
+func (f *formatter) flushBlank(line string) {
+	needed := f.pendingBlank || (f.blankAfter && line != "+" && !calloutItemLine.MatchString(line))
+	f.pendingBlank = false
+	f.blankAfter = false
+	if needed && len(f.out) > f.currentFrame().start && f.out[len(f.out)-1] != "" {
+		f.out = append(f.out, "")
+	}
+}
+
+func (f *formatter) startPrefix() {
+	if f.prefixStart < 0 {
+		f.prefixStart = len(f.out)
+	}
+}
+
+func (f *formatter) resetPrefix() {
+	f.prefixStart = -1
+	f.prefixVerbatim = false
+}
+
+// ensureBlankBefore inserts a blank line before the block (and its prefix) which is about to be written,
+// unless it is at the start of the document/enclosing block or attached to a list item
+func (f *formatter) ensureBlankBefore() {
+	idx := len(f.out)
+	if f.prefixStart >= 0 {
+		idx = f.prefixStart
+	}
+	if idx == 0 || idx <= f.currentFrame().start {
+		return
+	}
+	if previous := f.out[idx-1]; previous == "" || previous == "+" {
+		return
+	}
+	f.out = append(f.out[:idx], append([]string{""}, f.out[idx:]...)...)
+}
+
+// levelOf returns the level of the unordered list item with the given marker
+func (f *formatter) levelOf(marker string) int {
+	fr := f.currentFrame()
+	if !fr.inList {
+		fr.markers = nil
+	}
+	for i, m := range fr.markers {
+		if m == marker {
+			return i + 1
+		}
+	}
+	fr.markers = append(fr.markers, marker)
+	return len(fr.markers)
+}
+
+func (f *formatter) listItem() {
+	fr := f.currentFrame()
+	fr.inList = true
+	fr.paragraph = true // subsequent lines are part of the item text
+	f.resetPrefix()
+}
+
+func (f *formatter) endList() {
+	fr := f.currentFrame()
+	fr.inList = false
+	fr.markers = nil
+}
+
+func (f *formatter) openBlock(line string) {
+	fr := f.currentFrame()
+	// a delimited block which is not attached to a list item ends the list
+	idx := len(f.out)
+	if f.prefixStart >= 0 {
+		idx = f.prefixStart
+	}
+	if idx == 0 || f.out[idx-1] != "+" {
+		f.endList()
+	}
+	f.ensureBlankBefore()
+	f.resetPrefix()
+	fr.paragraph = false
+	b := &frame{
+		closing: line,
+	}
+	canonical := line
+	switch {
+	case strings.HasPrefix(line, "```"):
+		b.verbatim = true
+	case line == "--":
+		// open block
+	default:
+		b.char = line[0]
+		b.table = b.char == '|' || b.char == '!' || b.char == ',' || b.char == ':'
+		canonical = canonicalDelimiter(b, f.depth(b.char))
+		switch b.char {
+		case '-', '.', '+', '/':
+			b.verbatim = true
+		}
+	}
+	f.emit(canonical)
+	b.start = len(f.out)
+	f.frames = append(f.frames, b)
+}
+
+// depth returns the number of open blocks with the given delimiter char
+func (f *formatter) depth(char byte) int {
+	depth := 0
+	for _, fr := range f.frames {
+		if fr.char == char {
+			depth++
+		}
+	}
+	return depth
+}
+
+// canonicalDelimiter returns the delimiter of the given block, with a length of 4 characters
+// (or 3 `=` after the cell separator for tables), plus 2 for each enclosing block with the same delimiter
+func canonicalDelimiter(b *frame, depth int) string {
+	if b.table {
+		return string(b.char) + strings.Repeat("=", 3+2*depth)
+	}
+	return strings.Repeat(string(b.char), 4+2*depth)
+}
+
+func (f *formatter) closeBlock() {
+	b := f.currentFrame()
+	f.frames = f.frames[:len(f.frames)-1]
+	canonical := b.closing
+	if b.char != 0 {
+		canonical = canonicalDelimiter(b, f.depth(b.char))
+		if f.conflicts(b, canonical) {
+			canonical = b.closing
+			f.out[b.start-1] = b.closing
+		}
+	}
+	f.emit(canonical)
+	f.blankAfter = true
+	log.Debugf("closed block with delimiter '%s'", b.closing)
+}
+
+// conflicts returns `true` if the content of the given verbatim block or table contains a line which matches
+// the given delimiter, in which case the delimiters of the source must be kept (otherwise the block would end
+// at this line, eg: a `------` listing block containing a `----` line)
+func (f *formatter) conflicts(b *frame, delimiter string) bool {
+	if !b.verbatim && !b.table {
+		return false
+	}
+	for _, l := range f.out[b.start:] {
+		if strings.TrimRight(l, " \t") == delimiter {
+			return true
+		}
+	}
+	return false
+}
+
+// result returns the formatted lines, without the trailing blank lines
+func (f *formatter) result() []string {
+	// blocks which are not closed in the source extend to the end of the document
+	for i := len(f.frames) - 1; i > 0; i-- {
+		if b := f.frames[i]; b.char != 0 && f.conflicts(b, f.out[b.start-1]) {
+			f.out[b.start-1] = b.closing
+		}
+	}
+	out := f.out
+	for len(out) > 0 && out[len(out)-1] == "" {
+		out = out[:len(out)-1]
+	}
+	return out
+}
+
+// normalizeAttributes removes the spaces around the commas and equal signs in the given attribute list
+// (except within quoted values)
+func normalizeAttributes(line string) string {
+	content := line[1 : len(line)-1]
+	result := &strings.Builder{}
+	result.WriteByte('[')
+	for i, attr := range splitAttributes(content) {
+		if i > 0 {
+			result.WriteByte(',')
+		}
+		attr = strings.TrimSpace(attr)
+		if idx := strings.Index(attr, "="); idx > 0 && !strings.HasPrefix(attr, "\"") {
+			attr = strings.TrimSpace(attr[:idx]) + "=" + strings.TrimSpace(attr[idx+1:])
+		}
+		result.WriteString(attr)
+	}
+	result.WriteByte(']')
+	return result.String()
+}
+
+// splitAttributes splits the given content on commas which are not within double quotes
+func splitAttributes(content string) []string {
+	attrs := []string{}
+	quoted := false
+	start := 0
+	for i, c := range content {
+		switch c {
+		case '"':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				attrs = append(attrs, content[start:i])
+				start = i + 1
+			}
+		}
+	}
+	return append(attrs, content[start:])
+}
+
+// firstAttribute returns the first positional attribute of the given (normalized) attribute list,
+// without its options, roles and id
+func firstAttribute(line string) string {
+	attrs := splitAttributes(line[1 : len(line)-1])
+	if len(attrs) == 0 || strings.Contains(attrs[0], "=") {
+		return ""
+	}
+	if idx := strings.IndexAny(attrs[0], "%#."); idx >= 0 {
+		return attrs[0][:idx]
+	}
+	return attrs[0]
+}
+
+// abbreviations which should not be considered as the end of a sentence
+var abbreviations = map[string]bool{
+	"e.g.": true,
+	"i.e.": true,
+	"etc.": true,
+	"vs.":  true,
+	"Mr.":  true,
+	"Mrs.": true,
+	"Ms.":  true,
+	"Dr.":  true,
+	"St.":  true,
+}
+
+// matches initials, such as in `J. Doe`
+var initial = regexp.MustCompile(`^\p{Lu}\.$`)
+
+// splitSentences splits the given line after each sentence.
+// A sentence ends with a `.`, `?` or `!` followed by a space and an uppercase letter, except
+// within inline macros or monospace/passthrough text, and unless the next line would be interpreted as a list item.
+func splitSentences(line string) []string {
+	result := []string{}
+	runes := []rune(line)
+	start := 0
+	brackets := 0
+	backticks := 0
+	plus := 0
+	for i := 0; i < len(runes)-2; i++ {
+		switch runes[i] {
+		case '[':
+			brackets++
+		case ']':
+			if brackets > 0 {
+				brackets--
+			}
+		case '`':
+			backticks++
+		case '+':
+			plus++
+		case '.', '?', '!':
+			if brackets > 0 || backticks%2 == 1 || plus%2 == 1 {
+				continue
+			}
+			if runes[i+1] != ' ' {
+				continue
+			}
+			j := i + 1
+			for j < len(runes) && runes[j] == ' ' {
+				j++
+			}
+			if j == len(runes) || !unicode.IsUpper(runes[j]) {
+				continue
+			}
+			words := strings.Fields(string(runes[start : i+1]))
+			if len(words) == 0 || abbreviations[words[len(words)-1]] || initial.MatchString(words[len(words)-1]) {
+				continue
+			}
+			next := string(runes[j:])
+			if labeledItemLine.MatchString(strings.SplitN(next, " ", 2)[0]) {
+				continue
+			}
+			result = append(result, string(runes[start:i+1]))
+			start = j
+			i = j - 1
+		}
+	}
+	return append(result, string(runes[start:]))
+}
@@ -0,0 +1,13 @@
+package formatter_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+func TestFormatter(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Formatter Suite")
+}
@@ -0,0 +1,408 @@
+package formatter_test
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/formatter"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("formatter", func() {
+
+	format := func(source string, settings ...formatter.Setting) string {
+		result := &bytes.Buffer{}
+		err := formatter.Format(strings.NewReader(source), result, settings...)
+		Expect(err).NotTo(HaveOccurred())
+		// also verify that the formatting is idempotent
+		again := &bytes.Buffer{}
+		err = formatter.Format(bytes.NewReader(result.Bytes()), again, settings...)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(again.String()).To(Equal(result.String()))
+		return result.String()
+	}
+
+	It("should remove trailing spaces and extra blank lines", func() {
+		source := "first paragraph  \t\n\n\n\nsecond paragraph \n\n\n"
+		expected := "first paragraph\n\nsecond paragraph\n"
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("should add blank lines around sections and blocks", func() {
+		source := `= Title
+:toc:
+== Section
+a paragraph
+----
+some code
+----
+<1> a callout
+another paragraph
+
+image::foo.png[]
+.Title
+[source,go]
+----
+func main() {
+}
+----
+last paragraph`
+		expected := `= Title
+:toc:
+
+== Section
+
+a paragraph
+
+----
+some code
+----
+<1> a callout
+another paragraph
+
+image::foo.png[]
+
+.Title
+[source,go]
+----
+func main() {
+}
+----
+
+last paragraph
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("should normalize delimiter lengths", func() {
+		source := `------
+some code
+------
+
+======
+an example
+====
+nested example
+====
+======`
+		expected := `----
+some code
+----
+
+====
+an example
+
+======
+nested example
+======
+====
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("should keep the delimiters of verbatim blocks containing a conflicting line", func() {
+		source := `------
+code
+----
+more
+------
+
+........
+ a literal block
+....
+........
+
+|=====
+a|
+----
+code
+----
+|===
+|=====`
+		expected := `------
+code
+----
+more
+------
+
+........
+ a literal block
+....
+........
+
+|=====
+a|
+----
+code
+----
+|===
+|=====
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("should keep the delimiters of an unclosed verbatim block containing a conflicting line", func() {
+		source := `------
+code
+----
+more`
+		Expect(format(source)).To(Equal(source + "\n"))
+	})
+
+	It("should normalize unordered list markers", func() {
+		source := `- item 1
+*   item 1.1
+**    item 1.1.1
+- item 2
+
+- item 3`
+		expected := `* item 1
+** item 1.1
+*** item 1.1.1
+* item 2
+
+* item 3
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("should not end a list with an attached block", func() {
+		source := `- item 1
++
+----
+code
+----
+- item 2`
+		expected := `* item 1
++
+----
+code
+----
+
+* item 2
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("should normalize attribute lists", func() {
+		source := `[ quote , "Famous, Person" , title = Foo ]
+____
+a quote
+____`
+		expected := `[quote,"Famous, Person",title=Foo]
+____
+a quote
+____
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("should preserve comments, file inclusions and verbatim content", func() {
+		source := `// a comment
+include::chapter.adoc[leveloffset=+1]
+
+////
+a comment block  
+////
+
+ a literal   paragraph
+
+----
+ code with    spaces
+   indented
+----`
+		expected := `// a comment
+include::chapter.adoc[leveloffset=+1]
+
+////
+a comment block
+////
+
+ a literal   paragraph
+
+----
+ code with    spaces
+   indented
+----
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	It("should not format table content", func() {
+		source := `|===
+| Column 1 | Column 2  
+
+| cell 1 | cell 2
+|===`
+		expected := `|===
+| Column 1 | Column 2
+
+| cell 1 | cell 2
+|===
+`
+		Expect(format(source)).To(Equal(expected))
+	})
+
+	Context("round-trip", func() {
+
+		// verifies that the formatted source is parsed into the same document as the original source,
+		// except for the blank lines that the formatter adds or removes in the delimited blocks
+		roundTrip := func(source string) {
+			expected, err := testsupport.ParseDocument(source)
+			Expect(err).NotTo(HaveOccurred())
+			actual, err := testsupport.ParseDocument(format(source))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(withoutBlankLines(actual)).To(testsupport.MatchDocument(withoutBlankLines(expected)))
+		}
+
+		It("should preserve tables", func() {
+			roundTrip(`a paragraph
+
+
+.Title
+[cols="1,2",options="header,footer"]
+|===
+| Column 1 | Column 2
+
+| cell 1 | cell 2
+2+| spanning cell
+| footer 1 | footer 2
+|===
+[format=csv]
+,===
+a,"b, c"
+,===
+another paragraph`)
+		})
+
+		It("should preserve nested delimited blocks", func() {
+			roundTrip(`.Example
+[example]
+====
+an example
+****
+a sidebar
+
+
+--
+an open block
+--
+[quote, Famous Person, Famous Book]
+____
+a quote
+____
+****
+[source, go]
+----
+func main() {
+}
+----
+====
+a paragraph`)
+		})
+
+		It("should preserve list item continuations", func() {
+			roundTrip(`* item 1
++
+----
+some code
+----
++
+an attached paragraph
+** item 1.1
++
+====
+an example
+====
+* item 2
++
+--
+an open block
+--
+. ordered item
++
+a paragraph`)
+		})
+	})
+
+	Context("sentence per line", func() {
+
+		It("should split sentences", func() {
+			source := `First sentence. Second sentence! Is it the third one? Yes, as written by J. Doe, e.g. Foo.`
+			expected := `First sentence.
+Second sentence!
+Is it the third one?
+Yes, as written by J. Doe, e.g. Foo.
+`
+			Expect(format(source, formatter.WithSentencePerLine(true))).To(Equal(expected))
+		})
+
+		It("should not split sentences in verbatim paragraphs and macros", func() {
+			source := `[source]
+First sentence. Second sentence.
+
+See link:https://example.com[Some text. More text] and ` + "`code. Here`" + `.`
+			Expect(format(source, formatter.WithSentencePerLine(true))).To(Equal(source + "\n"))
+		})
+
+		It("should not split sentences by default", func() {
+			source := `First sentence. Second sentence.`
+			Expect(format(source)).To(Equal(source + "\n"))
+		})
+	})
+})
+
+var blankLineType = reflect.TypeOf(types.BlankLine{})
+
+// withoutBlankLines returns a copy of the given document without the blank lines
+func withoutBlankLines(doc types.Document) types.Document {
+	return removeBlankLines(reflect.ValueOf(doc)).Interface().(types.Document)
+}
+
+func removeBlankLines(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Struct:
+		result := reflect.New(v.Type()).Elem()
+		result.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if result.Field(i).CanSet() {
+				result.Field(i).Set(removeBlankLines(v.Field(i)))
+			}
+		}
+		return result
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.MakeSlice(v.Type(), 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			e := v.Index(i)
+			if e.Kind() == reflect.Interface && !e.IsNil() && e.Elem().Type() == blankLineType {
+				continue
+			}
+			result = reflect.Append(result, removeBlankLines(e))
+		}
+		return result
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.New(v.Type()).Elem()
+		result.Set(removeBlankLines(v.Elem()))
+		return result
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.New(v.Type().Elem())
+		result.Elem().Set(removeBlankLines(v.Elem()))
+		return result
+	default:
+		return v
+	}
+}
@@ -0,0 +1,19 @@
+package parser
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+)
+
+// configurationKey the key to the configuration of the document being parsed in the parser's global store
+const configurationKey = "configuration"
+
+// isCustomAdmonition returns `true` if the given name is the name of a user-defined admonition (eg: `DANGER`)
+// registered in the configuration in the parser's global store
+func (c *current) isCustomAdmonition(name string) (bool, error) {
+	config, ok := c.globalStore[configurationKey].(configuration.Configuration)
+	if !ok {
+		return false, nil
+	}
+	_, found := config.CustomAdmonition(name)
+	return found, nil
+}
@@ -0,0 +1,221 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("bibliography", func() {
+
+	Context("draft documents", func() {
+
+		It("anchors with and without label", func() {
+			source := `* [[[pp]]] The Pragmatic Programmer
+* [[[gof, gang]]] Design Patterns`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.UnorderedListItem{
+						Level:       1,
+						BulletStyle: types.OneAsterisk,
+						CheckStyle:  types.NoCheck,
+						Elements: []interface{}{
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.BibliographyAnchor{
+											ID:    "pp",
+											Label: "pp",
+										},
+										types.StringElement{
+											Content: " The Pragmatic Programmer",
+										},
+									},
+								},
+							},
+						},
+					},
+					types.UnorderedListItem{
+						Level:       1,
+						BulletStyle: types.OneAsterisk,
+						CheckStyle:  types.NoCheck,
+						Elements: []interface{}{
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.BibliographyAnchor{
+											ID:    "gof",
+											Label: "gang",
+										},
+										types.StringElement{
+											Content: " Design Patterns",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+	})
+
+	Context("final documents", func() {
+
+		It("list in bibliography section", func() {
+			source := `see <<pp>>
+
+[bibliography]
+== References
+
+* [[[pp]]] The Pragmatic Programmer
+* [[[gof,gang]]] Design Patterns`
+			expected := types.Document{
+				ElementReferences: types.ElementReferences{
+					"_references": []interface{}{
+						types.StringElement{
+							Content: "References",
+						},
+					},
+					"pp": []interface{}{
+						types.StringElement{
+							Content: "[pp]",
+						},
+					},
+					"gof": []interface{}{
+						types.StringElement{
+							Content: "[gang]",
+						},
+					},
+				},
+				Elements: []interface{}{
+					types.Preamble{
+						Elements: []interface{}{
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: "see ",
+										},
+										types.InternalCrossReference{
+											ID: "pp",
+										},
+									},
+								},
+							},
+						},
+					},
+					types.Section{
+						Level: 1,
+						Attributes: types.Attributes{
+							types.AttrID:    "_references",
+							types.AttrStyle: types.BibliographyStyle,
+						},
+						Title: []interface{}{
+							types.StringElement{
+								Content: "References",
+							},
+						},
+						Elements: []interface{}{
+							types.UnorderedList{
+								Attributes: types.Attributes{
+									types.AttrStyle: types.BibliographyStyle,
+								},
+								Items: []types.UnorderedListItem{
+									{
+										Level:       1,
+										BulletStyle: types.OneAsterisk,
+										CheckStyle:  types.NoCheck,
+										Elements: []interface{}{
+											types.Paragraph{
+												Lines: [][]interface{}{
+													{
+														types.BibliographyAnchor{
+															ID:    "pp",
+															Label: "pp",
+														},
+														types.StringElement{
+															Content: " The Pragmatic Programmer",
+														},
+													},
+												},
+											},
+										},
+									},
+									{
+										Level:       1,
+										BulletStyle: types.OneAsterisk,
+										CheckStyle:  types.NoCheck,
+										Elements: []interface{}{
+											types.Paragraph{
+												Lines: [][]interface{}{
+													{
+														types.BibliographyAnchor{
+															ID:    "gof",
+															Label: "gang",
+														},
+														types.StringElement{
+															Content: " Design Patterns",
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("list with bibliography style", func() {
+			source := `[bibliography]
+* [[[pp]]] The Pragmatic Programmer`
+			expected := types.Document{
+				ElementReferences: types.ElementReferences{
+					"pp": []interface{}{
+						types.StringElement{
+							Content: "[pp]",
+						},
+					},
+				},
+				Elements: []interface{}{
+					types.UnorderedList{
+						Attributes: types.Attributes{
+							types.AttrStyle: types.BibliographyStyle,
+						},
+						Items: []types.UnorderedListItem{
+							{
+								Level:       1,
+								BulletStyle: types.OneAsterisk,
+								CheckStyle:  types.NoCheck,
+								Elements: []interface{}{
+									types.Paragraph{
+										Lines: [][]interface{}{
+											{
+												types.BibliographyAnchor{
+													ID:    "pp",
+													Label: "pp",
+												},
+												types.StringElement{
+													Content: " The Pragmatic Programmer",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+	})
+})
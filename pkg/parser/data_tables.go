@@ -0,0 +1,164 @@
+package parser
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// isDataTable returns `true` if the given attributes specify a table in the CSV, TSV or DSV format
+func isDataTable(attributes interface{}) (bool, error) {
+	attrs, err := types.NewAttributes(attributes)
+	if err != nil {
+		return false, nil // will be reported when parsing the table as a regular one
+	}
+	switch attrs.GetAsStringWithDefault(types.AttrFormat, types.PSVTableFormat) {
+	case types.CSVTableFormat, types.TSVTableFormat, types.DSVTableFormat:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// newDataTable initializes a new table from the given raw lines in the CSV, TSV or DSV format.
+// The `format` attribute (if set) takes precedence over the given default format.
+// The cells contain the raw values, on which the substitutions are applied in a subsequent processing phase.
+// An invalid separator is reported, and replaced with the default separator of the format.
+func newDataTable(format string, lines []interface{}, attributes interface{}, diagnostics types.DiagnosticSink) (types.Table, error) {
+	attrs, err := types.NewAttributes(attributes)
+	if err != nil {
+		return types.Table{}, errors.Wrap(err, "failed to initialize a Table element")
+	}
+	format = attrs.GetAsStringWithDefault(types.AttrFormat, format)
+	attrs = attrs.Set(types.AttrFormat, format)
+	separator := defaultSeparator(format)
+	if s, found := attrs.GetAsString(types.AttrSeparator); found && s != "" {
+		if sep := strings.ReplaceAll(s, `\t`, "\t"); isValidSeparator(format, sep) {
+			separator = sep
+		} else {
+			diagnostics.Report(types.Diagnostic{
+				Severity: types.SeverityWarning,
+				Code:     types.InvalidTableSeparatorCode,
+				Message:  fmt.Sprintf("invalid separator '%s' in table in the '%s' format, using the default separator instead", s, format),
+				Kind:     "Table",
+			})
+		}
+	}
+	content := make([]string, len(lines))
+	for i, l := range lines {
+		content[i], _ = l.(string)
+	}
+	// the first line is the header if it is followed by a blank line
+	var header interface{}
+	if len(content) > 1 && strings.TrimSpace(content[1]) == "" {
+		if records, err := readRecords(format, separator, content[:1]); err == nil && len(records) == 1 {
+			header = newDataTableLine(records[0])
+			content = content[1:]
+		}
+	}
+	records, err := readRecords(format, separator, content)
+	if err != nil {
+		return types.Table{}, errors.Wrapf(err, "failed to read the content of the table in the '%s' format", format)
+	}
+	rows := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		rows = append(rows, newDataTableLine(record))
+	}
+	return types.NewTable(header, rows, attrs, diagnostics)
+}
+
+func defaultSeparator(format string) string {
+	switch format {
+	case types.TSVTableFormat:
+		return "\t"
+	case types.DSVTableFormat:
+		return ":"
+	default:
+		return ","
+	}
+}
+
+// isValidSeparator returns `true` if the given separator can be used in the given format, ie, any separator
+// in the DSV format, or a single character (other than a quote or a newline) in the CSV and TSV formats
+func isValidSeparator(format, separator string) bool {
+	if format == types.DSVTableFormat {
+		return true
+	}
+	comma, size := utf8.DecodeRuneInString(separator)
+	return size == len(separator) && comma != utf8.RuneError && comma != '"' && comma != '\r' && comma != '\n'
+}
+
+// readRecords reads the records in the given lines, ignoring the blank lines
+func readRecords(format, separator string, lines []string) ([][]string, error) {
+	if format == types.DSVTableFormat {
+		return readDelimiterSeparatedRecords(separator, lines), nil
+	}
+	// CSV and TSV content are read as per RFC 4180, hence values may contain separators and newlines if they are quoted
+	comma, _ := utf8.DecodeRuneInString(separator)
+	r := csv.NewReader(strings.NewReader(strings.Join(lines, "\n")))
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+	// leading spaces are ignored, which allows for quoted values after spaces (unless the separator itself is a space)
+	r.TrimLeadingSpace = !unicode.IsSpace(comma)
+	records := [][]string{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return records, nil
+		} else if err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+}
+
+// readDelimiterSeparatedRecords reads a record per non-blank line, in which the separator can be escaped with a backslash
+func readDelimiterSeparatedRecords(separator string, lines []string) [][]string {
+	records := [][]string{}
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		record := []string{}
+		value := &strings.Builder{}
+		for len(line) > 0 {
+			switch {
+			case strings.HasPrefix(line, `\`+separator):
+				value.WriteString(separator)
+				line = line[len(separator)+1:]
+			case strings.HasPrefix(line, separator):
+				record = append(record, value.String())
+				value.Reset()
+				line = line[len(separator):]
+			default:
+				value.WriteByte(line[0])
+				line = line[1:]
+			}
+		}
+		records = append(records, append(record, value.String()))
+	}
+	return records
+}
+
+// newDataTableLine initializes a new table line with the given raw values
+func newDataTableLine(record []string) types.TableLine {
+	cells := make([]types.TableCell, len(record))
+	for i, value := range record {
+		cells[i].Elements = []interface{}{}
+		if value = strings.TrimSpace(value); value != "" {
+			cells[i].Elements = append(cells[i].Elements, types.StringElement{
+				Content: value,
+			})
+		}
+	}
+	return types.TableLine{
+		Cells: cells,
+	}
+}
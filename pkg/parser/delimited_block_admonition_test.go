@@ -1,6 +1,7 @@
 package parser_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -150,6 +151,94 @@ paragraphs
 				}
 				Expect(ParseDocument(source)).To(MatchDocument(expected))
 			})
+
+			It("example block as admonition with caption and icon", func() {
+				source := `[TIP,caption=Hint,icon=bulb]
+====
+foo
+====`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.ExampleBlock{
+							Attributes: types.Attributes{
+								types.AttrAdmonitionKind: types.Tip,
+								types.AttrCaption:        "Hint",
+								types.AttrIcon:           "bulb",
+							},
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "foo",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("example block as custom admonition", func() {
+				source := `[DANGER]
+====
+foo
+====`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.ExampleBlock{
+							Attributes: types.Attributes{
+								types.AttrAdmonitionKind: types.AdmonitionKind("danger"),
+							},
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "foo",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source, configuration.WithCustomAdmonition(configuration.CustomAdmonition{
+					Name: "DANGER",
+				}))).To(MatchDocument(expected))
+			})
+
+			It("example block with unregistered custom admonition", func() {
+				source := `[DANGER]
+====
+foo
+====`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.ExampleBlock{
+							Attributes: types.Attributes{
+								"DANGER": nil,
+							},
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "foo",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
 		})
 	})
 })
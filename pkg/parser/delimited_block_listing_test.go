@@ -421,6 +421,89 @@ func foo() {} <4>
 				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 			})
 
+			It("with callouts in line comments", func() {
+				source := `----
+import java.util.List; // <1>
+x = 1 # <2>
+<!--3-->
+----
+<1> an import
+<2> an assignment
+<3> an XML comment`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.ListingBlock{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{
+										Content: "import java.util.List; ",
+									},
+									types.Callout{
+										Ref: 1,
+									},
+								},
+								{
+									types.StringElement{
+										Content: "x = 1 ",
+									},
+									types.Callout{
+										Ref: 2,
+									},
+								},
+								{
+									types.Callout{
+										Ref: 3,
+									},
+								},
+							},
+						},
+						types.CalloutListItem{
+							Ref: 1,
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "an import",
+											},
+										},
+									},
+								},
+							},
+						},
+						types.CalloutListItem{
+							Ref: 2,
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "an assignment",
+											},
+										},
+									},
+								},
+							},
+						},
+						types.CalloutListItem{
+							Ref: 3,
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "an XML comment",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
 			It("with invalid callout", func() {
 				source := `----
 import <a>
@@ -0,0 +1,318 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("open blocks", func() {
+
+	Context("draft documents", func() {
+
+		Context("delimited blocks", func() {
+
+			It("with paragraph and list", func() {
+				source := `--
+some *bold* content
+
+* an item
+--`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.OpenBlock{
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "some ",
+											},
+											types.QuotedText{
+												Kind: types.Bold,
+												Elements: []interface{}{
+													types.StringElement{
+														Content: "bold",
+													},
+												},
+											},
+											types.StringElement{
+												Content: " content",
+											},
+										},
+									},
+								},
+								types.BlankLine{},
+								types.UnorderedListItem{
+									Level:       1,
+									BulletStyle: types.OneAsterisk,
+									CheckStyle:  types.NoCheck,
+									Elements: []interface{}{
+										types.Paragraph{
+											Lines: [][]interface{}{
+												{
+													types.StringElement{
+														Content: "an item",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("with abstract style and title", func() {
+				source := `[abstract]
+.a title
+--
+the abstract
+--`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.OpenBlock{
+							Attributes: types.Attributes{
+								"abstract":      nil,
+								types.AttrTitle: "a title",
+							},
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "the abstract",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("with empty content", func() {
+				source := `--
+--`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.OpenBlock{},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+		})
+
+		Context("masquerading", func() {
+
+			It("open block as source block", func() {
+				source := `[source,go]
+--
+package foo
+
+// *Foo*
+--`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.ListingBlock{
+							Attributes: types.Attributes{
+								types.AttrBlockKind: types.Source,
+								types.AttrLanguage:  "go",
+							},
+							Lines: [][]interface{}{
+								{
+									types.StringElement{
+										Content: "package foo",
+									},
+								},
+								{},
+								{
+									types.StringElement{
+										Content: "// *Foo*",
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("open block as sidebar block", func() {
+				source := `[sidebar]
+--
+content
+--`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.SidebarBlock{
+							Attributes: types.Attributes{
+								"sidebar": nil,
+							},
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "content",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("open block as admonition block", func() {
+				source := `[NOTE]
+--
+content
+--`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.ExampleBlock{
+							Attributes: types.Attributes{
+								types.AttrAdmonitionKind: types.Note,
+							},
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "content",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("example block as quote block", func() {
+				source := `[quote, john doe]
+====
+content
+====`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.QuoteBlock{
+							Attributes: types.Attributes{
+								types.AttrBlockKind:   types.Quote,
+								types.AttrQuoteAuthor: "john doe",
+							},
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "content",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("listing block as verse block", func() {
+				source := `[verse]
+----
+some *verse*
+----`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.VerseBlock{
+							Attributes: types.Attributes{
+								types.AttrBlockKind: types.Verse,
+							},
+							Lines: [][]interface{}{
+								{
+									types.StringElement{
+										Content: "some ",
+									},
+									types.QuotedText{
+										Kind: types.Bold,
+										Elements: []interface{}{
+											types.StringElement{
+												Content: "verse",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("literal block as source block", func() {
+				source := `[source]
+....
+some *code*
+....`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.ListingBlock{
+							Attributes: types.Attributes{
+								types.AttrBlockKind: types.Source,
+							},
+							Lines: [][]interface{}{
+								{
+									types.StringElement{
+										Content: "some *code*",
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("example block with unsupported style", func() {
+				source := `[source]
+====
+content
+====`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.ExampleBlock{
+							Attributes: types.Attributes{
+								types.AttrBlockKind: types.Source,
+							},
+							Elements: []interface{}{
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{
+												Content: "content",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+		})
+	})
+})
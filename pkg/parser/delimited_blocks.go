@@ -0,0 +1,73 @@
+package parser
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+// isVerbatimBlockStyle returns `true` if the given attributes set a style for which the content of a delimited block
+// is a set of raw lines (eg: `[source]` or `[verse]`) rather than a set of blocks
+func isVerbatimBlockStyle(attributes interface{}) (bool, error) {
+	attrs, err := types.NewAttributes(attributes)
+	if err != nil {
+		return false, nil // will be reported when parsing the block with its other content
+	}
+	style, _ := attrs.BlockStyle()
+	switch style {
+	case types.Source, types.Listing, types.Literal, types.Verse, types.Passthrough, types.Comment,
+		types.Stem, types.LatexMath, types.AsciiMath:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// newCompoundBlock initializes a new delimited block with the given elements. The kind of block is given by its style
+// (eg: `[sidebar]`) if it applies to a block with elements, or by the given kind (ie, the kind of its delimiters) otherwise
+func newCompoundBlock(kind types.BlockKind, elements []interface{}, attributes interface{}) (interface{}, error) {
+	attrs, err := types.NewAttributes(attributes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize a delimited block")
+	}
+	style, _ := attrs.BlockStyle()
+	for _, k := range []types.BlockKind{style, kind} {
+		switch k {
+		case types.Example, types.Admonition:
+			return types.NewExampleBlock(elements, attrs)
+		case types.Quote:
+			return types.NewQuoteBlock(elements, attrs)
+		case types.Sidebar:
+			return types.NewSidebarBlock(elements, attrs)
+		case types.Open, types.Abstract, types.PartIntro:
+			return types.NewOpenBlock(elements, attrs)
+		}
+	}
+	return nil, errors.Errorf("unsupported kind of delimited block: '%s'", kind)
+}
+
+// newVerbatimBlock initializes a new delimited block with the given lines. The kind of block is given by its style
+// (eg: `[source]`) if it applies to a block with raw lines, or by the given kind (ie, the kind of its delimiters) otherwise
+func newVerbatimBlock(kind types.BlockKind, lines []interface{}, attributes interface{}) (interface{}, error) {
+	attrs, err := types.NewAttributes(attributes)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to initialize a delimited block")
+	}
+	style, _ := attrs.BlockStyle()
+	for _, k := range []types.BlockKind{style, kind} {
+		switch k {
+		case types.Source, types.Listing:
+			return types.NewListingBlock(lines, attrs)
+		case types.Literal:
+			return types.NewLiteralBlock(types.LiteralBlockWithDelimiter, lines, attrs)
+		case types.Verse:
+			return types.NewVerseBlock(lines, attrs)
+		case types.Passthrough:
+			return types.NewPassthroughBlock(lines, attrs)
+		case types.Stem, types.LatexMath, types.AsciiMath:
+			return types.NewStemBlock(lines, attrs)
+		case types.Comment:
+			return types.NewCommentBlock(lines, attrs)
+		}
+	}
+	return nil, errors.Errorf("unsupported kind of delimited block: '%s'", kind)
+}
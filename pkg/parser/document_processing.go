@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"context"
 	"fmt"
 	"io"
 
@@ -12,12 +13,18 @@ import (
 
 // ParseDocument parses the content of the reader identitied by the filename
 func ParseDocument(r io.Reader, config configuration.Configuration, options ...Option) (types.Document, error) {
-	rawDoc, err := ParseRawDocument(r, config, options...)
+	return ParseDocumentContext(context.Background(), r, config, options...)
+}
+
+// ParseDocumentContext parses the content of the reader identitied by the filename,
+// and stops with the context's error as soon as the given context is done
+func ParseDocumentContext(ctx context.Context, r io.Reader, config configuration.Configuration, options ...Option) (types.Document, error) {
+	rawDoc, source, err := parseRawDocument(ctx, r, config, options...)
 	if err != nil {
 		return types.Document{}, err
 	}
 
-	draftDoc, err := ApplySubstitutions(rawDoc, config)
+	draftDoc, err := applySubstitutionsContext(ctx, rawDoc, source, config)
 	if err != nil {
 		return types.Document{}, err
 	}
@@ -43,7 +50,7 @@ func ParseDocument(r io.Reader, config configuration.Configuration, options ...O
 	doc = includePreamble(doc)
 	doc.Attributes = doc.Attributes.Add(draftDoc.Attributes)
 	// also insert the table of contents
-	doc = includeTableOfContentsPlaceHolder(doc)
+	doc = includeTableOfContentsPlaceHolder(doc, config)
 	// finally
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debug("final document:")
@@ -52,29 +59,93 @@ func ParseDocument(r io.Reader, config configuration.Configuration, options ...O
 	return doc, nil
 }
 
+// contextKey the key to the `context.Context` in the parser's global store
+const contextKey = "context"
+
+// checkContext stops the parsing if the context in the parser's global store is done.
+// Returning `false` would only make the current rule fail, and the parser would keep on backtracking
+// through the other alternatives (which can take a very long time with deeply nested quoted texts),
+// so instead, this func panics with the context's error, which the parser recovers from and returns.
+func (c *current) checkContext() (bool, error) {
+	if ctx, ok := c.globalStore[contextKey].(context.Context); ok {
+		if err := ctx.Err(); err != nil {
+			panic(err)
+		}
+	}
+	return true, nil
+}
+
+// interruptedParseError returns the error of the given context if it is done (ie, the parsing was interrupted
+// by a failed `checkContext()`), or the given error otherwise
+func interruptedParseError(ctx context.Context, err error) error {
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+// diagnostics returns the sink of the diagnostics reported while parsing the current element, which sends them
+// to the configuration in the parser's global store (if any), at the position of the current element by default
+func (c *current) diagnostics() types.DiagnosticSink {
+	config, _ := c.globalStore[configurationKey].(configuration.Configuration)
+	position := c.position()
+	return func(d types.Diagnostic) {
+		if d.Filename == "" && d.Line == 0 {
+			d.Filename = position.Filename
+			d.Line = position.Line
+		}
+		config.Report(d)
+	}
+}
+
+// maxTableCellFactor returns the maximum duplication factor and column span of the table cells,
+// as set in the configuration in the parser's global store (or the default value if there is no configuration)
+func (c *current) maxTableCellFactor() int {
+	if config, ok := c.globalStore[configurationKey].(configuration.Configuration); ok {
+		return config.MaxTableCellFactor
+	}
+	return configuration.DefaultMaxTableCellFactor
+}
+
 // ContextKey a non-built-in type for keys in the context
 type ContextKey string
 
 // LevelOffset the key for the level offset of the file to include
 const LevelOffset ContextKey = "leveloffset"
 
+// configurationContextKey the key for the configuration of the document while applying the substitutions
+// (needed when parsing the content of AsciiDoc table cells)
+const configurationContextKey ContextKey = "configuration"
+
 // ParseRawDocument parses a document's content and applies the preprocessing directives (file inclusions)
 func ParseRawDocument(r io.Reader, config configuration.Configuration, options ...Option) (types.RawDocument, error) {
+	rawDoc, _, err := parseRawDocument(context.Background(), r, config, options...)
+	return rawDoc, err
+}
+
+// parseRawDocument parses the raw document, and also returns the map of the preprocessed source,
+// which is used to locate the elements in the main document or in the included files
+func parseRawDocument(ctx context.Context, r io.Reader, config configuration.Configuration, options ...Option) (types.RawDocument, *sourceMap, error) {
 	// first, let's find all file inclusions and replace with the actual content to include
-	source, err := ParseRawSource(r, config, options...)
+	source, positions, err := parseRawSourceContext(ctx, r, config, options...)
 	if err != nil {
-		return types.RawDocument{}, err
+		return types.RawDocument{}, nil, err
 	}
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debug("source to parse:")
 		fmt.Fprintf(log.StandardLogger().Out, "'%s'\n", source)
 	}
 	// then let's parse the "source" to detect raw blocks
-	if result, err := Parse(config.Filename, source, append(options, Entrypoint("RawDocument"))...); err != nil {
-		return types.RawDocument{}, err
+	// the context is checked before parsing each block
+	m := newSourceMap(source, positions)
+	if result, err := Parse(config.Filename, source, append(options, Entrypoint("RawDocument"), GlobalStore(contextKey, ctx), GlobalStore(configurationKey, config), GlobalStore(sourceMapKey, m))...); err != nil {
+		if ctx.Err() != nil {
+			return types.RawDocument{}, nil, ctx.Err()
+		}
+		return types.RawDocument{}, nil, err
 	} else if doc, ok := result.(types.RawDocument); ok {
-		return doc, nil
+		return doc, m, nil
 	} else {
-		return types.RawDocument{}, fmt.Errorf("unexpected type of raw lines: '%T'", result)
+		return types.RawDocument{}, nil, fmt.Errorf("unexpected type of raw lines: '%T'", result)
 	}
 }
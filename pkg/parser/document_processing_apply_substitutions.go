@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"context"
 	"fmt"
 	"path/filepath"
 	"strconv"
@@ -20,19 +21,40 @@ import (
 // ApplySubstitutions applies all the substitutions on delimited blocks, standalone paragraphs and paragraphs
 // in continued list items, and then attribute substitutions, and as a result returns a `DraftDocument`.
 func ApplySubstitutions(rawDoc types.RawDocument, config configuration.Configuration) (types.DraftDocument, error) {
+	return applySubstitutionsContext(context.Background(), rawDoc, nil, config)
+}
+
+// applySubstitutionsContext applies the substitutions on the given raw document, whose elements are located
+// in the given source (if not `nil`)
+func applySubstitutionsContext(ctx context.Context, rawDoc types.RawDocument, source *sourceMap, config configuration.Configuration) (types.DraftDocument, error) {
+	ctx = context.WithValue(ctx, configurationContextKey, config)
 	attrs := types.AttributesWithOverrides{
-		Content:   types.Attributes{},
-		Overrides: config.AttributeOverrides,
-		Counters:  map[string]interface{}{},
+		Content:     types.Attributes{},
+		Overrides:   config.AttributeOverrides,
+		Counters:    map[string]interface{}{},
+		Diagnostics: config.Report,
 	}
 	// also, add all front-matter key/values
 	attrs.Add(rawDoc.FrontMatter.Content)
 	// also, add all AttributeDeclaration at the top of the document
 	attrs.Add(rawDoc.Attributes())
 
-	elements, err := applySubstitutions(rawDoc.Elements, attrs)
-	if err != nil {
-		return types.DraftDocument{}, err
+	var elements []interface{}
+	if ranges := source.blockRanges(rawDoc.Elements); ranges != nil {
+		// apply the substitutions on each element with the range of lines in which it was found in the source
+		elements = make([]interface{}, 0, len(rawDoc.Elements))
+		for i, e := range rawDoc.Elements {
+			r, err := applySubstitutions(context.WithValue(ctx, sourceRangeKey, ranges[i]), []interface{}{e}, attrs)
+			if err != nil {
+				return types.DraftDocument{}, err
+			}
+			elements = append(elements, r...)
+		}
+	} else {
+		var err error
+		if elements, err = applySubstitutions(ctx, rawDoc.Elements, attrs); err != nil {
+			return types.DraftDocument{}, err
+		}
 	}
 	if len(elements) == 0 {
 		elements = nil // avoid carrying empty slice
@@ -45,12 +67,15 @@ func ApplySubstitutions(rawDoc types.RawDocument, config configuration.Configura
 }
 
 // applySubstitutions applies the substitutions on paragraphs and delimited blocks (including when in continued list elements)
-func applySubstitutions(elements []interface{}, attrs types.AttributesWithOverrides) ([]interface{}, error) {
+func applySubstitutions(ctx context.Context, elements []interface{}, attrs types.AttributesWithOverrides) ([]interface{}, error) {
 	if len(elements) == 0 {
 		return nil, nil
 	}
 	result := make([]interface{}, 0, len(elements))
 	for _, e := range elements {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		var err error
 		switch e := e.(type) {
 		case types.BlockWithElementSubstitution:
@@ -58,7 +83,7 @@ func applySubstitutions(elements []interface{}, attrs types.AttributesWithOverri
 			if err != nil {
 				return nil, err
 			}
-			elements, err := applySubstitutionsOnElements(e.ElementsToSubstitute(), subs, attrs)
+			elements, err := applySubstitutionsOnElements(ctx, e.ElementsToSubstitute(), subs, attrs)
 			if err != nil {
 				return nil, err
 			}
@@ -68,31 +93,51 @@ func applySubstitutions(elements []interface{}, attrs types.AttributesWithOverri
 			if err != nil {
 				return nil, err
 			}
-			elements, err := applySubstitutionsOnLines(e.LinesToSubstitute(), subs, attrs)
+			elements, err := applySubstitutionsOnLines(ctx, e.LinesToSubstitute(), subs, attrs)
 			if err != nil {
 				return nil, err
 			}
 			result = append(result, e.ReplaceLines(elements))
 		case types.MarkdownQuoteBlock: // slightly different since there is an extraction for the author attributions
-			e, err := applySubstitutionsOnMarkdownQuoteBlock(e, attrs)
+			e, err := applySubstitutionsOnMarkdownQuoteBlock(ctx, e, attrs)
 			if err != nil {
 				return nil, err
 			}
 			result = append(result, e)
 		case types.ContinuedListItemElement:
-			r, err := applySubstitutions([]interface{}{e.Element}, attrs)
+			r, err := applySubstitutions(ctx, []interface{}{e.Element}, attrs)
 			if err != nil {
 				return nil, err
 			}
 			e.Element = r[0]
 			result = append(result, e)
 		case types.ImageBlock:
-			if e, err = applySubstitutionsOnImageBlock(e, attrs); err != nil {
+			if e, err = applySubstitutionsOnImageBlock(ctx, e, attrs); err != nil {
+				return nil, err
+			}
+			result = append(result, e)
+		case types.VideoBlock:
+			if e, err = applySubstitutionsOnVideoBlock(ctx, e, attrs); err != nil {
+				return nil, err
+			}
+			result = append(result, e)
+		case types.AudioBlock:
+			if e, err = applySubstitutionsOnAudioBlock(ctx, e, attrs); err != nil {
 				return nil, err
 			}
 			result = append(result, e)
 		case types.Section:
-			if e, err = applySubstitutionsOnSection(e, attrs); err != nil {
+			if e, err = applySubstitutionsOnSection(ctx, e, attrs); err != nil {
+				return nil, err
+			}
+			result = append(result, e)
+		case types.DiscreteHeading:
+			if e, err = applySubstitutionsOnDiscreteHeading(ctx, e, attrs); err != nil {
+				return nil, err
+			}
+			result = append(result, e)
+		case types.Table:
+			if e, err = applySubstitutionsOnTable(ctx, e, attrs); err != nil {
 				return nil, err
 			}
 			result = append(result, e)
@@ -142,6 +187,7 @@ var defaultSubstitutionsForBlockElements = []string{
 var defaultExampleBlockSubstitutions = defaultSubstitutionsForBlockElements
 var defaultQuoteBlockSubstitutions = defaultSubstitutionsForBlockElements
 var defaultSidebarBlockSubstitutions = defaultSubstitutionsForBlockElements
+var defaultOpenBlockSubstitutions = defaultSubstitutionsForBlockElements
 var defaultVerseBlockSubstitutions = defaultSubstitutionsForBlockElements // even though it's a block of lines, not a block of blocks
 var defaultParagraphSubstitutions = defaultSubstitutionsForBlockElements  // even though it's a block of lines, not a block of blocks
 
@@ -156,9 +202,10 @@ var defaultLiteralBlockSubstitutions = defaultSubstitutionsForBlockLines
 
 // other blocks
 var defaultPassthroughBlockSubstitutions = []string{}
+var defaultStemBlockSubstitutions = []string{"specialcharacters"}
 var defaultCommentBlockSubstitutions = []string{"none"}
 
-func applySubstitutionsOnMarkdownQuoteBlock(b types.MarkdownQuoteBlock, attrs types.AttributesWithOverrides) (types.MarkdownQuoteBlock, error) {
+func applySubstitutionsOnMarkdownQuoteBlock(ctx context.Context, b types.MarkdownQuoteBlock, attrs types.AttributesWithOverrides) (types.MarkdownQuoteBlock, error) {
 	funcs := []elementsSubstitution{
 		substituteInlinePassthrough,
 		substituteSpecialCharacters,
@@ -183,7 +230,7 @@ func applySubstitutionsOnMarkdownQuoteBlock(b types.MarkdownQuoteBlock, attrs ty
 	// apply all the substitutions
 	var err error
 	for _, sub := range funcs {
-		if b.Lines, err = sub(b.Lines, attrs); err != nil {
+		if b.Lines, err = sub(ctx, b.Lines, attrs); err != nil {
 			return types.MarkdownQuoteBlock{}, err
 		}
 	}
@@ -286,6 +333,8 @@ func defaultSubstitutionsFor(block interface{}) []string {
 		return defaultQuoteBlockSubstitutions
 	case types.SidebarBlock:
 		return defaultSidebarBlockSubstitutions
+	case types.OpenBlock:
+		return defaultOpenBlockSubstitutions
 	case types.FencedBlock:
 		return defaultFencedBlockSubstitutions
 	case types.ListingBlock:
@@ -296,6 +345,8 @@ func defaultSubstitutionsFor(block interface{}) []string {
 		return defaultLiteralBlockSubstitutions
 	case types.PassthroughBlock:
 		return defaultPassthroughBlockSubstitutions
+	case types.StemBlock:
+		return defaultStemBlockSubstitutions
 	case types.CommentBlock:
 		return defaultCommentBlockSubstitutions
 	case types.Paragraph:
@@ -314,7 +365,7 @@ func defaultSubstitutionsFor(block interface{}) []string {
 	}
 }
 
-func applySubstitutionsOnElements(elements []interface{}, subs []elementsSubstitution, attrs types.AttributesWithOverrides) ([]interface{}, error) {
+func applySubstitutionsOnElements(ctx context.Context, elements []interface{}, subs []elementsSubstitution, attrs types.AttributesWithOverrides) ([]interface{}, error) {
 	// var err error
 	// apply all the substitutions on blocks that need to be processed
 	for i, element := range elements {
@@ -322,17 +373,24 @@ func applySubstitutionsOnElements(elements []interface{}, subs []elementsSubstit
 		switch e := element.(type) {
 		// if the block contains a block...
 		case types.BlockWithElementSubstitution:
-			lines, err := applySubstitutionsOnElements(e.ElementsToSubstitute(), subs, attrs)
+			lines, err := applySubstitutionsOnElements(ctx, e.ElementsToSubstitute(), subs, attrs)
 			if err != nil {
 				return nil, err
 			}
 			elements[i] = e.ReplaceElements(lines)
 		case types.BlockWithLineSubstitution:
-			lines, err := applySubstitutionsOnLines(e.LinesToSubstitute(), subs, attrs)
+			lines, err := applySubstitutionsOnLines(ctx, e.LinesToSubstitute(), subs, attrs)
 			if err != nil {
 				return nil, err
 			}
 			elements[i] = e.ReplaceLines(lines)
+		case types.DiscreteHeading:
+			// the heading title has its own set of substitutions, regardless of the enclosing block
+			h, err := applySubstitutionsOnDiscreteHeading(ctx, e, attrs)
+			if err != nil {
+				return nil, err
+			}
+			elements[i] = h
 		default:
 			log.Debugf("nothing to substitute on element of type '%T'", element)
 			// do nothing
@@ -341,10 +399,10 @@ func applySubstitutionsOnElements(elements []interface{}, subs []elementsSubstit
 	return elements, nil
 }
 
-func applySubstitutionsOnLines(lines [][]interface{}, subs []elementsSubstitution, attrs types.AttributesWithOverrides) ([][]interface{}, error) {
+func applySubstitutionsOnLines(ctx context.Context, lines [][]interface{}, subs []elementsSubstitution, attrs types.AttributesWithOverrides) ([][]interface{}, error) {
 	var err error
 	for _, sub := range subs {
-		if lines, err = sub(lines, attrs); err != nil {
+		if lines, err = sub(ctx, lines, attrs); err != nil {
 			return nil, err
 		}
 	}
@@ -356,8 +414,32 @@ func applySubstitutionsOnLines(lines [][]interface{}, subs []elementsSubstitutio
 // ----------------------------------------------------------------------------
 
 // applies the elements and attributes substitutions on the given section title.
-func applySubstitutionsOnSection(s types.Section, attrs types.AttributesWithOverrides) (types.Section, error) {
-	elements := [][]interface{}{s.Title} // wrap to match the `elementsSubstitution` arg type
+func applySubstitutionsOnSection(ctx context.Context, s types.Section, attrs types.AttributesWithOverrides) (types.Section, error) {
+	var err error
+	if s.Title, err = applySubstitutionsOnTitle(ctx, s.Title, attrs); err != nil {
+		return types.Section{}, err
+	}
+	if s, err = s.ResolveID(attrs); err != nil {
+		return types.Section{}, err
+	}
+	if log.IsLevelEnabled(log.DebugLevel) {
+		log.Debugf("section title after substitution:")
+		spew.Fdump(log.StandardLogger().Out, s.Title)
+	}
+	return s, nil
+}
+
+// applies the elements and attributes substitutions on the given discrete heading title.
+func applySubstitutionsOnDiscreteHeading(ctx context.Context, h types.DiscreteHeading, attrs types.AttributesWithOverrides) (types.DiscreteHeading, error) {
+	var err error
+	if h.Title, err = applySubstitutionsOnTitle(ctx, h.Title, attrs); err != nil {
+		return types.DiscreteHeading{}, err
+	}
+	return h.ResolveID(attrs)
+}
+
+func applySubstitutionsOnTitle(ctx context.Context, title []interface{}, attrs types.AttributesWithOverrides) ([]interface{}, error) {
+	elements := [][]interface{}{title} // wrap to match the `elementsSubstitution` arg type
 	subs := []elementsSubstitution{
 		substituteInlinePassthrough,
 		substituteSpecialCharacters,
@@ -369,19 +451,123 @@ func applySubstitutionsOnSection(s types.Section, attrs types.AttributesWithOver
 	}
 	var err error
 	for _, sub := range subs {
-		if elements, err = sub(elements, attrs); err != nil {
-			return types.Section{}, err
+		if elements, err = sub(ctx, elements, attrs); err != nil {
+			return nil, err
 		}
 	}
-	s.Title = elements[0]
-	if s, err = s.ResolveID(attrs); err != nil {
-		return types.Section{}, err
+	return elements[0], nil
+}
+
+// ----------------------------------------------------------------------------
+// Table substitutions
+// ----------------------------------------------------------------------------
+
+// applies the substitutions on the raw content of the cells of the given table, according to their style:
+// the content of the AsciiDoc cells (`a` style) is parsed as a standalone document with its own attribute scope,
+// the literal cells (`l` style) only get the special characters substitution, and all other cells get the normal substitutions.
+func applySubstitutionsOnTable(ctx context.Context, t types.Table, attrs types.AttributesWithOverrides) (types.Table, error) {
+	var err error
+	for i, cell := range t.Header.Cells {
+		if t.Header.Cells[i].Elements, err = applySubstitutionsOnCell(ctx, cell.Elements, normalCellSubstitutions, attrs); err != nil {
+			return types.Table{}, err
+		}
 	}
-	if log.IsLevelEnabled(log.DebugLevel) {
-		log.Debugf("section title after substitution:")
-		spew.Fdump(log.StandardLogger().Out, s.Title)
+	columns := t.CellColumns()
+	for i, l := range t.Lines {
+		if err = applySubstitutionsOnLine(ctx, l, t.Columns, columns[i], attrs); err != nil {
+			return types.Table{}, err
+		}
 	}
-	return s, nil
+	if err = applySubstitutionsOnLine(ctx, t.Footer, t.Columns, t.Footer.CellColumns(), attrs); err != nil {
+		return types.Table{}, err
+	}
+	return t, nil
+}
+
+// applies the substitutions on the cells of the given line, according to their style or the style of their column
+// (given its offset)
+func applySubstitutionsOnLine(ctx context.Context, l types.TableLine, columns []types.TableColumn, offsets []int, attrs types.AttributesWithOverrides) error {
+	for i, cell := range l.Cells {
+		var err error
+		switch cell.StyleIn(columns[offsets[i]%len(columns)]) {
+		case "a":
+			cell.Elements, err = parseAsciiDocCell(ctx, cell.Elements, attrs)
+		case "l":
+			cell.Elements, err = applySubstitutionsOnCell(ctx, cell.Elements, literalCellSubstitutions, attrs)
+		default:
+			cell.Elements, err = applySubstitutionsOnCell(ctx, cell.Elements, normalCellSubstitutions, attrs)
+		}
+		if err != nil {
+			return err
+		}
+		l.Cells[i] = cell
+	}
+	return nil
+}
+
+var normalCellSubstitutions = []elementsSubstitution{
+	substituteInlinePassthrough,
+	substituteSpecialCharacters,
+	substituteQuotedTexts,
+	substituteAttributes,
+	substituteReplacements,
+	substituteInlineMacros,
+	substitutePostReplacements,
+}
+
+var literalCellSubstitutions = []elementsSubstitution{
+	substituteSpecialCharacters,
+}
+
+func applySubstitutionsOnCell(ctx context.Context, elements []interface{}, subs []elementsSubstitution, attrs types.AttributesWithOverrides) ([]interface{}, error) {
+	lines := [][]interface{}{elements} // wrap to match the `elementsSubstitution` arg type
+	var err error
+	for _, sub := range subs {
+		if lines, err = sub(ctx, lines, attrs); err != nil {
+			return nil, err
+		}
+	}
+	return lines[0], nil
+}
+
+// parseAsciiDocCell parses the raw content of an AsciiDoc cell as a standalone document.
+// The attributes declared in the cell do not affect the rest of the document.
+func parseAsciiDocCell(ctx context.Context, elements []interface{}, attrs types.AttributesWithOverrides) ([]interface{}, error) {
+	content := &strings.Builder{}
+	for _, e := range elements {
+		if e, ok := e.(types.StringElement); ok {
+			content.WriteString(e.Content)
+		}
+	}
+	if content.Len() == 0 {
+		return []interface{}{}, nil
+	}
+	options := []Option{
+		Entrypoint("RawDocument"),
+		GlobalStore(contextKey, ctx),
+	}
+	// the configuration is needed to parse the user-defined admonitions, and to report the diagnostics
+	if config, ok := ctx.Value(configurationContextKey).(configuration.Configuration); ok {
+		options = append(options, GlobalStore(configurationKey, config))
+	}
+	result, err := Parse("", []byte(content.String()), options...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse the content of the table cell")
+	}
+	rawDoc, ok := result.(types.RawDocument)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type of table cell content: '%T'", result)
+	}
+	attrs = attrs.Clone()
+	attrs.Add(rawDoc.Attributes())
+	blocks, err := applySubstitutions(ctx, rawDoc.Elements, attrs)
+	if err != nil {
+		return nil, err
+	}
+	if blocks, err = rearrangeListItems(blocks, false); err != nil {
+		return nil, err
+	}
+	return filter(blocks, allMatchers...), nil
 }
 
 // ----------------------------------------------------------------------------
@@ -389,17 +575,15 @@ func applySubstitutionsOnSection(s types.Section, attrs types.AttributesWithOver
 // ----------------------------------------------------------------------------
 
 // applies the elements and attributes substitutions on the given image block.
-func applySubstitutionsOnImageBlock(b types.ImageBlock, attrs types.AttributesWithOverrides) (types.ImageBlock, error) {
-	elements := [][]interface{}{b.Location.Path} // wrap to match the `elementsSubstitution` arg type
-	subs := []elementsSubstitution{substituteAttributes}
+func applySubstitutionsOnImageBlock(ctx context.Context, b types.ImageBlock, attrs types.AttributesWithOverrides) (types.ImageBlock, error) {
 	var err error
-	for _, sub := range subs {
-		if elements, err = sub(elements, attrs); err != nil {
-			return types.ImageBlock{}, err
-		}
+	if b.Location, err = applySubstitutionsOnLocation(ctx, b.Location, attrs); err != nil {
+		return types.ImageBlock{}, err
 	}
-	b.Location.Path = elements[0]
 	b.Location = b.Location.WithPathPrefix(attrs.GetAsStringWithDefault("imagesdir", ""))
+	if fallback, found := b.Attributes.GetAsString(types.AttrImageFallback); found && fallback != "" {
+		b.Attributes = b.Attributes.Set(types.AttrImageFallback, withImagesDir(fallback, attrs))
+	}
 	if !b.Attributes.Has(types.AttrImageAlt) {
 		alt := filepath.Base(b.Location.Stringify())
 		ext := filepath.Ext(alt)
@@ -414,13 +598,63 @@ func applySubstitutionsOnImageBlock(b types.ImageBlock, attrs types.AttributesWi
 	return b, nil
 }
 
+// applies the attributes substitutions on the given location
+func applySubstitutionsOnLocation(ctx context.Context, l types.Location, attrs types.AttributesWithOverrides) (types.Location, error) {
+	elements := [][]interface{}{l.Path} // wrap to match the `elementsSubstitution` arg type
+	subs := []elementsSubstitution{substituteAttributes}
+	var err error
+	for _, sub := range subs {
+		if elements, err = sub(ctx, elements, attrs); err != nil {
+			return types.Location{}, err
+		}
+	}
+	l.Path = elements[0]
+	return l, nil
+}
+
+// withImagesDir returns the given path prefixed with the `imagesdir`, unless it is absolute or a URL
+func withImagesDir(path string, attrs types.AttributesWithOverrides) string {
+	l, _ := types.NewLocation("", []interface{}{path})
+	return l.WithPathPrefix(attrs.GetAsStringWithDefault("imagesdir", "")).Stringify()
+}
+
+// ----------------------------------------------------------------------------
+// Video and Audio Block substitutions
+// ----------------------------------------------------------------------------
+
+// applies the attributes substitutions on the given video block.
+// Unless the video is hosted on YouTube or Vimeo, its location and its poster are relative to the `imagesdir`
+func applySubstitutionsOnVideoBlock(ctx context.Context, b types.VideoBlock, attrs types.AttributesWithOverrides) (types.VideoBlock, error) {
+	var err error
+	if b.Location, err = applySubstitutionsOnLocation(ctx, b.Location, attrs); err != nil {
+		return types.VideoBlock{}, err
+	}
+	if b.Provider() == "" {
+		b.Location = b.Location.WithPathPrefix(attrs.GetAsStringWithDefault("imagesdir", ""))
+		if poster, found := b.Attributes.GetAsString(types.AttrPoster); found && poster != "" {
+			b.Attributes = b.Attributes.Set(types.AttrPoster, withImagesDir(poster, attrs))
+		}
+	}
+	return b, nil
+}
+
+// applies the attributes substitutions on the given audio block. Its location is relative to the `imagesdir`
+func applySubstitutionsOnAudioBlock(ctx context.Context, b types.AudioBlock, attrs types.AttributesWithOverrides) (types.AudioBlock, error) {
+	var err error
+	if b.Location, err = applySubstitutionsOnLocation(ctx, b.Location, attrs); err != nil {
+		return types.AudioBlock{}, err
+	}
+	b.Location = b.Location.WithPathPrefix(attrs.GetAsStringWithDefault("imagesdir", ""))
+	return b, nil
+}
+
 // ----------------------------------------------------------------------------
 // Individual substitution funcs
 // ----------------------------------------------------------------------------
 
 // includes a call to `elementsSubstitution` with some post-processing on the result
-var substituteAttributes = func(lines [][]interface{}, attrs types.AttributesWithOverrides) ([][]interface{}, error) {
-	lines, err := newElementsSubstitution("AttributeSubs", "AttributeSubs")(lines, attrs)
+var substituteAttributes = func(ctx context.Context, lines [][]interface{}, attrs types.AttributesWithOverrides) ([][]interface{}, error) {
+	lines, err := newElementsSubstitution("AttributeSubs", "AttributeSubs")(ctx, lines, attrs)
 	if err != nil {
 		return nil, err
 	}
@@ -449,35 +683,41 @@ var (
 	substituteCallouts          = newElementsSubstitution("CalloutSubs", "CalloutSubs")
 )
 
-type elementsSubstitution func(lines [][]interface{}, attrs types.AttributesWithOverrides) ([][]interface{}, error)
+type elementsSubstitution func(ctx context.Context, lines [][]interface{}, attrs types.AttributesWithOverrides) ([][]interface{}, error)
 
 func newElementsSubstitution(contentRuleName, placeholderRuleName string) elementsSubstitution {
-	return func(lines [][]interface{}, attrs types.AttributesWithOverrides) ([][]interface{}, error) {
+	return func(ctx context.Context, lines [][]interface{}, attrs types.AttributesWithOverrides) ([][]interface{}, error) {
 		log.Debugf("applying the '%s' rule on elements", contentRuleName)
 		placeholders := newPlaceHolders()
 		s := serializeLines(lines, placeholders)
-		imagesdirOption := GlobalStore("imagesdir", attrs.GetAsStringWithDefault("imagesdir", ""))
+		_, experimental := attrs.GetAsString(types.AttrExperimental)
+		options := []Option{
+			GlobalStore(contextKey, ctx),
+			GlobalStore("imagesdir", attrs.GetAsStringWithDefault("imagesdir", "")),
+			GlobalStore(experimentalKey, experimental),
+			GlobalStore(placeholdersKey, placeholders),
+		}
 		// process placeholder content (eg: quoted text may contain an inline link)
 		for ref, placeholder := range placeholders.elements {
 			switch placeholder := placeholder.(type) { // TODO: create `PlaceHolder` interface?
 			case types.QuotedString:
 				var err error
-				if placeholder.Elements, err = parserPlaceHolderElements(placeholder.Elements, imagesdirOption, Entrypoint(placeholderRuleName)); err != nil {
-					return nil, err
+				if placeholder.Elements, err = parserPlaceHolderElements(placeholder.Elements, append(options, Entrypoint(placeholderRuleName))...); err != nil {
+					return nil, interruptedParseError(ctx, err)
 				}
 				placeholders.elements[ref] = placeholder
 			case types.QuotedText:
 				var err error
-				if placeholder.Elements, err = parserPlaceHolderElements(placeholder.Elements, imagesdirOption, Entrypoint(placeholderRuleName)); err != nil {
-					return nil, err
+				if placeholder.Elements, err = parserPlaceHolderElements(placeholder.Elements, append(options, Entrypoint(placeholderRuleName))...); err != nil {
+					return nil, interruptedParseError(ctx, err)
 				}
 				placeholders.elements[ref] = placeholder
 			}
 		}
 		result := make([][]interface{}, 0, len(lines))
-		elmts, err := parseContent("", s, imagesdirOption, Entrypoint(contentRuleName))
+		elmts, err := parseContent("", s, append(options, Entrypoint(contentRuleName))...)
 		if err != nil {
-			return nil, err
+			return nil, interruptedParseError(ctx, err)
 		}
 		elmts = restoreElements(elmts, placeholders)
 		result = append(result, elmts)
@@ -489,21 +729,37 @@ func newElementsSubstitution(contentRuleName, placeholderRuleName string) elemen
 	}
 }
 
+// parserPlaceHolderElements parses the given elements of a placeholder (eg: the elements of a quoted text)
+// with the given options. The elements are serialized (with their own placeholders), so that the rules can match
+// across the elements (eg: the `->` arrow, in which the `>` was already substituted with a special character).
+// The elements of nested quoted texts are parsed as well.
 func parserPlaceHolderElements(elements []interface{}, options ...Option) ([]interface{}, error) {
-	result := make([]interface{}, 0, len(elements)) // default capacity (but may not be enough)
-	for _, element := range elements {
+	if len(elements) == 0 {
+		return elements, nil
+	}
+	for i, element := range elements {
 		switch element := element.(type) {
-		case types.StringElement:
-			elmts, err := parseContent("", element.Content, options...)
-			if err != nil {
+		case types.QuotedString:
+			var err error
+			if element.Elements, err = parserPlaceHolderElements(element.Elements, options...); err != nil {
 				return nil, err
 			}
-			result = append(result, elmts...)
-		default:
-			result = append(result, element)
+			elements[i] = element
+		case types.QuotedText:
+			var err error
+			if element.Elements, err = parserPlaceHolderElements(element.Elements, options...); err != nil {
+				return nil, err
+			}
+			elements[i] = element
 		}
 	}
-	return result, nil
+	placeholders := newPlaceHolders()
+	s := serializeLines([][]interface{}{elements}, placeholders)
+	result, err := parseContent("", s, append(options, GlobalStore(placeholdersKey, placeholders))...)
+	if err != nil {
+		return nil, err
+	}
+	return restoreElements(result, placeholders), nil
 }
 
 func parseContent(filename string, content string, options ...Option) ([]interface{}, error) {
@@ -553,6 +809,19 @@ func restoreElements(elmts []interface{}, placeholders *placeholders) []interfac
 		case types.Footnote:
 			elmt.Elements = restoreElements(elmt.Elements, placeholders)
 			elmts[i] = elmt
+		case types.InlineKeyboard:
+			for j, key := range elmt.Keys {
+				elmt.Keys[j] = restoreElements(key, placeholders)
+			}
+			elmts[i] = elmt
+		case types.InlineButton:
+			elmt.Label = restoreElements(elmt.Label, placeholders)
+			elmts[i] = elmt
+		case types.InlineMenu:
+			for j, p := range elmt.Path {
+				elmt.Path[j] = restoreElements(p, placeholders)
+			}
+			elmts[i] = elmt
 		case types.ElementRole:
 			elmts[i] = types.ElementRole(restoreElements(elmt, placeholders))
 		case []interface{}:
@@ -626,7 +895,7 @@ func serializeLines(lines [][]interface{}, placeholders *placeholders) string {
 	return result.String()
 }
 
-func splitLines(lines [][]interface{}, _ types.AttributesWithOverrides) ([][]interface{}, error) {
+func splitLines(_ context.Context, lines [][]interface{}, _ types.AttributesWithOverrides) ([][]interface{}, error) {
 	if log.IsLevelEnabled(log.DebugLevel) {
 		log.Debug("splitting lines on")
 		spew.Fdump(log.StandardLogger().Out, lines)
@@ -707,9 +976,18 @@ func applyAttributeSubstitutionsOnElement(element interface{}, attrs types.Attri
 	case types.ImageBlock:
 		e.Location.Path, err = applyAttributeSubstitutionsOnElements(e.Location.Path, attrs)
 		return e, err
+	case types.VideoBlock:
+		e.Location.Path, err = applyAttributeSubstitutionsOnElements(e.Location.Path, attrs)
+		return e, err
+	case types.AudioBlock:
+		e.Location.Path, err = applyAttributeSubstitutionsOnElements(e.Location.Path, attrs)
+		return e, err
 	case types.Section:
 		e.Title, err = applyAttributeSubstitutionsOnElements(e.Title, attrs)
 		return e, err
+	case types.DiscreteHeading:
+		e.Title, err = applyAttributeSubstitutionsOnElements(e.Title, attrs)
+		return e, err
 	case types.OrderedListItem:
 		e.Elements, err = applyAttributeSubstitutionsOnElements(e.Elements, attrs)
 		return e, err
@@ -732,6 +1010,9 @@ func applyAttributeSubstitutionsOnElement(element interface{}, attrs types.Attri
 	case types.SidebarBlock:
 		e.Elements, err = applyAttributeSubstitutionsOnElements(e.Elements, attrs)
 		return e, err
+	case types.OpenBlock:
+		e.Elements, err = applyAttributeSubstitutionsOnElements(e.Elements, attrs)
+		return e, err
 	case types.FencedBlock:
 		e.Lines, err = applyAttributeSubstitutionsOnLines(e.Lines, attrs)
 		return e, err
@@ -747,6 +1028,9 @@ func applyAttributeSubstitutionsOnElement(element interface{}, attrs types.Attri
 	case types.PassthroughBlock:
 		e.Lines, err = applyAttributeSubstitutionsOnLines(e.Lines, attrs)
 		return e, err
+	case types.StemBlock:
+		e.Lines, err = applyAttributeSubstitutionsOnLines(e.Lines, attrs)
+		return e, err
 	case types.CommentBlock:
 		e.Lines, err = applyAttributeSubstitutionsOnLines(e.Lines, attrs)
 		return e, err
@@ -759,7 +1043,14 @@ func applyAttributeSubstitutionsOnElement(element interface{}, attrs types.Attri
 				Content: value,
 			}, nil
 		}
-		log.Warnf("unable to find attribute '%s'", e.Name)
+		attrs.Diagnostics.Report(types.Diagnostic{
+			Severity: types.SeverityWarning,
+			Code:     types.UnresolvedAttributeCode,
+			Message:  fmt.Sprintf("unable to find attribute '%s'", e.Name),
+			Filename: e.Position.Filename,
+			Line:     e.Position.Line,
+			Kind:     "AttributeSubstitution",
+		})
 		return types.StringElement{
 			Content: "{" + e.Name + "}",
 		}, nil
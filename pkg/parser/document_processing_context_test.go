@@ -0,0 +1,74 @@
+package parser
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("context checks", func() {
+
+	It("should parse blocks when context is active", func() {
+		_, err := Parse("", []byte("a paragraph\n\nanother paragraph"), Entrypoint("RawDocument"), GlobalStore(contextKey, context.Background()))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should stop parsing blocks when context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := Parse("", []byte("a paragraph\n\nanother paragraph"), Entrypoint("RawDocument"), GlobalStore(contextKey, ctx))
+		Expect(err).To(MatchError(ContainSubstring(context.Canceled.Error())))
+	})
+
+	// eg: `*_*_*_..._*_*_*`
+	nestedQuotes := strings.Repeat("*_", 50) + "content" + strings.Repeat("_*", 50)
+
+	It("should stop parsing nested quoted texts when context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		_, err := Parse("", []byte(nestedQuotes), Entrypoint("QuotedTextSubs"), GlobalStore(contextKey, ctx))
+		Expect(err).To(MatchError(ContainSubstring(context.Canceled.Error())))
+	})
+
+	It("should stop the quotes substitution when context is done", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		lines := [][]interface{}{
+			{
+				types.StringElement{
+					Content: nestedQuotes,
+				},
+			},
+		}
+		_, err := substituteQuotedTexts(ctx, lines, types.AttributesWithOverrides{
+			Content: types.Attributes{},
+		})
+		Expect(err).To(Equal(context.Canceled))
+	})
+
+	It("should stop the quotes substitution when deadline is exceeded", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+		// unclosed quoted texts, which take a very long time to parse (eg: "*_`#*_`#...content")
+		lines := [][]interface{}{
+			{
+				types.StringElement{
+					Content: strings.Repeat("*_`#", 8) + "content",
+				},
+			},
+		}
+		result := make(chan error, 1)
+		go func() {
+			_, err := substituteQuotedTexts(ctx, lines, types.AttributesWithOverrides{
+				Content: types.Attributes{},
+			})
+			result <- err
+		}()
+		Eventually(result, 5*time.Second).Should(Receive(Equal(context.DeadlineExceeded)))
+	})
+})
@@ -50,6 +50,9 @@ elements:
 		case types.SidebarBlock:
 			e.Elements = filter(e.Elements, matchers...)
 			result = append(result, e)
+		case types.OpenBlock:
+			e.Elements = filter(e.Elements, matchers...)
+			result = append(result, e)
 		case types.OrderedList:
 			items := make([]types.OrderedListItem, 0, len(e.Items))
 			for _, i := range e.Items {
@@ -3,6 +3,7 @@ package parser
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -19,32 +20,75 @@ import (
 
 // ParseRawSource parses a document's content and applies the preprocessing directives (file inclusions)
 func ParseRawSource(r io.Reader, config configuration.Configuration, options ...Option) ([]byte, error) {
+	source, _, err := parseRawSourceContext(context.Background(), r, config, options...)
+	return source, err
+}
+
+// parseRawSourceContext parses a document's content and applies the preprocessing directives (file inclusions),
+// and returns the resulting source along with the position of each of its lines in the main document or in the included files
+func parseRawSourceContext(ctx context.Context, r io.Reader, config configuration.Configuration, options ...Option) ([]byte, []types.Position, error) {
 	attrs := types.AttributesWithOverrides{
-		Content:   map[string]interface{}{},
-		Overrides: map[string]string{},
-		Counters:  map[string]interface{}{},
+		Content:     map[string]interface{}{},
+		Overrides:   map[string]string{},
+		Counters:    map[string]interface{}{},
+		Diagnostics: config.Report,
 	}
-	return parseRawSource(r, attrs, []levelOffset{}, config, append(options, Entrypoint("RawSource"))...)
+	return parseRawSource(ctx, r, attrs, []levelOffset{}, 0, "", nil, config, append(options, Entrypoint("RawSource"))...)
 }
 
-func parseRawSource(r io.Reader, attrs types.AttributesWithOverrides, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) ([]byte, error) {
+// parseRawSource parses the given content, which was included at the given depth (`0` for the main document)
+// from a file in the given directory (relative to the directory of the main document, or empty for the main document).
+// The line numbers are the numbers of the lines of the content in the included file (or `nil` if the lines were not filtered)
+func parseRawSource(ctx context.Context, r io.Reader, attrs types.AttributesWithOverrides, levelOffsets []levelOffset, depth int, dir string, lineNumbers []int, config configuration.Configuration, options ...Option) ([]byte, []types.Position, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
 	// log.Debugf("parsing raw document '%s'", config.Filename)
 	lines, err := ParseReader(config.Filename, r, options...)
 	if err != nil {
-		log.Errorf("failed to parse raw document: %s", err)
-		return nil, err
+		config.Report(types.Diagnostic{
+			Severity: types.SeverityError,
+			Code:     types.ParseErrorCode,
+			Message:  fmt.Sprintf("failed to parse raw document: %s", err),
+			Line:     errorLine(err),
+		})
+		return nil, nil, err
 	}
 	l, ok := lines.([]interface{})
 	if !ok {
-		return nil, fmt.Errorf("unexpected type of raw lines: '%T'", lines)
+		return nil, nil, fmt.Errorf("unexpected type of raw lines: '%T'", lines)
+	}
+	return processFileInclusions(ctx, l, attrs, levelOffsets, depth, dir, lineNumbers, config, options...)
+}
+
+// errorLine returns the line of the first error reported by the parser, or `0` if unknown
+func errorLine(err error) int {
+	if errs, ok := err.(errList); ok && len(errs) > 0 {
+		err = errs[0]
+	}
+	if e, ok := err.(*parserError); ok {
+		return e.pos.line
 	}
-	return processFileInclusions(l, attrs, levelOffsets, config, options...)
+	return 0
 }
 
-// processFileInclusions processes the file inclusions in the given lines and returns a serialized content which can be parsed again
-func processFileInclusions(lines []interface{}, globalAttrs types.AttributesWithOverrides, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) ([]byte, error) {
+// processFileInclusions processes the file inclusions in the given lines and returns a serialized content which can be parsed again,
+// along with the position of each line of this content
+func processFileInclusions(ctx context.Context, lines []interface{}, globalAttrs types.AttributesWithOverrides, levelOffsets []levelOffset, depth int, dir string, lineNumbers []int, config configuration.Configuration, options ...Option) ([]byte, []types.Position, error) {
 	result := bytes.NewBuffer(nil)
-	for _, line := range lines {
+	positions := make([]types.Position, 0, len(lines))
+	for i, line := range lines {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		// each raw line is parsed into a single element
+		position := types.Position{
+			Filename: config.Filename,
+			Line:     i + 1,
+		}
+		if i < len(lineNumbers) {
+			position.Line = lineNumbers[i]
+		}
 		switch l := line.(type) {
 		case []interface{}:
 			for _, e := range l {
@@ -52,10 +96,11 @@ func processFileInclusions(lines []interface{}, globalAttrs types.AttributesWith
 					result.WriteString(s.Content)
 					continue
 				}
-				return nil, fmt.Errorf("unexpected type of element in raw line: '%T'", e)
+				return nil, nil, fmt.Errorf("unexpected type of element in raw line: '%T'", e)
 			}
 			// append linefeed
 			result.WriteString("\n")
+			positions = append(positions, position)
 		case types.RawSection:
 			for _, offset := range levelOffsets {
 				oldLevel := l.Level
@@ -71,22 +116,38 @@ func processFileInclusions(lines []interface{}, globalAttrs types.AttributesWith
 			result.WriteString(l.Stringify())
 			// append linefeed
 			result.WriteString("\n")
+			positions = append(positions, position)
 		case types.AttributeDeclaration:
+			if l.Name == types.AttrImagesDir {
+				l.Value = resolveImagesDir(dir, l.Value)
+			}
 			globalAttrs.Set(l.Name, l.Value)
 			result.WriteString(l.Stringify())
 			// append linefeed
 			result.WriteString("\n")
+			positions = append(positions, position)
 		case types.FileInclusion:
-			includedLines, err := parseFileToInclude(l, globalAttrs, levelOffsets, config, options...)
+			if config.MaxIncludeDepth > 0 && depth >= config.MaxIncludeDepth {
+				config.Report(types.Diagnostic{
+					Severity: types.SeverityError,
+					Code:     types.MaxIncludeDepthCode,
+					Message:  fmt.Sprintf("maximum include depth of %d exceeded - %s", config.MaxIncludeDepth, l.RawText),
+					Line:     position.Line,
+					Kind:     "FileInclusion",
+				})
+				return nil, nil, errors.Errorf("maximum include depth of %d exceeded in %s", config.MaxIncludeDepth, config.Filename)
+			}
+			includedLines, includedPositions, err := parseFileToInclude(ctx, l, globalAttrs, levelOffsets, depth+1, dir, config, options...)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			result.Write(includedLines)
+			positions = append(positions, includedPositions...)
 		default:
-			return nil, fmt.Errorf("unexpected type of line: '%T'", line)
+			return nil, nil, fmt.Errorf("unexpected type of line: '%T'", line)
 		}
 	}
-	return result.Bytes(), nil
+	return result.Bytes(), positions, nil
 
 }
 
@@ -118,9 +179,9 @@ func absoluteOffset(offset int) levelOffset {
 }
 
 // applies the elements and attributes substitutions on the given image block.
-func applySubstitutionsOnFileInclusion(f types.FileInclusion, attrs types.AttributesWithOverrides) (types.FileInclusion, error) {
+func applySubstitutionsOnFileInclusion(ctx context.Context, f types.FileInclusion, attrs types.AttributesWithOverrides) (types.FileInclusion, error) {
 	elements := [][]interface{}{f.Location.Path} // wrap to
-	elements, err := substituteAttributes(elements, attrs)
+	elements, err := substituteAttributes(ctx, elements, attrs)
 	if err != nil {
 		return types.FileInclusion{}, err
 	}
@@ -128,10 +189,35 @@ func applySubstitutionsOnFileInclusion(f types.FileInclusion, attrs types.Attrib
 	return f, nil
 }
 
-func parseFileToInclude(incl types.FileInclusion, attrs types.AttributesWithOverrides, levelOffsets []levelOffset, config configuration.Configuration, options ...Option) ([]byte, error) {
-	incl, err := applySubstitutionsOnFileInclusion(incl, attrs)
+// UnresolvedDirectiveError the error returned when a file to include could not be found or read
+type UnresolvedDirectiveError struct {
+	Filename string // the file in which the directive was found
+	RawText  string // the directive, as written in the file
+}
+
+// newUnresolvedDirectiveError returns a new UnresolvedDirectiveError for the given file inclusion, after reporting it
+func newUnresolvedDirectiveError(config configuration.Configuration, incl types.FileInclusion) UnresolvedDirectiveError {
+	err := UnresolvedDirectiveError{
+		Filename: config.Filename,
+		RawText:  incl.RawText,
+	}
+	config.Report(types.Diagnostic{
+		Severity: types.SeverityError,
+		Code:     types.MissingIncludeCode,
+		Message:  err.Error(),
+		Kind:     "FileInclusion",
+	})
+	return err
+}
+
+func (e UnresolvedDirectiveError) Error() string {
+	return fmt.Sprintf("Unresolved directive in %s - %s", e.Filename, e.RawText)
+}
+
+func parseFileToInclude(ctx context.Context, incl types.FileInclusion, attrs types.AttributesWithOverrides, levelOffsets []levelOffset, depth int, dir string, config configuration.Configuration, options ...Option) ([]byte, []types.Position, error) {
+	incl, err := applySubstitutionsOnFileInclusion(ctx, incl, attrs)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	path := incl.Location.Stringify()
 	currentDir := filepath.Dir(config.Filename)
@@ -139,35 +225,44 @@ func parseFileToInclude(incl types.FileInclusion, attrs types.AttributesWithOver
 	f, absPath, done, err := open(filepath.Join(currentDir, path))
 	defer done()
 	if err != nil {
-		return nil, fmt.Errorf("Unresolved directive in %s - %s", config.Filename, incl.RawText)
+		return nil, nil, newUnresolvedDirectiveError(config, incl)
 	}
-	content := bytes.NewBuffer(nil)
+	inclConfig := config.Clone()
+	inclConfig.Filename = absPath
+	content := &includedContent{}
 	scanner := bufio.NewScanner(bufio.NewReader(f))
 	if lineRanges, ok := incl.LineRanges(); ok {
 		if err := readWithinLines(scanner, content, lineRanges); err != nil {
-			return nil, fmt.Errorf("Unresolved directive in %s - %s", config.Filename, incl.RawText)
+			return nil, nil, newUnresolvedDirectiveError(config, incl)
 		}
 	} else if tagRanges, ok := incl.TagRanges(); ok {
-		if err := readWithinTags(path, scanner, content, tagRanges); err != nil {
-			return nil, err
+		if err := readWithinTags(path, scanner, content, tagRanges, inclConfig); err != nil {
+			return nil, nil, err
 		}
 	} else {
 		if err := readAll(scanner, content); err != nil {
-			return nil, fmt.Errorf("Unresolved directive in %s - %s", config.Filename, incl.RawText)
+			return nil, nil, newUnresolvedDirectiveError(config, incl)
 		}
 	}
 	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("Unresolved directive in %s - %s", config.Filename, incl.RawText)
+		return nil, nil, newUnresolvedDirectiveError(config, incl)
 	}
 	// just include the file content if the file to include is not an Asciidoc document.
 	if !IsAsciidoc(absPath) {
-		return content.Bytes(), nil
+		positions := make([]types.Position, len(content.lines))
+		for i, l := range content.lines {
+			positions[i] = types.Position{
+				Filename: absPath,
+				Line:     l,
+			}
+		}
+		return content.Bytes(), positions, nil
 	}
 	// parse the content, and returns the corresponding elements
 	if l, found := incl.Attributes.GetAsString(types.AttrLevelOffset); found {
 		offset, err := strconv.Atoi(l)
 		if err != nil {
-			return nil, errors.Wrap(err, "unable to read file to include")
+			return nil, nil, errors.Wrap(err, "unable to read file to include")
 		}
 		if strings.HasPrefix(l, "+") || strings.HasPrefix(l, "-") {
 			levelOffsets = append(levelOffsets, relativeOffset(offset))
@@ -177,13 +272,45 @@ func parseFileToInclude(incl types.FileInclusion, attrs types.AttributesWithOver
 		}
 	}
 
-	inclConfig := config.Clone()
-	inclConfig.Filename = absPath
 	// now, let's parse this content and process nested file inclusions
-	return parseRawSource(content, attrs, levelOffsets, inclConfig, options...)
+	if filepath.IsAbs(path) {
+		dir = filepath.Dir(path)
+	} else {
+		dir = filepath.Join(dir, filepath.Dir(path))
+	}
+	return parseRawSource(ctx, content, attrs, levelOffsets, depth, dir, content.lines, inclConfig, options...)
+}
+
+// resolveImagesDir returns the given `imagesdir` value declared in a file in the given directory (relative to the
+// directory of the main document), so that the images referenced in an included file are relative to this file.
+// The value is returned as-is if it is absolute, a URL or if it refers to another attribute
+func resolveImagesDir(dir, imagesdir string) string {
+	if dir == "" || dir == "." || imagesdir == "" ||
+		filepath.IsAbs(imagesdir) || strings.Contains(imagesdir, "://") || strings.HasPrefix(imagesdir, "{") {
+		return imagesdir
+	}
+	return filepath.ToSlash(filepath.Join(dir, imagesdir))
 }
 
-func readWithinLines(scanner *bufio.Scanner, content *bytes.Buffer, lineRanges types.LineRanges) error {
+// includedContent the content read from a file to include, along with the number of each line in the file
+type includedContent struct {
+	bytes.Buffer
+	lines []int
+}
+
+// writeLine appends the given line (and a linefeed) to the content
+func (c *includedContent) writeLine(line []byte, number int) error {
+	if _, err := c.Write(line); err != nil {
+		return err
+	}
+	if _, err := c.WriteString("\n"); err != nil {
+		return err
+	}
+	c.lines = append(c.lines, number)
+	return nil
+}
+
+func readWithinLines(scanner *bufio.Scanner, content *includedContent, lineRanges types.LineRanges) error {
 	log.Debugf("limiting to line ranges: %v", lineRanges)
 	line := 0
 	for scanner.Scan() {
@@ -204,12 +331,7 @@ func readWithinLines(scanner *bufio.Scanner, content *bytes.Buffer, lineRanges t
 		}
 		// TODO: stop reading if current line above highest range
 		if lineRanges.Match(line) {
-			_, err := content.Write(scanner.Bytes())
-			if err != nil {
-				return err
-			}
-			_, err = content.WriteString("\n")
-			if err != nil {
+			if err := content.writeLine(scanner.Bytes(), line); err != nil {
 				return err
 			}
 		}
@@ -217,7 +339,7 @@ func readWithinLines(scanner *bufio.Scanner, content *bytes.Buffer, lineRanges t
 	return nil
 }
 
-func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer, expectedRanges types.TagRanges) error {
+func readWithinTags(path string, scanner *bufio.Scanner, content *includedContent, expectedRanges types.TagRanges, config configuration.Configuration) error {
 	log.Debugf("limiting to tag ranges: %v", expectedRanges)
 	currentRanges := make(map[string]*types.CurrentTagRange, len(expectedRanges)) // ensure capacity
 	lineNumber := 0
@@ -244,12 +366,7 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 			currentRanges[endTag.Value].EndLine = lineNumber
 		}
 		if expectedRanges.Match(lineNumber, currentRanges) && !fl.HasTag() {
-			_, err := content.Write(scanner.Bytes())
-			if err != nil {
-				return err
-			}
-			_, err = content.WriteString("\n")
-			if err != nil {
+			if err := content.writeLine(scanner.Bytes(), lineNumber); err != nil {
 				return err
 			}
 		}
@@ -264,15 +381,23 @@ func readWithinTags(path string, scanner *bufio.Scanner, content *bytes.Buffer,
 			if tr, found := currentRanges[tag.Name]; !found {
 				return fmt.Errorf("tag '%s' not found in include file: %s", tag.Name, path)
 			} else if tr.EndLine == -1 {
-				log.Warnf("detected unclosed tag '%s' starting at line %d of include file: %s", tag.Name, tr.StartLine, path)
+				config.Report(types.Diagnostic{
+					Severity: types.SeverityWarning,
+					Code:     types.UnclosedTagCode,
+					Message:  fmt.Sprintf("detected unclosed tag '%s' starting at line %d of include file: %s", tag.Name, tr.StartLine, path),
+					Line:     tr.StartLine,
+					Kind:     "FileInclusion",
+				})
 			}
 		}
 	}
 	return nil
 }
 
-func readAll(scanner *bufio.Scanner, content *bytes.Buffer) error {
+func readAll(scanner *bufio.Scanner, content *includedContent) error {
+	line := 0
 	for scanner.Scan() {
+		line++
 		// parse the line in search for the `tag::<tag>[]` or `end:<tag>[]` macros
 		l, err := Parse("", scanner.Bytes(), Entrypoint("IncludedFileLine"))
 		if err != nil {
@@ -286,12 +411,7 @@ func readAll(scanner *bufio.Scanner, content *bytes.Buffer) error {
 		if fl.HasTag() {
 			continue
 		}
-		_, err = content.Write(scanner.Bytes())
-		if err != nil {
-			return err
-		}
-		_, err = content.WriteString("\n")
-		if err != nil {
+		if err := content.writeLine(scanner.Bytes(), line); err != nil {
 			return err
 		}
 	}
@@ -1,6 +1,7 @@
 package parser
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 
 	. "github.com/onsi/ginkgo" //nolint golint
@@ -58,7 +59,7 @@ var _ = Describe("include table of contents", func() {
 				section,
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with default placement and a header with content", func() {
@@ -101,7 +102,7 @@ var _ = Describe("include table of contents", func() {
 				},
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with default placement and a header without content", func() {
@@ -142,7 +143,7 @@ var _ = Describe("include table of contents", func() {
 				},
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with preamble placement and no header with content", func() {
@@ -165,7 +166,7 @@ var _ = Describe("include table of contents", func() {
 				section,
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with preamble placement and header with content", func() {
@@ -206,7 +207,7 @@ var _ = Describe("include table of contents", func() {
 				},
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 	It("table of contents with preamble placement and header without content", func() {
@@ -245,7 +246,7 @@ var _ = Describe("include table of contents", func() {
 				},
 			},
 		}
-		Expect(includeTableOfContentsPlaceHolder(source)).To(Equal(expected))
+		Expect(includeTableOfContentsPlaceHolder(source, configuration.NewConfiguration())).To(Equal(expected))
 	})
 
 })
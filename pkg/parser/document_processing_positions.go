@@ -0,0 +1,128 @@
+package parser
+
+import (
+	"context"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// sourceMapKey the key to the `sourceMap` of the preprocessed source in the parser's global store
+const sourceMapKey = "sourceMap"
+
+// sourceRangeKey the key to the `sourceRange` of the block being processed in the context
+const sourceRangeKey ContextKey = "sourcerange"
+
+// sourceMap the position in the main document or in the included files of each line of the preprocessed source
+// (ie, the source in which all file inclusions were replaced with the actual content)
+type sourceMap struct {
+	lines     []string
+	positions []types.Position
+	blocks    []int // the index of the first line of each block of the document (excluding the header)
+}
+
+func newSourceMap(source []byte, positions []types.Position) *sourceMap {
+	return &sourceMap{
+		lines:     strings.Split(string(source), "\n"),
+		positions: positions,
+	}
+}
+
+// position returns the position of the line at the given index (starting at `0`)
+func (m *sourceMap) position(index int) types.Position {
+	if index < 0 || index >= len(m.positions) {
+		return types.Position{}
+	}
+	return m.positions[index]
+}
+
+// blockRanges returns the ranges of lines of the given top-level elements, which were parsed in the preprocessed source,
+// or `nil` if the source is unknown or if the ranges do not match the elements
+func (m *sourceMap) blockRanges(elements []interface{}) []sourceRange {
+	if m == nil {
+		return nil
+	}
+	starts := m.blocks
+	if len(elements) == len(starts)+1 { // header
+		starts = append([]int{0}, starts...)
+	}
+	if len(elements) != len(starts) {
+		return nil
+	}
+	result := make([]sourceRange, len(starts))
+	for i, start := range starts {
+		end := len(m.lines)
+		if i < len(starts)-1 {
+			end = starts[i+1]
+		}
+		result[i] = sourceRange{
+			source: m,
+			start:  start,
+			end:    end,
+		}
+	}
+	return result
+}
+
+// sourceRange the range of lines of a top-level block in the preprocessed source, used to locate
+// the elements which are parsed while applying the substitutions on the content of this block
+type sourceRange struct {
+	source *sourceMap
+	start  int // index of the first line of the block
+	end    int // index of the first line after the block
+}
+
+// locate returns the position of the element with the given text which was found at the given line
+// in the content of the block. Since the lines of the content may not match with the lines of the block
+// (eg: block attributes, delimiters, etc.), the text is searched in the block's lines, starting at the given line.
+func (r sourceRange) locate(line int, text []byte) types.Position {
+	if r.end <= r.start {
+		return types.Position{}
+	}
+	hint := r.start + line - 1
+	if hint < r.start {
+		hint = r.start
+	} else if hint >= r.end {
+		hint = r.end - 1
+	}
+	// only search the first line of the element's text, and until the first placeholder (if any)
+	token := string(text)
+	if i := strings.IndexAny(token, "\n\uFFFD"); i >= 0 {
+		token = token[:i]
+	}
+	if token != "" {
+		for i := hint; i < r.end; i++ {
+			if strings.Contains(r.source.lines[i], token) {
+				return r.source.position(i)
+			}
+		}
+		for i := r.start; i < hint; i++ {
+			if strings.Contains(r.source.lines[i], token) {
+				return r.source.position(i)
+			}
+		}
+	}
+	return r.source.position(hint)
+}
+
+// position returns the position of the current element in the source, ie, in the main document or in an included file
+func (c *current) position() types.Position {
+	// parsing the preprocessed source
+	if m, ok := c.globalStore[sourceMapKey].(*sourceMap); ok {
+		return m.position(c.pos.line - 1)
+	}
+	// parsing the content of a block while applying the substitutions
+	if ctx, ok := c.globalStore[contextKey].(context.Context); ok {
+		if r, ok := ctx.Value(sourceRangeKey).(sourceRange); ok {
+			return r.locate(c.pos.line, c.text)
+		}
+	}
+	return types.Position{}
+}
+
+// recordBlock records the line at which the current top-level block starts in the preprocessed source
+func (c *current) recordBlock() {
+	if m, ok := c.globalStore[sourceMapKey].(*sourceMap); ok {
+		m.blocks = append(m.blocks, c.pos.line-1)
+	}
+}
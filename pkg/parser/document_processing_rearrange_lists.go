@@ -36,6 +36,11 @@ func rearrangeListItems(blocks []interface{}, withinDelimitedBlock bool) ([]inte
 				return nil, err
 			}
 			result = append(result, block)
+		case types.OpenBlock:
+			if block.Elements, err = rearrangeListItemsInBlocks(block.Elements); err != nil {
+				return nil, err
+			}
+			result = append(result, block)
 		case types.OrderedListItem, types.UnorderedListItem, types.LabeledListItem, types.CalloutListItem:
 			// there's a special case: if the next list item has attributes and was preceded by a
 			// blank line, then we need to start a new list
@@ -20,9 +20,16 @@ func rearrangeSections(blocks []interface{}) types.Document {
 	elementRefs := types.ElementReferences{}
 	var previous *types.Section // the current "parent" section
 	for _, element := range blocks {
+		if h, ok := element.(types.DiscreteHeading); ok {
+			// discrete headings can be referenced, but they do not start a new section
+			referenceElement(h.Attributes, h.Title, elementRefs)
+		}
+		if l, ok := element.(types.UnorderedList); ok && isBibliography(l.Attributes, previous) {
+			element = referenceBibliographyEntries(l, elementRefs)
+		}
 		if e, ok := element.(types.Section); ok {
 			// avoid duplicate IDs in sections
-			referenceSection(&e, elementRefs)
+			referenceElement(e.Attributes, e.Title, elementRefs)
 			if previous == nil { // set first parent
 				log.Debugf("setting section with title %v as a top-level element", e.Title)
 				sections = append(sections, e)
@@ -65,8 +72,9 @@ func rearrangeSections(blocks []interface{}) types.Document {
 	}
 }
 
-func referenceSection(e *types.Section, elementRefs types.ElementReferences) {
-	attrID, found := e.Attributes.GetAsString(types.AttrID)
+// referenceElement registers the title of the element with the given attributes, using its ID (which is updated if it was already in use)
+func referenceElement(attrs types.Attributes, title []interface{}, elementRefs types.ElementReferences) {
+	attrID, found := attrs.GetAsString(types.AttrID)
 	if !found {
 		return
 	}
@@ -78,13 +86,43 @@ func referenceSection(e *types.Section, elementRefs types.ElementReferences) {
 			id = attrID + "_" + strconv.Itoa(i)
 		}
 		if _, found := elementRefs[id]; !found {
-			elementRefs[id] = e.Title
+			elementRefs[id] = title
 			// override the element id
-			e.Attributes.Set(types.AttrID, id)
+			attrs.Set(types.AttrID, id)
 			break
 		}
 	}
-	elementRefs[attrID] = e.Title
+	elementRefs[attrID] = title
+}
+
+// isBibliography returns `true` if the list with the given attributes has the `bibliography` style,
+// or if its parent section has this style
+func isBibliography(attrs types.Attributes, parent *types.Section) bool {
+	if attrs.GetAsStringWithDefault(types.AttrStyle, "") == types.BibliographyStyle {
+		return true
+	}
+	return parent != nil && parent.Attributes.GetAsStringWithDefault(types.AttrStyle, "") == types.BibliographyStyle
+}
+
+// referenceBibliographyEntries sets the `bibliography` style on the given list, and registers the anchors
+// at the beginning of its items, so they can be used as the labels of the cross references to the entries
+func referenceBibliographyEntries(l types.UnorderedList, elementRefs types.ElementReferences) types.UnorderedList {
+	if l.Attributes == nil {
+		l.Attributes = types.Attributes{}
+	}
+	l.Attributes[types.AttrStyle] = types.BibliographyStyle
+	for _, item := range l.Items {
+		if len(item.Elements) == 0 {
+			continue
+		}
+		if p, ok := item.Elements[0].(types.Paragraph); ok && len(p.Lines) > 0 && len(p.Lines[0]) > 0 {
+			if a, ok := p.Lines[0][0].(types.BibliographyAnchor); ok {
+				log.Debugf("referencing bibliography entry with ID '%s'", a.ID)
+				elementRefs[a.ID] = a.ReferenceText()
+			}
+		}
+	}
+	return l
 }
 
 func pruneSections(sections []types.Section, level int) []types.Section {
@@ -1431,5 +1431,331 @@ a link to {scheme}:{path}[] and https://foo.baz`
 
 		})
 
+		Context("bare links", func() {
+
+			It("external links followed by punctuation", func() {
+				source := `see https://foo.bar, or (https://foo.bar/baz).`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "see "},
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "https://",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "foo.bar",
+												},
+											},
+										},
+									},
+									types.StringElement{Content: ", or ("},
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "https://",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "foo.bar/baz",
+												},
+											},
+										},
+									},
+									types.StringElement{Content: ")."},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("external link with punctuation in the path", func() {
+				source := `https://foo.bar/a.b?c=d!e&f=g.`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "https://",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "foo.bar/a.b?c=d!e",
+												},
+												types.SpecialCharacter{
+													Name: "&",
+												},
+												types.StringElement{
+													Content: "f=g",
+												},
+											},
+										},
+									},
+									types.StringElement{Content: "."},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("external link within angle brackets", func() {
+				source := `see <https://foo.bar>`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "see "},
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "https://",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "foo.bar",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("external link within angle brackets in a list item", func() {
+				source := `* see <https://foo.bar>, or https://foo.bar.`
+				link := types.InlineLink{
+					Location: types.Location{
+						Scheme: "https://",
+						Path: []interface{}{
+							types.StringElement{
+								Content: "foo.bar",
+							},
+						},
+					},
+				}
+				expected := types.Document{
+					Elements: []interface{}{
+						types.UnorderedList{
+							Items: []types.UnorderedListItem{
+								{
+									Level:       1,
+									BulletStyle: types.OneAsterisk,
+									CheckStyle:  types.NoCheck,
+									Elements: []interface{}{
+										types.Paragraph{
+											Lines: [][]interface{}{
+												{
+													types.StringElement{Content: "see "},
+													link,
+													types.StringElement{Content: ", or "},
+													link,
+													types.StringElement{Content: "."},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+		})
+
+		Context("link attributes", func() {
+
+			It("external link with caret window shorthand", func() {
+				source := `https://foo.bar[foo^]`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "https://",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "foo.bar",
+												},
+											},
+										},
+										Attributes: types.Attributes{
+											"positional-1": []interface{}{
+												types.StringElement{
+													Content: "foo",
+												},
+											},
+											types.AttrImageWindow: "_blank",
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("relative link with caret window shorthand only", func() {
+				source := `link:foo.adoc[^]`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineLink{
+										Location: types.Location{
+											Path: []interface{}{
+												types.StringElement{
+													Content: "foo.adoc",
+												},
+											},
+										},
+										Attributes: types.Attributes{
+											types.AttrImageWindow: "_blank",
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("external link with window and options", func() {
+				source := `https://foo.bar[foo,window=read-later,opts=nofollow]`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "https://",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "foo.bar",
+												},
+											},
+										},
+										Attributes: types.Attributes{
+											"positional-1": []interface{}{
+												types.StringElement{
+													Content: "foo",
+												},
+											},
+											types.AttrImageWindow: "read-later",
+											types.AttrOptions: map[string]bool{
+												"nofollow": true,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+		})
+
+		Context("email addresses", func() {
+
+			It("bare email address", func() {
+				source := `write to foo.bar@example.co.uk.`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "write to "},
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "mailto:",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "foo.bar@example.co.uk",
+												},
+											},
+										},
+									},
+									types.StringElement{Content: "."},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("escaped and invalid email addresses", func() {
+				source := `\foo@example.com, x:foo@example.com and foo@example`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "foo@example.com, x:foo@example.com and foo@example"},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("email link with subject and body", func() {
+				source := `mailto:foo@example.com[Subscribe,Subscribe me,I want to participate.]`
+				expected := types.Document{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "mailto:",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "foo@example.com",
+												},
+											},
+										},
+										Attributes: types.Attributes{
+											"positional-1": []interface{}{
+												types.StringElement{
+													Content: "Subscribe",
+												},
+											},
+											"positional-2": []interface{}{
+												types.StringElement{
+													Content: "Subscribe me",
+												},
+											},
+											"positional-3": []interface{}{
+												types.StringElement{
+													Content: "I want to participate.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+		})
 	})
 })
@@ -3,6 +3,7 @@ package parser_test
 import (
 	"strings"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -841,6 +842,43 @@ warning!`
 				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 			})
 
+			It("custom admonition paragraph", func() {
+				source := `DANGER: this is dangerous.`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.Paragraph{
+							Attributes: types.Attributes{
+								types.AttrAdmonitionKind: types.AdmonitionKind("danger"),
+							},
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "this is dangerous."},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source, configuration.WithCustomAdmonition(configuration.CustomAdmonition{
+					Name: "DANGER",
+				}))).To(MatchDraftDocument(expected))
+			})
+
+			It("unregistered custom admonition paragraph", func() {
+				source := `DANGER: this is dangerous.`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "DANGER: this is dangerous."},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
 			It("admonition note paragraph with id and title", func() {
 				source := `[[foo]]
 .bar
@@ -238,9 +238,26 @@ var g = &grammar{
 							label: "blocks",
 							expr: &zeroOrMoreExpr{
 								pos: position{line: 55, col: 56, offset: 1654},
-								expr: &ruleRefExpr{
-									pos:  position{line: 55, col: 56, offset: 1654},
-									name: "DocumentRawBlock",
+								expr: &actionExpr{
+									pos: position{line: 55, col: 57, offset: 1655},
+									run: (*parser).callonRawBlocks10,
+									expr: &seqExpr{
+										pos: position{line: 55, col: 57, offset: 1655},
+										exprs: []interface{}{
+											&andCodeExpr{
+												pos: position{line: 55, col: 57, offset: 1655},
+												run: (*parser).callonRawBlocks12,
+											},
+											&labeledExpr{
+												pos:   position{line: 55, col: 86, offset: 1684},
+												label: "block",
+												expr: &ruleRefExpr{
+													pos:  position{line: 55, col: 93, offset: 1691},
+													name: "DocumentRawBlock",
+												},
+											},
+										},
+									},
 								},
 							},
 						},
@@ -250,88 +267,100 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRawBlock",
-			pos:  position{line: 64, col: 1, offset: 1906},
+			pos:  position{line: 67, col: 1, offset: 2005},
 			expr: &choiceExpr{
-				pos: position{line: 65, col: 9, offset: 1935},
+				pos: position{line: 68, col: 9, offset: 2034},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 65, col: 9, offset: 1935},
+						pos:  position{line: 68, col: 9, offset: 2034},
 						name: "LabeledListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 66, col: 11, offset: 2000},
+						pos:  position{line: 69, col: 11, offset: 2099},
 						name: "ImageBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 67, col: 11, offset: 2021},
+						pos:  position{line: 70, col: 11, offset: 2120},
+						name: "VideoBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 71, col: 11, offset: 2141},
+						name: "AudioBlock",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 72, col: 11, offset: 2162},
 						name: "SimpleRawParagraph",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 68, col: 11, offset: 2050},
+						pos:  position{line: 73, col: 11, offset: 2191},
 						name: "BlankLine",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 69, col: 11, offset: 2102},
+						pos:  position{line: 74, col: 11, offset: 2243},
+						name: "DiscreteHeading",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 75, col: 11, offset: 2295},
 						name: "Section",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 70, col: 11, offset: 2120},
+						pos:  position{line: 76, col: 11, offset: 2313},
 						name: "DelimitedBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 71, col: 11, offset: 2145},
+						pos:  position{line: 77, col: 11, offset: 2338},
 						name: "SingleLineComment",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 72, col: 11, offset: 2173},
+						pos:  position{line: 78, col: 11, offset: 2366},
 						name: "Table",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 73, col: 11, offset: 2189},
+						pos:  position{line: 79, col: 11, offset: 2382},
 						name: "ThematicBreak",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 74, col: 11, offset: 2213},
+						pos:  position{line: 80, col: 11, offset: 2406},
 						name: "OrderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 75, col: 11, offset: 2239},
+						pos:  position{line: 81, col: 11, offset: 2432},
 						name: "UnorderedListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 76, col: 11, offset: 2268},
+						pos:  position{line: 82, col: 11, offset: 2461},
 						name: "CalloutListItem",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 77, col: 11, offset: 2294},
+						pos:  position{line: 83, col: 11, offset: 2487},
 						name: "ContinuedListItemElement",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 78, col: 11, offset: 2329},
+						pos:  position{line: 84, col: 11, offset: 2522},
 						name: "LiteralBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 79, col: 11, offset: 2353},
+						pos:  position{line: 85, col: 11, offset: 2546},
 						name: "AttributeDeclaration",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 80, col: 11, offset: 2385},
+						pos:  position{line: 86, col: 11, offset: 2578},
 						name: "AttributeReset",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 81, col: 11, offset: 2411},
+						pos:  position{line: 87, col: 11, offset: 2604},
 						name: "TableOfContentsPlaceHolder",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 82, col: 11, offset: 2448},
+						pos:  position{line: 88, col: 11, offset: 2641},
 						name: "UserMacroBlock",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 83, col: 11, offset: 2473},
+						pos:  position{line: 89, col: 11, offset: 2666},
 						name: "RawParagraph",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 84, col: 11, offset: 2496},
+						pos:  position{line: 90, col: 11, offset: 2689},
 						name: "StandaloneAttributes",
 					},
 				},
@@ -339,38 +368,38 @@ var g = &grammar{
 		},
 		{
 			name: "FrontMatter",
-			pos:  position{line: 89, col: 1, offset: 2626},
+			pos:  position{line: 95, col: 1, offset: 2819},
 			expr: &ruleRefExpr{
-				pos:  position{line: 89, col: 16, offset: 2641},
+				pos:  position{line: 95, col: 16, offset: 2834},
 				name: "YamlFrontMatter",
 			},
 		},
 		{
 			name: "YamlFrontMatter",
-			pos:  position{line: 91, col: 1, offset: 2659},
+			pos:  position{line: 97, col: 1, offset: 2852},
 			expr: &actionExpr{
-				pos: position{line: 91, col: 20, offset: 2678},
+				pos: position{line: 97, col: 20, offset: 2871},
 				run: (*parser).callonYamlFrontMatter1,
 				expr: &seqExpr{
-					pos: position{line: 91, col: 20, offset: 2678},
+					pos: position{line: 97, col: 20, offset: 2871},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 91, col: 20, offset: 2678},
+							pos:  position{line: 97, col: 20, offset: 2871},
 							name: "YamlFrontMatterToken",
 						},
 						&labeledExpr{
-							pos:   position{line: 91, col: 41, offset: 2699},
+							pos:   position{line: 97, col: 41, offset: 2892},
 							label: "content",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 91, col: 49, offset: 2707},
+								pos: position{line: 97, col: 49, offset: 2900},
 								expr: &ruleRefExpr{
-									pos:  position{line: 91, col: 50, offset: 2708},
+									pos:  position{line: 97, col: 50, offset: 2901},
 									name: "YamlFrontMatterContent",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 91, col: 75, offset: 2733},
+							pos:  position{line: 97, col: 75, offset: 2926},
 							name: "YamlFrontMatterToken",
 						},
 					},
@@ -379,25 +408,25 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterToken",
-			pos:  position{line: 95, col: 1, offset: 2813},
+			pos:  position{line: 101, col: 1, offset: 3006},
 			expr: &seqExpr{
-				pos: position{line: 95, col: 26, offset: 2838},
+				pos: position{line: 101, col: 26, offset: 3031},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 95, col: 26, offset: 2838},
+						pos:        position{line: 101, col: 26, offset: 3031},
 						val:        "---",
 						ignoreCase: false,
 						want:       "\"---\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 95, col: 32, offset: 2844},
+						pos: position{line: 101, col: 32, offset: 3037},
 						expr: &ruleRefExpr{
-							pos:  position{line: 95, col: 32, offset: 2844},
+							pos:  position{line: 101, col: 32, offset: 3037},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 95, col: 39, offset: 2851},
+						pos:  position{line: 101, col: 39, offset: 3044},
 						name: "EOL",
 					},
 				},
@@ -405,26 +434,26 @@ var g = &grammar{
 		},
 		{
 			name: "YamlFrontMatterContent",
-			pos:  position{line: 97, col: 1, offset: 2856},
+			pos:  position{line: 103, col: 1, offset: 3049},
 			expr: &actionExpr{
-				pos: position{line: 97, col: 27, offset: 2882},
+				pos: position{line: 103, col: 27, offset: 3075},
 				run: (*parser).callonYamlFrontMatterContent1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 97, col: 27, offset: 2882},
+					pos: position{line: 103, col: 27, offset: 3075},
 					expr: &oneOrMoreExpr{
-						pos: position{line: 97, col: 28, offset: 2883},
+						pos: position{line: 103, col: 28, offset: 3076},
 						expr: &seqExpr{
-							pos: position{line: 97, col: 29, offset: 2884},
+							pos: position{line: 103, col: 29, offset: 3077},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 97, col: 29, offset: 2884},
+									pos: position{line: 103, col: 29, offset: 3077},
 									expr: &ruleRefExpr{
-										pos:  position{line: 97, col: 30, offset: 2885},
+										pos:  position{line: 103, col: 30, offset: 3078},
 										name: "YamlFrontMatterToken",
 									},
 								},
 								&anyMatcher{
-									line: 97, col: 51, offset: 2906,
+									line: 103, col: 51, offset: 3099,
 								},
 							},
 						},
@@ -434,123 +463,131 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentHeader",
-			pos:  position{line: 104, col: 1, offset: 3072},
+			pos:  position{line: 110, col: 1, offset: 3265},
 			expr: &actionExpr{
-				pos: position{line: 104, col: 19, offset: 3090},
+				pos: position{line: 110, col: 19, offset: 3283},
 				run: (*parser).callonDocumentHeader1,
 				expr: &seqExpr{
-					pos: position{line: 104, col: 19, offset: 3090},
+					pos: position{line: 110, col: 19, offset: 3283},
 					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 110, col: 19, offset: 3283},
+							label: "pos",
+							expr: &ruleRefExpr{
+								pos:  position{line: 110, col: 24, offset: 3288},
+								name: "SourcePosition",
+							},
+						},
 						&litMatcher{
-							pos:        position{line: 104, col: 19, offset: 3090},
+							pos:        position{line: 110, col: 40, offset: 3304},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 104, col: 23, offset: 3094},
+							pos: position{line: 110, col: 44, offset: 3308},
 							expr: &ruleRefExpr{
-								pos:  position{line: 104, col: 23, offset: 3094},
+								pos:  position{line: 110, col: 44, offset: 3308},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 104, col: 30, offset: 3101},
+							pos:   position{line: 110, col: 51, offset: 3315},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 104, col: 37, offset: 3108},
+								pos:  position{line: 110, col: 58, offset: 3322},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 104, col: 52, offset: 3123},
+							pos:   position{line: 110, col: 73, offset: 3337},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 104, col: 56, offset: 3127},
+								pos: position{line: 110, col: 77, offset: 3341},
 								expr: &ruleRefExpr{
-									pos:  position{line: 104, col: 56, offset: 3127},
+									pos:  position{line: 110, col: 77, offset: 3341},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 104, col: 74, offset: 3145},
+							pos:  position{line: 110, col: 95, offset: 3359},
 							name: "EOL",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 105, col: 9, offset: 3157},
+							pos: position{line: 111, col: 9, offset: 3371},
 							expr: &choiceExpr{
-								pos: position{line: 105, col: 10, offset: 3158},
+								pos: position{line: 111, col: 10, offset: 3372},
 								alternatives: []interface{}{
 									&seqExpr{
-										pos: position{line: 105, col: 10, offset: 3158},
+										pos: position{line: 111, col: 10, offset: 3372},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 105, col: 10, offset: 3158},
+												pos: position{line: 111, col: 10, offset: 3372},
 												expr: &ruleRefExpr{
-													pos:  position{line: 105, col: 10, offset: 3158},
+													pos:  position{line: 111, col: 10, offset: 3372},
 													name: "Space",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 105, col: 17, offset: 3165},
+												pos:  position{line: 111, col: 17, offset: 3379},
 												name: "SingleLineComment",
 											},
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 105, col: 37, offset: 3185},
+										pos:  position{line: 111, col: 37, offset: 3399},
 										name: "CommentBlock",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 106, col: 9, offset: 3208},
+							pos:   position{line: 112, col: 9, offset: 3422},
 							label: "authors",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 106, col: 18, offset: 3217},
+								pos: position{line: 112, col: 18, offset: 3431},
 								expr: &ruleRefExpr{
-									pos:  position{line: 106, col: 18, offset: 3217},
+									pos:  position{line: 112, col: 18, offset: 3431},
 									name: "DocumentAuthors",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 107, col: 9, offset: 3244},
+							pos: position{line: 113, col: 9, offset: 3458},
 							expr: &choiceExpr{
-								pos: position{line: 107, col: 10, offset: 3245},
+								pos: position{line: 113, col: 10, offset: 3459},
 								alternatives: []interface{}{
 									&seqExpr{
-										pos: position{line: 107, col: 10, offset: 3245},
+										pos: position{line: 113, col: 10, offset: 3459},
 										exprs: []interface{}{
 											&zeroOrMoreExpr{
-												pos: position{line: 107, col: 10, offset: 3245},
+												pos: position{line: 113, col: 10, offset: 3459},
 												expr: &ruleRefExpr{
-													pos:  position{line: 107, col: 10, offset: 3245},
+													pos:  position{line: 113, col: 10, offset: 3459},
 													name: "Space",
 												},
 											},
 											&ruleRefExpr{
-												pos:  position{line: 107, col: 17, offset: 3252},
+												pos:  position{line: 113, col: 17, offset: 3466},
 												name: "SingleLineComment",
 											},
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 107, col: 37, offset: 3272},
+										pos:  position{line: 113, col: 37, offset: 3486},
 										name: "CommentBlock",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 108, col: 9, offset: 3295},
+							pos:   position{line: 114, col: 9, offset: 3509},
 							label: "revision",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 108, col: 19, offset: 3305},
+								pos: position{line: 114, col: 19, offset: 3519},
 								expr: &ruleRefExpr{
-									pos:  position{line: 108, col: 19, offset: 3305},
+									pos:  position{line: 114, col: 19, offset: 3519},
 									name: "DocumentRevision",
 								},
 							},
@@ -561,16 +598,16 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthors",
-			pos:  position{line: 112, col: 1, offset: 3406},
+			pos:  position{line: 118, col: 1, offset: 3642},
 			expr: &choiceExpr{
-				pos: position{line: 112, col: 20, offset: 3425},
+				pos: position{line: 118, col: 20, offset: 3661},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 112, col: 20, offset: 3425},
+						pos:  position{line: 118, col: 20, offset: 3661},
 						name: "DocumentAuthorsInlineForm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 112, col: 48, offset: 3453},
+						pos:  position{line: 118, col: 48, offset: 3689},
 						name: "DocumentAuthorsAttributeForm",
 					},
 				},
@@ -578,42 +615,42 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsInlineForm",
-			pos:  position{line: 114, col: 1, offset: 3483},
+			pos:  position{line: 120, col: 1, offset: 3719},
 			expr: &actionExpr{
-				pos: position{line: 114, col: 30, offset: 3512},
+				pos: position{line: 120, col: 30, offset: 3748},
 				run: (*parser).callonDocumentAuthorsInlineForm1,
 				expr: &seqExpr{
-					pos: position{line: 114, col: 30, offset: 3512},
+					pos: position{line: 120, col: 30, offset: 3748},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 114, col: 30, offset: 3512},
+							pos: position{line: 120, col: 30, offset: 3748},
 							expr: &ruleRefExpr{
-								pos:  position{line: 114, col: 30, offset: 3512},
+								pos:  position{line: 120, col: 30, offset: 3748},
 								name: "Space",
 							},
 						},
 						&notExpr{
-							pos: position{line: 114, col: 37, offset: 3519},
+							pos: position{line: 120, col: 37, offset: 3755},
 							expr: &litMatcher{
-								pos:        position{line: 114, col: 38, offset: 3520},
+								pos:        position{line: 120, col: 38, offset: 3756},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 114, col: 42, offset: 3524},
+							pos:   position{line: 120, col: 42, offset: 3760},
 							label: "authors",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 114, col: 51, offset: 3533},
+								pos: position{line: 120, col: 51, offset: 3769},
 								expr: &ruleRefExpr{
-									pos:  position{line: 114, col: 51, offset: 3533},
+									pos:  position{line: 120, col: 51, offset: 3769},
 									name: "DocumentAuthor",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 114, col: 68, offset: 3550},
+							pos:  position{line: 120, col: 68, offset: 3786},
 							name: "EOL",
 						},
 					},
@@ -622,36 +659,36 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorsAttributeForm",
-			pos:  position{line: 118, col: 1, offset: 3620},
+			pos:  position{line: 124, col: 1, offset: 3856},
 			expr: &actionExpr{
-				pos: position{line: 118, col: 33, offset: 3652},
+				pos: position{line: 124, col: 33, offset: 3888},
 				run: (*parser).callonDocumentAuthorsAttributeForm1,
 				expr: &seqExpr{
-					pos: position{line: 118, col: 33, offset: 3652},
+					pos: position{line: 124, col: 33, offset: 3888},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 118, col: 33, offset: 3652},
+							pos: position{line: 124, col: 33, offset: 3888},
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 33, offset: 3652},
+								pos:  position{line: 124, col: 33, offset: 3888},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 118, col: 40, offset: 3659},
+							pos:        position{line: 124, col: 40, offset: 3895},
 							val:        ":author:",
 							ignoreCase: false,
 							want:       "\":author:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 118, col: 51, offset: 3670},
+							pos:   position{line: 124, col: 51, offset: 3906},
 							label: "author",
 							expr: &ruleRefExpr{
-								pos:  position{line: 118, col: 59, offset: 3678},
+								pos:  position{line: 124, col: 59, offset: 3914},
 								name: "DocumentAuthor",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 118, col: 75, offset: 3694},
+							pos:  position{line: 124, col: 75, offset: 3930},
 							name: "EOL",
 						},
 					},
@@ -660,59 +697,59 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthor",
-			pos:  position{line: 122, col: 1, offset: 3773},
+			pos:  position{line: 128, col: 1, offset: 4009},
 			expr: &actionExpr{
-				pos: position{line: 122, col: 19, offset: 3791},
+				pos: position{line: 128, col: 19, offset: 4027},
 				run: (*parser).callonDocumentAuthor1,
 				expr: &seqExpr{
-					pos: position{line: 122, col: 19, offset: 3791},
+					pos: position{line: 128, col: 19, offset: 4027},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 122, col: 19, offset: 3791},
+							pos: position{line: 128, col: 19, offset: 4027},
 							expr: &ruleRefExpr{
-								pos:  position{line: 122, col: 19, offset: 3791},
+								pos:  position{line: 128, col: 19, offset: 4027},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 122, col: 26, offset: 3798},
+							pos:   position{line: 128, col: 26, offset: 4034},
 							label: "fullname",
 							expr: &ruleRefExpr{
-								pos:  position{line: 122, col: 36, offset: 3808},
+								pos:  position{line: 128, col: 36, offset: 4044},
 								name: "DocumentAuthorName",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 122, col: 56, offset: 3828},
+							pos:   position{line: 128, col: 56, offset: 4064},
 							label: "email",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 122, col: 62, offset: 3834},
+								pos: position{line: 128, col: 62, offset: 4070},
 								expr: &ruleRefExpr{
-									pos:  position{line: 122, col: 63, offset: 3835},
+									pos:  position{line: 128, col: 63, offset: 4071},
 									name: "DocumentAuthorEmail",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 122, col: 85, offset: 3857},
+							pos: position{line: 128, col: 85, offset: 4093},
 							expr: &ruleRefExpr{
-								pos:  position{line: 122, col: 85, offset: 3857},
+								pos:  position{line: 128, col: 85, offset: 4093},
 								name: "Space",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 122, col: 92, offset: 3864},
+							pos: position{line: 128, col: 92, offset: 4100},
 							expr: &litMatcher{
-								pos:        position{line: 122, col: 92, offset: 3864},
+								pos:        position{line: 128, col: 92, offset: 4100},
 								val:        ";",
 								ignoreCase: false,
 								want:       "\";\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 122, col: 97, offset: 3869},
+							pos: position{line: 128, col: 97, offset: 4105},
 							expr: &ruleRefExpr{
-								pos:  position{line: 122, col: 97, offset: 3869},
+								pos:  position{line: 128, col: 97, offset: 4105},
 								name: "Space",
 							},
 						},
@@ -722,14 +759,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorName",
-			pos:  position{line: 127, col: 1, offset: 4014},
+			pos:  position{line: 133, col: 1, offset: 4250},
 			expr: &actionExpr{
-				pos: position{line: 127, col: 23, offset: 4036},
+				pos: position{line: 133, col: 23, offset: 4272},
 				run: (*parser).callonDocumentAuthorName1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 127, col: 23, offset: 4036},
+					pos: position{line: 133, col: 23, offset: 4272},
 					expr: &charClassMatcher{
-						pos:        position{line: 127, col: 23, offset: 4036},
+						pos:        position{line: 133, col: 23, offset: 4272},
 						val:        "[^<;\\r\\n]",
 						chars:      []rune{'<', ';', '\r', '\n'},
 						ignoreCase: false,
@@ -740,29 +777,29 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentAuthorEmail",
-			pos:  position{line: 131, col: 1, offset: 4083},
+			pos:  position{line: 137, col: 1, offset: 4319},
 			expr: &actionExpr{
-				pos: position{line: 131, col: 24, offset: 4106},
+				pos: position{line: 137, col: 24, offset: 4342},
 				run: (*parser).callonDocumentAuthorEmail1,
 				expr: &seqExpr{
-					pos: position{line: 131, col: 24, offset: 4106},
+					pos: position{line: 137, col: 24, offset: 4342},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 131, col: 24, offset: 4106},
+							pos:        position{line: 137, col: 24, offset: 4342},
 							val:        "<",
 							ignoreCase: false,
 							want:       "\"<\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 131, col: 28, offset: 4110},
+							pos:   position{line: 137, col: 28, offset: 4346},
 							label: "email",
 							expr: &actionExpr{
-								pos: position{line: 131, col: 35, offset: 4117},
+								pos: position{line: 137, col: 35, offset: 4353},
 								run: (*parser).callonDocumentAuthorEmail5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 131, col: 36, offset: 4118},
+									pos: position{line: 137, col: 36, offset: 4354},
 									expr: &charClassMatcher{
-										pos:        position{line: 131, col: 36, offset: 4118},
+										pos:        position{line: 137, col: 36, offset: 4354},
 										val:        "[^>\\r\\n]",
 										chars:      []rune{'>', '\r', '\n'},
 										ignoreCase: false,
@@ -772,7 +809,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 133, col: 4, offset: 4165},
+							pos:        position{line: 139, col: 4, offset: 4401},
 							val:        ">",
 							ignoreCase: false,
 							want:       "\">\"",
@@ -783,85 +820,85 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevision",
-			pos:  position{line: 139, col: 1, offset: 4326},
+			pos:  position{line: 145, col: 1, offset: 4562},
 			expr: &actionExpr{
-				pos: position{line: 139, col: 21, offset: 4346},
+				pos: position{line: 145, col: 21, offset: 4582},
 				run: (*parser).callonDocumentRevision1,
 				expr: &seqExpr{
-					pos: position{line: 139, col: 21, offset: 4346},
+					pos: position{line: 145, col: 21, offset: 4582},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 139, col: 21, offset: 4346},
+							pos: position{line: 145, col: 21, offset: 4582},
 							expr: &ruleRefExpr{
-								pos:  position{line: 139, col: 21, offset: 4346},
+								pos:  position{line: 145, col: 21, offset: 4582},
 								name: "Space",
 							},
 						},
 						&notExpr{
-							pos: position{line: 139, col: 28, offset: 4353},
+							pos: position{line: 145, col: 28, offset: 4589},
 							expr: &litMatcher{
-								pos:        position{line: 139, col: 29, offset: 4354},
+								pos:        position{line: 145, col: 29, offset: 4590},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 139, col: 33, offset: 4358},
+							pos:   position{line: 145, col: 33, offset: 4594},
 							label: "revision",
 							expr: &choiceExpr{
-								pos: position{line: 140, col: 9, offset: 4377},
+								pos: position{line: 146, col: 9, offset: 4613},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 140, col: 10, offset: 4378},
+										pos: position{line: 146, col: 10, offset: 4614},
 										run: (*parser).callonDocumentRevision9,
 										expr: &seqExpr{
-											pos: position{line: 140, col: 10, offset: 4378},
+											pos: position{line: 146, col: 10, offset: 4614},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 140, col: 10, offset: 4378},
+													pos:   position{line: 146, col: 10, offset: 4614},
 													label: "revnumber",
 													expr: &ruleRefExpr{
-														pos:  position{line: 140, col: 21, offset: 4389},
+														pos:  position{line: 146, col: 21, offset: 4625},
 														name: "DocumentRevisionNumber",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 140, col: 45, offset: 4413},
+													pos: position{line: 146, col: 45, offset: 4649},
 													expr: &litMatcher{
-														pos:        position{line: 140, col: 45, offset: 4413},
+														pos:        position{line: 146, col: 45, offset: 4649},
 														val:        ",",
 														ignoreCase: false,
 														want:       "\",\"",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 140, col: 50, offset: 4418},
+													pos:   position{line: 146, col: 50, offset: 4654},
 													label: "revdate",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 140, col: 58, offset: 4426},
+														pos: position{line: 146, col: 58, offset: 4662},
 														expr: &ruleRefExpr{
-															pos:  position{line: 140, col: 59, offset: 4427},
+															pos:  position{line: 146, col: 59, offset: 4663},
 															name: "DocumentRevisionDate",
 														},
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 140, col: 82, offset: 4450},
+													pos: position{line: 146, col: 82, offset: 4686},
 													expr: &litMatcher{
-														pos:        position{line: 140, col: 82, offset: 4450},
+														pos:        position{line: 146, col: 82, offset: 4686},
 														val:        ":",
 														ignoreCase: false,
 														want:       "\":\"",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 140, col: 87, offset: 4455},
+													pos:   position{line: 146, col: 87, offset: 4691},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 140, col: 97, offset: 4465},
+														pos: position{line: 146, col: 97, offset: 4701},
 														expr: &ruleRefExpr{
-															pos:  position{line: 140, col: 98, offset: 4466},
+															pos:  position{line: 146, col: 98, offset: 4702},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -870,35 +907,35 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 142, col: 15, offset: 4583},
+										pos: position{line: 148, col: 15, offset: 4819},
 										run: (*parser).callonDocumentRevision23,
 										expr: &seqExpr{
-											pos: position{line: 142, col: 15, offset: 4583},
+											pos: position{line: 148, col: 15, offset: 4819},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 142, col: 15, offset: 4583},
+													pos:   position{line: 148, col: 15, offset: 4819},
 													label: "revdate",
 													expr: &ruleRefExpr{
-														pos:  position{line: 142, col: 24, offset: 4592},
+														pos:  position{line: 148, col: 24, offset: 4828},
 														name: "DocumentRevisionDate",
 													},
 												},
 												&zeroOrOneExpr{
-													pos: position{line: 142, col: 46, offset: 4614},
+													pos: position{line: 148, col: 46, offset: 4850},
 													expr: &litMatcher{
-														pos:        position{line: 142, col: 46, offset: 4614},
+														pos:        position{line: 148, col: 46, offset: 4850},
 														val:        ":",
 														ignoreCase: false,
 														want:       "\":\"",
 													},
 												},
 												&labeledExpr{
-													pos:   position{line: 142, col: 51, offset: 4619},
+													pos:   position{line: 148, col: 51, offset: 4855},
 													label: "revremark",
 													expr: &zeroOrOneExpr{
-														pos: position{line: 142, col: 61, offset: 4629},
+														pos: position{line: 148, col: 61, offset: 4865},
 														expr: &ruleRefExpr{
-															pos:  position{line: 142, col: 62, offset: 4630},
+															pos:  position{line: 148, col: 62, offset: 4866},
 															name: "DocumentRevisionRemark",
 														},
 													},
@@ -910,7 +947,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 144, col: 13, offset: 4739},
+							pos:  position{line: 150, col: 13, offset: 4975},
 							name: "EOL",
 						},
 					},
@@ -919,30 +956,30 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionNumber",
-			pos:  position{line: 149, col: 1, offset: 4869},
+			pos:  position{line: 155, col: 1, offset: 5105},
 			expr: &choiceExpr{
-				pos: position{line: 149, col: 27, offset: 4895},
+				pos: position{line: 155, col: 27, offset: 5131},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 149, col: 27, offset: 4895},
+						pos: position{line: 155, col: 27, offset: 5131},
 						run: (*parser).callonDocumentRevisionNumber2,
 						expr: &seqExpr{
-							pos: position{line: 149, col: 27, offset: 4895},
+							pos: position{line: 155, col: 27, offset: 5131},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 149, col: 27, offset: 4895},
+									pos:        position{line: 155, col: 27, offset: 5131},
 									val:        "v",
 									ignoreCase: true,
 									want:       "\"v\"i",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 149, col: 32, offset: 4900},
+									pos:  position{line: 155, col: 32, offset: 5136},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 149, col: 39, offset: 4907},
+									pos: position{line: 155, col: 39, offset: 5143},
 									expr: &charClassMatcher{
-										pos:        position{line: 149, col: 39, offset: 4907},
+										pos:        position{line: 155, col: 39, offset: 5143},
 										val:        "[^:,\\r\\n]",
 										chars:      []rune{':', ',', '\r', '\n'},
 										ignoreCase: false,
@@ -953,28 +990,28 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 151, col: 5, offset: 4955},
+						pos: position{line: 157, col: 5, offset: 5191},
 						run: (*parser).callonDocumentRevisionNumber8,
 						expr: &seqExpr{
-							pos: position{line: 151, col: 5, offset: 4955},
+							pos: position{line: 157, col: 5, offset: 5191},
 							exprs: []interface{}{
 								&zeroOrOneExpr{
-									pos: position{line: 151, col: 5, offset: 4955},
+									pos: position{line: 157, col: 5, offset: 5191},
 									expr: &litMatcher{
-										pos:        position{line: 151, col: 5, offset: 4955},
+										pos:        position{line: 157, col: 5, offset: 5191},
 										val:        "v",
 										ignoreCase: true,
 										want:       "\"v\"i",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 151, col: 11, offset: 4961},
+									pos:  position{line: 157, col: 11, offset: 5197},
 									name: "DIGIT",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 151, col: 18, offset: 4968},
+									pos: position{line: 157, col: 18, offset: 5204},
 									expr: &charClassMatcher{
-										pos:        position{line: 151, col: 18, offset: 4968},
+										pos:        position{line: 157, col: 18, offset: 5204},
 										val:        "[^:,\\r\\n]",
 										chars:      []rune{':', ',', '\r', '\n'},
 										ignoreCase: false,
@@ -982,16 +1019,16 @@ var g = &grammar{
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 151, col: 29, offset: 4979},
+									pos: position{line: 157, col: 29, offset: 5215},
 									expr: &ruleRefExpr{
-										pos:  position{line: 151, col: 29, offset: 4979},
+										pos:  position{line: 157, col: 29, offset: 5215},
 										name: "Space",
 									},
 								},
 								&andExpr{
-									pos: position{line: 151, col: 36, offset: 4986},
+									pos: position{line: 157, col: 36, offset: 5222},
 									expr: &litMatcher{
-										pos:        position{line: 151, col: 37, offset: 4987},
+										pos:        position{line: 157, col: 37, offset: 5223},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
@@ -1005,14 +1042,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionDate",
-			pos:  position{line: 155, col: 1, offset: 5027},
+			pos:  position{line: 161, col: 1, offset: 5263},
 			expr: &actionExpr{
-				pos: position{line: 155, col: 25, offset: 5051},
+				pos: position{line: 161, col: 25, offset: 5287},
 				run: (*parser).callonDocumentRevisionDate1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 155, col: 25, offset: 5051},
+					pos: position{line: 161, col: 25, offset: 5287},
 					expr: &charClassMatcher{
-						pos:        position{line: 155, col: 25, offset: 5051},
+						pos:        position{line: 161, col: 25, offset: 5287},
 						val:        "[^:\\r\\n]",
 						chars:      []rune{':', '\r', '\n'},
 						ignoreCase: false,
@@ -1023,14 +1060,14 @@ var g = &grammar{
 		},
 		{
 			name: "DocumentRevisionRemark",
-			pos:  position{line: 159, col: 1, offset: 5097},
+			pos:  position{line: 165, col: 1, offset: 5333},
 			expr: &actionExpr{
-				pos: position{line: 159, col: 27, offset: 5123},
+				pos: position{line: 165, col: 27, offset: 5359},
 				run: (*parser).callonDocumentRevisionRemark1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 159, col: 27, offset: 5123},
+					pos: position{line: 165, col: 27, offset: 5359},
 					expr: &charClassMatcher{
-						pos:        position{line: 159, col: 27, offset: 5123},
+						pos:        position{line: 165, col: 27, offset: 5359},
 						val:        "[^\\r\\r\\n]",
 						chars:      []rune{'\r', '\r', '\n'},
 						ignoreCase: false,
@@ -1041,56 +1078,56 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeDeclaration",
-			pos:  position{line: 166, col: 1, offset: 5276},
+			pos:  position{line: 172, col: 1, offset: 5512},
 			expr: &actionExpr{
-				pos: position{line: 166, col: 25, offset: 5300},
+				pos: position{line: 172, col: 25, offset: 5536},
 				run: (*parser).callonAttributeDeclaration1,
 				expr: &seqExpr{
-					pos: position{line: 166, col: 25, offset: 5300},
+					pos: position{line: 172, col: 25, offset: 5536},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 166, col: 25, offset: 5300},
+							pos:        position{line: 172, col: 25, offset: 5536},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 166, col: 29, offset: 5304},
+							pos:   position{line: 172, col: 29, offset: 5540},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 166, col: 35, offset: 5310},
+								pos:  position{line: 172, col: 35, offset: 5546},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 166, col: 50, offset: 5325},
+							pos:        position{line: 172, col: 50, offset: 5561},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 167, col: 9, offset: 5338},
+							pos:   position{line: 173, col: 9, offset: 5574},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 167, col: 15, offset: 5344},
+								pos: position{line: 173, col: 15, offset: 5580},
 								expr: &actionExpr{
-									pos: position{line: 167, col: 16, offset: 5345},
+									pos: position{line: 173, col: 16, offset: 5581},
 									run: (*parser).callonAttributeDeclaration9,
 									expr: &seqExpr{
-										pos: position{line: 167, col: 17, offset: 5346},
+										pos: position{line: 173, col: 17, offset: 5582},
 										exprs: []interface{}{
 											&oneOrMoreExpr{
-												pos: position{line: 167, col: 17, offset: 5346},
+												pos: position{line: 173, col: 17, offset: 5582},
 												expr: &ruleRefExpr{
-													pos:  position{line: 167, col: 17, offset: 5346},
+													pos:  position{line: 173, col: 17, offset: 5582},
 													name: "Space",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 167, col: 24, offset: 5353},
+												pos:   position{line: 173, col: 24, offset: 5589},
 												label: "value",
 												expr: &ruleRefExpr{
-													pos:  position{line: 167, col: 31, offset: 5360},
+													pos:  position{line: 173, col: 31, offset: 5596},
 													name: "AttributeDeclarationValue",
 												},
 											},
@@ -1100,14 +1137,14 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 169, col: 13, offset: 5434},
+							pos: position{line: 175, col: 13, offset: 5670},
 							expr: &ruleRefExpr{
-								pos:  position{line: 169, col: 13, offset: 5434},
+								pos:  position{line: 175, col: 13, offset: 5670},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 169, col: 20, offset: 5441},
+							pos:  position{line: 175, col: 20, offset: 5677},
 							name: "EOL",
 						},
 					},
@@ -1116,15 +1153,15 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeName",
-			pos:  position{line: 176, col: 1, offset: 5681},
+			pos:  position{line: 182, col: 1, offset: 5917},
 			expr: &actionExpr{
-				pos: position{line: 176, col: 18, offset: 5698},
+				pos: position{line: 182, col: 18, offset: 5934},
 				run: (*parser).callonAttributeName1,
 				expr: &seqExpr{
-					pos: position{line: 176, col: 18, offset: 5698},
+					pos: position{line: 182, col: 18, offset: 5934},
 					exprs: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 176, col: 18, offset: 5698},
+							pos:        position{line: 182, col: 18, offset: 5934},
 							val:        "[\\pL0-9_]",
 							chars:      []rune{'_'},
 							ranges:     []rune{'0', '9'},
@@ -1133,9 +1170,9 @@ var g = &grammar{
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 176, col: 28, offset: 5708},
+							pos: position{line: 182, col: 28, offset: 5944},
 							expr: &charClassMatcher{
-								pos:        position{line: 176, col: 29, offset: 5709},
+								pos:        position{line: 182, col: 29, offset: 5945},
 								val:        "[\\pL0-9-]",
 								chars:      []rune{'-'},
 								ranges:     []rune{'0', '9'},
@@ -1150,14 +1187,14 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeDeclarationValue",
-			pos:  position{line: 180, col: 1, offset: 5757},
+			pos:  position{line: 186, col: 1, offset: 5993},
 			expr: &actionExpr{
-				pos: position{line: 180, col: 30, offset: 5786},
+				pos: position{line: 186, col: 30, offset: 6022},
 				run: (*parser).callonAttributeDeclarationValue1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 180, col: 30, offset: 5786},
+					pos: position{line: 186, col: 30, offset: 6022},
 					expr: &charClassMatcher{
-						pos:        position{line: 180, col: 30, offset: 5786},
+						pos:        position{line: 186, col: 30, offset: 6022},
 						val:        "[^\\r\\n]",
 						chars:      []rune{'\r', '\n'},
 						ignoreCase: false,
@@ -1168,85 +1205,85 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeReset",
-			pos:  position{line: 184, col: 1, offset: 5831},
+			pos:  position{line: 190, col: 1, offset: 6067},
 			expr: &choiceExpr{
-				pos: position{line: 184, col: 19, offset: 5849},
+				pos: position{line: 190, col: 19, offset: 6085},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 184, col: 19, offset: 5849},
+						pos: position{line: 190, col: 19, offset: 6085},
 						run: (*parser).callonAttributeReset2,
 						expr: &seqExpr{
-							pos: position{line: 184, col: 19, offset: 5849},
+							pos: position{line: 190, col: 19, offset: 6085},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 184, col: 19, offset: 5849},
+									pos:        position{line: 190, col: 19, offset: 6085},
 									val:        ":!",
 									ignoreCase: false,
 									want:       "\":!\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 184, col: 24, offset: 5854},
+									pos:   position{line: 190, col: 24, offset: 6090},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 184, col: 30, offset: 5860},
+										pos:  position{line: 190, col: 30, offset: 6096},
 										name: "AttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 184, col: 45, offset: 5875},
+									pos:        position{line: 190, col: 45, offset: 6111},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 184, col: 49, offset: 5879},
+									pos: position{line: 190, col: 49, offset: 6115},
 									expr: &ruleRefExpr{
-										pos:  position{line: 184, col: 49, offset: 5879},
+										pos:  position{line: 190, col: 49, offset: 6115},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 184, col: 56, offset: 5886},
+									pos:  position{line: 190, col: 56, offset: 6122},
 									name: "EOL",
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 186, col: 5, offset: 5946},
+						pos: position{line: 192, col: 5, offset: 6182},
 						run: (*parser).callonAttributeReset11,
 						expr: &seqExpr{
-							pos: position{line: 186, col: 5, offset: 5946},
+							pos: position{line: 192, col: 5, offset: 6182},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 186, col: 5, offset: 5946},
+									pos:        position{line: 192, col: 5, offset: 6182},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 186, col: 9, offset: 5950},
+									pos:   position{line: 192, col: 9, offset: 6186},
 									label: "name",
 									expr: &ruleRefExpr{
-										pos:  position{line: 186, col: 15, offset: 5956},
+										pos:  position{line: 192, col: 15, offset: 6192},
 										name: "AttributeName",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 186, col: 30, offset: 5971},
+									pos:        position{line: 192, col: 30, offset: 6207},
 									val:        "!:",
 									ignoreCase: false,
 									want:       "\"!:\"",
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 186, col: 35, offset: 5976},
+									pos: position{line: 192, col: 35, offset: 6212},
 									expr: &ruleRefExpr{
-										pos:  position{line: 186, col: 35, offset: 5976},
+										pos:  position{line: 192, col: 35, offset: 6212},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 186, col: 42, offset: 5983},
+									pos:  position{line: 192, col: 42, offset: 6219},
 									name: "EOL",
 								},
 							},
@@ -1257,16 +1294,16 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeSubstitution",
-			pos:  position{line: 190, col: 1, offset: 6042},
+			pos:  position{line: 196, col: 1, offset: 6278},
 			expr: &choiceExpr{
-				pos: position{line: 190, col: 26, offset: 6067},
+				pos: position{line: 196, col: 26, offset: 6303},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 190, col: 26, offset: 6067},
+						pos:  position{line: 196, col: 26, offset: 6303},
 						name: "CounterSub",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 190, col: 39, offset: 6080},
+						pos:  position{line: 196, col: 39, offset: 6316},
 						name: "AttrSub",
 					},
 				},
@@ -1274,29 +1311,29 @@ var g = &grammar{
 		},
 		{
 			name: "AttrSub",
-			pos:  position{line: 192, col: 1, offset: 6089},
+			pos:  position{line: 198, col: 1, offset: 6325},
 			expr: &actionExpr{
-				pos: position{line: 192, col: 12, offset: 6100},
+				pos: position{line: 198, col: 12, offset: 6336},
 				run: (*parser).callonAttrSub1,
 				expr: &seqExpr{
-					pos: position{line: 192, col: 12, offset: 6100},
+					pos: position{line: 198, col: 12, offset: 6336},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 192, col: 12, offset: 6100},
+							pos:        position{line: 198, col: 12, offset: 6336},
 							val:        "{",
 							ignoreCase: false,
 							want:       "\"{\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 192, col: 16, offset: 6104},
+							pos:   position{line: 198, col: 16, offset: 6340},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 192, col: 21, offset: 6109},
+								pos:  position{line: 198, col: 21, offset: 6345},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 192, col: 35, offset: 6123},
+							pos:        position{line: 198, col: 35, offset: 6359},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1307,32 +1344,32 @@ var g = &grammar{
 		},
 		{
 			name: "CounterSub",
-			pos:  position{line: 196, col: 1, offset: 6189},
+			pos:  position{line: 202, col: 1, offset: 6439},
 			expr: &choiceExpr{
-				pos: position{line: 196, col: 15, offset: 6203},
+				pos: position{line: 202, col: 15, offset: 6453},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 196, col: 15, offset: 6203},
+						pos:  position{line: 202, col: 15, offset: 6453},
 						name: "CounterSub1",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 196, col: 29, offset: 6217},
+						pos:  position{line: 202, col: 29, offset: 6467},
 						name: "CounterSub2",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 196, col: 43, offset: 6231},
+						pos:  position{line: 202, col: 43, offset: 6481},
 						name: "CounterSubAlpha",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 196, col: 61, offset: 6249},
+						pos:  position{line: 202, col: 61, offset: 6499},
 						name: "CounterSubAlpha2",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 196, col: 80, offset: 6268},
+						pos:  position{line: 202, col: 80, offset: 6518},
 						name: "CounterSubStart",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 196, col: 98, offset: 6286},
+						pos:  position{line: 202, col: 98, offset: 6536},
 						name: "CounterSubStart2",
 					},
 				},
@@ -1340,29 +1377,29 @@ var g = &grammar{
 		},
 		{
 			name: "CounterSub1",
-			pos:  position{line: 198, col: 1, offset: 6304},
+			pos:  position{line: 204, col: 1, offset: 6554},
 			expr: &actionExpr{
-				pos: position{line: 198, col: 16, offset: 6319},
+				pos: position{line: 204, col: 16, offset: 6569},
 				run: (*parser).callonCounterSub11,
 				expr: &seqExpr{
-					pos: position{line: 198, col: 16, offset: 6319},
+					pos: position{line: 204, col: 16, offset: 6569},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 198, col: 16, offset: 6319},
+							pos:        position{line: 204, col: 16, offset: 6569},
 							val:        "{counter:",
 							ignoreCase: false,
 							want:       "\"{counter:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 198, col: 28, offset: 6331},
+							pos:   position{line: 204, col: 28, offset: 6581},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 198, col: 33, offset: 6336},
+								pos:  position{line: 204, col: 33, offset: 6586},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 198, col: 47, offset: 6350},
+							pos:        position{line: 204, col: 47, offset: 6600},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1373,29 +1410,29 @@ var g = &grammar{
 		},
 		{
 			name: "CounterSub2",
-			pos:  position{line: 202, col: 1, offset: 6426},
+			pos:  position{line: 208, col: 1, offset: 6676},
 			expr: &actionExpr{
-				pos: position{line: 202, col: 16, offset: 6441},
+				pos: position{line: 208, col: 16, offset: 6691},
 				run: (*parser).callonCounterSub21,
 				expr: &seqExpr{
-					pos: position{line: 202, col: 16, offset: 6441},
+					pos: position{line: 208, col: 16, offset: 6691},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 202, col: 16, offset: 6441},
+							pos:        position{line: 208, col: 16, offset: 6691},
 							val:        "{counter2:",
 							ignoreCase: false,
 							want:       "\"{counter2:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 202, col: 29, offset: 6454},
+							pos:   position{line: 208, col: 29, offset: 6704},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 202, col: 34, offset: 6459},
+								pos:  position{line: 208, col: 34, offset: 6709},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 202, col: 48, offset: 6473},
+							pos:        position{line: 208, col: 48, offset: 6723},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1406,38 +1443,38 @@ var g = &grammar{
 		},
 		{
 			name: "CounterSubAlpha",
-			pos:  position{line: 206, col: 1, offset: 6548},
+			pos:  position{line: 212, col: 1, offset: 6798},
 			expr: &actionExpr{
-				pos: position{line: 206, col: 20, offset: 6567},
+				pos: position{line: 212, col: 20, offset: 6817},
 				run: (*parser).callonCounterSubAlpha1,
 				expr: &seqExpr{
-					pos: position{line: 206, col: 20, offset: 6567},
+					pos: position{line: 212, col: 20, offset: 6817},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 206, col: 20, offset: 6567},
+							pos:        position{line: 212, col: 20, offset: 6817},
 							val:        "{counter:",
 							ignoreCase: false,
 							want:       "\"{counter:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 206, col: 32, offset: 6579},
+							pos:   position{line: 212, col: 32, offset: 6829},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 206, col: 37, offset: 6584},
+								pos:  position{line: 212, col: 37, offset: 6834},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 206, col: 51, offset: 6598},
+							pos:        position{line: 212, col: 51, offset: 6848},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 206, col: 55, offset: 6602},
+							pos:   position{line: 212, col: 55, offset: 6852},
 							label: "start",
 							expr: &charClassMatcher{
-								pos:        position{line: 206, col: 61, offset: 6608},
+								pos:        position{line: 212, col: 61, offset: 6858},
 								val:        "[A-Za-z]",
 								ranges:     []rune{'A', 'Z', 'a', 'z'},
 								ignoreCase: false,
@@ -1445,7 +1482,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 206, col: 70, offset: 6617},
+							pos:        position{line: 212, col: 70, offset: 6867},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1456,38 +1493,38 @@ var g = &grammar{
 		},
 		{
 			name: "CounterSubAlpha2",
-			pos:  position{line: 210, col: 1, offset: 6695},
+			pos:  position{line: 216, col: 1, offset: 6945},
 			expr: &actionExpr{
-				pos: position{line: 210, col: 21, offset: 6715},
+				pos: position{line: 216, col: 21, offset: 6965},
 				run: (*parser).callonCounterSubAlpha21,
 				expr: &seqExpr{
-					pos: position{line: 210, col: 21, offset: 6715},
+					pos: position{line: 216, col: 21, offset: 6965},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 210, col: 21, offset: 6715},
+							pos:        position{line: 216, col: 21, offset: 6965},
 							val:        "{counter2:",
 							ignoreCase: false,
 							want:       "\"{counter2:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 210, col: 34, offset: 6728},
+							pos:   position{line: 216, col: 34, offset: 6978},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 210, col: 39, offset: 6733},
+								pos:  position{line: 216, col: 39, offset: 6983},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 210, col: 53, offset: 6747},
+							pos:        position{line: 216, col: 53, offset: 6997},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 210, col: 57, offset: 6751},
+							pos:   position{line: 216, col: 57, offset: 7001},
 							label: "start",
 							expr: &charClassMatcher{
-								pos:        position{line: 210, col: 63, offset: 6757},
+								pos:        position{line: 216, col: 63, offset: 7007},
 								val:        "[A-Za-z]",
 								ranges:     []rune{'A', 'Z', 'a', 'z'},
 								ignoreCase: false,
@@ -1495,7 +1532,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 210, col: 72, offset: 6766},
+							pos:        position{line: 216, col: 72, offset: 7016},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1506,43 +1543,43 @@ var g = &grammar{
 		},
 		{
 			name: "CounterSubStart",
-			pos:  position{line: 214, col: 1, offset: 6843},
+			pos:  position{line: 220, col: 1, offset: 7093},
 			expr: &actionExpr{
-				pos: position{line: 214, col: 20, offset: 6862},
+				pos: position{line: 220, col: 20, offset: 7112},
 				run: (*parser).callonCounterSubStart1,
 				expr: &seqExpr{
-					pos: position{line: 214, col: 20, offset: 6862},
+					pos: position{line: 220, col: 20, offset: 7112},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 214, col: 20, offset: 6862},
+							pos:        position{line: 220, col: 20, offset: 7112},
 							val:        "{counter:",
 							ignoreCase: false,
 							want:       "\"{counter:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 214, col: 32, offset: 6874},
+							pos:   position{line: 220, col: 32, offset: 7124},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 214, col: 37, offset: 6879},
+								pos:  position{line: 220, col: 37, offset: 7129},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 214, col: 51, offset: 6893},
+							pos:        position{line: 220, col: 51, offset: 7143},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 214, col: 55, offset: 6897},
+							pos:   position{line: 220, col: 55, offset: 7147},
 							label: "num",
 							expr: &actionExpr{
-								pos: position{line: 214, col: 60, offset: 6902},
+								pos: position{line: 220, col: 60, offset: 7152},
 								run: (*parser).callonCounterSubStart8,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 214, col: 60, offset: 6902},
+									pos: position{line: 220, col: 60, offset: 7152},
 									expr: &charClassMatcher{
-										pos:        position{line: 214, col: 60, offset: 6902},
+										pos:        position{line: 220, col: 60, offset: 7152},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -1552,7 +1589,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 214, col: 108, offset: 6950},
+							pos:        position{line: 220, col: 108, offset: 7200},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1563,43 +1600,43 @@ var g = &grammar{
 		},
 		{
 			name: "CounterSubStart2",
-			pos:  position{line: 218, col: 1, offset: 7032},
+			pos:  position{line: 224, col: 1, offset: 7282},
 			expr: &actionExpr{
-				pos: position{line: 218, col: 21, offset: 7052},
+				pos: position{line: 224, col: 21, offset: 7302},
 				run: (*parser).callonCounterSubStart21,
 				expr: &seqExpr{
-					pos: position{line: 218, col: 21, offset: 7052},
+					pos: position{line: 224, col: 21, offset: 7302},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 218, col: 21, offset: 7052},
+							pos:        position{line: 224, col: 21, offset: 7302},
 							val:        "{counter2:",
 							ignoreCase: false,
 							want:       "\"{counter2:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 34, offset: 7065},
+							pos:   position{line: 224, col: 34, offset: 7315},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 218, col: 39, offset: 7070},
+								pos:  position{line: 224, col: 39, offset: 7320},
 								name: "AttributeName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 218, col: 53, offset: 7084},
+							pos:        position{line: 224, col: 53, offset: 7334},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 218, col: 57, offset: 7088},
+							pos:   position{line: 224, col: 57, offset: 7338},
 							label: "num",
 							expr: &actionExpr{
-								pos: position{line: 218, col: 62, offset: 7093},
+								pos: position{line: 224, col: 62, offset: 7343},
 								run: (*parser).callonCounterSubStart28,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 218, col: 62, offset: 7093},
+									pos: position{line: 224, col: 62, offset: 7343},
 									expr: &charClassMatcher{
-										pos:        position{line: 218, col: 62, offset: 7093},
+										pos:        position{line: 224, col: 62, offset: 7343},
 										val:        "[0-9]",
 										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
@@ -1609,7 +1646,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 218, col: 110, offset: 7141},
+							pos:        position{line: 224, col: 110, offset: 7391},
 							val:        "}",
 							ignoreCase: false,
 							want:       "\"}\"",
@@ -1620,28 +1657,28 @@ var g = &grammar{
 		},
 		{
 			name: "Attributes",
-			pos:  position{line: 222, col: 1, offset: 7222},
+			pos:  position{line: 228, col: 1, offset: 7472},
 			expr: &actionExpr{
-				pos: position{line: 222, col: 15, offset: 7236},
+				pos: position{line: 228, col: 15, offset: 7486},
 				run: (*parser).callonAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 222, col: 15, offset: 7236},
+					pos: position{line: 228, col: 15, offset: 7486},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 222, col: 15, offset: 7236},
+							pos:   position{line: 228, col: 15, offset: 7486},
 							label: "attrs",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 222, col: 21, offset: 7242},
+								pos: position{line: 228, col: 21, offset: 7492},
 								expr: &ruleRefExpr{
-									pos:  position{line: 222, col: 22, offset: 7243},
+									pos:  position{line: 228, col: 22, offset: 7493},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 222, col: 41, offset: 7262},
+							pos: position{line: 228, col: 41, offset: 7512},
 							expr: &ruleRefExpr{
-								pos:  position{line: 222, col: 41, offset: 7262},
+								pos:  position{line: 228, col: 41, offset: 7512},
 								name: "BlankLine",
 							},
 						},
@@ -1651,26 +1688,26 @@ var g = &grammar{
 		},
 		{
 			name: "ElementAttribute",
-			pos:  position{line: 226, col: 1, offset: 7332},
+			pos:  position{line: 232, col: 1, offset: 7582},
 			expr: &actionExpr{
-				pos: position{line: 226, col: 21, offset: 7352},
+				pos: position{line: 232, col: 21, offset: 7602},
 				run: (*parser).callonElementAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 226, col: 21, offset: 7352},
+					pos: position{line: 232, col: 21, offset: 7602},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 226, col: 21, offset: 7352},
+							pos: position{line: 232, col: 21, offset: 7602},
 							expr: &choiceExpr{
-								pos: position{line: 226, col: 23, offset: 7354},
+								pos: position{line: 232, col: 23, offset: 7604},
 								alternatives: []interface{}{
 									&litMatcher{
-										pos:        position{line: 226, col: 23, offset: 7354},
+										pos:        position{line: 232, col: 23, offset: 7604},
 										val:        "[",
 										ignoreCase: false,
 										want:       "\"[\"",
 									},
 									&litMatcher{
-										pos:        position{line: 226, col: 29, offset: 7360},
+										pos:        position{line: 232, col: 29, offset: 7610},
 										val:        ".",
 										ignoreCase: false,
 										want:       "\".\"",
@@ -1679,57 +1716,57 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 227, col: 5, offset: 7437},
+							pos:   position{line: 233, col: 5, offset: 7687},
 							label: "attr",
 							expr: &choiceExpr{
-								pos: position{line: 227, col: 11, offset: 7443},
+								pos: position{line: 233, col: 11, offset: 7693},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 227, col: 11, offset: 7443},
+										pos:  position{line: 233, col: 11, offset: 7693},
 										name: "ElementID",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 228, col: 9, offset: 7464},
+										pos:  position{line: 234, col: 9, offset: 7714},
 										name: "ElementTitle",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 229, col: 9, offset: 7488},
+										pos:  position{line: 235, col: 9, offset: 7738},
 										name: "ElementShortHandAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 230, col: 9, offset: 7525},
+										pos:  position{line: 236, col: 9, offset: 7775},
 										name: "LiteralBlockAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 231, col: 9, offset: 7558},
+										pos:  position{line: 237, col: 9, offset: 7808},
 										name: "SourceAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 232, col: 9, offset: 7586},
+										pos:  position{line: 238, col: 9, offset: 7836},
 										name: "ExampleBlockAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 233, col: 9, offset: 7618},
+										pos:  position{line: 239, col: 9, offset: 7868},
 										name: "ListingBlockAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 234, col: 9, offset: 7650},
+										pos:  position{line: 240, col: 9, offset: 7900},
 										name: "QuoteAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 235, col: 9, offset: 7677},
+										pos:  position{line: 241, col: 9, offset: 7927},
 										name: "VerseAttributes",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 236, col: 9, offset: 7704},
+										pos:  position{line: 242, col: 9, offset: 7954},
 										name: "AdmonitionMarkerAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 237, col: 9, offset: 7741},
+										pos:  position{line: 243, col: 9, offset: 7991},
 										name: "PassthroughBlockAttribute",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 238, col: 9, offset: 7777},
+										pos:  position{line: 244, col: 9, offset: 8027},
 										name: "AttributeGroup",
 									},
 								},
@@ -1741,42 +1778,42 @@ var g = &grammar{
 		},
 		{
 			name: "ElementID",
-			pos:  position{line: 242, col: 1, offset: 7880},
+			pos:  position{line: 248, col: 1, offset: 8130},
 			expr: &actionExpr{
-				pos: position{line: 242, col: 14, offset: 7893},
+				pos: position{line: 248, col: 14, offset: 8143},
 				run: (*parser).callonElementID1,
 				expr: &seqExpr{
-					pos: position{line: 242, col: 14, offset: 7893},
+					pos: position{line: 248, col: 14, offset: 8143},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 242, col: 14, offset: 7893},
+							pos:        position{line: 248, col: 14, offset: 8143},
 							val:        "[[",
 							ignoreCase: false,
 							want:       "\"[[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 242, col: 19, offset: 7898},
+							pos:   position{line: 248, col: 19, offset: 8148},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 242, col: 23, offset: 7902},
+								pos:  position{line: 248, col: 23, offset: 8152},
 								name: "ID",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 242, col: 27, offset: 7906},
+							pos:        position{line: 248, col: 27, offset: 8156},
 							val:        "]]",
 							ignoreCase: false,
 							want:       "\"]]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 242, col: 32, offset: 7911},
+							pos: position{line: 248, col: 32, offset: 8161},
 							expr: &ruleRefExpr{
-								pos:  position{line: 242, col: 32, offset: 7911},
+								pos:  position{line: 248, col: 32, offset: 8161},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 242, col: 39, offset: 7918},
+							pos:  position{line: 248, col: 39, offset: 8168},
 							name: "EOL",
 						},
 					},
@@ -1785,37 +1822,37 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElementID",
-			pos:  position{line: 246, col: 1, offset: 7961},
+			pos:  position{line: 252, col: 1, offset: 8211},
 			expr: &actionExpr{
-				pos: position{line: 246, col: 20, offset: 7980},
+				pos: position{line: 252, col: 20, offset: 8230},
 				run: (*parser).callonInlineElementID1,
 				expr: &seqExpr{
-					pos: position{line: 246, col: 20, offset: 7980},
+					pos: position{line: 252, col: 20, offset: 8230},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 246, col: 20, offset: 7980},
+							pos:        position{line: 252, col: 20, offset: 8230},
 							val:        "[[",
 							ignoreCase: false,
 							want:       "\"[[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 246, col: 25, offset: 7985},
+							pos:   position{line: 252, col: 25, offset: 8235},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 246, col: 29, offset: 7989},
+								pos:  position{line: 252, col: 29, offset: 8239},
 								name: "ID",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 246, col: 33, offset: 7993},
+							pos:        position{line: 252, col: 33, offset: 8243},
 							val:        "]]",
 							ignoreCase: false,
 							want:       "\"]]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 246, col: 38, offset: 7998},
+							pos: position{line: 252, col: 38, offset: 8248},
 							expr: &ruleRefExpr{
-								pos:  position{line: 246, col: 38, offset: 7998},
+								pos:  position{line: 252, col: 38, offset: 8248},
 								name: "Space",
 							},
 						},
@@ -1823,38 +1860,108 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "BibliographyAnchor",
+			pos:  position{line: 257, col: 1, offset: 8450},
+			expr: &actionExpr{
+				pos: position{line: 257, col: 23, offset: 8472},
+				run: (*parser).callonBibliographyAnchor1,
+				expr: &seqExpr{
+					pos: position{line: 257, col: 23, offset: 8472},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 257, col: 23, offset: 8472},
+							val:        "[[[",
+							ignoreCase: false,
+							want:       "\"[[[\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 257, col: 29, offset: 8478},
+							label: "id",
+							expr: &ruleRefExpr{
+								pos:  position{line: 257, col: 33, offset: 8482},
+								name: "ID",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 257, col: 37, offset: 8486},
+							label: "label",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 257, col: 43, offset: 8492},
+								expr: &actionExpr{
+									pos: position{line: 257, col: 44, offset: 8493},
+									run: (*parser).callonBibliographyAnchor8,
+									expr: &seqExpr{
+										pos: position{line: 257, col: 44, offset: 8493},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 257, col: 44, offset: 8493},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 257, col: 48, offset: 8497},
+												expr: &ruleRefExpr{
+													pos:  position{line: 257, col: 48, offset: 8497},
+													name: "Space",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 257, col: 55, offset: 8504},
+												label: "label",
+												expr: &ruleRefExpr{
+													pos:  position{line: 257, col: 62, offset: 8511},
+													name: "ID",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 257, col: 90, offset: 8539},
+							val:        "]]]",
+							ignoreCase: false,
+							want:       "\"]]]\"",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "ElementTitle",
-			pos:  position{line: 252, col: 1, offset: 8275},
+			pos:  position{line: 263, col: 1, offset: 8749},
 			expr: &actionExpr{
-				pos: position{line: 252, col: 17, offset: 8291},
+				pos: position{line: 263, col: 17, offset: 8765},
 				run: (*parser).callonElementTitle1,
 				expr: &seqExpr{
-					pos: position{line: 252, col: 17, offset: 8291},
+					pos: position{line: 263, col: 17, offset: 8765},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 252, col: 17, offset: 8291},
+							pos:        position{line: 263, col: 17, offset: 8765},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 252, col: 21, offset: 8295},
+							pos:   position{line: 263, col: 21, offset: 8769},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 252, col: 28, offset: 8302},
+								pos:  position{line: 263, col: 28, offset: 8776},
 								name: "ElementTitleContent",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 252, col: 49, offset: 8323},
+							pos: position{line: 263, col: 49, offset: 8797},
 							expr: &ruleRefExpr{
-								pos:  position{line: 252, col: 49, offset: 8323},
+								pos:  position{line: 263, col: 49, offset: 8797},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 252, col: 56, offset: 8330},
+							pos:  position{line: 263, col: 56, offset: 8804},
 							name: "EOL",
 						},
 					},
@@ -1863,15 +1970,15 @@ var g = &grammar{
 		},
 		{
 			name: "ElementTitleContent",
-			pos:  position{line: 256, col: 1, offset: 8388},
+			pos:  position{line: 267, col: 1, offset: 8862},
 			expr: &actionExpr{
-				pos: position{line: 256, col: 24, offset: 8411},
+				pos: position{line: 267, col: 24, offset: 8885},
 				run: (*parser).callonElementTitleContent1,
 				expr: &seqExpr{
-					pos: position{line: 256, col: 24, offset: 8411},
+					pos: position{line: 267, col: 24, offset: 8885},
 					exprs: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 256, col: 24, offset: 8411},
+							pos:        position{line: 267, col: 24, offset: 8885},
 							val:        "[\\pL0-9]",
 							ranges:     []rune{'0', '9'},
 							classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -1879,9 +1986,9 @@ var g = &grammar{
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 256, col: 32, offset: 8419},
+							pos: position{line: 267, col: 32, offset: 8893},
 							expr: &charClassMatcher{
-								pos:        position{line: 256, col: 32, offset: 8419},
+								pos:        position{line: 267, col: 32, offset: 8893},
 								val:        "[^\\r\\n<>]",
 								chars:      []rune{'\r', '\n', '<', '>'},
 								ignoreCase: false,
@@ -1894,36 +2001,36 @@ var g = &grammar{
 		},
 		{
 			name: "ElementShortHandAttributes",
-			pos:  position{line: 262, col: 1, offset: 8673},
+			pos:  position{line: 273, col: 1, offset: 9147},
 			expr: &actionExpr{
-				pos: position{line: 262, col: 31, offset: 8703},
+				pos: position{line: 273, col: 31, offset: 9177},
 				run: (*parser).callonElementShortHandAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 262, col: 31, offset: 8703},
+					pos: position{line: 273, col: 31, offset: 9177},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 262, col: 31, offset: 8703},
+							pos:        position{line: 273, col: 31, offset: 9177},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 262, col: 35, offset: 8707},
+							pos:   position{line: 273, col: 35, offset: 9181},
 							label: "attrs",
 							expr: &seqExpr{
-								pos: position{line: 262, col: 42, offset: 8714},
+								pos: position{line: 273, col: 42, offset: 9188},
 								exprs: []interface{}{
 									&zeroOrMoreExpr{
-										pos: position{line: 262, col: 42, offset: 8714},
+										pos: position{line: 273, col: 42, offset: 9188},
 										expr: &ruleRefExpr{
-											pos:  position{line: 262, col: 42, offset: 8714},
+											pos:  position{line: 273, col: 42, offset: 9188},
 											name: "ShortHandAttr",
 										},
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 262, col: 57, offset: 8729},
+										pos: position{line: 273, col: 57, offset: 9203},
 										expr: &ruleRefExpr{
-											pos:  position{line: 262, col: 57, offset: 8729},
+											pos:  position{line: 273, col: 57, offset: 9203},
 											name: "NamedAttr",
 										},
 									},
@@ -1931,20 +2038,20 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 262, col: 69, offset: 8741},
+							pos:        position{line: 273, col: 69, offset: 9215},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 262, col: 73, offset: 8745},
+							pos: position{line: 273, col: 73, offset: 9219},
 							expr: &ruleRefExpr{
-								pos:  position{line: 262, col: 73, offset: 8745},
+								pos:  position{line: 273, col: 73, offset: 9219},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 262, col: 80, offset: 8752},
+							pos:  position{line: 273, col: 80, offset: 9226},
 							name: "EOL",
 						},
 					},
@@ -1953,20 +2060,20 @@ var g = &grammar{
 		},
 		{
 			name: "BlockAttrs",
-			pos:  position{line: 266, col: 1, offset: 8806},
+			pos:  position{line: 277, col: 1, offset: 9280},
 			expr: &choiceExpr{
-				pos: position{line: 266, col: 15, offset: 8820},
+				pos: position{line: 277, col: 15, offset: 9294},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 266, col: 15, offset: 8820},
+						pos:  position{line: 277, col: 15, offset: 9294},
 						name: "BlockAttrList",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 266, col: 31, offset: 8836},
+						pos:  position{line: 277, col: 31, offset: 9310},
 						name: "ElementTitle",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 266, col: 46, offset: 8851},
+						pos:  position{line: 277, col: 46, offset: 9325},
 						name: "ElementID",
 					},
 				},
@@ -1974,57 +2081,57 @@ var g = &grammar{
 		},
 		{
 			name: "BlockAttrList",
-			pos:  position{line: 270, col: 1, offset: 9079},
+			pos:  position{line: 281, col: 1, offset: 9553},
 			expr: &actionExpr{
-				pos: position{line: 270, col: 18, offset: 9096},
+				pos: position{line: 281, col: 18, offset: 9570},
 				run: (*parser).callonBlockAttrList1,
 				expr: &seqExpr{
-					pos: position{line: 270, col: 18, offset: 9096},
+					pos: position{line: 281, col: 18, offset: 9570},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 270, col: 18, offset: 9096},
+							pos:        position{line: 281, col: 18, offset: 9570},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 270, col: 22, offset: 9100},
+							pos:   position{line: 281, col: 22, offset: 9574},
 							label: "attrs",
 							expr: &seqExpr{
-								pos: position{line: 270, col: 29, offset: 9107},
+								pos: position{line: 281, col: 29, offset: 9581},
 								exprs: []interface{}{
 									&zeroOrOneExpr{
-										pos: position{line: 270, col: 29, offset: 9107},
+										pos: position{line: 281, col: 29, offset: 9581},
 										expr: &ruleRefExpr{
-											pos:  position{line: 270, col: 29, offset: 9107},
+											pos:  position{line: 281, col: 29, offset: 9581},
 											name: "BlockAttrStyle",
 										},
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 270, col: 45, offset: 9123},
+										pos: position{line: 281, col: 45, offset: 9597},
 										expr: &ruleRefExpr{
-											pos:  position{line: 270, col: 45, offset: 9123},
+											pos:  position{line: 281, col: 45, offset: 9597},
 											name: "ShortHandAttr",
 										},
 									},
 									&zeroOrOneExpr{
-										pos: position{line: 270, col: 60, offset: 9138},
+										pos: position{line: 281, col: 60, offset: 9612},
 										expr: &ruleRefExpr{
-											pos:  position{line: 270, col: 60, offset: 9138},
+											pos:  position{line: 281, col: 60, offset: 9612},
 											name: "BlockAttrPositional2",
 										},
 									},
 									&zeroOrOneExpr{
-										pos: position{line: 270, col: 82, offset: 9160},
+										pos: position{line: 281, col: 82, offset: 9634},
 										expr: &ruleRefExpr{
-											pos:  position{line: 270, col: 82, offset: 9160},
+											pos:  position{line: 281, col: 82, offset: 9634},
 											name: "BlockAttrPositional3",
 										},
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 270, col: 104, offset: 9182},
+										pos: position{line: 281, col: 104, offset: 9656},
 										expr: &ruleRefExpr{
-											pos:  position{line: 270, col: 104, offset: 9182},
+											pos:  position{line: 281, col: 104, offset: 9656},
 											name: "NamedAttr",
 										},
 									},
@@ -2032,13 +2139,13 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 270, col: 116, offset: 9194},
+							pos:        position{line: 281, col: 116, offset: 9668},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&ruleRefExpr{
-							pos:  position{line: 270, col: 120, offset: 9198},
+							pos:  position{line: 281, col: 120, offset: 9672},
 							name: "EOL",
 						},
 					},
@@ -2047,15 +2154,15 @@ var g = &grammar{
 		},
 		{
 			name: "BlockAttrStyle",
-			pos:  position{line: 274, col: 1, offset: 9252},
+			pos:  position{line: 285, col: 1, offset: 9726},
 			expr: &actionExpr{
-				pos: position{line: 274, col: 19, offset: 9270},
+				pos: position{line: 285, col: 19, offset: 9744},
 				run: (*parser).callonBlockAttrStyle1,
 				expr: &labeledExpr{
-					pos:   position{line: 274, col: 19, offset: 9270},
+					pos:   position{line: 285, col: 19, offset: 9744},
 					label: "style",
 					expr: &ruleRefExpr{
-						pos:  position{line: 274, col: 25, offset: 9276},
+						pos:  position{line: 285, col: 25, offset: 9750},
 						name: "PositionalValue",
 					},
 				},
@@ -2063,40 +2170,40 @@ var g = &grammar{
 		},
 		{
 			name: "BlockAttrPositional2",
-			pos:  position{line: 278, col: 1, offset: 9337},
+			pos:  position{line: 289, col: 1, offset: 9811},
 			expr: &actionExpr{
-				pos: position{line: 278, col: 25, offset: 9361},
+				pos: position{line: 289, col: 25, offset: 9835},
 				run: (*parser).callonBlockAttrPositional21,
 				expr: &seqExpr{
-					pos: position{line: 278, col: 25, offset: 9361},
+					pos: position{line: 289, col: 25, offset: 9835},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 278, col: 25, offset: 9361},
+							pos: position{line: 289, col: 25, offset: 9835},
 							expr: &ruleRefExpr{
-								pos:  position{line: 278, col: 25, offset: 9361},
+								pos:  position{line: 289, col: 25, offset: 9835},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 278, col: 32, offset: 9368},
+							pos:        position{line: 289, col: 32, offset: 9842},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 278, col: 36, offset: 9372},
+							pos: position{line: 289, col: 36, offset: 9846},
 							expr: &ruleRefExpr{
-								pos:  position{line: 278, col: 36, offset: 9372},
+								pos:  position{line: 289, col: 36, offset: 9846},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 278, col: 43, offset: 9379},
+							pos:   position{line: 289, col: 43, offset: 9853},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 278, col: 49, offset: 9385},
+								pos: position{line: 289, col: 49, offset: 9859},
 								expr: &ruleRefExpr{
-									pos:  position{line: 278, col: 49, offset: 9385},
+									pos:  position{line: 289, col: 49, offset: 9859},
 									name: "PositionalValue",
 								},
 							},
@@ -2107,40 +2214,40 @@ var g = &grammar{
 		},
 		{
 			name: "BlockAttrPositional3",
-			pos:  position{line: 285, col: 1, offset: 9526},
+			pos:  position{line: 296, col: 1, offset: 10000},
 			expr: &actionExpr{
-				pos: position{line: 285, col: 25, offset: 9550},
+				pos: position{line: 296, col: 25, offset: 10024},
 				run: (*parser).callonBlockAttrPositional31,
 				expr: &seqExpr{
-					pos: position{line: 285, col: 25, offset: 9550},
+					pos: position{line: 296, col: 25, offset: 10024},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 285, col: 25, offset: 9550},
+							pos: position{line: 296, col: 25, offset: 10024},
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 25, offset: 9550},
+								pos:  position{line: 296, col: 25, offset: 10024},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 285, col: 32, offset: 9557},
+							pos:        position{line: 296, col: 32, offset: 10031},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 285, col: 36, offset: 9561},
+							pos: position{line: 296, col: 36, offset: 10035},
 							expr: &ruleRefExpr{
-								pos:  position{line: 285, col: 36, offset: 9561},
+								pos:  position{line: 296, col: 36, offset: 10035},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 285, col: 43, offset: 9568},
+							pos:   position{line: 296, col: 43, offset: 10042},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 285, col: 49, offset: 9574},
+								pos: position{line: 296, col: 49, offset: 10048},
 								expr: &ruleRefExpr{
-									pos:  position{line: 285, col: 49, offset: 9574},
+									pos:  position{line: 296, col: 49, offset: 10048},
 									name: "PositionalValue",
 								},
 							},
@@ -2151,28 +2258,28 @@ var g = &grammar{
 		},
 		{
 			name: "LiteralBlockAttribute",
-			pos:  position{line: 292, col: 1, offset: 9715},
+			pos:  position{line: 303, col: 1, offset: 10189},
 			expr: &actionExpr{
-				pos: position{line: 292, col: 26, offset: 9740},
+				pos: position{line: 303, col: 26, offset: 10214},
 				run: (*parser).callonLiteralBlockAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 292, col: 26, offset: 9740},
+					pos: position{line: 303, col: 26, offset: 10214},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 292, col: 26, offset: 9740},
+							pos:        position{line: 303, col: 26, offset: 10214},
 							val:        "[literal]",
 							ignoreCase: false,
 							want:       "\"[literal]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 292, col: 38, offset: 9752},
+							pos: position{line: 303, col: 38, offset: 10226},
 							expr: &ruleRefExpr{
-								pos:  position{line: 292, col: 38, offset: 9752},
+								pos:  position{line: 303, col: 38, offset: 10226},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 292, col: 45, offset: 9759},
+							pos:  position{line: 303, col: 45, offset: 10233},
 							name: "Newline",
 						},
 					},
@@ -2181,28 +2288,28 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughBlockAttribute",
-			pos:  position{line: 296, col: 1, offset: 9816},
+			pos:  position{line: 307, col: 1, offset: 10290},
 			expr: &actionExpr{
-				pos: position{line: 296, col: 30, offset: 9845},
+				pos: position{line: 307, col: 30, offset: 10319},
 				run: (*parser).callonPassthroughBlockAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 296, col: 30, offset: 9845},
+					pos: position{line: 307, col: 30, offset: 10319},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 296, col: 30, offset: 9845},
+							pos:        position{line: 307, col: 30, offset: 10319},
 							val:        "[pass]",
 							ignoreCase: false,
 							want:       "\"[pass]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 296, col: 39, offset: 9854},
+							pos: position{line: 307, col: 39, offset: 10328},
 							expr: &ruleRefExpr{
-								pos:  position{line: 296, col: 39, offset: 9854},
+								pos:  position{line: 307, col: 39, offset: 10328},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 296, col: 46, offset: 9861},
+							pos:  position{line: 307, col: 46, offset: 10335},
 							name: "Newline",
 						},
 					},
@@ -2211,28 +2318,28 @@ var g = &grammar{
 		},
 		{
 			name: "ExampleBlockAttribute",
-			pos:  position{line: 300, col: 1, offset: 9922},
+			pos:  position{line: 311, col: 1, offset: 10396},
 			expr: &actionExpr{
-				pos: position{line: 300, col: 26, offset: 9947},
+				pos: position{line: 311, col: 26, offset: 10421},
 				run: (*parser).callonExampleBlockAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 300, col: 26, offset: 9947},
+					pos: position{line: 311, col: 26, offset: 10421},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 300, col: 26, offset: 9947},
+							pos:        position{line: 311, col: 26, offset: 10421},
 							val:        "[example]",
 							ignoreCase: false,
 							want:       "\"[example]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 300, col: 38, offset: 9959},
+							pos: position{line: 311, col: 38, offset: 10433},
 							expr: &ruleRefExpr{
-								pos:  position{line: 300, col: 38, offset: 9959},
+								pos:  position{line: 311, col: 38, offset: 10433},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 300, col: 45, offset: 9966},
+							pos:  position{line: 311, col: 45, offset: 10440},
 							name: "EOL",
 						},
 					},
@@ -2241,28 +2348,28 @@ var g = &grammar{
 		},
 		{
 			name: "ListingBlockAttribute",
-			pos:  position{line: 304, col: 1, offset: 10019},
+			pos:  position{line: 315, col: 1, offset: 10493},
 			expr: &actionExpr{
-				pos: position{line: 304, col: 26, offset: 10044},
+				pos: position{line: 315, col: 26, offset: 10518},
 				run: (*parser).callonListingBlockAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 304, col: 26, offset: 10044},
+					pos: position{line: 315, col: 26, offset: 10518},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 304, col: 26, offset: 10044},
+							pos:        position{line: 315, col: 26, offset: 10518},
 							val:        "[listing]",
 							ignoreCase: false,
 							want:       "\"[listing]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 304, col: 38, offset: 10056},
+							pos: position{line: 315, col: 38, offset: 10530},
 							expr: &ruleRefExpr{
-								pos:  position{line: 304, col: 38, offset: 10056},
+								pos:  position{line: 315, col: 38, offset: 10530},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 304, col: 45, offset: 10063},
+							pos:  position{line: 315, col: 45, offset: 10537},
 							name: "EOL",
 						},
 					},
@@ -2271,42 +2378,82 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionMarkerAttribute",
-			pos:  position{line: 309, col: 1, offset: 10196},
+			pos:  position{line: 320, col: 1, offset: 10724},
 			expr: &actionExpr{
-				pos: position{line: 309, col: 30, offset: 10225},
+				pos: position{line: 320, col: 30, offset: 10753},
 				run: (*parser).callonAdmonitionMarkerAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 309, col: 30, offset: 10225},
+					pos: position{line: 320, col: 30, offset: 10753},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 309, col: 30, offset: 10225},
+							pos:        position{line: 320, col: 30, offset: 10753},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 309, col: 34, offset: 10229},
+							pos:   position{line: 320, col: 34, offset: 10757},
 							label: "k",
 							expr: &ruleRefExpr{
-								pos:  position{line: 309, col: 37, offset: 10232},
+								pos:  position{line: 320, col: 37, offset: 10760},
 								name: "AdmonitionKind",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 320, col: 53, offset: 10776},
+							label: "others",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 320, col: 60, offset: 10783},
+								expr: &actionExpr{
+									pos: position{line: 320, col: 61, offset: 10784},
+									run: (*parser).callonAdmonitionMarkerAttribute8,
+									expr: &seqExpr{
+										pos: position{line: 320, col: 61, offset: 10784},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 320, col: 61, offset: 10784},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 320, col: 65, offset: 10788},
+												expr: &ruleRefExpr{
+													pos:  position{line: 320, col: 65, offset: 10788},
+													name: "Space",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 320, col: 72, offset: 10795},
+												label: "attrs",
+												expr: &zeroOrMoreExpr{
+													pos: position{line: 320, col: 78, offset: 10801},
+													expr: &ruleRefExpr{
+														pos:  position{line: 320, col: 79, offset: 10802},
+														name: "GenericAttribute",
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
 						&litMatcher{
-							pos:        position{line: 309, col: 53, offset: 10248},
+							pos:        position{line: 320, col: 122, offset: 10845},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 309, col: 57, offset: 10252},
+							pos: position{line: 320, col: 126, offset: 10849},
 							expr: &ruleRefExpr{
-								pos:  position{line: 309, col: 57, offset: 10252},
+								pos:  position{line: 320, col: 126, offset: 10849},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 309, col: 64, offset: 10259},
+							pos:  position{line: 320, col: 133, offset: 10856},
 							name: "EOL",
 						},
 					},
@@ -2315,43 +2462,43 @@ var g = &grammar{
 		},
 		{
 			name: "SourceAttributes",
-			pos:  position{line: 314, col: 1, offset: 10414},
+			pos:  position{line: 325, col: 1, offset: 11019},
 			expr: &actionExpr{
-				pos: position{line: 314, col: 21, offset: 10434},
+				pos: position{line: 325, col: 21, offset: 11039},
 				run: (*parser).callonSourceAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 314, col: 21, offset: 10434},
+					pos: position{line: 325, col: 21, offset: 11039},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 314, col: 21, offset: 10434},
+							pos:        position{line: 325, col: 21, offset: 11039},
 							val:        "[source",
 							ignoreCase: false,
 							want:       "\"[source\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 315, col: 5, offset: 10449},
+							pos:   position{line: 326, col: 5, offset: 11054},
 							label: "language",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 315, col: 14, offset: 10458},
+								pos: position{line: 326, col: 14, offset: 11063},
 								expr: &actionExpr{
-									pos: position{line: 315, col: 15, offset: 10459},
+									pos: position{line: 326, col: 15, offset: 11064},
 									run: (*parser).callonSourceAttributes6,
 									expr: &seqExpr{
-										pos: position{line: 315, col: 15, offset: 10459},
+										pos: position{line: 326, col: 15, offset: 11064},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 315, col: 15, offset: 10459},
+												pos:        position{line: 326, col: 15, offset: 11064},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 315, col: 19, offset: 10463},
+												pos:   position{line: 326, col: 19, offset: 11068},
 												label: "attr",
 												expr: &zeroOrOneExpr{
-													pos: position{line: 315, col: 24, offset: 10468},
+													pos: position{line: 326, col: 24, offset: 11073},
 													expr: &ruleRefExpr{
-														pos:  position{line: 315, col: 25, offset: 10469},
+														pos:  position{line: 326, col: 25, offset: 11074},
 														name: "StandaloneAttributeValue",
 													},
 												},
@@ -2362,29 +2509,29 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 316, col: 5, offset: 10524},
+							pos:   position{line: 327, col: 5, offset: 11129},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 316, col: 12, offset: 10531},
+								pos: position{line: 327, col: 12, offset: 11136},
 								expr: &actionExpr{
-									pos: position{line: 316, col: 13, offset: 10532},
+									pos: position{line: 327, col: 13, offset: 11137},
 									run: (*parser).callonSourceAttributes14,
 									expr: &seqExpr{
-										pos: position{line: 316, col: 13, offset: 10532},
+										pos: position{line: 327, col: 13, offset: 11137},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 316, col: 13, offset: 10532},
+												pos:        position{line: 327, col: 13, offset: 11137},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 316, col: 17, offset: 10536},
+												pos:   position{line: 327, col: 17, offset: 11141},
 												label: "attr",
 												expr: &zeroOrOneExpr{
-													pos: position{line: 316, col: 22, offset: 10541},
+													pos: position{line: 327, col: 22, offset: 11146},
 													expr: &ruleRefExpr{
-														pos:  position{line: 316, col: 23, offset: 10542},
+														pos:  position{line: 327, col: 23, offset: 11147},
 														name: "GenericAttribute",
 													},
 												},
@@ -2395,20 +2542,20 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 317, col: 5, offset: 10589},
+							pos:        position{line: 328, col: 5, offset: 11194},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 317, col: 9, offset: 10593},
+							pos: position{line: 328, col: 9, offset: 11198},
 							expr: &ruleRefExpr{
-								pos:  position{line: 317, col: 9, offset: 10593},
+								pos:  position{line: 328, col: 9, offset: 11198},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 317, col: 16, offset: 10600},
+							pos:  position{line: 328, col: 16, offset: 11205},
 							name: "EOL",
 						},
 					},
@@ -2417,45 +2564,45 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeGroup",
-			pos:  position{line: 322, col: 1, offset: 10751},
+			pos:  position{line: 333, col: 1, offset: 11356},
 			expr: &actionExpr{
-				pos: position{line: 322, col: 19, offset: 10769},
+				pos: position{line: 333, col: 19, offset: 11374},
 				run: (*parser).callonAttributeGroup1,
 				expr: &seqExpr{
-					pos: position{line: 322, col: 19, offset: 10769},
+					pos: position{line: 333, col: 19, offset: 11374},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 322, col: 19, offset: 10769},
+							pos:        position{line: 333, col: 19, offset: 11374},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 322, col: 23, offset: 10773},
+							pos:   position{line: 333, col: 23, offset: 11378},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 322, col: 34, offset: 10784},
+								pos: position{line: 333, col: 34, offset: 11389},
 								expr: &ruleRefExpr{
-									pos:  position{line: 322, col: 35, offset: 10785},
+									pos:  position{line: 333, col: 35, offset: 11390},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 322, col: 54, offset: 10804},
+							pos:        position{line: 333, col: 54, offset: 11409},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 322, col: 58, offset: 10808},
+							pos: position{line: 333, col: 58, offset: 11413},
 							expr: &ruleRefExpr{
-								pos:  position{line: 322, col: 58, offset: 10808},
+								pos:  position{line: 333, col: 58, offset: 11413},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 322, col: 65, offset: 10815},
+							pos:  position{line: 333, col: 65, offset: 11420},
 							name: "EOL",
 						},
 					},
@@ -2464,16 +2611,16 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttribute",
-			pos:  position{line: 326, col: 1, offset: 10887},
+			pos:  position{line: 337, col: 1, offset: 11492},
 			expr: &choiceExpr{
-				pos: position{line: 326, col: 21, offset: 10907},
+				pos: position{line: 337, col: 21, offset: 11512},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 326, col: 21, offset: 10907},
+						pos:  position{line: 337, col: 21, offset: 11512},
 						name: "GenericAttributeWithValue",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 326, col: 49, offset: 10935},
+						pos:  position{line: 337, col: 49, offset: 11540},
 						name: "GenericAttributeWithoutValue",
 					},
 				},
@@ -2481,51 +2628,51 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttributeWithValue",
-			pos:  position{line: 328, col: 1, offset: 10965},
+			pos:  position{line: 339, col: 1, offset: 11570},
 			expr: &actionExpr{
-				pos: position{line: 328, col: 30, offset: 10994},
+				pos: position{line: 339, col: 30, offset: 11599},
 				run: (*parser).callonGenericAttributeWithValue1,
 				expr: &seqExpr{
-					pos: position{line: 328, col: 30, offset: 10994},
+					pos: position{line: 339, col: 30, offset: 11599},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 328, col: 30, offset: 10994},
+							pos:   position{line: 339, col: 30, offset: 11599},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 35, offset: 10999},
+								pos:  position{line: 339, col: 35, offset: 11604},
 								name: "AttributeKey",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 328, col: 49, offset: 11013},
+							pos:        position{line: 339, col: 49, offset: 11618},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 328, col: 53, offset: 11017},
+							pos:   position{line: 339, col: 53, offset: 11622},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 328, col: 59, offset: 11023},
+								pos: position{line: 339, col: 59, offset: 11628},
 								expr: &ruleRefExpr{
-									pos:  position{line: 328, col: 60, offset: 11024},
+									pos:  position{line: 339, col: 60, offset: 11629},
 									name: "AttributeValue",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 328, col: 77, offset: 11041},
+							pos: position{line: 339, col: 77, offset: 11646},
 							expr: &litMatcher{
-								pos:        position{line: 328, col: 77, offset: 11041},
+								pos:        position{line: 339, col: 77, offset: 11646},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 328, col: 82, offset: 11046},
+							pos: position{line: 339, col: 82, offset: 11651},
 							expr: &ruleRefExpr{
-								pos:  position{line: 328, col: 82, offset: 11046},
+								pos:  position{line: 339, col: 82, offset: 11651},
 								name: "Space",
 							},
 						},
@@ -2535,34 +2682,34 @@ var g = &grammar{
 		},
 		{
 			name: "GenericAttributeWithoutValue",
-			pos:  position{line: 332, col: 1, offset: 11145},
+			pos:  position{line: 343, col: 1, offset: 11750},
 			expr: &actionExpr{
-				pos: position{line: 332, col: 33, offset: 11177},
+				pos: position{line: 343, col: 33, offset: 11782},
 				run: (*parser).callonGenericAttributeWithoutValue1,
 				expr: &seqExpr{
-					pos: position{line: 332, col: 33, offset: 11177},
+					pos: position{line: 343, col: 33, offset: 11782},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 332, col: 33, offset: 11177},
+							pos:   position{line: 343, col: 33, offset: 11782},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 332, col: 38, offset: 11182},
+								pos:  position{line: 343, col: 38, offset: 11787},
 								name: "AttributeKey",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 332, col: 52, offset: 11196},
+							pos: position{line: 343, col: 52, offset: 11801},
 							expr: &litMatcher{
-								pos:        position{line: 332, col: 52, offset: 11196},
+								pos:        position{line: 343, col: 52, offset: 11801},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 332, col: 57, offset: 11201},
+							pos: position{line: 343, col: 57, offset: 11806},
 							expr: &ruleRefExpr{
-								pos:  position{line: 332, col: 57, offset: 11201},
+								pos:  position{line: 343, col: 57, offset: 11806},
 								name: "Space",
 							},
 						},
@@ -2572,57 +2719,57 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeKey",
-			pos:  position{line: 336, col: 1, offset: 11289},
+			pos:  position{line: 347, col: 1, offset: 11894},
 			expr: &actionExpr{
-				pos: position{line: 336, col: 17, offset: 11305},
+				pos: position{line: 347, col: 17, offset: 11910},
 				run: (*parser).callonAttributeKey1,
 				expr: &seqExpr{
-					pos: position{line: 336, col: 17, offset: 11305},
+					pos: position{line: 347, col: 17, offset: 11910},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 336, col: 17, offset: 11305},
+							pos: position{line: 347, col: 17, offset: 11910},
 							expr: &litMatcher{
-								pos:        position{line: 336, col: 18, offset: 11306},
+								pos:        position{line: 347, col: 18, offset: 11911},
 								val:        "quote",
 								ignoreCase: false,
 								want:       "\"quote\"",
 							},
 						},
 						&notExpr{
-							pos: position{line: 336, col: 26, offset: 11314},
+							pos: position{line: 347, col: 26, offset: 11919},
 							expr: &litMatcher{
-								pos:        position{line: 336, col: 27, offset: 11315},
+								pos:        position{line: 347, col: 27, offset: 11920},
 								val:        "verse",
 								ignoreCase: false,
 								want:       "\"verse\"",
 							},
 						},
 						&notExpr{
-							pos: position{line: 336, col: 35, offset: 11323},
+							pos: position{line: 347, col: 35, offset: 11928},
 							expr: &litMatcher{
-								pos:        position{line: 336, col: 36, offset: 11324},
+								pos:        position{line: 347, col: 36, offset: 11929},
 								val:        "literal",
 								ignoreCase: false,
 								want:       "\"literal\"",
 							},
 						},
 						&notExpr{
-							pos: position{line: 336, col: 46, offset: 11334},
+							pos: position{line: 347, col: 46, offset: 11939},
 							expr: &oneOrMoreExpr{
-								pos: position{line: 336, col: 48, offset: 11336},
+								pos: position{line: 347, col: 48, offset: 11941},
 								expr: &ruleRefExpr{
-									pos:  position{line: 336, col: 48, offset: 11336},
+									pos:  position{line: 347, col: 48, offset: 11941},
 									name: "Space",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 336, col: 56, offset: 11344},
+							pos:   position{line: 347, col: 56, offset: 11949},
 							label: "key",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 336, col: 61, offset: 11349},
+								pos: position{line: 347, col: 61, offset: 11954},
 								expr: &charClassMatcher{
-									pos:        position{line: 336, col: 61, offset: 11349},
+									pos:        position{line: 347, col: 61, offset: 11954},
 									val:        "[^\\r\\n=,\\]]",
 									chars:      []rune{'\r', '\n', '=', ',', ']'},
 									ignoreCase: false,
@@ -2631,9 +2778,9 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 336, col: 75, offset: 11363},
+							pos: position{line: 347, col: 75, offset: 11968},
 							expr: &ruleRefExpr{
-								pos:  position{line: 336, col: 75, offset: 11363},
+								pos:  position{line: 347, col: 75, offset: 11968},
 								name: "Space",
 							},
 						},
@@ -2643,17 +2790,17 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeValue",
-			pos:  position{line: 340, col: 1, offset: 11406},
+			pos:  position{line: 351, col: 1, offset: 12011},
 			expr: &actionExpr{
-				pos: position{line: 340, col: 19, offset: 11424},
+				pos: position{line: 351, col: 19, offset: 12029},
 				run: (*parser).callonAttributeValue1,
 				expr: &labeledExpr{
-					pos:   position{line: 340, col: 19, offset: 11424},
+					pos:   position{line: 351, col: 19, offset: 12029},
 					label: "value",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 340, col: 26, offset: 11431},
+						pos: position{line: 351, col: 26, offset: 12036},
 						expr: &charClassMatcher{
-							pos:        position{line: 340, col: 26, offset: 11431},
+							pos:        position{line: 351, col: 26, offset: 12036},
 							val:        "[^\\r\\n=,\\]]",
 							chars:      []rune{'\r', '\n', '=', ',', ']'},
 							ignoreCase: false,
@@ -2665,20 +2812,20 @@ var g = &grammar{
 		},
 		{
 			name: "StandaloneAttributeValue",
-			pos:  position{line: 344, col: 1, offset: 11482},
+			pos:  position{line: 355, col: 1, offset: 12087},
 			expr: &actionExpr{
-				pos: position{line: 344, col: 29, offset: 11510},
+				pos: position{line: 355, col: 29, offset: 12115},
 				run: (*parser).callonStandaloneAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 344, col: 29, offset: 11510},
+					pos: position{line: 355, col: 29, offset: 12115},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 344, col: 29, offset: 11510},
+							pos:   position{line: 355, col: 29, offset: 12115},
 							label: "value",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 344, col: 36, offset: 11517},
+								pos: position{line: 355, col: 36, offset: 12122},
 								expr: &charClassMatcher{
-									pos:        position{line: 344, col: 36, offset: 11517},
+									pos:        position{line: 355, col: 36, offset: 12122},
 									val:        "[^\\r\\n=,\\]]",
 									chars:      []rune{'\r', '\n', '=', ',', ']'},
 									ignoreCase: false,
@@ -2687,9 +2834,9 @@ var g = &grammar{
 							},
 						},
 						&notExpr{
-							pos: position{line: 344, col: 50, offset: 11531},
+							pos: position{line: 355, col: 50, offset: 12136},
 							expr: &litMatcher{
-								pos:        position{line: 344, col: 51, offset: 11532},
+								pos:        position{line: 355, col: 51, offset: 12137},
 								val:        "=",
 								ignoreCase: false,
 								want:       "\"=\"",
@@ -2701,81 +2848,81 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttributes",
-			pos:  position{line: 348, col: 1, offset: 11698},
+			pos:  position{line: 359, col: 1, offset: 12303},
 			expr: &actionExpr{
-				pos: position{line: 348, col: 20, offset: 11717},
+				pos: position{line: 359, col: 20, offset: 12322},
 				run: (*parser).callonQuoteAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 348, col: 20, offset: 11717},
+					pos: position{line: 359, col: 20, offset: 12322},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 348, col: 20, offset: 11717},
+							pos:        position{line: 359, col: 20, offset: 12322},
 							val:        "[quote",
 							ignoreCase: false,
 							want:       "\"[quote\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 348, col: 29, offset: 11726},
+							pos: position{line: 359, col: 29, offset: 12331},
 							expr: &ruleRefExpr{
-								pos:  position{line: 348, col: 29, offset: 11726},
+								pos:  position{line: 359, col: 29, offset: 12331},
 								name: "Space",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 348, col: 36, offset: 11733},
+							pos: position{line: 359, col: 36, offset: 12338},
 							expr: &litMatcher{
-								pos:        position{line: 348, col: 36, offset: 11733},
+								pos:        position{line: 359, col: 36, offset: 12338},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 348, col: 41, offset: 11738},
+							pos:   position{line: 359, col: 41, offset: 12343},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 348, col: 48, offset: 11745},
+								pos: position{line: 359, col: 48, offset: 12350},
 								expr: &ruleRefExpr{
-									pos:  position{line: 348, col: 49, offset: 11746},
+									pos:  position{line: 359, col: 49, offset: 12351},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 348, col: 66, offset: 11763},
+							pos: position{line: 359, col: 66, offset: 12368},
 							expr: &litMatcher{
-								pos:        position{line: 348, col: 66, offset: 11763},
+								pos:        position{line: 359, col: 66, offset: 12368},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 348, col: 71, offset: 11768},
+							pos:   position{line: 359, col: 71, offset: 12373},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 348, col: 77, offset: 11774},
+								pos: position{line: 359, col: 77, offset: 12379},
 								expr: &ruleRefExpr{
-									pos:  position{line: 348, col: 78, offset: 11775},
+									pos:  position{line: 359, col: 78, offset: 12380},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 348, col: 95, offset: 11792},
+							pos:        position{line: 359, col: 95, offset: 12397},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 348, col: 99, offset: 11796},
+							pos: position{line: 359, col: 99, offset: 12401},
 							expr: &ruleRefExpr{
-								pos:  position{line: 348, col: 99, offset: 11796},
+								pos:  position{line: 359, col: 99, offset: 12401},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 348, col: 106, offset: 11803},
+							pos:  position{line: 359, col: 106, offset: 12408},
 							name: "EOL",
 						},
 					},
@@ -2784,81 +2931,81 @@ var g = &grammar{
 		},
 		{
 			name: "VerseAttributes",
-			pos:  position{line: 352, col: 1, offset: 11872},
+			pos:  position{line: 363, col: 1, offset: 12477},
 			expr: &actionExpr{
-				pos: position{line: 352, col: 20, offset: 11891},
+				pos: position{line: 363, col: 20, offset: 12496},
 				run: (*parser).callonVerseAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 352, col: 20, offset: 11891},
+					pos: position{line: 363, col: 20, offset: 12496},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 352, col: 20, offset: 11891},
+							pos:        position{line: 363, col: 20, offset: 12496},
 							val:        "[verse",
 							ignoreCase: false,
 							want:       "\"[verse\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 352, col: 29, offset: 11900},
+							pos: position{line: 363, col: 29, offset: 12505},
 							expr: &ruleRefExpr{
-								pos:  position{line: 352, col: 29, offset: 11900},
+								pos:  position{line: 363, col: 29, offset: 12505},
 								name: "Space",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 352, col: 36, offset: 11907},
+							pos: position{line: 363, col: 36, offset: 12512},
 							expr: &litMatcher{
-								pos:        position{line: 352, col: 36, offset: 11907},
+								pos:        position{line: 363, col: 36, offset: 12512},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 352, col: 41, offset: 11912},
+							pos:   position{line: 363, col: 41, offset: 12517},
 							label: "author",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 352, col: 48, offset: 11919},
+								pos: position{line: 363, col: 48, offset: 12524},
 								expr: &ruleRefExpr{
-									pos:  position{line: 352, col: 49, offset: 11920},
+									pos:  position{line: 363, col: 49, offset: 12525},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 352, col: 66, offset: 11937},
+							pos: position{line: 363, col: 66, offset: 12542},
 							expr: &litMatcher{
-								pos:        position{line: 352, col: 66, offset: 11937},
+								pos:        position{line: 363, col: 66, offset: 12542},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 352, col: 71, offset: 11942},
+							pos:   position{line: 363, col: 71, offset: 12547},
 							label: "title",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 352, col: 77, offset: 11948},
+								pos: position{line: 363, col: 77, offset: 12553},
 								expr: &ruleRefExpr{
-									pos:  position{line: 352, col: 78, offset: 11949},
+									pos:  position{line: 363, col: 78, offset: 12554},
 									name: "QuoteAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 352, col: 95, offset: 11966},
+							pos:        position{line: 363, col: 95, offset: 12571},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 352, col: 99, offset: 11970},
+							pos: position{line: 363, col: 99, offset: 12575},
 							expr: &ruleRefExpr{
-								pos:  position{line: 352, col: 99, offset: 11970},
+								pos:  position{line: 363, col: 99, offset: 12575},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 352, col: 106, offset: 11977},
+							pos:  position{line: 363, col: 106, offset: 12582},
 							name: "EOL",
 						},
 					},
@@ -2867,14 +3014,14 @@ var g = &grammar{
 		},
 		{
 			name: "QuoteAttribute",
-			pos:  position{line: 356, col: 1, offset: 12064},
+			pos:  position{line: 367, col: 1, offset: 12669},
 			expr: &actionExpr{
-				pos: position{line: 356, col: 19, offset: 12082},
+				pos: position{line: 367, col: 19, offset: 12687},
 				run: (*parser).callonQuoteAttribute1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 356, col: 20, offset: 12083},
+					pos: position{line: 367, col: 20, offset: 12688},
 					expr: &charClassMatcher{
-						pos:        position{line: 356, col: 20, offset: 12083},
+						pos:        position{line: 367, col: 20, offset: 12688},
 						val:        "[^\\r\\n,\\]]",
 						chars:      []rune{'\r', '\n', ',', ']'},
 						ignoreCase: false,
@@ -2885,43 +3032,43 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedTextAttrs",
-			pos:  position{line: 360, col: 1, offset: 12132},
+			pos:  position{line: 371, col: 1, offset: 12737},
 			expr: &actionExpr{
-				pos: position{line: 360, col: 20, offset: 12151},
+				pos: position{line: 371, col: 20, offset: 12756},
 				run: (*parser).callonQuotedTextAttrs1,
 				expr: &seqExpr{
-					pos: position{line: 360, col: 20, offset: 12151},
+					pos: position{line: 371, col: 20, offset: 12756},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 360, col: 20, offset: 12151},
+							pos:        position{line: 371, col: 20, offset: 12756},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 360, col: 24, offset: 12155},
+							pos:   position{line: 371, col: 24, offset: 12760},
 							label: "attrs",
 							expr: &seqExpr{
-								pos: position{line: 360, col: 31, offset: 12162},
+								pos: position{line: 371, col: 31, offset: 12767},
 								exprs: []interface{}{
 									&zeroOrOneExpr{
-										pos: position{line: 360, col: 31, offset: 12162},
+										pos: position{line: 371, col: 31, offset: 12767},
 										expr: &ruleRefExpr{
-											pos:  position{line: 360, col: 31, offset: 12162},
+											pos:  position{line: 371, col: 31, offset: 12767},
 											name: "QuotedTextAttrRole",
 										},
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 360, col: 51, offset: 12182},
+										pos: position{line: 371, col: 51, offset: 12787},
 										expr: &ruleRefExpr{
-											pos:  position{line: 360, col: 51, offset: 12182},
+											pos:  position{line: 371, col: 51, offset: 12787},
 											name: "ShortHandAttr",
 										},
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 360, col: 66, offset: 12197},
+										pos: position{line: 371, col: 66, offset: 12802},
 										expr: &ruleRefExpr{
-											pos:  position{line: 360, col: 66, offset: 12197},
+											pos:  position{line: 371, col: 66, offset: 12802},
 											name: "NamedAttr",
 										},
 									},
@@ -2929,7 +3076,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 360, col: 78, offset: 12209},
+							pos:        position{line: 371, col: 78, offset: 12814},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -2940,15 +3087,15 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedTextAttrRole",
-			pos:  position{line: 364, col: 1, offset: 12263},
+			pos:  position{line: 375, col: 1, offset: 12868},
 			expr: &actionExpr{
-				pos: position{line: 364, col: 23, offset: 12285},
+				pos: position{line: 375, col: 23, offset: 12890},
 				run: (*parser).callonQuotedTextAttrRole1,
 				expr: &labeledExpr{
-					pos:   position{line: 364, col: 23, offset: 12285},
+					pos:   position{line: 375, col: 23, offset: 12890},
 					label: "role",
 					expr: &ruleRefExpr{
-						pos:  position{line: 364, col: 28, offset: 12290},
+						pos:  position{line: 375, col: 28, offset: 12895},
 						name: "PositionalValue",
 					},
 				},
@@ -2956,28 +3103,28 @@ var g = &grammar{
 		},
 		{
 			name: "StandaloneAttributes",
-			pos:  position{line: 368, col: 1, offset: 12349},
+			pos:  position{line: 379, col: 1, offset: 12954},
 			expr: &actionExpr{
-				pos: position{line: 368, col: 25, offset: 12373},
+				pos: position{line: 379, col: 25, offset: 12978},
 				run: (*parser).callonStandaloneAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 368, col: 25, offset: 12373},
+					pos: position{line: 379, col: 25, offset: 12978},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 368, col: 25, offset: 12373},
+							pos:   position{line: 379, col: 25, offset: 12978},
 							label: "attributes",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 368, col: 36, offset: 12384},
+								pos: position{line: 379, col: 36, offset: 12989},
 								expr: &ruleRefExpr{
-									pos:  position{line: 368, col: 37, offset: 12385},
+									pos:  position{line: 379, col: 37, offset: 12990},
 									name: "ElementAttribute",
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 368, col: 56, offset: 12404},
+							pos: position{line: 379, col: 56, offset: 13009},
 							expr: &ruleRefExpr{
-								pos:  position{line: 368, col: 56, offset: 12404},
+								pos:  position{line: 379, col: 56, offset: 13009},
 								name: "BlankLine",
 							},
 						},
@@ -2987,20 +3134,20 @@ var g = &grammar{
 		},
 		{
 			name: "ShortHandAttr",
-			pos:  position{line: 372, col: 1, offset: 12519},
+			pos:  position{line: 383, col: 1, offset: 13124},
 			expr: &choiceExpr{
-				pos: position{line: 372, col: 18, offset: 12536},
+				pos: position{line: 383, col: 18, offset: 13141},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 372, col: 18, offset: 12536},
+						pos:  position{line: 383, col: 18, offset: 13141},
 						name: "ShortHandAttrID",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 372, col: 36, offset: 12554},
+						pos:  position{line: 383, col: 36, offset: 13159},
 						name: "ShortHandAttrOption",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 372, col: 58, offset: 12576},
+						pos:  position{line: 383, col: 58, offset: 13181},
 						name: "ShortHandAttrRole",
 					},
 				},
@@ -3008,31 +3155,31 @@ var g = &grammar{
 		},
 		{
 			name: "ShortHandAttrOption",
-			pos:  position{line: 374, col: 1, offset: 12595},
+			pos:  position{line: 385, col: 1, offset: 13200},
 			expr: &actionExpr{
-				pos: position{line: 374, col: 24, offset: 12618},
+				pos: position{line: 385, col: 24, offset: 13223},
 				run: (*parser).callonShortHandAttrOption1,
 				expr: &seqExpr{
-					pos: position{line: 374, col: 24, offset: 12618},
+					pos: position{line: 385, col: 24, offset: 13223},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 374, col: 24, offset: 12618},
+							pos:        position{line: 385, col: 24, offset: 13223},
 							val:        "%",
 							ignoreCase: false,
 							want:       "\"%\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 374, col: 28, offset: 12622},
+							pos:   position{line: 385, col: 28, offset: 13227},
 							label: "option",
 							expr: &ruleRefExpr{
-								pos:  position{line: 374, col: 35, offset: 12629},
+								pos:  position{line: 385, col: 35, offset: 13234},
 								name: "ShortHandValue",
 							},
 						},
 						&andExpr{
-							pos: position{line: 374, col: 50, offset: 12644},
+							pos: position{line: 385, col: 50, offset: 13249},
 							expr: &charClassMatcher{
-								pos:        position{line: 374, col: 51, offset: 12645},
+								pos:        position{line: 385, col: 51, offset: 13250},
 								val:        "[,#%.\\r\\n\\]]",
 								chars:      []rune{',', '#', '%', '.', '\r', '\n', ']'},
 								ignoreCase: false,
@@ -3045,31 +3192,31 @@ var g = &grammar{
 		},
 		{
 			name: "ShortHandAttrID",
-			pos:  position{line: 378, col: 1, offset: 12705},
+			pos:  position{line: 389, col: 1, offset: 13310},
 			expr: &actionExpr{
-				pos: position{line: 378, col: 20, offset: 12724},
+				pos: position{line: 389, col: 20, offset: 13329},
 				run: (*parser).callonShortHandAttrID1,
 				expr: &seqExpr{
-					pos: position{line: 378, col: 20, offset: 12724},
+					pos: position{line: 389, col: 20, offset: 13329},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 378, col: 20, offset: 12724},
+							pos:        position{line: 389, col: 20, offset: 13329},
 							val:        "#",
 							ignoreCase: false,
 							want:       "\"#\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 378, col: 24, offset: 12728},
+							pos:   position{line: 389, col: 24, offset: 13333},
 							label: "id",
 							expr: &ruleRefExpr{
-								pos:  position{line: 378, col: 27, offset: 12731},
+								pos:  position{line: 389, col: 27, offset: 13336},
 								name: "ShortHandValue",
 							},
 						},
 						&andExpr{
-							pos: position{line: 378, col: 42, offset: 12746},
+							pos: position{line: 389, col: 42, offset: 13351},
 							expr: &charClassMatcher{
-								pos:        position{line: 378, col: 43, offset: 12747},
+								pos:        position{line: 389, col: 43, offset: 13352},
 								val:        "[,#%.\\r\\n\\]]",
 								chars:      []rune{',', '#', '%', '.', '\r', '\n', ']'},
 								ignoreCase: false,
@@ -3082,31 +3229,31 @@ var g = &grammar{
 		},
 		{
 			name: "ShortHandAttrRole",
-			pos:  position{line: 382, col: 1, offset: 12799},
+			pos:  position{line: 393, col: 1, offset: 13404},
 			expr: &actionExpr{
-				pos: position{line: 382, col: 22, offset: 12820},
+				pos: position{line: 393, col: 22, offset: 13425},
 				run: (*parser).callonShortHandAttrRole1,
 				expr: &seqExpr{
-					pos: position{line: 382, col: 22, offset: 12820},
+					pos: position{line: 393, col: 22, offset: 13425},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 382, col: 22, offset: 12820},
+							pos:        position{line: 393, col: 22, offset: 13425},
 							val:        ".",
 							ignoreCase: false,
 							want:       "\".\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 382, col: 26, offset: 12824},
+							pos:   position{line: 393, col: 26, offset: 13429},
 							label: "role",
 							expr: &ruleRefExpr{
-								pos:  position{line: 382, col: 31, offset: 12829},
+								pos:  position{line: 393, col: 31, offset: 13434},
 								name: "ShortHandValue",
 							},
 						},
 						&andExpr{
-							pos: position{line: 382, col: 46, offset: 12844},
+							pos: position{line: 393, col: 46, offset: 13449},
 							expr: &charClassMatcher{
-								pos:        position{line: 382, col: 47, offset: 12845},
+								pos:        position{line: 393, col: 47, offset: 13450},
 								val:        "[,#%.\\r\\n\\]]",
 								chars:      []rune{',', '#', '%', '.', '\r', '\n', ']'},
 								ignoreCase: false,
@@ -3119,25 +3266,25 @@ var g = &grammar{
 		},
 		{
 			name: "PositionalValue",
-			pos:  position{line: 387, col: 1, offset: 12945},
+			pos:  position{line: 398, col: 1, offset: 13550},
 			expr: &actionExpr{
-				pos: position{line: 387, col: 20, offset: 12964},
+				pos: position{line: 398, col: 20, offset: 13569},
 				run: (*parser).callonPositionalValue1,
 				expr: &seqExpr{
-					pos: position{line: 387, col: 20, offset: 12964},
+					pos: position{line: 398, col: 20, offset: 13569},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 387, col: 20, offset: 12964},
+							pos:   position{line: 398, col: 20, offset: 13569},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 387, col: 26, offset: 12970},
+								pos:  position{line: 398, col: 26, offset: 13575},
 								name: "ShortHandValue",
 							},
 						},
 						&andExpr{
-							pos: position{line: 387, col: 41, offset: 12985},
+							pos: position{line: 398, col: 41, offset: 13590},
 							expr: &charClassMatcher{
-								pos:        position{line: 387, col: 42, offset: 12986},
+								pos:        position{line: 398, col: 42, offset: 13591},
 								val:        "[,#%.\\]]",
 								chars:      []rune{',', '#', '%', '.', ']'},
 								ignoreCase: false,
@@ -3150,24 +3297,24 @@ var g = &grammar{
 		},
 		{
 			name: "InlineVal",
-			pos:  position{line: 391, col: 1, offset: 13022},
+			pos:  position{line: 402, col: 1, offset: 13627},
 			expr: &choiceExpr{
-				pos: position{line: 391, col: 14, offset: 13035},
+				pos: position{line: 402, col: 14, offset: 13640},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 391, col: 14, offset: 13035},
+						pos:  position{line: 402, col: 14, offset: 13640},
 						name: "AttrEmpty",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 391, col: 26, offset: 13047},
+						pos:  position{line: 402, col: 26, offset: 13652},
 						name: "AttrValSQ",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 391, col: 38, offset: 13059},
+						pos:  position{line: 402, col: 38, offset: 13664},
 						name: "AttrValDQ",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 391, col: 50, offset: 13071},
+						pos:  position{line: 402, col: 50, offset: 13676},
 						name: "AttrValPosFB",
 					},
 				},
@@ -3175,17 +3322,17 @@ var g = &grammar{
 		},
 		{
 			name: "NamedAttrs",
-			pos:  position{line: 393, col: 1, offset: 13085},
+			pos:  position{line: 404, col: 1, offset: 13690},
 			expr: &actionExpr{
-				pos: position{line: 393, col: 15, offset: 13099},
+				pos: position{line: 404, col: 15, offset: 13704},
 				run: (*parser).callonNamedAttrs1,
 				expr: &labeledExpr{
-					pos:   position{line: 393, col: 15, offset: 13099},
+					pos:   position{line: 404, col: 15, offset: 13704},
 					label: "attrs",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 393, col: 21, offset: 13105},
+						pos: position{line: 404, col: 21, offset: 13710},
 						expr: &ruleRefExpr{
-							pos:  position{line: 393, col: 21, offset: 13105},
+							pos:  position{line: 404, col: 21, offset: 13710},
 							name: "NamedAttrPair",
 						},
 					},
@@ -3194,66 +3341,66 @@ var g = &grammar{
 		},
 		{
 			name: "NamedAttrPair",
-			pos:  position{line: 397, col: 1, offset: 13170},
+			pos:  position{line: 408, col: 1, offset: 13775},
 			expr: &actionExpr{
-				pos: position{line: 397, col: 18, offset: 13187},
+				pos: position{line: 408, col: 18, offset: 13792},
 				run: (*parser).callonNamedAttrPair1,
 				expr: &seqExpr{
-					pos: position{line: 397, col: 18, offset: 13187},
+					pos: position{line: 408, col: 18, offset: 13792},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 397, col: 18, offset: 13187},
+							pos: position{line: 408, col: 18, offset: 13792},
 							expr: &litMatcher{
-								pos:        position{line: 397, col: 18, offset: 13187},
+								pos:        position{line: 408, col: 18, offset: 13792},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 397, col: 23, offset: 13192},
+							pos: position{line: 408, col: 23, offset: 13797},
 							expr: &ruleRefExpr{
-								pos:  position{line: 397, col: 23, offset: 13192},
+								pos:  position{line: 408, col: 23, offset: 13797},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 397, col: 30, offset: 13199},
+							pos:   position{line: 408, col: 30, offset: 13804},
 							label: "n",
 							expr: &ruleRefExpr{
-								pos:  position{line: 397, col: 32, offset: 13201},
+								pos:  position{line: 408, col: 32, offset: 13806},
 								name: "NamedAttrKey",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 397, col: 45, offset: 13214},
+							pos: position{line: 408, col: 45, offset: 13819},
 							expr: &ruleRefExpr{
-								pos:  position{line: 397, col: 45, offset: 13214},
+								pos:  position{line: 408, col: 45, offset: 13819},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 397, col: 52, offset: 13221},
+							pos:        position{line: 408, col: 52, offset: 13826},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 397, col: 56, offset: 13225},
+							pos:   position{line: 408, col: 56, offset: 13830},
 							label: "v",
 							expr: &choiceExpr{
-								pos: position{line: 397, col: 59, offset: 13228},
+								pos: position{line: 408, col: 59, offset: 13833},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 397, col: 59, offset: 13228},
+										pos:  position{line: 408, col: 59, offset: 13833},
 										name: "AttrValDQ",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 397, col: 71, offset: 13240},
+										pos:  position{line: 408, col: 71, offset: 13845},
 										name: "AttrValSQ",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 397, col: 83, offset: 13252},
+										pos:  position{line: 408, col: 83, offset: 13857},
 										name: "AttrValNamedFB",
 									},
 								},
@@ -3265,17 +3412,17 @@ var g = &grammar{
 		},
 		{
 			name: "AttrEmpty",
-			pos:  position{line: 402, col: 1, offset: 13441},
+			pos:  position{line: 413, col: 1, offset: 14046},
 			expr: &actionExpr{
-				pos: position{line: 402, col: 14, offset: 13454},
+				pos: position{line: 413, col: 14, offset: 14059},
 				run: (*parser).callonAttrEmpty1,
 				expr: &seqExpr{
-					pos: position{line: 402, col: 14, offset: 13454},
+					pos: position{line: 413, col: 14, offset: 14059},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 402, col: 14, offset: 13454},
+							pos: position{line: 413, col: 14, offset: 14059},
 							expr: &charClassMatcher{
-								pos:        position{line: 402, col: 14, offset: 13454},
+								pos:        position{line: 413, col: 14, offset: 14059},
 								val:        "[ \\t]",
 								chars:      []rune{' ', '\t'},
 								ignoreCase: false,
@@ -3283,9 +3430,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 402, col: 21, offset: 13461},
+							pos: position{line: 413, col: 21, offset: 14066},
 							expr: &charClassMatcher{
-								pos:        position{line: 402, col: 22, offset: 13462},
+								pos:        position{line: 413, col: 22, offset: 14067},
 								val:        "[,\\]]",
 								chars:      []rune{',', ']'},
 								ignoreCase: false,
@@ -3298,51 +3445,51 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValSQ",
-			pos:  position{line: 408, col: 1, offset: 13598},
+			pos:  position{line: 419, col: 1, offset: 14203},
 			expr: &actionExpr{
-				pos: position{line: 408, col: 14, offset: 13611},
+				pos: position{line: 419, col: 14, offset: 14216},
 				run: (*parser).callonAttrValSQ1,
 				expr: &seqExpr{
-					pos: position{line: 408, col: 14, offset: 13611},
+					pos: position{line: 419, col: 14, offset: 14216},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 408, col: 14, offset: 13611},
+							pos: position{line: 419, col: 14, offset: 14216},
 							expr: &ruleRefExpr{
-								pos:  position{line: 408, col: 14, offset: 13611},
+								pos:  position{line: 419, col: 14, offset: 14216},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 408, col: 21, offset: 13618},
+							pos:        position{line: 419, col: 21, offset: 14223},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 408, col: 25, offset: 13622},
+							pos:   position{line: 419, col: 25, offset: 14227},
 							label: "val",
 							expr: &ruleRefExpr{
-								pos:  position{line: 408, col: 29, offset: 13626},
+								pos:  position{line: 419, col: 29, offset: 14231},
 								name: "AttrValSQin",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 408, col: 41, offset: 13638},
+							pos:        position{line: 419, col: 41, offset: 14243},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 408, col: 45, offset: 13642},
+							pos: position{line: 419, col: 45, offset: 14247},
 							expr: &ruleRefExpr{
-								pos:  position{line: 408, col: 45, offset: 13642},
+								pos:  position{line: 419, col: 45, offset: 14247},
 								name: "Space",
 							},
 						},
 						&andExpr{
-							pos: position{line: 408, col: 52, offset: 13649},
+							pos: position{line: 419, col: 52, offset: 14254},
 							expr: &charClassMatcher{
-								pos:        position{line: 408, col: 53, offset: 13650},
+								pos:        position{line: 419, col: 53, offset: 14255},
 								val:        "[,\\]]",
 								chars:      []rune{',', ']'},
 								ignoreCase: false,
@@ -3355,26 +3502,26 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValSQin",
-			pos:  position{line: 410, col: 1, offset: 13677},
+			pos:  position{line: 421, col: 1, offset: 14282},
 			expr: &actionExpr{
-				pos: position{line: 410, col: 16, offset: 13692},
+				pos: position{line: 421, col: 16, offset: 14297},
 				run: (*parser).callonAttrValSQin1,
 				expr: &labeledExpr{
-					pos:   position{line: 410, col: 16, offset: 13692},
+					pos:   position{line: 421, col: 16, offset: 14297},
 					label: "val",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 410, col: 20, offset: 13696},
+						pos: position{line: 421, col: 20, offset: 14301},
 						expr: &choiceExpr{
-							pos: position{line: 410, col: 22, offset: 13698},
+							pos: position{line: 421, col: 22, offset: 14303},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 410, col: 22, offset: 13698},
+									pos:  position{line: 421, col: 22, offset: 14303},
 									name: "AttrValSQEsc",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 410, col: 37, offset: 13713},
+									pos: position{line: 421, col: 37, offset: 14318},
 									expr: &charClassMatcher{
-										pos:        position{line: 410, col: 37, offset: 13713},
+										pos:        position{line: 421, col: 37, offset: 14318},
 										val:        "[^\\r\\n'\\\\]",
 										chars:      []rune{'\r', '\n', '\'', '\\'},
 										ignoreCase: false,
@@ -3382,7 +3529,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 410, col: 51, offset: 13727},
+									pos:        position{line: 421, col: 51, offset: 14332},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
@@ -3395,12 +3542,12 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValSQEsc",
-			pos:  position{line: 412, col: 1, offset: 13767},
+			pos:  position{line: 423, col: 1, offset: 14372},
 			expr: &actionExpr{
-				pos: position{line: 412, col: 17, offset: 13783},
+				pos: position{line: 423, col: 17, offset: 14388},
 				run: (*parser).callonAttrValSQEsc1,
 				expr: &litMatcher{
-					pos:        position{line: 412, col: 17, offset: 13783},
+					pos:        position{line: 423, col: 17, offset: 14388},
 					val:        "\\'",
 					ignoreCase: false,
 					want:       "\"\\\\'\"",
@@ -3409,44 +3556,44 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValDQ",
-			pos:  position{line: 415, col: 1, offset: 13843},
+			pos:  position{line: 426, col: 1, offset: 14448},
 			expr: &actionExpr{
-				pos: position{line: 415, col: 14, offset: 13856},
+				pos: position{line: 426, col: 14, offset: 14461},
 				run: (*parser).callonAttrValDQ1,
 				expr: &seqExpr{
-					pos: position{line: 415, col: 14, offset: 13856},
+					pos: position{line: 426, col: 14, offset: 14461},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 415, col: 14, offset: 13856},
+							pos: position{line: 426, col: 14, offset: 14461},
 							expr: &ruleRefExpr{
-								pos:  position{line: 415, col: 14, offset: 13856},
+								pos:  position{line: 426, col: 14, offset: 14461},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 415, col: 21, offset: 13863},
+							pos:        position{line: 426, col: 21, offset: 14468},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 415, col: 25, offset: 13867},
+							pos:   position{line: 426, col: 25, offset: 14472},
 							label: "val",
 							expr: &ruleRefExpr{
-								pos:  position{line: 415, col: 29, offset: 13871},
+								pos:  position{line: 426, col: 29, offset: 14476},
 								name: "AttrValDQin",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 415, col: 41, offset: 13883},
+							pos:        position{line: 426, col: 41, offset: 14488},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 415, col: 45, offset: 13887},
+							pos: position{line: 426, col: 45, offset: 14492},
 							expr: &ruleRefExpr{
-								pos:  position{line: 415, col: 45, offset: 13887},
+								pos:  position{line: 426, col: 45, offset: 14492},
 								name: "Space",
 							},
 						},
@@ -3456,26 +3603,26 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValDQin",
-			pos:  position{line: 417, col: 1, offset: 13915},
+			pos:  position{line: 428, col: 1, offset: 14520},
 			expr: &actionExpr{
-				pos: position{line: 417, col: 16, offset: 13930},
+				pos: position{line: 428, col: 16, offset: 14535},
 				run: (*parser).callonAttrValDQin1,
 				expr: &labeledExpr{
-					pos:   position{line: 417, col: 16, offset: 13930},
+					pos:   position{line: 428, col: 16, offset: 14535},
 					label: "val",
 					expr: &zeroOrMoreExpr{
-						pos: position{line: 417, col: 20, offset: 13934},
+						pos: position{line: 428, col: 20, offset: 14539},
 						expr: &choiceExpr{
-							pos: position{line: 417, col: 22, offset: 13936},
+							pos: position{line: 428, col: 22, offset: 14541},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 417, col: 22, offset: 13936},
+									pos:  position{line: 428, col: 22, offset: 14541},
 									name: "AttrValDQEsc",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 417, col: 37, offset: 13951},
+									pos: position{line: 428, col: 37, offset: 14556},
 									expr: &charClassMatcher{
-										pos:        position{line: 417, col: 37, offset: 13951},
+										pos:        position{line: 428, col: 37, offset: 14556},
 										val:        "[^\\r\\n\"\\\\]",
 										chars:      []rune{'\r', '\n', '"', '\\'},
 										ignoreCase: false,
@@ -3483,7 +3630,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 417, col: 51, offset: 13965},
+									pos:        position{line: 428, col: 51, offset: 14570},
 									val:        "\\",
 									ignoreCase: false,
 									want:       "\"\\\\\"",
@@ -3496,12 +3643,12 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValDQEsc",
-			pos:  position{line: 419, col: 1, offset: 14005},
+			pos:  position{line: 430, col: 1, offset: 14610},
 			expr: &actionExpr{
-				pos: position{line: 419, col: 17, offset: 14021},
+				pos: position{line: 430, col: 17, offset: 14626},
 				run: (*parser).callonAttrValDQEsc1,
 				expr: &litMatcher{
-					pos:        position{line: 419, col: 17, offset: 14021},
+					pos:        position{line: 430, col: 17, offset: 14626},
 					val:        "\\\"",
 					ignoreCase: false,
 					want:       "\"\\\\\\\"\"",
@@ -3510,17 +3657,17 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValPosFB",
-			pos:  position{line: 422, col: 1, offset: 14112},
+			pos:  position{line: 433, col: 1, offset: 14717},
 			expr: &actionExpr{
-				pos: position{line: 422, col: 17, offset: 14128},
+				pos: position{line: 433, col: 17, offset: 14733},
 				run: (*parser).callonAttrValPosFB1,
 				expr: &seqExpr{
-					pos: position{line: 422, col: 17, offset: 14128},
+					pos: position{line: 433, col: 17, offset: 14733},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 422, col: 17, offset: 14128},
+							pos: position{line: 433, col: 17, offset: 14733},
 							expr: &charClassMatcher{
-								pos:        position{line: 422, col: 17, offset: 14128},
+								pos:        position{line: 433, col: 17, offset: 14733},
 								val:        "[^,=\\r\\n\\]]",
 								chars:      []rune{',', '=', '\r', '\n', ']'},
 								ignoreCase: false,
@@ -3528,9 +3675,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 422, col: 30, offset: 14141},
+							pos: position{line: 433, col: 30, offset: 14746},
 							expr: &charClassMatcher{
-								pos:        position{line: 422, col: 31, offset: 14142},
+								pos:        position{line: 433, col: 31, offset: 14747},
 								val:        "[,\\]]",
 								chars:      []rune{',', ']'},
 								ignoreCase: false,
@@ -3543,17 +3690,17 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValNamedFB",
-			pos:  position{line: 425, col: 1, offset: 14253},
+			pos:  position{line: 436, col: 1, offset: 14858},
 			expr: &actionExpr{
-				pos: position{line: 425, col: 19, offset: 14271},
+				pos: position{line: 436, col: 19, offset: 14876},
 				run: (*parser).callonAttrValNamedFB1,
 				expr: &seqExpr{
-					pos: position{line: 425, col: 19, offset: 14271},
+					pos: position{line: 436, col: 19, offset: 14876},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 425, col: 19, offset: 14271},
+							pos: position{line: 436, col: 19, offset: 14876},
 							expr: &charClassMatcher{
-								pos:        position{line: 425, col: 19, offset: 14271},
+								pos:        position{line: 436, col: 19, offset: 14876},
 								val:        "[^,\\r\\n\\]]",
 								chars:      []rune{',', '\r', '\n', ']'},
 								ignoreCase: false,
@@ -3561,9 +3708,9 @@ var g = &grammar{
 							},
 						},
 						&andExpr{
-							pos: position{line: 425, col: 31, offset: 14283},
+							pos: position{line: 436, col: 31, offset: 14888},
 							expr: &charClassMatcher{
-								pos:        position{line: 425, col: 32, offset: 14284},
+								pos:        position{line: 436, col: 32, offset: 14889},
 								val:        "[,\\]]",
 								chars:      []rune{',', ']'},
 								ignoreCase: false,
@@ -3576,20 +3723,20 @@ var g = &grammar{
 		},
 		{
 			name: "ShortHandValue",
-			pos:  position{line: 427, col: 1, offset: 14341},
+			pos:  position{line: 438, col: 1, offset: 14946},
 			expr: &choiceExpr{
-				pos: position{line: 427, col: 19, offset: 14359},
+				pos: position{line: 438, col: 19, offset: 14964},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 427, col: 19, offset: 14359},
+						pos:  position{line: 438, col: 19, offset: 14964},
 						name: "ShortHandValuePlain",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 427, col: 41, offset: 14381},
+						pos:  position{line: 438, col: 41, offset: 14986},
 						name: "AttrValueSingleQuoted",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 427, col: 65, offset: 14405},
+						pos:  position{line: 438, col: 65, offset: 15010},
 						name: "AttrValueDoubleQuoted",
 					},
 				},
@@ -3597,21 +3744,21 @@ var g = &grammar{
 		},
 		{
 			name: "ShortHandValuePlain",
-			pos:  position{line: 431, col: 1, offset: 14603},
+			pos:  position{line: 442, col: 1, offset: 15208},
 			expr: &actionExpr{
-				pos: position{line: 431, col: 24, offset: 14626},
+				pos: position{line: 442, col: 24, offset: 15231},
 				run: (*parser).callonShortHandValuePlain1,
 				expr: &seqExpr{
-					pos: position{line: 431, col: 24, offset: 14626},
+					pos: position{line: 442, col: 24, offset: 15231},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 431, col: 24, offset: 14626},
+							pos:   position{line: 442, col: 24, offset: 15231},
 							label: "first",
 							expr: &actionExpr{
-								pos: position{line: 431, col: 31, offset: 14633},
+								pos: position{line: 442, col: 31, offset: 15238},
 								run: (*parser).callonShortHandValuePlain4,
 								expr: &charClassMatcher{
-									pos:        position{line: 431, col: 31, offset: 14633},
+									pos:        position{line: 442, col: 31, offset: 15238},
 									val:        "[^,\\r\\n\"' \\t.#%=\\]]",
 									chars:      []rune{',', '\r', '\n', '"', '\'', ' ', '\t', '.', '#', '%', '=', ']'},
 									ignoreCase: false,
@@ -3620,42 +3767,42 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 434, col: 5, offset: 14719},
+							pos:   position{line: 445, col: 5, offset: 15324},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 434, col: 13, offset: 14727},
+								pos: position{line: 445, col: 13, offset: 15332},
 								expr: &choiceExpr{
-									pos: position{line: 434, col: 14, offset: 14728},
+									pos: position{line: 445, col: 14, offset: 15333},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 434, col: 14, offset: 14728},
+											pos:  position{line: 445, col: 14, offset: 15333},
 											name: "ElementPlaceHolder",
 										},
 										&choiceExpr{
-											pos: position{line: 435, col: 12, offset: 14759},
+											pos: position{line: 446, col: 12, offset: 15364},
 											alternatives: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 435, col: 12, offset: 14759},
+													pos:        position{line: 446, col: 12, offset: 15364},
 													val:        "[^ \\t,\\r\\n\"'.#%=\\]]",
 													chars:      []rune{' ', '\t', ',', '\r', '\n', '"', '\'', '.', '#', '%', '=', ']'},
 													ignoreCase: false,
 													inverted:   true,
 												},
 												&actionExpr{
-													pos: position{line: 435, col: 34, offset: 14781},
+													pos: position{line: 446, col: 34, offset: 15386},
 													run: (*parser).callonShortHandValuePlain12,
 													expr: &seqExpr{
-														pos: position{line: 435, col: 34, offset: 14781},
+														pos: position{line: 446, col: 34, offset: 15386},
 														exprs: []interface{}{
 															&charClassMatcher{
-																pos:        position{line: 435, col: 34, offset: 14781},
+																pos:        position{line: 446, col: 34, offset: 15386},
 																val:        "[ \\t]",
 																chars:      []rune{' ', '\t'},
 																ignoreCase: false,
 																inverted:   false,
 															},
 															&charClassMatcher{
-																pos:        position{line: 435, col: 39, offset: 14786},
+																pos:        position{line: 446, col: 39, offset: 15391},
 																val:        "[^ \\t,\\r\\n\"'.#%=\\]]",
 																chars:      []rune{' ', '\t', ',', '\r', '\n', '"', '\'', '.', '#', '%', '=', ']'},
 																ignoreCase: false,
@@ -3676,28 +3823,28 @@ var g = &grammar{
 		},
 		{
 			name: "NamedAttr",
-			pos:  position{line: 442, col: 1, offset: 14969},
+			pos:  position{line: 453, col: 1, offset: 15574},
 			expr: &actionExpr{
-				pos: position{line: 442, col: 13, offset: 14981},
+				pos: position{line: 453, col: 13, offset: 15586},
 				run: (*parser).callonNamedAttr1,
 				expr: &seqExpr{
-					pos: position{line: 442, col: 13, offset: 14981},
+					pos: position{line: 453, col: 13, offset: 15586},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 442, col: 13, offset: 14981},
+							pos: position{line: 453, col: 13, offset: 15586},
 							expr: &seqExpr{
-								pos: position{line: 442, col: 15, offset: 14983},
+								pos: position{line: 453, col: 15, offset: 15588},
 								exprs: []interface{}{
 									&litMatcher{
-										pos:        position{line: 442, col: 15, offset: 14983},
+										pos:        position{line: 453, col: 15, offset: 15588},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 442, col: 19, offset: 14987},
+										pos: position{line: 453, col: 19, offset: 15592},
 										expr: &ruleRefExpr{
-											pos:  position{line: 442, col: 19, offset: 14987},
+											pos:  position{line: 453, col: 19, offset: 15592},
 											name: "Space",
 										},
 									},
@@ -3705,45 +3852,45 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 442, col: 29, offset: 14997},
+							pos:   position{line: 453, col: 29, offset: 15602},
 							label: "key",
 							expr: &ruleRefExpr{
-								pos:  position{line: 442, col: 33, offset: 15001},
+								pos:  position{line: 453, col: 33, offset: 15606},
 								name: "NamedAttrKey",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 442, col: 46, offset: 15014},
+							pos: position{line: 453, col: 46, offset: 15619},
 							expr: &ruleRefExpr{
-								pos:  position{line: 442, col: 46, offset: 15014},
+								pos:  position{line: 453, col: 46, offset: 15619},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 442, col: 53, offset: 15021},
+							pos:        position{line: 453, col: 53, offset: 15626},
 							val:        "=",
 							ignoreCase: false,
 							want:       "\"=\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 442, col: 57, offset: 15025},
+							pos: position{line: 453, col: 57, offset: 15630},
 							expr: &ruleRefExpr{
-								pos:  position{line: 442, col: 57, offset: 15025},
+								pos:  position{line: 453, col: 57, offset: 15630},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 442, col: 64, offset: 15032},
+							pos:   position{line: 453, col: 64, offset: 15637},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 442, col: 70, offset: 15038},
+								pos:  position{line: 453, col: 70, offset: 15643},
 								name: "NamedAttrValue",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 442, col: 85, offset: 15053},
+							pos: position{line: 453, col: 85, offset: 15658},
 							expr: &ruleRefExpr{
-								pos:  position{line: 442, col: 85, offset: 15053},
+								pos:  position{line: 453, col: 85, offset: 15658},
 								name: "Space",
 							},
 						},
@@ -3753,15 +3900,15 @@ var g = &grammar{
 		},
 		{
 			name: "NamedAttrKey",
-			pos:  position{line: 447, col: 1, offset: 15234},
+			pos:  position{line: 458, col: 1, offset: 15839},
 			expr: &actionExpr{
-				pos: position{line: 447, col: 17, offset: 15250},
+				pos: position{line: 458, col: 17, offset: 15855},
 				run: (*parser).callonNamedAttrKey1,
 				expr: &seqExpr{
-					pos: position{line: 447, col: 17, offset: 15250},
+					pos: position{line: 458, col: 17, offset: 15855},
 					exprs: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 447, col: 17, offset: 15250},
+							pos:        position{line: 458, col: 17, offset: 15855},
 							val:        "[\\pL0-9_]",
 							chars:      []rune{'_'},
 							ranges:     []rune{'0', '9'},
@@ -3770,9 +3917,9 @@ var g = &grammar{
 							inverted:   false,
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 447, col: 26, offset: 15259},
+							pos: position{line: 458, col: 26, offset: 15864},
 							expr: &charClassMatcher{
-								pos:        position{line: 447, col: 26, offset: 15259},
+								pos:        position{line: 458, col: 26, offset: 15864},
 								val:        "[\\pL0-9_-]",
 								chars:      []rune{'_', '-'},
 								ranges:     []rune{'0', '9'},
@@ -3787,24 +3934,24 @@ var g = &grammar{
 		},
 		{
 			name: "NamedAttrValue",
-			pos:  position{line: 451, col: 1, offset: 15307},
+			pos:  position{line: 462, col: 1, offset: 15912},
 			expr: &choiceExpr{
-				pos: position{line: 451, col: 19, offset: 15325},
+				pos: position{line: 462, col: 19, offset: 15930},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 451, col: 19, offset: 15325},
+						pos:  position{line: 462, col: 19, offset: 15930},
 						name: "AttrValueNone",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 451, col: 35, offset: 15341},
+						pos:  position{line: 462, col: 35, offset: 15946},
 						name: "AttrValueSingleQuoted",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 451, col: 59, offset: 15365},
+						pos:  position{line: 462, col: 59, offset: 15970},
 						name: "AttrValueDoubleQuoted",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 451, col: 83, offset: 15389},
+						pos:  position{line: 462, col: 83, offset: 15994},
 						name: "AttrValuePlain",
 					},
 				},
@@ -3812,14 +3959,14 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValuePlain",
-			pos:  position{line: 453, col: 1, offset: 15405},
+			pos:  position{line: 464, col: 1, offset: 16010},
 			expr: &actionExpr{
-				pos: position{line: 453, col: 19, offset: 15423},
+				pos: position{line: 464, col: 19, offset: 16028},
 				run: (*parser).callonAttrValuePlain1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 453, col: 19, offset: 15423},
+					pos: position{line: 464, col: 19, offset: 16028},
 					expr: &charClassMatcher{
-						pos:        position{line: 453, col: 19, offset: 15423},
+						pos:        position{line: 464, col: 19, offset: 16028},
 						val:        "[^,\\r\\n\"' \\t\\]]",
 						chars:      []rune{',', '\r', '\n', '"', '\'', ' ', '\t', ']'},
 						ignoreCase: false,
@@ -3830,34 +3977,34 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValueSingleQuoted",
-			pos:  position{line: 457, col: 1, offset: 15476},
+			pos:  position{line: 468, col: 1, offset: 16081},
 			expr: &actionExpr{
-				pos: position{line: 457, col: 26, offset: 15501},
+				pos: position{line: 468, col: 26, offset: 16106},
 				run: (*parser).callonAttrValueSingleQuoted1,
 				expr: &seqExpr{
-					pos: position{line: 457, col: 26, offset: 15501},
+					pos: position{line: 468, col: 26, offset: 16106},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 457, col: 26, offset: 15501},
+							pos:        position{line: 468, col: 26, offset: 16106},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 457, col: 30, offset: 15505},
+							pos:   position{line: 468, col: 30, offset: 16110},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 457, col: 39, offset: 15514},
+								pos: position{line: 468, col: 39, offset: 16119},
 								expr: &choiceExpr{
-									pos: position{line: 458, col: 5, offset: 15520},
+									pos: position{line: 469, col: 5, offset: 16125},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 458, col: 6, offset: 15521},
+											pos: position{line: 469, col: 6, offset: 16126},
 											run: (*parser).callonAttrValueSingleQuoted7,
 											expr: &oneOrMoreExpr{
-												pos: position{line: 458, col: 6, offset: 15521},
+												pos: position{line: 469, col: 6, offset: 16126},
 												expr: &charClassMatcher{
-													pos:        position{line: 458, col: 6, offset: 15521},
+													pos:        position{line: 469, col: 6, offset: 16126},
 													val:        "[^'\\r\\n\\uFFFD]",
 													chars:      []rune{'\'', '\r', '\n', '�'},
 													ignoreCase: false,
@@ -3866,7 +4013,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 460, col: 10, offset: 15603},
+											pos:  position{line: 471, col: 10, offset: 16208},
 											name: "ElementPlaceHolder",
 										},
 									},
@@ -3874,7 +4021,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 460, col: 31, offset: 15624},
+							pos:        position{line: 471, col: 31, offset: 16229},
 							val:        "'",
 							ignoreCase: false,
 							want:       "\"'\"",
@@ -3885,34 +4032,34 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValueDoubleQuoted",
-			pos:  position{line: 464, col: 1, offset: 15666},
+			pos:  position{line: 475, col: 1, offset: 16271},
 			expr: &actionExpr{
-				pos: position{line: 464, col: 26, offset: 15691},
+				pos: position{line: 475, col: 26, offset: 16296},
 				run: (*parser).callonAttrValueDoubleQuoted1,
 				expr: &seqExpr{
-					pos: position{line: 464, col: 26, offset: 15691},
+					pos: position{line: 475, col: 26, offset: 16296},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 464, col: 26, offset: 15691},
+							pos:        position{line: 475, col: 26, offset: 16296},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 464, col: 31, offset: 15696},
+							pos:   position{line: 475, col: 31, offset: 16301},
 							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 464, col: 40, offset: 15705},
+								pos: position{line: 475, col: 40, offset: 16310},
 								expr: &choiceExpr{
-									pos: position{line: 465, col: 5, offset: 15711},
+									pos: position{line: 476, col: 5, offset: 16316},
 									alternatives: []interface{}{
 										&actionExpr{
-											pos: position{line: 465, col: 6, offset: 15712},
+											pos: position{line: 476, col: 6, offset: 16317},
 											run: (*parser).callonAttrValueDoubleQuoted7,
 											expr: &oneOrMoreExpr{
-												pos: position{line: 465, col: 6, offset: 15712},
+												pos: position{line: 476, col: 6, offset: 16317},
 												expr: &charClassMatcher{
-													pos:        position{line: 465, col: 6, offset: 15712},
+													pos:        position{line: 476, col: 6, offset: 16317},
 													val:        "[^\"\\r\\n\\uFFFD]",
 													chars:      []rune{'"', '\r', '\n', '�'},
 													ignoreCase: false,
@@ -3921,7 +4068,7 @@ var g = &grammar{
 											},
 										},
 										&ruleRefExpr{
-											pos:  position{line: 467, col: 10, offset: 15794},
+											pos:  position{line: 478, col: 10, offset: 16399},
 											name: "ElementPlaceHolder",
 										},
 									},
@@ -3929,7 +4076,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 467, col: 31, offset: 15815},
+							pos:        position{line: 478, col: 31, offset: 16420},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -3940,12 +4087,12 @@ var g = &grammar{
 		},
 		{
 			name: "AttrValueNone",
-			pos:  position{line: 473, col: 1, offset: 16055},
+			pos:  position{line: 484, col: 1, offset: 16660},
 			expr: &actionExpr{
-				pos: position{line: 473, col: 18, offset: 16072},
+				pos: position{line: 484, col: 18, offset: 16677},
 				run: (*parser).callonAttrValueNone1,
 				expr: &litMatcher{
-					pos:        position{line: 473, col: 18, offset: 16072},
+					pos:        position{line: 484, col: 18, offset: 16677},
 					val:        "None",
 					ignoreCase: false,
 					want:       "\"None\"",
@@ -3954,34 +4101,121 @@ var g = &grammar{
 		},
 		{
 			name: "Section",
-			pos:  position{line: 480, col: 1, offset: 16207},
+			pos:  position{line: 491, col: 1, offset: 16812},
 			expr: &actionExpr{
-				pos: position{line: 480, col: 12, offset: 16218},
+				pos: position{line: 491, col: 12, offset: 16823},
 				run: (*parser).callonSection1,
 				expr: &seqExpr{
-					pos: position{line: 480, col: 12, offset: 16218},
+					pos: position{line: 491, col: 12, offset: 16823},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 480, col: 12, offset: 16218},
+							pos:   position{line: 491, col: 12, offset: 16823},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 480, col: 23, offset: 16229},
+								pos: position{line: 491, col: 23, offset: 16834},
+								expr: &ruleRefExpr{
+									pos:  position{line: 491, col: 24, offset: 16835},
+									name: "BlockAttrs",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 491, col: 37, offset: 16848},
+							label: "pos",
+							expr: &ruleRefExpr{
+								pos:  position{line: 491, col: 42, offset: 16853},
+								name: "SourcePosition",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 492, col: 5, offset: 16873},
+							label: "level",
+							expr: &actionExpr{
+								pos: position{line: 492, col: 12, offset: 16880},
+								run: (*parser).callonSection9,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 492, col: 12, offset: 16880},
+									expr: &litMatcher{
+										pos:        position{line: 492, col: 13, offset: 16881},
+										val:        "=",
+										ignoreCase: false,
+										want:       "\"=\"",
+									},
+								},
+							},
+						},
+						&andCodeExpr{
+							pos: position{line: 496, col: 5, offset: 16972},
+							run: (*parser).callonSection12,
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 500, col: 5, offset: 17124},
+							expr: &ruleRefExpr{
+								pos:  position{line: 500, col: 5, offset: 17124},
+								name: "Space",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 500, col: 12, offset: 17131},
+							label: "title",
+							expr: &ruleRefExpr{
+								pos:  position{line: 500, col: 19, offset: 17138},
+								name: "TitleElements",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 500, col: 34, offset: 17153},
+							label: "id",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 500, col: 38, offset: 17157},
+								expr: &ruleRefExpr{
+									pos:  position{line: 500, col: 38, offset: 17157},
+									name: "InlineElementID",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 500, col: 56, offset: 17175},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DiscreteHeading",
+			pos:  position{line: 505, col: 1, offset: 17393},
+			expr: &actionExpr{
+				pos: position{line: 505, col: 20, offset: 17412},
+				run: (*parser).callonDiscreteHeading1,
+				expr: &seqExpr{
+					pos: position{line: 505, col: 20, offset: 17412},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 505, col: 20, offset: 17412},
+							label: "attributes",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 505, col: 31, offset: 17423},
 								expr: &ruleRefExpr{
-									pos:  position{line: 480, col: 24, offset: 16230},
+									pos:  position{line: 505, col: 32, offset: 17424},
 									name: "BlockAttrs",
 								},
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 506, col: 5, offset: 17441},
+							run: (*parser).callonDiscreteHeading6,
+						},
 						&labeledExpr{
-							pos:   position{line: 481, col: 5, offset: 16247},
+							pos:   position{line: 509, col: 5, offset: 17499},
 							label: "level",
 							expr: &actionExpr{
-								pos: position{line: 481, col: 12, offset: 16254},
-								run: (*parser).callonSection7,
+								pos: position{line: 509, col: 12, offset: 17506},
+								run: (*parser).callonDiscreteHeading8,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 481, col: 12, offset: 16254},
+									pos: position{line: 509, col: 12, offset: 17506},
 									expr: &litMatcher{
-										pos:        position{line: 481, col: 13, offset: 16255},
+										pos:        position{line: 509, col: 13, offset: 17507},
 										val:        "=",
 										ignoreCase: false,
 										want:       "\"=\"",
@@ -3990,37 +4224,37 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 485, col: 5, offset: 16346},
-							run: (*parser).callonSection10,
+							pos: position{line: 512, col: 5, offset: 17566},
+							run: (*parser).callonDiscreteHeading11,
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 489, col: 5, offset: 16498},
+							pos: position{line: 515, col: 5, offset: 17620},
 							expr: &ruleRefExpr{
-								pos:  position{line: 489, col: 5, offset: 16498},
+								pos:  position{line: 515, col: 5, offset: 17620},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 489, col: 12, offset: 16505},
+							pos:   position{line: 515, col: 12, offset: 17627},
 							label: "title",
 							expr: &ruleRefExpr{
-								pos:  position{line: 489, col: 19, offset: 16512},
+								pos:  position{line: 515, col: 19, offset: 17634},
 								name: "TitleElements",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 489, col: 34, offset: 16527},
+							pos:   position{line: 515, col: 34, offset: 17649},
 							label: "id",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 489, col: 38, offset: 16531},
+								pos: position{line: 515, col: 38, offset: 17653},
 								expr: &ruleRefExpr{
-									pos:  position{line: 489, col: 38, offset: 16531},
+									pos:  position{line: 515, col: 38, offset: 17653},
 									name: "InlineElementID",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 489, col: 56, offset: 16549},
+							pos:  position{line: 515, col: 56, offset: 17671},
 							name: "EOL",
 						},
 					},
@@ -4029,34 +4263,34 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElements",
-			pos:  position{line: 493, col: 1, offset: 16655},
+			pos:  position{line: 519, col: 1, offset: 17785},
 			expr: &actionExpr{
-				pos: position{line: 493, col: 18, offset: 16672},
+				pos: position{line: 519, col: 18, offset: 17802},
 				run: (*parser).callonTitleElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 493, col: 18, offset: 16672},
+					pos:   position{line: 519, col: 18, offset: 17802},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 493, col: 27, offset: 16681},
+						pos: position{line: 519, col: 27, offset: 17811},
 						expr: &seqExpr{
-							pos: position{line: 493, col: 28, offset: 16682},
+							pos: position{line: 519, col: 28, offset: 17812},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 493, col: 28, offset: 16682},
+									pos: position{line: 519, col: 28, offset: 17812},
 									expr: &ruleRefExpr{
-										pos:  position{line: 493, col: 29, offset: 16683},
+										pos:  position{line: 519, col: 29, offset: 17813},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 493, col: 37, offset: 16691},
+									pos: position{line: 519, col: 37, offset: 17821},
 									expr: &ruleRefExpr{
-										pos:  position{line: 493, col: 38, offset: 16692},
+										pos:  position{line: 519, col: 38, offset: 17822},
 										name: "InlineElementID",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 493, col: 54, offset: 16708},
+									pos:  position{line: 519, col: 54, offset: 17838},
 									name: "TitleElement",
 								},
 							},
@@ -4067,37 +4301,37 @@ var g = &grammar{
 		},
 		{
 			name: "TitleElement",
-			pos:  position{line: 497, col: 1, offset: 16829},
+			pos:  position{line: 523, col: 1, offset: 17959},
 			expr: &actionExpr{
-				pos: position{line: 497, col: 17, offset: 16845},
+				pos: position{line: 523, col: 17, offset: 17975},
 				run: (*parser).callonTitleElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 497, col: 17, offset: 16845},
+					pos:   position{line: 523, col: 17, offset: 17975},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 497, col: 26, offset: 16854},
+						pos: position{line: 523, col: 26, offset: 17984},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 497, col: 26, offset: 16854},
+								pos:  position{line: 523, col: 26, offset: 17984},
 								name: "Word",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 498, col: 11, offset: 16869},
+								pos:  position{line: 524, col: 11, offset: 17999},
 								name: "LineBreak",
 							},
 							&oneOrMoreExpr{
-								pos: position{line: 499, col: 11, offset: 16914},
+								pos: position{line: 525, col: 11, offset: 18044},
 								expr: &ruleRefExpr{
-									pos:  position{line: 499, col: 11, offset: 16914},
+									pos:  position{line: 525, col: 11, offset: 18044},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 500, col: 11, offset: 16932},
+								pos:  position{line: 526, col: 11, offset: 18062},
 								name: "ElementPlaceHolder",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 501, col: 11, offset: 16961},
+								pos:  position{line: 527, col: 11, offset: 18091},
 								name: "AnyChar",
 							},
 						},
@@ -4107,18 +4341,18 @@ var g = &grammar{
 		},
 		{
 			name: "TableOfContentsPlaceHolder",
-			pos:  position{line: 508, col: 1, offset: 17112},
+			pos:  position{line: 534, col: 1, offset: 18242},
 			expr: &seqExpr{
-				pos: position{line: 508, col: 31, offset: 17142},
+				pos: position{line: 534, col: 31, offset: 18272},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 508, col: 31, offset: 17142},
+						pos:        position{line: 534, col: 31, offset: 18272},
 						val:        "toc::[]",
 						ignoreCase: false,
 						want:       "\"toc::[]\"",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 508, col: 41, offset: 17152},
+						pos:  position{line: 534, col: 41, offset: 18282},
 						name: "EOL",
 					},
 				},
@@ -4126,40 +4360,40 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroBlock",
-			pos:  position{line: 513, col: 1, offset: 17263},
+			pos:  position{line: 539, col: 1, offset: 18393},
 			expr: &actionExpr{
-				pos: position{line: 513, col: 19, offset: 17281},
+				pos: position{line: 539, col: 19, offset: 18411},
 				run: (*parser).callonUserMacroBlock1,
 				expr: &seqExpr{
-					pos: position{line: 513, col: 19, offset: 17281},
+					pos: position{line: 539, col: 19, offset: 18411},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 513, col: 19, offset: 17281},
+							pos:   position{line: 539, col: 19, offset: 18411},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 513, col: 25, offset: 17287},
+								pos:  position{line: 539, col: 25, offset: 18417},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 513, col: 40, offset: 17302},
+							pos:        position{line: 539, col: 40, offset: 18432},
 							val:        "::",
 							ignoreCase: false,
 							want:       "\"::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 513, col: 45, offset: 17307},
+							pos:   position{line: 539, col: 45, offset: 18437},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 513, col: 52, offset: 17314},
+								pos:  position{line: 539, col: 52, offset: 18444},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 513, col: 68, offset: 17330},
+							pos:   position{line: 539, col: 68, offset: 18460},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 513, col: 75, offset: 17337},
+								pos:  position{line: 539, col: 75, offset: 18467},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -4169,40 +4403,40 @@ var g = &grammar{
 		},
 		{
 			name: "InlineUserMacro",
-			pos:  position{line: 517, col: 1, offset: 17452},
+			pos:  position{line: 543, col: 1, offset: 18582},
 			expr: &actionExpr{
-				pos: position{line: 517, col: 20, offset: 17471},
+				pos: position{line: 543, col: 20, offset: 18601},
 				run: (*parser).callonInlineUserMacro1,
 				expr: &seqExpr{
-					pos: position{line: 517, col: 20, offset: 17471},
+					pos: position{line: 543, col: 20, offset: 18601},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 517, col: 20, offset: 17471},
+							pos:   position{line: 543, col: 20, offset: 18601},
 							label: "name",
 							expr: &ruleRefExpr{
-								pos:  position{line: 517, col: 26, offset: 17477},
+								pos:  position{line: 543, col: 26, offset: 18607},
 								name: "UserMacroName",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 517, col: 41, offset: 17492},
+							pos:        position{line: 543, col: 41, offset: 18622},
 							val:        ":",
 							ignoreCase: false,
 							want:       "\":\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 517, col: 45, offset: 17496},
+							pos:   position{line: 543, col: 45, offset: 18626},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 517, col: 52, offset: 17503},
+								pos:  position{line: 543, col: 52, offset: 18633},
 								name: "UserMacroValue",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 517, col: 68, offset: 17519},
+							pos:   position{line: 543, col: 68, offset: 18649},
 							label: "attrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 517, col: 75, offset: 17526},
+								pos:  position{line: 543, col: 75, offset: 18656},
 								name: "UserMacroAttributes",
 							},
 						},
@@ -4212,26 +4446,26 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroName",
-			pos:  position{line: 521, col: 1, offset: 17642},
+			pos:  position{line: 547, col: 1, offset: 18772},
 			expr: &actionExpr{
-				pos: position{line: 521, col: 18, offset: 17659},
+				pos: position{line: 547, col: 18, offset: 18789},
 				run: (*parser).callonUserMacroName1,
 				expr: &seqExpr{
-					pos: position{line: 521, col: 18, offset: 17659},
+					pos: position{line: 547, col: 18, offset: 18789},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 521, col: 18, offset: 17659},
+							pos: position{line: 547, col: 18, offset: 18789},
 							expr: &litMatcher{
-								pos:        position{line: 521, col: 19, offset: 17660},
+								pos:        position{line: 547, col: 19, offset: 18790},
 								val:        "include",
 								ignoreCase: false,
 								want:       "\"include\"",
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 521, col: 30, offset: 17671},
+							pos: position{line: 547, col: 30, offset: 18801},
 							expr: &charClassMatcher{
-								pos:        position{line: 521, col: 30, offset: 17671},
+								pos:        position{line: 547, col: 30, offset: 18801},
 								val:        "[\\pL0-9_-]",
 								chars:      []rune{'_', '-'},
 								ranges:     []rune{'0', '9'},
@@ -4246,14 +4480,14 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroValue",
-			pos:  position{line: 525, col: 1, offset: 17720},
+			pos:  position{line: 551, col: 1, offset: 18850},
 			expr: &actionExpr{
-				pos: position{line: 525, col: 19, offset: 17738},
+				pos: position{line: 551, col: 19, offset: 18868},
 				run: (*parser).callonUserMacroValue1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 525, col: 19, offset: 17738},
+					pos: position{line: 551, col: 19, offset: 18868},
 					expr: &charClassMatcher{
-						pos:        position{line: 525, col: 19, offset: 17738},
+						pos:        position{line: 551, col: 19, offset: 18868},
 						val:        "[^:[ \\r\\n]",
 						chars:      []rune{':', '[', ' ', '\r', '\n'},
 						ignoreCase: false,
@@ -4264,32 +4498,32 @@ var g = &grammar{
 		},
 		{
 			name: "UserMacroAttributes",
-			pos:  position{line: 529, col: 1, offset: 17786},
+			pos:  position{line: 555, col: 1, offset: 18916},
 			expr: &actionExpr{
-				pos: position{line: 529, col: 24, offset: 17809},
+				pos: position{line: 555, col: 24, offset: 18939},
 				run: (*parser).callonUserMacroAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 529, col: 24, offset: 17809},
+					pos: position{line: 555, col: 24, offset: 18939},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 529, col: 24, offset: 17809},
+							pos:        position{line: 555, col: 24, offset: 18939},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 529, col: 28, offset: 17813},
+							pos:   position{line: 555, col: 28, offset: 18943},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 529, col: 34, offset: 17819},
+								pos: position{line: 555, col: 34, offset: 18949},
 								expr: &ruleRefExpr{
-									pos:  position{line: 529, col: 35, offset: 17820},
+									pos:  position{line: 555, col: 35, offset: 18950},
 									name: "GenericAttribute",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 529, col: 54, offset: 17839},
+							pos:        position{line: 555, col: 54, offset: 18969},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -4300,41 +4534,41 @@ var g = &grammar{
 		},
 		{
 			name: "FileInclusion",
-			pos:  position{line: 536, col: 1, offset: 18021},
+			pos:  position{line: 562, col: 1, offset: 19151},
 			expr: &actionExpr{
-				pos: position{line: 536, col: 18, offset: 18038},
+				pos: position{line: 562, col: 18, offset: 19168},
 				run: (*parser).callonFileInclusion1,
 				expr: &seqExpr{
-					pos: position{line: 536, col: 18, offset: 18038},
+					pos: position{line: 562, col: 18, offset: 19168},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 536, col: 18, offset: 18038},
+							pos:   position{line: 562, col: 18, offset: 19168},
 							label: "incl",
 							expr: &actionExpr{
-								pos: position{line: 536, col: 24, offset: 18044},
+								pos: position{line: 562, col: 24, offset: 19174},
 								run: (*parser).callonFileInclusion4,
 								expr: &seqExpr{
-									pos: position{line: 536, col: 24, offset: 18044},
+									pos: position{line: 562, col: 24, offset: 19174},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 536, col: 24, offset: 18044},
+											pos:        position{line: 562, col: 24, offset: 19174},
 											val:        "include::",
 											ignoreCase: false,
 											want:       "\"include::\"",
 										},
 										&labeledExpr{
-											pos:   position{line: 536, col: 36, offset: 18056},
+											pos:   position{line: 562, col: 36, offset: 19186},
 											label: "path",
 											expr: &ruleRefExpr{
-												pos:  position{line: 536, col: 42, offset: 18062},
+												pos:  position{line: 562, col: 42, offset: 19192},
 												name: "FileLocation",
 											},
 										},
 										&labeledExpr{
-											pos:   position{line: 536, col: 56, offset: 18076},
+											pos:   position{line: 562, col: 56, offset: 19206},
 											label: "inlineAttributes",
 											expr: &ruleRefExpr{
-												pos:  position{line: 536, col: 74, offset: 18094},
+												pos:  position{line: 562, col: 74, offset: 19224},
 												name: "FileIncludeAttributes",
 											},
 										},
@@ -4343,14 +4577,14 @@ var g = &grammar{
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 538, col: 8, offset: 18241},
+							pos: position{line: 564, col: 8, offset: 19371},
 							expr: &ruleRefExpr{
-								pos:  position{line: 538, col: 8, offset: 18241},
+								pos:  position{line: 564, col: 8, offset: 19371},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 538, col: 15, offset: 18248},
+							pos:  position{line: 564, col: 15, offset: 19378},
 							name: "EOL",
 						},
 					},
@@ -4359,37 +4593,37 @@ var g = &grammar{
 		},
 		{
 			name: "FileIncludeAttributes",
-			pos:  position{line: 542, col: 1, offset: 18300},
+			pos:  position{line: 568, col: 1, offset: 19430},
 			expr: &actionExpr{
-				pos: position{line: 542, col: 26, offset: 18325},
+				pos: position{line: 568, col: 26, offset: 19455},
 				run: (*parser).callonFileIncludeAttributes1,
 				expr: &seqExpr{
-					pos: position{line: 542, col: 26, offset: 18325},
+					pos: position{line: 568, col: 26, offset: 19455},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 542, col: 26, offset: 18325},
+							pos:        position{line: 568, col: 26, offset: 19455},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 542, col: 30, offset: 18329},
+							pos:   position{line: 568, col: 30, offset: 19459},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 542, col: 36, offset: 18335},
+								pos: position{line: 568, col: 36, offset: 19465},
 								expr: &choiceExpr{
-									pos: position{line: 542, col: 37, offset: 18336},
+									pos: position{line: 568, col: 37, offset: 19466},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 542, col: 37, offset: 18336},
+											pos:  position{line: 568, col: 37, offset: 19466},
 											name: "LineRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 542, col: 59, offset: 18358},
+											pos:  position{line: 568, col: 59, offset: 19488},
 											name: "TagRangesAttribute",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 542, col: 80, offset: 18379},
+											pos:  position{line: 568, col: 80, offset: 19509},
 											name: "GenericAttribute",
 										},
 									},
@@ -4397,7 +4631,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 542, col: 99, offset: 18398},
+							pos:        position{line: 568, col: 99, offset: 19528},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -4408,31 +4642,31 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttribute",
-			pos:  position{line: 546, col: 1, offset: 18470},
+			pos:  position{line: 572, col: 1, offset: 19600},
 			expr: &actionExpr{
-				pos: position{line: 546, col: 24, offset: 18493},
+				pos: position{line: 572, col: 24, offset: 19623},
 				run: (*parser).callonLineRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 546, col: 24, offset: 18493},
+					pos: position{line: 572, col: 24, offset: 19623},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 546, col: 24, offset: 18493},
+							pos:        position{line: 572, col: 24, offset: 19623},
 							val:        "lines=",
 							ignoreCase: false,
 							want:       "\"lines=\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 546, col: 33, offset: 18502},
+							pos:   position{line: 572, col: 33, offset: 19632},
 							label: "lines",
 							expr: &ruleRefExpr{
-								pos:  position{line: 546, col: 40, offset: 18509},
+								pos:  position{line: 572, col: 40, offset: 19639},
 								name: "LineRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 546, col: 66, offset: 18535},
+							pos: position{line: 572, col: 66, offset: 19665},
 							expr: &litMatcher{
-								pos:        position{line: 546, col: 66, offset: 18535},
+								pos:        position{line: 572, col: 66, offset: 19665},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
@@ -4444,73 +4678,73 @@ var g = &grammar{
 		},
 		{
 			name: "LineRangesAttributeValue",
-			pos:  position{line: 550, col: 1, offset: 18594},
+			pos:  position{line: 576, col: 1, offset: 19724},
 			expr: &actionExpr{
-				pos: position{line: 550, col: 29, offset: 18622},
+				pos: position{line: 576, col: 29, offset: 19752},
 				run: (*parser).callonLineRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 550, col: 29, offset: 18622},
+					pos: position{line: 576, col: 29, offset: 19752},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 550, col: 29, offset: 18622},
+							pos:   position{line: 576, col: 29, offset: 19752},
 							label: "value",
 							expr: &choiceExpr{
-								pos: position{line: 550, col: 36, offset: 18629},
+								pos: position{line: 576, col: 36, offset: 19759},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 550, col: 36, offset: 18629},
+										pos:  position{line: 576, col: 36, offset: 19759},
 										name: "MultipleLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 551, col: 11, offset: 18746},
+										pos:  position{line: 577, col: 11, offset: 19876},
 										name: "MultipleQuotedLineRanges",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 552, col: 11, offset: 18782},
+										pos:  position{line: 578, col: 11, offset: 19912},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 553, col: 11, offset: 18808},
+										pos:  position{line: 579, col: 11, offset: 19938},
 										name: "MultiLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 554, col: 11, offset: 18840},
+										pos:  position{line: 580, col: 11, offset: 19970},
 										name: "SingleLineQuotedRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 555, col: 11, offset: 18872},
+										pos:  position{line: 581, col: 11, offset: 20002},
 										name: "SingleLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 556, col: 11, offset: 18899},
+										pos:  position{line: 582, col: 11, offset: 20029},
 										name: "UndefinedLineRange",
 									},
 								},
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 556, col: 31, offset: 18919},
+							pos: position{line: 582, col: 31, offset: 20049},
 							expr: &ruleRefExpr{
-								pos:  position{line: 556, col: 31, offset: 18919},
+								pos:  position{line: 582, col: 31, offset: 20049},
 								name: "Space",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 556, col: 39, offset: 18927},
+							pos: position{line: 582, col: 39, offset: 20057},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 556, col: 39, offset: 18927},
+									pos: position{line: 582, col: 39, offset: 20057},
 									expr: &litMatcher{
-										pos:        position{line: 556, col: 40, offset: 18928},
+										pos:        position{line: 582, col: 40, offset: 20058},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 								},
 								&andExpr{
-									pos: position{line: 556, col: 46, offset: 18934},
+									pos: position{line: 582, col: 46, offset: 20064},
 									expr: &litMatcher{
-										pos:        position{line: 556, col: 47, offset: 18935},
+										pos:        position{line: 582, col: 47, offset: 20065},
 										val:        "]",
 										ignoreCase: false,
 										want:       "\"]\"",
@@ -4524,59 +4758,59 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleLineRanges",
-			pos:  position{line: 560, col: 1, offset: 18967},
+			pos:  position{line: 586, col: 1, offset: 20097},
 			expr: &actionExpr{
-				pos: position{line: 560, col: 23, offset: 18989},
+				pos: position{line: 586, col: 23, offset: 20119},
 				run: (*parser).callonMultipleLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 560, col: 23, offset: 18989},
+					pos: position{line: 586, col: 23, offset: 20119},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 560, col: 23, offset: 18989},
+							pos:   position{line: 586, col: 23, offset: 20119},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 560, col: 30, offset: 18996},
+								pos: position{line: 586, col: 30, offset: 20126},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 560, col: 30, offset: 18996},
+										pos:  position{line: 586, col: 30, offset: 20126},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 560, col: 47, offset: 19013},
+										pos:  position{line: 586, col: 47, offset: 20143},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 561, col: 5, offset: 19035},
+							pos:   position{line: 587, col: 5, offset: 20165},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 561, col: 12, offset: 19042},
+								pos: position{line: 587, col: 12, offset: 20172},
 								expr: &actionExpr{
-									pos: position{line: 561, col: 13, offset: 19043},
+									pos: position{line: 587, col: 13, offset: 20173},
 									run: (*parser).callonMultipleLineRanges9,
 									expr: &seqExpr{
-										pos: position{line: 561, col: 13, offset: 19043},
+										pos: position{line: 587, col: 13, offset: 20173},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 561, col: 13, offset: 19043},
+												pos:        position{line: 587, col: 13, offset: 20173},
 												val:        ";",
 												ignoreCase: false,
 												want:       "\";\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 561, col: 17, offset: 19047},
+												pos:   position{line: 587, col: 17, offset: 20177},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 561, col: 24, offset: 19054},
+													pos: position{line: 587, col: 24, offset: 20184},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 561, col: 24, offset: 19054},
+															pos:  position{line: 587, col: 24, offset: 20184},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 561, col: 41, offset: 19071},
+															pos:  position{line: 587, col: 41, offset: 20201},
 															name: "SingleLineRange",
 														},
 													},
@@ -4593,65 +4827,65 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleQuotedLineRanges",
-			pos:  position{line: 567, col: 1, offset: 19209},
+			pos:  position{line: 593, col: 1, offset: 20339},
 			expr: &actionExpr{
-				pos: position{line: 567, col: 29, offset: 19237},
+				pos: position{line: 593, col: 29, offset: 20367},
 				run: (*parser).callonMultipleQuotedLineRanges1,
 				expr: &seqExpr{
-					pos: position{line: 567, col: 29, offset: 19237},
+					pos: position{line: 593, col: 29, offset: 20367},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 567, col: 29, offset: 19237},
+							pos:        position{line: 593, col: 29, offset: 20367},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 567, col: 34, offset: 19242},
+							pos:   position{line: 593, col: 34, offset: 20372},
 							label: "first",
 							expr: &choiceExpr{
-								pos: position{line: 567, col: 41, offset: 19249},
+								pos: position{line: 593, col: 41, offset: 20379},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 567, col: 41, offset: 19249},
+										pos:  position{line: 593, col: 41, offset: 20379},
 										name: "MultiLineRange",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 567, col: 58, offset: 19266},
+										pos:  position{line: 593, col: 58, offset: 20396},
 										name: "SingleLineRange",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 568, col: 5, offset: 19288},
+							pos:   position{line: 594, col: 5, offset: 20418},
 							label: "others",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 568, col: 12, offset: 19295},
+								pos: position{line: 594, col: 12, offset: 20425},
 								expr: &actionExpr{
-									pos: position{line: 568, col: 13, offset: 19296},
+									pos: position{line: 594, col: 13, offset: 20426},
 									run: (*parser).callonMultipleQuotedLineRanges10,
 									expr: &seqExpr{
-										pos: position{line: 568, col: 13, offset: 19296},
+										pos: position{line: 594, col: 13, offset: 20426},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 568, col: 13, offset: 19296},
+												pos:        position{line: 594, col: 13, offset: 20426},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 568, col: 17, offset: 19300},
+												pos:   position{line: 594, col: 17, offset: 20430},
 												label: "other",
 												expr: &choiceExpr{
-													pos: position{line: 568, col: 24, offset: 19307},
+													pos: position{line: 594, col: 24, offset: 20437},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 568, col: 24, offset: 19307},
+															pos:  position{line: 594, col: 24, offset: 20437},
 															name: "MultiLineRange",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 568, col: 41, offset: 19324},
+															pos:  position{line: 594, col: 41, offset: 20454},
 															name: "SingleLineRange",
 														},
 													},
@@ -4663,7 +4897,7 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 570, col: 9, offset: 19377},
+							pos:        position{line: 596, col: 9, offset: 20507},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -4674,32 +4908,32 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineRange",
-			pos:  position{line: 574, col: 1, offset: 19467},
+			pos:  position{line: 600, col: 1, offset: 20597},
 			expr: &actionExpr{
-				pos: position{line: 574, col: 19, offset: 19485},
+				pos: position{line: 600, col: 19, offset: 20615},
 				run: (*parser).callonMultiLineRange1,
 				expr: &seqExpr{
-					pos: position{line: 574, col: 19, offset: 19485},
+					pos: position{line: 600, col: 19, offset: 20615},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 574, col: 19, offset: 19485},
+							pos:   position{line: 600, col: 19, offset: 20615},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 574, col: 26, offset: 19492},
+								pos:  position{line: 600, col: 26, offset: 20622},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 574, col: 34, offset: 19500},
+							pos:        position{line: 600, col: 34, offset: 20630},
 							val:        "..",
 							ignoreCase: false,
 							want:       "\"..\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 574, col: 39, offset: 19505},
+							pos:   position{line: 600, col: 39, offset: 20635},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 574, col: 44, offset: 19510},
+								pos:  position{line: 600, col: 44, offset: 20640},
 								name: "NUMBER",
 							},
 						},
@@ -4709,43 +4943,43 @@ var g = &grammar{
 		},
 		{
 			name: "MultiLineQuotedRange",
-			pos:  position{line: 578, col: 1, offset: 19598},
+			pos:  position{line: 604, col: 1, offset: 20728},
 			expr: &actionExpr{
-				pos: position{line: 578, col: 25, offset: 19622},
+				pos: position{line: 604, col: 25, offset: 20752},
 				run: (*parser).callonMultiLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 578, col: 25, offset: 19622},
+					pos: position{line: 604, col: 25, offset: 20752},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 578, col: 25, offset: 19622},
+							pos:        position{line: 604, col: 25, offset: 20752},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 30, offset: 19627},
+							pos:   position{line: 604, col: 30, offset: 20757},
 							label: "start",
 							expr: &ruleRefExpr{
-								pos:  position{line: 578, col: 37, offset: 19634},
+								pos:  position{line: 604, col: 37, offset: 20764},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 578, col: 45, offset: 19642},
+							pos:        position{line: 604, col: 45, offset: 20772},
 							val:        "..",
 							ignoreCase: false,
 							want:       "\"..\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 578, col: 50, offset: 19647},
+							pos:   position{line: 604, col: 50, offset: 20777},
 							label: "end",
 							expr: &ruleRefExpr{
-								pos:  position{line: 578, col: 55, offset: 19652},
+								pos:  position{line: 604, col: 55, offset: 20782},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 578, col: 63, offset: 19660},
+							pos:        position{line: 604, col: 63, offset: 20790},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -4756,15 +4990,15 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineRange",
-			pos:  position{line: 582, col: 1, offset: 19745},
+			pos:  position{line: 608, col: 1, offset: 20875},
 			expr: &actionExpr{
-				pos: position{line: 582, col: 20, offset: 19764},
+				pos: position{line: 608, col: 20, offset: 20894},
 				run: (*parser).callonSingleLineRange1,
 				expr: &labeledExpr{
-					pos:   position{line: 582, col: 20, offset: 19764},
+					pos:   position{line: 608, col: 20, offset: 20894},
 					label: "singleline",
 					expr: &ruleRefExpr{
-						pos:  position{line: 582, col: 32, offset: 19776},
+						pos:  position{line: 608, col: 32, offset: 20906},
 						name: "NUMBER",
 					},
 				},
@@ -4772,29 +5006,29 @@ var g = &grammar{
 		},
 		{
 			name: "SingleLineQuotedRange",
-			pos:  position{line: 586, col: 1, offset: 19871},
+			pos:  position{line: 612, col: 1, offset: 21001},
 			expr: &actionExpr{
-				pos: position{line: 586, col: 26, offset: 19896},
+				pos: position{line: 612, col: 26, offset: 21026},
 				run: (*parser).callonSingleLineQuotedRange1,
 				expr: &seqExpr{
-					pos: position{line: 586, col: 26, offset: 19896},
+					pos: position{line: 612, col: 26, offset: 21026},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 586, col: 26, offset: 19896},
+							pos:        position{line: 612, col: 26, offset: 21026},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 586, col: 31, offset: 19901},
+							pos:   position{line: 612, col: 31, offset: 21031},
 							label: "singleline",
 							expr: &ruleRefExpr{
-								pos:  position{line: 586, col: 43, offset: 19913},
+								pos:  position{line: 612, col: 43, offset: 21043},
 								name: "NUMBER",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 586, col: 51, offset: 19921},
+							pos:        position{line: 612, col: 51, offset: 21051},
 							val:        "\"",
 							ignoreCase: false,
 							want:       "\"\\\"\"",
@@ -4805,14 +5039,14 @@ var g = &grammar{
 		},
 		{
 			name: "UndefinedLineRange",
-			pos:  position{line: 590, col: 1, offset: 20013},
+			pos:  position{line: 616, col: 1, offset: 21143},
 			expr: &actionExpr{
-				pos: position{line: 590, col: 23, offset: 20035},
+				pos: position{line: 616, col: 23, offset: 21165},
 				run: (*parser).callonUndefinedLineRange1,
 				expr: &zeroOrMoreExpr{
-					pos: position{line: 590, col: 23, offset: 20035},
+					pos: position{line: 616, col: 23, offset: 21165},
 					expr: &charClassMatcher{
-						pos:        position{line: 590, col: 23, offset: 20035},
+						pos:        position{line: 616, col: 23, offset: 21165},
 						val:        "[^\\], ]",
 						chars:      []rune{']', ',', ' '},
 						ignoreCase: false,
@@ -4823,24 +5057,24 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttribute",
-			pos:  position{line: 594, col: 1, offset: 20080},
+			pos:  position{line: 620, col: 1, offset: 21210},
 			expr: &actionExpr{
-				pos: position{line: 594, col: 23, offset: 20102},
+				pos: position{line: 620, col: 23, offset: 21232},
 				run: (*parser).callonTagRangesAttribute1,
 				expr: &seqExpr{
-					pos: position{line: 594, col: 23, offset: 20102},
+					pos: position{line: 620, col: 23, offset: 21232},
 					exprs: []interface{}{
 						&choiceExpr{
-							pos: position{line: 594, col: 24, offset: 20103},
+							pos: position{line: 620, col: 24, offset: 21233},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 594, col: 24, offset: 20103},
+									pos:        position{line: 620, col: 24, offset: 21233},
 									val:        "tags=",
 									ignoreCase: false,
 									want:       "\"tags=\"",
 								},
 								&litMatcher{
-									pos:        position{line: 594, col: 34, offset: 20113},
+									pos:        position{line: 620, col: 34, offset: 21243},
 									val:        "tag=",
 									ignoreCase: false,
 									want:       "\"tag=\"",
@@ -4848,17 +5082,17 @@ var g = &grammar{
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 594, col: 42, offset: 20121},
+							pos:   position{line: 620, col: 42, offset: 21251},
 							label: "tags",
 							expr: &ruleRefExpr{
-								pos:  position{line: 594, col: 48, offset: 20127},
+								pos:  position{line: 620, col: 48, offset: 21257},
 								name: "TagRangesAttributeValue",
 							},
 						},
 						&zeroOrOneExpr{
-							pos: position{line: 594, col: 73, offset: 20152},
+							pos: position{line: 620, col: 73, offset: 21282},
 							expr: &litMatcher{
-								pos:        position{line: 594, col: 73, offset: 20152},
+								pos:        position{line: 620, col: 73, offset: 21282},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
@@ -4870,44 +5104,44 @@ var g = &grammar{
 		},
 		{
 			name: "TagRangesAttributeValue",
-			pos:  position{line: 598, col: 1, offset: 20301},
+			pos:  position{line: 624, col: 1, offset: 21431},
 			expr: &actionExpr{
-				pos: position{line: 598, col: 28, offset: 20328},
+				pos: position{line: 624, col: 28, offset: 21458},
 				run: (*parser).callonTagRangesAttributeValue1,
 				expr: &seqExpr{
-					pos: position{line: 598, col: 28, offset: 20328},
+					pos: position{line: 624, col: 28, offset: 21458},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 598, col: 28, offset: 20328},
+							pos:   position{line: 624, col: 28, offset: 21458},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 598, col: 35, offset: 20335},
+								pos:  position{line: 624, col: 35, offset: 21465},
 								name: "MultipleTagRanges",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 598, col: 54, offset: 20354},
+							pos: position{line: 624, col: 54, offset: 21484},
 							expr: &ruleRefExpr{
-								pos:  position{line: 598, col: 54, offset: 20354},
+								pos:  position{line: 624, col: 54, offset: 21484},
 								name: "Space",
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 598, col: 62, offset: 20362},
+							pos: position{line: 624, col: 62, offset: 21492},
 							alternatives: []interface{}{
 								&andExpr{
-									pos: position{line: 598, col: 62, offset: 20362},
+									pos: position{line: 624, col: 62, offset: 21492},
 									expr: &litMatcher{
-										pos:        position{line: 598, col: 63, offset: 20363},
+										pos:        position{line: 624, col: 63, offset: 21493},
 										val:        ",",
 										ignoreCase: false,
 										want:       "\",\"",
 									},
 								},
 								&andExpr{
-									pos: position{line: 598, col: 69, offset: 20369},
+									pos: position{line: 624, col: 69, offset: 21499},
 									expr: &litMatcher{
-										pos:        position{line: 598, col: 70, offset: 20370},
+										pos:        position{line: 624, col: 70, offset: 21500},
 										val:        "]",
 										ignoreCase: false,
 										want:       "\"]\"",
@@ -4921,43 +5155,43 @@ var g = &grammar{
 		},
 		{
 			name: "MultipleTagRanges",
-			pos:  position{line: 602, col: 1, offset: 20402},
+			pos:  position{line: 628, col: 1, offset: 21532},
 			expr: &actionExpr{
-				pos: position{line: 602, col: 22, offset: 20423},
+				pos: position{line: 628, col: 22, offset: 21553},
 				run: (*parser).callonMultipleTagRanges1,
 				expr: &seqExpr{
-					pos: position{line: 602, col: 22, offset: 20423},
+					pos: position{line: 628, col: 22, offset: 21553},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 602, col: 22, offset: 20423},
+							pos:   position{line: 628, col: 22, offset: 21553},
 							label: "first",
 							expr: &ruleRefExpr{
-								pos:  position{line: 602, col: 29, offset: 20430},
+								pos:  position{line: 628, col: 29, offset: 21560},
 								name: "TagRange",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 603, col: 5, offset: 20444},
+							pos:   position{line: 629, col: 5, offset: 21574},
 							label: "others",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 603, col: 12, offset: 20451},
+								pos: position{line: 629, col: 12, offset: 21581},
 								expr: &actionExpr{
-									pos: position{line: 603, col: 13, offset: 20452},
+									pos: position{line: 629, col: 13, offset: 21582},
 									run: (*parser).callonMultipleTagRanges7,
 									expr: &seqExpr{
-										pos: position{line: 603, col: 13, offset: 20452},
+										pos: position{line: 629, col: 13, offset: 21582},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 603, col: 13, offset: 20452},
+												pos:        position{line: 629, col: 13, offset: 21582},
 												val:        ";",
 												ignoreCase: false,
 												want:       "\";\"",
 											},
 											&labeledExpr{
-												pos:   position{line: 603, col: 17, offset: 20456},
+												pos:   position{line: 629, col: 17, offset: 21586},
 												label: "other",
 												expr: &ruleRefExpr{
-													pos:  position{line: 603, col: 24, offset: 20463},
+													pos:  position{line: 629, col: 24, offset: 21593},
 													name: "TagRange",
 												},
 											},
@@ -4972,25 +5206,25 @@ var g = &grammar{
 		},
 		{
 			name: "TagRange",
-			pos:  position{line: 609, col: 1, offset: 20594},
+			pos:  position{line: 635, col: 1, offset: 21724},
 			expr: &choiceExpr{
-				pos: position{line: 609, col: 13, offset: 20606},
+				pos: position{line: 635, col: 13, offset: 21736},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 609, col: 13, offset: 20606},
+						pos: position{line: 635, col: 13, offset: 21736},
 						run: (*parser).callonTagRange2,
 						expr: &labeledExpr{
-							pos:   position{line: 609, col: 13, offset: 20606},
+							pos:   position{line: 635, col: 13, offset: 21736},
 							label: "tag",
 							expr: &choiceExpr{
-								pos: position{line: 609, col: 18, offset: 20611},
+								pos: position{line: 635, col: 18, offset: 21741},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 609, col: 18, offset: 20611},
+										pos:  position{line: 635, col: 18, offset: 21741},
 										name: "Alphanums",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 609, col: 30, offset: 20623},
+										pos:  position{line: 635, col: 30, offset: 21753},
 										name: "TagWildcard",
 									},
 								},
@@ -4998,29 +5232,29 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 611, col: 5, offset: 20691},
+						pos: position{line: 637, col: 5, offset: 21821},
 						run: (*parser).callonTagRange7,
 						expr: &seqExpr{
-							pos: position{line: 611, col: 5, offset: 20691},
+							pos: position{line: 637, col: 5, offset: 21821},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 611, col: 5, offset: 20691},
+									pos:        position{line: 637, col: 5, offset: 21821},
 									val:        "!",
 									ignoreCase: false,
 									want:       "\"!\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 611, col: 9, offset: 20695},
+									pos:   position{line: 637, col: 9, offset: 21825},
 									label: "tag",
 									expr: &choiceExpr{
-										pos: position{line: 611, col: 14, offset: 20700},
+										pos: position{line: 637, col: 14, offset: 21830},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 611, col: 14, offset: 20700},
+												pos:  position{line: 637, col: 14, offset: 21830},
 												name: "Alphanums",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 611, col: 26, offset: 20712},
+												pos:  position{line: 637, col: 26, offset: 21842},
 												name: "TagWildcard",
 											},
 										},
@@ -5034,23 +5268,23 @@ var g = &grammar{
 		},
 		{
 			name: "TagWildcard",
-			pos:  position{line: 615, col: 1, offset: 20780},
+			pos:  position{line: 641, col: 1, offset: 21910},
 			expr: &actionExpr{
-				pos: position{line: 615, col: 16, offset: 20795},
+				pos: position{line: 641, col: 16, offset: 21925},
 				run: (*parser).callonTagWildcard1,
 				expr: &seqExpr{
-					pos: position{line: 615, col: 16, offset: 20795},
+					pos: position{line: 641, col: 16, offset: 21925},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 615, col: 16, offset: 20795},
+							pos:   position{line: 641, col: 16, offset: 21925},
 							label: "stars",
 							expr: &actionExpr{
-								pos: position{line: 615, col: 23, offset: 20802},
+								pos: position{line: 641, col: 23, offset: 21932},
 								run: (*parser).callonTagWildcard4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 615, col: 23, offset: 20802},
+									pos: position{line: 641, col: 23, offset: 21932},
 									expr: &litMatcher{
-										pos:        position{line: 615, col: 24, offset: 20803},
+										pos:        position{line: 641, col: 24, offset: 21933},
 										val:        "*",
 										ignoreCase: false,
 										want:       "\"*\"",
@@ -5059,7 +5293,7 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 618, col: 5, offset: 20857},
+							pos: position{line: 644, col: 5, offset: 21987},
 							run: (*parser).callonTagWildcard7,
 						},
 					},
@@ -5068,34 +5302,34 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileLine",
-			pos:  position{line: 628, col: 1, offset: 21151},
+			pos:  position{line: 654, col: 1, offset: 22281},
 			expr: &actionExpr{
-				pos: position{line: 628, col: 21, offset: 21171},
+				pos: position{line: 654, col: 21, offset: 22301},
 				run: (*parser).callonIncludedFileLine1,
 				expr: &seqExpr{
-					pos: position{line: 628, col: 21, offset: 21171},
+					pos: position{line: 654, col: 21, offset: 22301},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 628, col: 21, offset: 21171},
+							pos:   position{line: 654, col: 21, offset: 22301},
 							label: "content",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 628, col: 29, offset: 21179},
+								pos: position{line: 654, col: 29, offset: 22309},
 								expr: &choiceExpr{
-									pos: position{line: 628, col: 30, offset: 21180},
+									pos: position{line: 654, col: 30, offset: 22310},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 628, col: 30, offset: 21180},
+											pos:  position{line: 654, col: 30, offset: 22310},
 											name: "IncludedFileStartTag",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 628, col: 53, offset: 21203},
+											pos:  position{line: 654, col: 53, offset: 22333},
 											name: "IncludedFileEndTag",
 										},
 										&actionExpr{
-											pos: position{line: 628, col: 74, offset: 21224},
+											pos: position{line: 654, col: 74, offset: 22354},
 											run: (*parser).callonIncludedFileLine8,
 											expr: &anyMatcher{
-												line: 628, col: 74, offset: 21224,
+												line: 654, col: 74, offset: 22354,
 											},
 										},
 									},
@@ -5103,7 +5337,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 628, col: 107, offset: 21257},
+							pos:  position{line: 654, col: 107, offset: 22387},
 							name: "EOL",
 						},
 					},
@@ -5112,33 +5346,33 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileStartTag",
-			pos:  position{line: 632, col: 1, offset: 21328},
+			pos:  position{line: 658, col: 1, offset: 22458},
 			expr: &actionExpr{
-				pos: position{line: 632, col: 25, offset: 21352},
+				pos: position{line: 658, col: 25, offset: 22482},
 				run: (*parser).callonIncludedFileStartTag1,
 				expr: &seqExpr{
-					pos: position{line: 632, col: 25, offset: 21352},
+					pos: position{line: 658, col: 25, offset: 22482},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 632, col: 25, offset: 21352},
+							pos:        position{line: 658, col: 25, offset: 22482},
 							val:        "tag::",
 							ignoreCase: false,
 							want:       "\"tag::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 632, col: 33, offset: 21360},
+							pos:   position{line: 658, col: 33, offset: 22490},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 632, col: 38, offset: 21365},
+								pos: position{line: 658, col: 38, offset: 22495},
 								run: (*parser).callonIncludedFileStartTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 632, col: 38, offset: 21365},
+									pos:  position{line: 658, col: 38, offset: 22495},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 632, col: 78, offset: 21405},
+							pos:        position{line: 658, col: 78, offset: 22535},
 							val:        "[]",
 							ignoreCase: false,
 							want:       "\"[]\"",
@@ -5149,33 +5383,33 @@ var g = &grammar{
 		},
 		{
 			name: "IncludedFileEndTag",
-			pos:  position{line: 636, col: 1, offset: 21470},
+			pos:  position{line: 662, col: 1, offset: 22600},
 			expr: &actionExpr{
-				pos: position{line: 636, col: 23, offset: 21492},
+				pos: position{line: 662, col: 23, offset: 22622},
 				run: (*parser).callonIncludedFileEndTag1,
 				expr: &seqExpr{
-					pos: position{line: 636, col: 23, offset: 21492},
+					pos: position{line: 662, col: 23, offset: 22622},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 636, col: 23, offset: 21492},
+							pos:        position{line: 662, col: 23, offset: 22622},
 							val:        "end::",
 							ignoreCase: false,
 							want:       "\"end::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 636, col: 31, offset: 21500},
+							pos:   position{line: 662, col: 31, offset: 22630},
 							label: "tag",
 							expr: &actionExpr{
-								pos: position{line: 636, col: 36, offset: 21505},
+								pos: position{line: 662, col: 36, offset: 22635},
 								run: (*parser).callonIncludedFileEndTag5,
 								expr: &ruleRefExpr{
-									pos:  position{line: 636, col: 36, offset: 21505},
+									pos:  position{line: 662, col: 36, offset: 22635},
 									name: "Alphanums",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 636, col: 76, offset: 21545},
+							pos:        position{line: 662, col: 76, offset: 22675},
 							val:        "[]",
 							ignoreCase: false,
 							want:       "\"[]\"",
@@ -5186,32 +5420,32 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraph",
-			pos:  position{line: 643, col: 1, offset: 21709},
+			pos:  position{line: 669, col: 1, offset: 22839},
 			expr: &choiceExpr{
-				pos: position{line: 643, col: 18, offset: 21726},
+				pos: position{line: 669, col: 18, offset: 22856},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 643, col: 18, offset: 21726},
+						pos: position{line: 669, col: 18, offset: 22856},
 						run: (*parser).callonListParagraph2,
 						expr: &labeledExpr{
-							pos:   position{line: 643, col: 18, offset: 21726},
+							pos:   position{line: 669, col: 18, offset: 22856},
 							label: "comment",
 							expr: &ruleRefExpr{
-								pos:  position{line: 643, col: 27, offset: 21735},
+								pos:  position{line: 669, col: 27, offset: 22865},
 								name: "SingleLineComment",
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 645, col: 9, offset: 21792},
+						pos: position{line: 671, col: 9, offset: 22922},
 						run: (*parser).callonListParagraph5,
 						expr: &labeledExpr{
-							pos:   position{line: 645, col: 9, offset: 21792},
+							pos:   position{line: 671, col: 9, offset: 22922},
 							label: "lines",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 645, col: 15, offset: 21798},
+								pos: position{line: 671, col: 15, offset: 22928},
 								expr: &ruleRefExpr{
-									pos:  position{line: 645, col: 16, offset: 21799},
+									pos:  position{line: 671, col: 16, offset: 22929},
 									name: "ListParagraphLine",
 								},
 							},
@@ -5222,96 +5456,96 @@ var g = &grammar{
 		},
 		{
 			name: "ListParagraphLine",
-			pos:  position{line: 649, col: 1, offset: 21891},
+			pos:  position{line: 675, col: 1, offset: 23021},
 			expr: &actionExpr{
-				pos: position{line: 649, col: 22, offset: 21912},
+				pos: position{line: 675, col: 22, offset: 23042},
 				run: (*parser).callonListParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 649, col: 22, offset: 21912},
+					pos: position{line: 675, col: 22, offset: 23042},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 649, col: 22, offset: 21912},
+							pos: position{line: 675, col: 22, offset: 23042},
 							expr: &ruleRefExpr{
-								pos:  position{line: 649, col: 23, offset: 21913},
+								pos:  position{line: 675, col: 23, offset: 23043},
 								name: "EOF",
 							},
 						},
 						&notExpr{
-							pos: position{line: 650, col: 5, offset: 21921},
+							pos: position{line: 676, col: 5, offset: 23051},
 							expr: &ruleRefExpr{
-								pos:  position{line: 650, col: 6, offset: 21922},
+								pos:  position{line: 676, col: 6, offset: 23052},
 								name: "BlankLine",
 							},
 						},
 						&notExpr{
-							pos: position{line: 651, col: 5, offset: 21937},
+							pos: position{line: 677, col: 5, offset: 23067},
 							expr: &ruleRefExpr{
-								pos:  position{line: 651, col: 6, offset: 21938},
+								pos:  position{line: 677, col: 6, offset: 23068},
 								name: "SingleLineComment",
 							},
 						},
 						&notExpr{
-							pos: position{line: 652, col: 5, offset: 21960},
+							pos: position{line: 678, col: 5, offset: 23090},
 							expr: &ruleRefExpr{
-								pos:  position{line: 652, col: 6, offset: 21961},
+								pos:  position{line: 678, col: 6, offset: 23091},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 653, col: 5, offset: 21987},
+							pos: position{line: 679, col: 5, offset: 23117},
 							expr: &ruleRefExpr{
-								pos:  position{line: 653, col: 6, offset: 21988},
+								pos:  position{line: 679, col: 6, offset: 23118},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 654, col: 5, offset: 22016},
+							pos: position{line: 680, col: 5, offset: 23146},
 							expr: &ruleRefExpr{
-								pos:  position{line: 654, col: 6, offset: 22017},
+								pos:  position{line: 680, col: 6, offset: 23147},
 								name: "CalloutListItemPrefix",
 							},
 						},
 						&notExpr{
-							pos: position{line: 655, col: 5, offset: 22043},
+							pos: position{line: 681, col: 5, offset: 23173},
 							expr: &ruleRefExpr{
-								pos:  position{line: 655, col: 6, offset: 22044},
+								pos:  position{line: 681, col: 6, offset: 23174},
 								name: "ListItemContinuation",
 							},
 						},
 						&notExpr{
-							pos: position{line: 656, col: 5, offset: 22069},
+							pos: position{line: 682, col: 5, offset: 23199},
 							expr: &ruleRefExpr{
-								pos:  position{line: 656, col: 6, offset: 22070},
+								pos:  position{line: 682, col: 6, offset: 23200},
 								name: "ElementAttribute",
 							},
 						},
 						&notExpr{
-							pos: position{line: 657, col: 5, offset: 22091},
+							pos: position{line: 683, col: 5, offset: 23221},
 							expr: &ruleRefExpr{
-								pos:  position{line: 657, col: 6, offset: 22092},
+								pos:  position{line: 683, col: 6, offset: 23222},
 								name: "BlockDelimiter",
 							},
 						},
 						&notExpr{
-							pos: position{line: 658, col: 5, offset: 22111},
+							pos: position{line: 684, col: 5, offset: 23241},
 							expr: &ruleRefExpr{
-								pos:  position{line: 658, col: 6, offset: 22112},
+								pos:  position{line: 684, col: 6, offset: 23242},
 								name: "LabeledListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 659, col: 5, offset: 22139},
+							pos:   position{line: 685, col: 5, offset: 23269},
 							label: "line",
 							expr: &actionExpr{
-								pos: position{line: 659, col: 11, offset: 22145},
+								pos: position{line: 685, col: 11, offset: 23275},
 								run: (*parser).callonListParagraphLine24,
 								expr: &labeledExpr{
-									pos:   position{line: 659, col: 11, offset: 22145},
+									pos:   position{line: 685, col: 11, offset: 23275},
 									label: "elements",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 659, col: 20, offset: 22154},
+										pos: position{line: 685, col: 20, offset: 23284},
 										expr: &ruleRefExpr{
-											pos:  position{line: 659, col: 21, offset: 22155},
+											pos:  position{line: 685, col: 21, offset: 23285},
 											name: "InlineElement",
 										},
 									},
@@ -5319,7 +5553,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 661, col: 12, offset: 22254},
+							pos:  position{line: 687, col: 12, offset: 23384},
 							name: "EOL",
 						},
 					},
@@ -5328,25 +5562,25 @@ var g = &grammar{
 		},
 		{
 			name: "ListItemContinuation",
-			pos:  position{line: 665, col: 1, offset: 22293},
+			pos:  position{line: 691, col: 1, offset: 23423},
 			expr: &seqExpr{
-				pos: position{line: 665, col: 25, offset: 22317},
+				pos: position{line: 691, col: 25, offset: 23447},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 665, col: 25, offset: 22317},
+						pos:        position{line: 691, col: 25, offset: 23447},
 						val:        "+",
 						ignoreCase: false,
 						want:       "\"+\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 665, col: 29, offset: 22321},
+						pos: position{line: 691, col: 29, offset: 23451},
 						expr: &ruleRefExpr{
-							pos:  position{line: 665, col: 29, offset: 22321},
+							pos:  position{line: 691, col: 29, offset: 23451},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 665, col: 36, offset: 22328},
+						pos:  position{line: 691, col: 36, offset: 23458},
 						name: "Newline",
 					},
 				},
@@ -5354,22 +5588,22 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemElement",
-			pos:  position{line: 667, col: 1, offset: 22400},
+			pos:  position{line: 693, col: 1, offset: 23530},
 			expr: &actionExpr{
-				pos: position{line: 667, col: 29, offset: 22428},
+				pos: position{line: 693, col: 29, offset: 23558},
 				run: (*parser).callonContinuedListItemElement1,
 				expr: &seqExpr{
-					pos: position{line: 667, col: 29, offset: 22428},
+					pos: position{line: 693, col: 29, offset: 23558},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 667, col: 29, offset: 22428},
+							pos:  position{line: 693, col: 29, offset: 23558},
 							name: "ListItemContinuation",
 						},
 						&labeledExpr{
-							pos:   position{line: 667, col: 50, offset: 22449},
+							pos:   position{line: 693, col: 50, offset: 23579},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 667, col: 58, offset: 22457},
+								pos:  position{line: 693, col: 58, offset: 23587},
 								name: "ContinuedListItemContent",
 							},
 						},
@@ -5379,84 +5613,96 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedListItemContent",
-			pos:  position{line: 671, col: 1, offset: 22563},
+			pos:  position{line: 697, col: 1, offset: 23693},
 			expr: &actionExpr{
-				pos: position{line: 671, col: 29, offset: 22591},
+				pos: position{line: 697, col: 29, offset: 23721},
 				run: (*parser).callonContinuedListItemContent1,
 				expr: &seqExpr{
-					pos: position{line: 671, col: 29, offset: 22591},
+					pos: position{line: 697, col: 29, offset: 23721},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 671, col: 29, offset: 22591},
+							pos: position{line: 697, col: 29, offset: 23721},
 							expr: &ruleRefExpr{
-								pos:  position{line: 671, col: 30, offset: 22592},
+								pos:  position{line: 697, col: 30, offset: 23722},
 								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 672, col: 5, offset: 22601},
+							pos:   position{line: 698, col: 5, offset: 23731},
 							label: "content",
 							expr: &choiceExpr{
-								pos: position{line: 672, col: 14, offset: 22610},
+								pos: position{line: 698, col: 14, offset: 23740},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 672, col: 14, offset: 22610},
+										pos:  position{line: 698, col: 14, offset: 23740},
+										name: "DiscreteHeading",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 699, col: 11, offset: 23766},
 										name: "DelimitedBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 673, col: 11, offset: 22635},
+										pos:  position{line: 700, col: 11, offset: 23791},
 										name: "SingleLineComment",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 674, col: 11, offset: 22663},
+										pos:  position{line: 701, col: 11, offset: 23819},
 										name: "Table",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 675, col: 11, offset: 22679},
+										pos:  position{line: 702, col: 11, offset: 23835},
 										name: "ImageBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 676, col: 11, offset: 22700},
+										pos:  position{line: 703, col: 11, offset: 23856},
+										name: "VideoBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 704, col: 11, offset: 23877},
+										name: "AudioBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 705, col: 11, offset: 23898},
 										name: "ThematicBreak",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 677, col: 11, offset: 22724},
+										pos:  position{line: 706, col: 11, offset: 23922},
 										name: "OrderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 678, col: 11, offset: 22751},
+										pos:  position{line: 707, col: 11, offset: 23949},
 										name: "UnorderedListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 679, col: 11, offset: 22780},
+										pos:  position{line: 708, col: 11, offset: 23978},
 										name: "LabeledListItem",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 681, col: 11, offset: 22845},
+										pos:  position{line: 710, col: 11, offset: 24043},
 										name: "BlankLine",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 682, col: 11, offset: 22896},
+										pos:  position{line: 711, col: 11, offset: 24094},
 										name: "LiteralBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 683, col: 11, offset: 22920},
+										pos:  position{line: 712, col: 11, offset: 24118},
 										name: "AttributeDeclaration",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 684, col: 11, offset: 22952},
+										pos:  position{line: 713, col: 11, offset: 24150},
 										name: "AttributeReset",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 685, col: 11, offset: 22978},
+										pos:  position{line: 714, col: 11, offset: 24176},
 										name: "TableOfContentsPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 686, col: 11, offset: 23015},
+										pos:  position{line: 715, col: 11, offset: 24213},
 										name: "UserMacroBlock",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 687, col: 11, offset: 23040},
+										pos:  position{line: 716, col: 11, offset: 24238},
 										name: "ContinuedRawParagraph",
 									},
 								},
@@ -5468,37 +5714,37 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItem",
-			pos:  position{line: 694, col: 1, offset: 23206},
+			pos:  position{line: 723, col: 1, offset: 24404},
 			expr: &actionExpr{
-				pos: position{line: 694, col: 20, offset: 23225},
+				pos: position{line: 723, col: 20, offset: 24423},
 				run: (*parser).callonOrderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 694, col: 20, offset: 23225},
+					pos: position{line: 723, col: 20, offset: 24423},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 694, col: 20, offset: 23225},
+							pos:   position{line: 723, col: 20, offset: 24423},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 694, col: 26, offset: 23231},
+								pos: position{line: 723, col: 26, offset: 24429},
 								expr: &ruleRefExpr{
-									pos:  position{line: 694, col: 27, offset: 23232},
+									pos:  position{line: 723, col: 27, offset: 24430},
 									name: "BlockAttrs",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 694, col: 40, offset: 23245},
+							pos:   position{line: 723, col: 40, offset: 24443},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 694, col: 48, offset: 23253},
+								pos:  position{line: 723, col: 48, offset: 24451},
 								name: "OrderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 694, col: 71, offset: 23276},
+							pos:   position{line: 723, col: 71, offset: 24474},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 694, col: 80, offset: 23285},
+								pos:  position{line: 723, col: 80, offset: 24483},
 								name: "OrderedListItemContent",
 							},
 						},
@@ -5508,42 +5754,42 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemPrefix",
-			pos:  position{line: 698, col: 1, offset: 23420},
+			pos:  position{line: 727, col: 1, offset: 24618},
 			expr: &actionExpr{
-				pos: position{line: 699, col: 5, offset: 23450},
+				pos: position{line: 728, col: 5, offset: 24648},
 				run: (*parser).callonOrderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 699, col: 5, offset: 23450},
+					pos: position{line: 728, col: 5, offset: 24648},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 699, col: 5, offset: 23450},
+							pos: position{line: 728, col: 5, offset: 24648},
 							expr: &ruleRefExpr{
-								pos:  position{line: 699, col: 5, offset: 23450},
+								pos:  position{line: 728, col: 5, offset: 24648},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 699, col: 12, offset: 23457},
+							pos:   position{line: 728, col: 12, offset: 24655},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 701, col: 9, offset: 23520},
+								pos: position{line: 730, col: 9, offset: 24718},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 701, col: 9, offset: 23520},
+										pos: position{line: 730, col: 9, offset: 24718},
 										run: (*parser).callonOrderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 701, col: 9, offset: 23520},
+											pos: position{line: 730, col: 9, offset: 24718},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 701, col: 9, offset: 23520},
+													pos:   position{line: 730, col: 9, offset: 24718},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 701, col: 16, offset: 23527},
+														pos: position{line: 730, col: 16, offset: 24725},
 														run: (*parser).callonOrderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 701, col: 16, offset: 23527},
+															pos: position{line: 730, col: 16, offset: 24725},
 															expr: &litMatcher{
-																pos:        position{line: 701, col: 17, offset: 23528},
+																pos:        position{line: 730, col: 17, offset: 24726},
 																val:        ".",
 																ignoreCase: false,
 																want:       "\".\"",
@@ -5552,22 +5798,22 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 705, col: 9, offset: 23628},
+													pos: position{line: 734, col: 9, offset: 24826},
 													run: (*parser).callonOrderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 724, col: 11, offset: 24345},
+										pos: position{line: 753, col: 11, offset: 25543},
 										run: (*parser).callonOrderedListItemPrefix14,
 										expr: &seqExpr{
-											pos: position{line: 724, col: 11, offset: 24345},
+											pos: position{line: 753, col: 11, offset: 25543},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 724, col: 11, offset: 24345},
+													pos: position{line: 753, col: 11, offset: 25543},
 													expr: &charClassMatcher{
-														pos:        position{line: 724, col: 12, offset: 24346},
+														pos:        position{line: 753, col: 12, offset: 25544},
 														val:        "[0-9]",
 														ranges:     []rune{'0', '9'},
 														ignoreCase: false,
@@ -5575,7 +5821,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 724, col: 20, offset: 24354},
+													pos:        position{line: 753, col: 20, offset: 25552},
 													val:        ".",
 													ignoreCase: false,
 													want:       "\".\"",
@@ -5584,20 +5830,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 726, col: 13, offset: 24465},
+										pos: position{line: 755, col: 13, offset: 25663},
 										run: (*parser).callonOrderedListItemPrefix19,
 										expr: &seqExpr{
-											pos: position{line: 726, col: 13, offset: 24465},
+											pos: position{line: 755, col: 13, offset: 25663},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 726, col: 14, offset: 24466},
+													pos:        position{line: 755, col: 14, offset: 25664},
 													val:        "[a-z]",
 													ranges:     []rune{'a', 'z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 726, col: 21, offset: 24473},
+													pos:        position{line: 755, col: 21, offset: 25671},
 													val:        ".",
 													ignoreCase: false,
 													want:       "\".\"",
@@ -5606,20 +5852,20 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 728, col: 13, offset: 24587},
+										pos: position{line: 757, col: 13, offset: 25785},
 										run: (*parser).callonOrderedListItemPrefix23,
 										expr: &seqExpr{
-											pos: position{line: 728, col: 13, offset: 24587},
+											pos: position{line: 757, col: 13, offset: 25785},
 											exprs: []interface{}{
 												&charClassMatcher{
-													pos:        position{line: 728, col: 14, offset: 24588},
+													pos:        position{line: 757, col: 14, offset: 25786},
 													val:        "[A-Z]",
 													ranges:     []rune{'A', 'Z'},
 													ignoreCase: false,
 													inverted:   false,
 												},
 												&litMatcher{
-													pos:        position{line: 728, col: 21, offset: 24595},
+													pos:        position{line: 757, col: 21, offset: 25793},
 													val:        ".",
 													ignoreCase: false,
 													want:       "\".\"",
@@ -5628,15 +5874,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 730, col: 13, offset: 24709},
+										pos: position{line: 759, col: 13, offset: 25907},
 										run: (*parser).callonOrderedListItemPrefix27,
 										expr: &seqExpr{
-											pos: position{line: 730, col: 13, offset: 24709},
+											pos: position{line: 759, col: 13, offset: 25907},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 730, col: 13, offset: 24709},
+													pos: position{line: 759, col: 13, offset: 25907},
 													expr: &charClassMatcher{
-														pos:        position{line: 730, col: 14, offset: 24710},
+														pos:        position{line: 759, col: 14, offset: 25908},
 														val:        "[a-z]",
 														ranges:     []rune{'a', 'z'},
 														ignoreCase: false,
@@ -5644,7 +5890,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 730, col: 22, offset: 24718},
+													pos:        position{line: 759, col: 22, offset: 25916},
 													val:        ")",
 													ignoreCase: false,
 													want:       "\")\"",
@@ -5653,15 +5899,15 @@ var g = &grammar{
 										},
 									},
 									&actionExpr{
-										pos: position{line: 732, col: 13, offset: 24832},
+										pos: position{line: 761, col: 13, offset: 26030},
 										run: (*parser).callonOrderedListItemPrefix32,
 										expr: &seqExpr{
-											pos: position{line: 732, col: 13, offset: 24832},
+											pos: position{line: 761, col: 13, offset: 26030},
 											exprs: []interface{}{
 												&oneOrMoreExpr{
-													pos: position{line: 732, col: 13, offset: 24832},
+													pos: position{line: 761, col: 13, offset: 26030},
 													expr: &charClassMatcher{
-														pos:        position{line: 732, col: 14, offset: 24833},
+														pos:        position{line: 761, col: 14, offset: 26031},
 														val:        "[A-Z]",
 														ranges:     []rune{'A', 'Z'},
 														ignoreCase: false,
@@ -5669,7 +5915,7 @@ var g = &grammar{
 													},
 												},
 												&litMatcher{
-													pos:        position{line: 732, col: 22, offset: 24841},
+													pos:        position{line: 761, col: 22, offset: 26039},
 													val:        ")",
 													ignoreCase: false,
 													want:       "\")\"",
@@ -5681,9 +5927,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 734, col: 12, offset: 24954},
+							pos: position{line: 763, col: 12, offset: 26152},
 							expr: &ruleRefExpr{
-								pos:  position{line: 734, col: 12, offset: 24954},
+								pos:  position{line: 763, col: 12, offset: 26152},
 								name: "Space",
 							},
 						},
@@ -5693,17 +5939,17 @@ var g = &grammar{
 		},
 		{
 			name: "OrderedListItemContent",
-			pos:  position{line: 738, col: 1, offset: 24989},
+			pos:  position{line: 767, col: 1, offset: 26187},
 			expr: &actionExpr{
-				pos: position{line: 738, col: 27, offset: 25015},
+				pos: position{line: 767, col: 27, offset: 26213},
 				run: (*parser).callonOrderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 738, col: 27, offset: 25015},
+					pos:   position{line: 767, col: 27, offset: 26213},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 738, col: 37, offset: 25025},
+						pos: position{line: 767, col: 37, offset: 26223},
 						expr: &ruleRefExpr{
-							pos:  position{line: 738, col: 37, offset: 25025},
+							pos:  position{line: 767, col: 37, offset: 26223},
 							name: "ListParagraph",
 						},
 					},
@@ -5712,48 +5958,48 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItem",
-			pos:  position{line: 745, col: 1, offset: 25225},
+			pos:  position{line: 774, col: 1, offset: 26423},
 			expr: &actionExpr{
-				pos: position{line: 745, col: 22, offset: 25246},
+				pos: position{line: 774, col: 22, offset: 26444},
 				run: (*parser).callonUnorderedListItem1,
 				expr: &seqExpr{
-					pos: position{line: 745, col: 22, offset: 25246},
+					pos: position{line: 774, col: 22, offset: 26444},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 745, col: 22, offset: 25246},
+							pos:   position{line: 774, col: 22, offset: 26444},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 745, col: 28, offset: 25252},
+								pos: position{line: 774, col: 28, offset: 26450},
 								expr: &ruleRefExpr{
-									pos:  position{line: 745, col: 29, offset: 25253},
+									pos:  position{line: 774, col: 29, offset: 26451},
 									name: "BlockAttrs",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 745, col: 42, offset: 25266},
+							pos:   position{line: 774, col: 42, offset: 26464},
 							label: "prefix",
 							expr: &ruleRefExpr{
-								pos:  position{line: 745, col: 50, offset: 25274},
+								pos:  position{line: 774, col: 50, offset: 26472},
 								name: "UnorderedListItemPrefix",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 745, col: 75, offset: 25299},
+							pos:   position{line: 774, col: 75, offset: 26497},
 							label: "checkstyle",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 745, col: 86, offset: 25310},
+								pos: position{line: 774, col: 86, offset: 26508},
 								expr: &ruleRefExpr{
-									pos:  position{line: 745, col: 87, offset: 25311},
+									pos:  position{line: 774, col: 87, offset: 26509},
 									name: "UnorderedListItemCheckStyle",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 745, col: 117, offset: 25341},
+							pos:   position{line: 774, col: 117, offset: 26539},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 745, col: 126, offset: 25350},
+								pos:  position{line: 774, col: 126, offset: 26548},
 								name: "UnorderedListItemContent",
 							},
 						},
@@ -5763,42 +6009,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemPrefix",
-			pos:  position{line: 749, col: 1, offset: 25503},
+			pos:  position{line: 778, col: 1, offset: 26701},
 			expr: &actionExpr{
-				pos: position{line: 750, col: 5, offset: 25535},
+				pos: position{line: 779, col: 5, offset: 26733},
 				run: (*parser).callonUnorderedListItemPrefix1,
 				expr: &seqExpr{
-					pos: position{line: 750, col: 5, offset: 25535},
+					pos: position{line: 779, col: 5, offset: 26733},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 750, col: 5, offset: 25535},
+							pos: position{line: 779, col: 5, offset: 26733},
 							expr: &ruleRefExpr{
-								pos:  position{line: 750, col: 5, offset: 25535},
+								pos:  position{line: 779, col: 5, offset: 26733},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 750, col: 12, offset: 25542},
+							pos:   position{line: 779, col: 12, offset: 26740},
 							label: "prefix",
 							expr: &choiceExpr{
-								pos: position{line: 750, col: 20, offset: 25550},
+								pos: position{line: 779, col: 20, offset: 26748},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 752, col: 9, offset: 25607},
+										pos: position{line: 781, col: 9, offset: 26805},
 										run: (*parser).callonUnorderedListItemPrefix7,
 										expr: &seqExpr{
-											pos: position{line: 752, col: 9, offset: 25607},
+											pos: position{line: 781, col: 9, offset: 26805},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 752, col: 9, offset: 25607},
+													pos:   position{line: 781, col: 9, offset: 26805},
 													label: "depth",
 													expr: &actionExpr{
-														pos: position{line: 752, col: 16, offset: 25614},
+														pos: position{line: 781, col: 16, offset: 26812},
 														run: (*parser).callonUnorderedListItemPrefix10,
 														expr: &oneOrMoreExpr{
-															pos: position{line: 752, col: 16, offset: 25614},
+															pos: position{line: 781, col: 16, offset: 26812},
 															expr: &litMatcher{
-																pos:        position{line: 752, col: 17, offset: 25615},
+																pos:        position{line: 781, col: 17, offset: 26813},
 																val:        "*",
 																ignoreCase: false,
 																want:       "\"*\"",
@@ -5807,20 +6053,20 @@ var g = &grammar{
 													},
 												},
 												&andCodeExpr{
-													pos: position{line: 756, col: 9, offset: 25715},
+													pos: position{line: 785, col: 9, offset: 26913},
 													run: (*parser).callonUnorderedListItemPrefix13,
 												},
 											},
 										},
 									},
 									&labeledExpr{
-										pos:   position{line: 773, col: 14, offset: 26422},
+										pos:   position{line: 802, col: 14, offset: 27620},
 										label: "depth",
 										expr: &actionExpr{
-											pos: position{line: 773, col: 21, offset: 26429},
+											pos: position{line: 802, col: 21, offset: 27627},
 											run: (*parser).callonUnorderedListItemPrefix15,
 											expr: &litMatcher{
-												pos:        position{line: 773, col: 22, offset: 26430},
+												pos:        position{line: 802, col: 22, offset: 27628},
 												val:        "-",
 												ignoreCase: false,
 												want:       "\"-\"",
@@ -5831,9 +6077,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 775, col: 13, offset: 26516},
+							pos: position{line: 804, col: 13, offset: 27714},
 							expr: &ruleRefExpr{
-								pos:  position{line: 775, col: 13, offset: 26516},
+								pos:  position{line: 804, col: 13, offset: 27714},
 								name: "Space",
 							},
 						},
@@ -5843,53 +6089,53 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemCheckStyle",
-			pos:  position{line: 779, col: 1, offset: 26552},
+			pos:  position{line: 808, col: 1, offset: 27750},
 			expr: &actionExpr{
-				pos: position{line: 779, col: 32, offset: 26583},
+				pos: position{line: 808, col: 32, offset: 27781},
 				run: (*parser).callonUnorderedListItemCheckStyle1,
 				expr: &seqExpr{
-					pos: position{line: 779, col: 32, offset: 26583},
+					pos: position{line: 808, col: 32, offset: 27781},
 					exprs: []interface{}{
 						&andExpr{
-							pos: position{line: 779, col: 32, offset: 26583},
+							pos: position{line: 808, col: 32, offset: 27781},
 							expr: &litMatcher{
-								pos:        position{line: 779, col: 33, offset: 26584},
+								pos:        position{line: 808, col: 33, offset: 27782},
 								val:        "[",
 								ignoreCase: false,
 								want:       "\"[\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 779, col: 37, offset: 26588},
+							pos:   position{line: 808, col: 37, offset: 27786},
 							label: "style",
 							expr: &choiceExpr{
-								pos: position{line: 780, col: 7, offset: 26602},
+								pos: position{line: 809, col: 7, offset: 27800},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 780, col: 7, offset: 26602},
+										pos: position{line: 809, col: 7, offset: 27800},
 										run: (*parser).callonUnorderedListItemCheckStyle7,
 										expr: &litMatcher{
-											pos:        position{line: 780, col: 7, offset: 26602},
+											pos:        position{line: 809, col: 7, offset: 27800},
 											val:        "[ ]",
 											ignoreCase: false,
 											want:       "\"[ ]\"",
 										},
 									},
 									&actionExpr{
-										pos: position{line: 781, col: 7, offset: 26647},
+										pos: position{line: 810, col: 7, offset: 27845},
 										run: (*parser).callonUnorderedListItemCheckStyle9,
 										expr: &litMatcher{
-											pos:        position{line: 781, col: 7, offset: 26647},
+											pos:        position{line: 810, col: 7, offset: 27845},
 											val:        "[*]",
 											ignoreCase: false,
 											want:       "\"[*]\"",
 										},
 									},
 									&actionExpr{
-										pos: position{line: 782, col: 7, offset: 26690},
+										pos: position{line: 811, col: 7, offset: 27888},
 										run: (*parser).callonUnorderedListItemCheckStyle11,
 										expr: &litMatcher{
-											pos:        position{line: 782, col: 7, offset: 26690},
+											pos:        position{line: 811, col: 7, offset: 27888},
 											val:        "[x]",
 											ignoreCase: false,
 											want:       "\"[x]\"",
@@ -5899,9 +6145,9 @@ var g = &grammar{
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 783, col: 7, offset: 26732},
+							pos: position{line: 812, col: 7, offset: 27930},
 							expr: &ruleRefExpr{
-								pos:  position{line: 783, col: 7, offset: 26732},
+								pos:  position{line: 812, col: 7, offset: 27930},
 								name: "Space",
 							},
 						},
@@ -5911,17 +6157,17 @@ var g = &grammar{
 		},
 		{
 			name: "UnorderedListItemContent",
-			pos:  position{line: 787, col: 1, offset: 26774},
+			pos:  position{line: 816, col: 1, offset: 27972},
 			expr: &actionExpr{
-				pos: position{line: 787, col: 29, offset: 26802},
+				pos: position{line: 816, col: 29, offset: 28000},
 				run: (*parser).callonUnorderedListItemContent1,
 				expr: &labeledExpr{
-					pos:   position{line: 787, col: 29, offset: 26802},
+					pos:   position{line: 816, col: 29, offset: 28000},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 787, col: 39, offset: 26812},
+						pos: position{line: 816, col: 39, offset: 28010},
 						expr: &ruleRefExpr{
-							pos:  position{line: 787, col: 39, offset: 26812},
+							pos:  position{line: 816, col: 39, offset: 28010},
 							name: "ListParagraph",
 						},
 					},
@@ -5930,47 +6176,47 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItem",
-			pos:  position{line: 794, col: 1, offset: 27128},
+			pos:  position{line: 823, col: 1, offset: 28326},
 			expr: &actionExpr{
-				pos: position{line: 794, col: 20, offset: 27147},
+				pos: position{line: 823, col: 20, offset: 28345},
 				run: (*parser).callonLabeledListItem1,
 				expr: &seqExpr{
-					pos: position{line: 794, col: 20, offset: 27147},
+					pos: position{line: 823, col: 20, offset: 28345},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 794, col: 20, offset: 27147},
+							pos:   position{line: 823, col: 20, offset: 28345},
 							label: "attrs",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 794, col: 26, offset: 27153},
+								pos: position{line: 823, col: 26, offset: 28351},
 								expr: &ruleRefExpr{
-									pos:  position{line: 794, col: 27, offset: 27154},
+									pos:  position{line: 823, col: 27, offset: 28352},
 									name: "BlockAttrs",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 794, col: 40, offset: 27167},
+							pos:   position{line: 823, col: 40, offset: 28365},
 							label: "term",
 							expr: &ruleRefExpr{
-								pos:  position{line: 794, col: 46, offset: 27173},
+								pos:  position{line: 823, col: 46, offset: 28371},
 								name: "VerbatimLabeledListItemTerm",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 794, col: 75, offset: 27202},
+							pos:   position{line: 823, col: 75, offset: 28400},
 							label: "separator",
 							expr: &ruleRefExpr{
-								pos:  position{line: 794, col: 86, offset: 27213},
+								pos:  position{line: 823, col: 86, offset: 28411},
 								name: "LabeledListItemSeparator",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 794, col: 112, offset: 27239},
+							pos:   position{line: 823, col: 112, offset: 28437},
 							label: "description",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 794, col: 124, offset: 27251},
+								pos: position{line: 823, col: 124, offset: 28449},
 								expr: &ruleRefExpr{
-									pos:  position{line: 794, col: 125, offset: 27252},
+									pos:  position{line: 823, col: 125, offset: 28450},
 									name: "LabeledListItemDescription",
 								},
 							},
@@ -5981,16 +6227,16 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemPrefix",
-			pos:  position{line: 798, col: 1, offset: 27393},
+			pos:  position{line: 827, col: 1, offset: 28591},
 			expr: &seqExpr{
-				pos: position{line: 798, col: 26, offset: 27418},
+				pos: position{line: 827, col: 26, offset: 28616},
 				exprs: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 798, col: 26, offset: 27418},
+						pos:  position{line: 827, col: 26, offset: 28616},
 						name: "VerbatimLabeledListItemTerm",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 798, col: 54, offset: 27446},
+						pos:  position{line: 827, col: 54, offset: 28644},
 						name: "LabeledListItemSeparator",
 					},
 				},
@@ -5998,14 +6244,14 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimLabeledListItemChars",
-			pos:  position{line: 800, col: 1, offset: 27472},
+			pos:  position{line: 829, col: 1, offset: 28670},
 			expr: &choiceExpr{
-				pos: position{line: 800, col: 33, offset: 27504},
+				pos: position{line: 829, col: 33, offset: 28702},
 				alternatives: []interface{}{
 					&oneOrMoreExpr{
-						pos: position{line: 800, col: 33, offset: 27504},
+						pos: position{line: 829, col: 33, offset: 28702},
 						expr: &charClassMatcher{
-							pos:        position{line: 800, col: 33, offset: 27504},
+							pos:        position{line: 829, col: 33, offset: 28702},
 							val:        "[^:\\r\\n]",
 							chars:      []rune{':', '\r', '\n'},
 							ignoreCase: false,
@@ -6013,18 +6259,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 800, col: 45, offset: 27516},
+						pos: position{line: 829, col: 45, offset: 28714},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 800, col: 45, offset: 27516},
+								pos:        position{line: 829, col: 45, offset: 28714},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 							&notExpr{
-								pos: position{line: 800, col: 49, offset: 27520},
+								pos: position{line: 829, col: 49, offset: 28718},
 								expr: &litMatcher{
-									pos:        position{line: 800, col: 50, offset: 27521},
+									pos:        position{line: 829, col: 50, offset: 28719},
 									val:        ":",
 									ignoreCase: false,
 									want:       "\":\"",
@@ -6037,20 +6283,20 @@ var g = &grammar{
 		},
 		{
 			name: "VerbatimLabeledListItemTerm",
-			pos:  position{line: 801, col: 1, offset: 27525},
+			pos:  position{line: 830, col: 1, offset: 28723},
 			expr: &actionExpr{
-				pos: position{line: 801, col: 32, offset: 27556},
+				pos: position{line: 830, col: 32, offset: 28754},
 				run: (*parser).callonVerbatimLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 801, col: 32, offset: 27556},
+					pos:   position{line: 830, col: 32, offset: 28754},
 					label: "content",
 					expr: &actionExpr{
-						pos: position{line: 801, col: 42, offset: 27566},
+						pos: position{line: 830, col: 42, offset: 28764},
 						run: (*parser).callonVerbatimLabeledListItemTerm3,
 						expr: &oneOrMoreExpr{
-							pos: position{line: 801, col: 42, offset: 27566},
+							pos: position{line: 830, col: 42, offset: 28764},
 							expr: &ruleRefExpr{
-								pos:  position{line: 801, col: 42, offset: 27566},
+								pos:  position{line: 830, col: 42, offset: 28764},
 								name: "VerbatimLabeledListItemChars",
 							},
 						},
@@ -6060,36 +6306,36 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTerm",
-			pos:  position{line: 807, col: 1, offset: 27721},
+			pos:  position{line: 836, col: 1, offset: 28919},
 			expr: &actionExpr{
-				pos: position{line: 807, col: 24, offset: 27744},
+				pos: position{line: 836, col: 24, offset: 28942},
 				run: (*parser).callonLabeledListItemTerm1,
 				expr: &labeledExpr{
-					pos:   position{line: 807, col: 24, offset: 27744},
+					pos:   position{line: 836, col: 24, offset: 28942},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 807, col: 33, offset: 27753},
+						pos: position{line: 836, col: 33, offset: 28951},
 						expr: &seqExpr{
-							pos: position{line: 807, col: 34, offset: 27754},
+							pos: position{line: 836, col: 34, offset: 28952},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 807, col: 34, offset: 27754},
+									pos: position{line: 836, col: 34, offset: 28952},
 									expr: &ruleRefExpr{
-										pos:  position{line: 807, col: 35, offset: 27755},
+										pos:  position{line: 836, col: 35, offset: 28953},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 807, col: 43, offset: 27763},
+									pos: position{line: 836, col: 43, offset: 28961},
 									expr: &litMatcher{
-										pos:        position{line: 807, col: 44, offset: 27764},
+										pos:        position{line: 836, col: 44, offset: 28962},
 										val:        "::",
 										ignoreCase: false,
 										want:       "\"::\"",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 807, col: 49, offset: 27769},
+									pos:  position{line: 836, col: 49, offset: 28967},
 									name: "LabeledListItemTermElement",
 								},
 							},
@@ -6100,85 +6346,93 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemTermElement",
-			pos:  position{line: 811, col: 1, offset: 27896},
+			pos:  position{line: 840, col: 1, offset: 29094},
 			expr: &actionExpr{
-				pos: position{line: 811, col: 31, offset: 27926},
+				pos: position{line: 840, col: 31, offset: 29124},
 				run: (*parser).callonLabeledListItemTermElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 811, col: 31, offset: 27926},
+					pos:   position{line: 840, col: 31, offset: 29124},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 811, col: 40, offset: 27935},
+						pos: position{line: 840, col: 40, offset: 29133},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 811, col: 40, offset: 27935},
+								pos:  position{line: 840, col: 40, offset: 29133},
 								name: "Word",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 812, col: 11, offset: 27950},
+								pos:  position{line: 841, col: 11, offset: 29148},
 								name: "LineBreak",
 							},
+							&ruleRefExpr{
+								pos:  position{line: 842, col: 11, offset: 29197},
+								name: "EmDash",
+							},
 							&oneOrMoreExpr{
-								pos: position{line: 813, col: 11, offset: 27999},
+								pos: position{line: 843, col: 11, offset: 29243},
 								expr: &ruleRefExpr{
-									pos:  position{line: 813, col: 11, offset: 27999},
+									pos:  position{line: 843, col: 11, offset: 29243},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 814, col: 11, offset: 28017},
+								pos:  position{line: 844, col: 11, offset: 29261},
 								name: "CrossReference",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 815, col: 11, offset: 28042},
+								pos:  position{line: 845, col: 11, offset: 29286},
 								name: "ConcealedIndexTerm",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 816, col: 11, offset: 28071},
+								pos:  position{line: 846, col: 11, offset: 29315},
 								name: "IndexTerm",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 817, col: 11, offset: 28091},
+								pos:  position{line: 847, col: 11, offset: 29335},
 								name: "InlinePassthrough",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 818, col: 11, offset: 28180},
+								pos:  position{line: 848, col: 11, offset: 29424},
 								name: "InlineIcon",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 819, col: 11, offset: 28201},
+								pos:  position{line: 849, col: 11, offset: 29445},
 								name: "InlineImage",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 820, col: 11, offset: 28224},
+								pos:  position{line: 850, col: 11, offset: 29468},
 								name: "Link",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 821, col: 11, offset: 28239},
+								pos:  position{line: 851, col: 11, offset: 29483},
+								name: "InlineEmail",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 852, col: 11, offset: 29505},
 								name: "InlineFootnote",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 822, col: 11, offset: 28264},
+								pos:  position{line: 853, col: 11, offset: 29530},
 								name: "QuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 823, col: 11, offset: 28287},
+								pos:  position{line: 854, col: 11, offset: 29553},
 								name: "QuotedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 824, col: 11, offset: 28308},
-								name: "SpecialCharacter",
+								pos:  position{line: 855, col: 11, offset: 29574},
+								name: "Replacement",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 825, col: 11, offset: 28335},
-								name: "Symbol",
+								pos:  position{line: 856, col: 11, offset: 29685},
+								name: "SpecialCharacter",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 826, col: 11, offset: 28352},
+								pos:  position{line: 857, col: 11, offset: 29712},
 								name: "AttributeSubstitution",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 827, col: 11, offset: 28384},
+								pos:  position{line: 858, col: 11, offset: 29744},
 								name: "AnyChar",
 							},
 						},
@@ -6188,23 +6442,23 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemSeparator",
-			pos:  position{line: 831, col: 1, offset: 28423},
+			pos:  position{line: 862, col: 1, offset: 29783},
 			expr: &actionExpr{
-				pos: position{line: 832, col: 5, offset: 28456},
+				pos: position{line: 863, col: 5, offset: 29816},
 				run: (*parser).callonLabeledListItemSeparator1,
 				expr: &seqExpr{
-					pos: position{line: 832, col: 5, offset: 28456},
+					pos: position{line: 863, col: 5, offset: 29816},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 832, col: 5, offset: 28456},
+							pos:   position{line: 863, col: 5, offset: 29816},
 							label: "separator",
 							expr: &actionExpr{
-								pos: position{line: 832, col: 16, offset: 28467},
+								pos: position{line: 863, col: 16, offset: 29827},
 								run: (*parser).callonLabeledListItemSeparator4,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 832, col: 16, offset: 28467},
+									pos: position{line: 863, col: 16, offset: 29827},
 									expr: &litMatcher{
-										pos:        position{line: 832, col: 17, offset: 28468},
+										pos:        position{line: 863, col: 17, offset: 29828},
 										val:        ":",
 										ignoreCase: false,
 										want:       "\":\"",
@@ -6213,30 +6467,30 @@ var g = &grammar{
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 835, col: 5, offset: 28526},
+							pos: position{line: 866, col: 5, offset: 29886},
 							run: (*parser).callonLabeledListItemSeparator7,
 						},
 						&choiceExpr{
-							pos: position{line: 839, col: 6, offset: 28702},
+							pos: position{line: 870, col: 6, offset: 30062},
 							alternatives: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 839, col: 6, offset: 28702},
+									pos: position{line: 870, col: 6, offset: 30062},
 									expr: &choiceExpr{
-										pos: position{line: 839, col: 7, offset: 28703},
+										pos: position{line: 870, col: 7, offset: 30063},
 										alternatives: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 839, col: 7, offset: 28703},
+												pos:  position{line: 870, col: 7, offset: 30063},
 												name: "Space",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 839, col: 15, offset: 28711},
+												pos:  position{line: 870, col: 15, offset: 30071},
 												name: "Newline",
 											},
 										},
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 839, col: 27, offset: 28723},
+									pos:  position{line: 870, col: 27, offset: 30083},
 									name: "EOL",
 								},
 							},
@@ -6247,17 +6501,17 @@ var g = &grammar{
 		},
 		{
 			name: "LabeledListItemDescription",
-			pos:  position{line: 843, col: 1, offset: 28763},
+			pos:  position{line: 874, col: 1, offset: 30123},
 			expr: &actionExpr{
-				pos: position{line: 843, col: 31, offset: 28793},
+				pos: position{line: 874, col: 31, offset: 30153},
 				run: (*parser).callonLabeledListItemDescription1,
 				expr: &labeledExpr{
-					pos:   position{line: 843, col: 31, offset: 28793},
+					pos:   position{line: 874, col: 31, offset: 30153},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 843, col: 40, offset: 28802},
+						pos: position{line: 874, col: 40, offset: 30162},
 						expr: &ruleRefExpr{
-							pos:  position{line: 843, col: 41, offset: 28803},
+							pos:  position{line: 874, col: 41, offset: 30163},
 							name: "ListParagraph",
 						},
 					},
@@ -6266,55 +6520,99 @@ var g = &grammar{
 		},
 		{
 			name: "AdmonitionKind",
-			pos:  position{line: 850, col: 1, offset: 28994},
+			pos:  position{line: 882, col: 1, offset: 30481},
 			expr: &choiceExpr{
-				pos: position{line: 850, col: 19, offset: 29012},
+				pos: position{line: 882, col: 19, offset: 30499},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 850, col: 19, offset: 29012},
+						pos: position{line: 882, col: 19, offset: 30499},
 						run: (*parser).callonAdmonitionKind2,
+						expr: &seqExpr{
+							pos: position{line: 882, col: 19, offset: 30499},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 882, col: 19, offset: 30499},
+									label: "name",
+									expr: &actionExpr{
+										pos: position{line: 882, col: 25, offset: 30505},
+										run: (*parser).callonAdmonitionKind5,
+										expr: &seqExpr{
+											pos: position{line: 882, col: 25, offset: 30505},
+											exprs: []interface{}{
+												&charClassMatcher{
+													pos:        position{line: 882, col: 25, offset: 30505},
+													val:        "[A-Z]",
+													ranges:     []rune{'A', 'Z'},
+													ignoreCase: false,
+													inverted:   false,
+												},
+												&zeroOrMoreExpr{
+													pos: position{line: 882, col: 31, offset: 30511},
+													expr: &charClassMatcher{
+														pos:        position{line: 882, col: 31, offset: 30511},
+														val:        "[A-Z0-9_-]",
+														chars:      []rune{'_', '-'},
+														ranges:     []rune{'A', 'Z', '0', '9'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
+											},
+										},
+									},
+								},
+								&andCodeExpr{
+									pos: position{line: 882, col: 75, offset: 30555},
+									run: (*parser).callonAdmonitionKind10,
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 886, col: 5, offset: 30682},
+						run: (*parser).callonAdmonitionKind11,
 						expr: &litMatcher{
-							pos:        position{line: 850, col: 19, offset: 29012},
+							pos:        position{line: 886, col: 5, offset: 30682},
 							val:        "TIP",
 							ignoreCase: false,
 							want:       "\"TIP\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 852, col: 5, offset: 29050},
-						run: (*parser).callonAdmonitionKind4,
+						pos: position{line: 888, col: 5, offset: 30720},
+						run: (*parser).callonAdmonitionKind13,
 						expr: &litMatcher{
-							pos:        position{line: 852, col: 5, offset: 29050},
+							pos:        position{line: 888, col: 5, offset: 30720},
 							val:        "NOTE",
 							ignoreCase: false,
 							want:       "\"NOTE\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 854, col: 5, offset: 29090},
-						run: (*parser).callonAdmonitionKind6,
+						pos: position{line: 890, col: 5, offset: 30760},
+						run: (*parser).callonAdmonitionKind15,
 						expr: &litMatcher{
-							pos:        position{line: 854, col: 5, offset: 29090},
+							pos:        position{line: 890, col: 5, offset: 30760},
 							val:        "IMPORTANT",
 							ignoreCase: false,
 							want:       "\"IMPORTANT\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 856, col: 5, offset: 29140},
-						run: (*parser).callonAdmonitionKind8,
+						pos: position{line: 892, col: 5, offset: 30810},
+						run: (*parser).callonAdmonitionKind17,
 						expr: &litMatcher{
-							pos:        position{line: 856, col: 5, offset: 29140},
+							pos:        position{line: 892, col: 5, offset: 30810},
 							val:        "WARNING",
 							ignoreCase: false,
 							want:       "\"WARNING\"",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 858, col: 5, offset: 29186},
-						run: (*parser).callonAdmonitionKind10,
+						pos: position{line: 894, col: 5, offset: 30856},
+						run: (*parser).callonAdmonitionKind19,
 						expr: &litMatcher{
-							pos:        position{line: 858, col: 5, offset: 29186},
+							pos:        position{line: 894, col: 5, offset: 30856},
 							val:        "CAUTION",
 							ignoreCase: false,
 							want:       "\"CAUTION\"",
@@ -6325,55 +6623,55 @@ var g = &grammar{
 		},
 		{
 			name: "RawParagraph",
-			pos:  position{line: 869, col: 1, offset: 29498},
+			pos:  position{line: 905, col: 1, offset: 31168},
 			expr: &choiceExpr{
-				pos: position{line: 871, col: 5, offset: 29548},
+				pos: position{line: 907, col: 5, offset: 31218},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 871, col: 5, offset: 29548},
+						pos: position{line: 907, col: 5, offset: 31218},
 						run: (*parser).callonRawParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 871, col: 5, offset: 29548},
+							pos: position{line: 907, col: 5, offset: 31218},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 871, col: 5, offset: 29548},
+									pos:   position{line: 907, col: 5, offset: 31218},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 871, col: 16, offset: 29559},
+										pos: position{line: 907, col: 16, offset: 31229},
 										expr: &ruleRefExpr{
-											pos:  position{line: 871, col: 17, offset: 29560},
+											pos:  position{line: 907, col: 17, offset: 31230},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 871, col: 30, offset: 29573},
+									pos:   position{line: 907, col: 30, offset: 31243},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 871, col: 33, offset: 29576},
+										pos:  position{line: 907, col: 33, offset: 31246},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 871, col: 49, offset: 29592},
+									pos:        position{line: 907, col: 49, offset: 31262},
 									val:        ": ",
 									ignoreCase: false,
 									want:       "\": \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 871, col: 54, offset: 29597},
+									pos:   position{line: 907, col: 54, offset: 31267},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 871, col: 60, offset: 29603},
+										pos: position{line: 907, col: 60, offset: 31273},
 										expr: &choiceExpr{
-											pos: position{line: 871, col: 61, offset: 29604},
+											pos: position{line: 907, col: 61, offset: 31274},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 871, col: 61, offset: 29604},
+													pos:  position{line: 907, col: 61, offset: 31274},
 													name: "SingleLineComment",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 871, col: 81, offset: 29624},
+													pos:  position{line: 907, col: 81, offset: 31294},
 													name: "RawParagraphLine",
 												},
 											},
@@ -6384,33 +6682,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 876, col: 5, offset: 29859},
+						pos: position{line: 912, col: 5, offset: 31529},
 						run: (*parser).callonRawParagraph15,
 						expr: &seqExpr{
-							pos: position{line: 876, col: 5, offset: 29859},
+							pos: position{line: 912, col: 5, offset: 31529},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 876, col: 5, offset: 29859},
+									pos:   position{line: 912, col: 5, offset: 31529},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 876, col: 16, offset: 29870},
+										pos: position{line: 912, col: 16, offset: 31540},
 										expr: &ruleRefExpr{
-											pos:  position{line: 876, col: 17, offset: 29871},
+											pos:  position{line: 912, col: 17, offset: 31541},
 											name: "Attributes",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 876, col: 30, offset: 29884},
+									pos:        position{line: 912, col: 30, offset: 31554},
 									val:        "> ",
 									ignoreCase: false,
 									want:       "\"> \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 876, col: 35, offset: 29889},
+									pos:   position{line: 912, col: 35, offset: 31559},
 									label: "content",
 									expr: &ruleRefExpr{
-										pos:  position{line: 876, col: 44, offset: 29898},
+										pos:  position{line: 912, col: 44, offset: 31568},
 										name: "MarkdownQuoteBlockRawContent",
 									},
 								},
@@ -6418,33 +6716,33 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 880, col: 5, offset: 30071},
+						pos: position{line: 916, col: 5, offset: 31741},
 						run: (*parser).callonRawParagraph23,
 						expr: &seqExpr{
-							pos: position{line: 880, col: 5, offset: 30071},
+							pos: position{line: 916, col: 5, offset: 31741},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 880, col: 5, offset: 30071},
+									pos:   position{line: 916, col: 5, offset: 31741},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 880, col: 16, offset: 30082},
+										pos: position{line: 916, col: 16, offset: 31752},
 										expr: &ruleRefExpr{
-											pos:  position{line: 880, col: 17, offset: 30083},
+											pos:  position{line: 916, col: 17, offset: 31753},
 											name: "Attributes",
 										},
 									},
 								},
 								&andCodeExpr{
-									pos: position{line: 880, col: 30, offset: 30096},
+									pos: position{line: 916, col: 30, offset: 31766},
 									run: (*parser).callonRawParagraph28,
 								},
 								&labeledExpr{
-									pos:   position{line: 887, col: 7, offset: 30380},
+									pos:   position{line: 923, col: 7, offset: 32050},
 									label: "content",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 887, col: 15, offset: 30388},
+										pos: position{line: 923, col: 15, offset: 32058},
 										expr: &ruleRefExpr{
-											pos:  position{line: 887, col: 16, offset: 30389},
+											pos:  position{line: 923, col: 16, offset: 32059},
 											name: "RawParagraphLine",
 										},
 									},
@@ -6453,36 +6751,36 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 891, col: 5, offset: 30555},
+						pos: position{line: 927, col: 5, offset: 32225},
 						run: (*parser).callonRawParagraph32,
 						expr: &seqExpr{
-							pos: position{line: 891, col: 5, offset: 30555},
+							pos: position{line: 927, col: 5, offset: 32225},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 891, col: 5, offset: 30555},
+									pos:   position{line: 927, col: 5, offset: 32225},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 891, col: 16, offset: 30566},
+										pos: position{line: 927, col: 16, offset: 32236},
 										expr: &ruleRefExpr{
-											pos:  position{line: 891, col: 17, offset: 30567},
+											pos:  position{line: 927, col: 17, offset: 32237},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 891, col: 31, offset: 30581},
+									pos:   position{line: 927, col: 31, offset: 32251},
 									label: "lines",
 									expr: &oneOrMoreExpr{
-										pos: position{line: 891, col: 37, offset: 30587},
+										pos: position{line: 927, col: 37, offset: 32257},
 										expr: &choiceExpr{
-											pos: position{line: 891, col: 38, offset: 30588},
+											pos: position{line: 927, col: 38, offset: 32258},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 891, col: 38, offset: 30588},
+													pos:  position{line: 927, col: 38, offset: 32258},
 													name: "SingleLineComment",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 891, col: 58, offset: 30608},
+													pos:  position{line: 927, col: 58, offset: 32278},
 													name: "RawParagraphLine",
 												},
 											},
@@ -6497,36 +6795,36 @@ var g = &grammar{
 		},
 		{
 			name: "MarkdownQuoteBlockRawContent",
-			pos:  position{line: 895, col: 1, offset: 30706},
+			pos:  position{line: 931, col: 1, offset: 32376},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 895, col: 33, offset: 30738},
+				pos: position{line: 931, col: 33, offset: 32408},
 				expr: &actionExpr{
-					pos: position{line: 895, col: 34, offset: 30739},
+					pos: position{line: 931, col: 34, offset: 32409},
 					run: (*parser).callonMarkdownQuoteBlockRawContent2,
 					expr: &seqExpr{
-						pos: position{line: 895, col: 34, offset: 30739},
+						pos: position{line: 931, col: 34, offset: 32409},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 895, col: 34, offset: 30739},
+								pos: position{line: 931, col: 34, offset: 32409},
 								expr: &ruleRefExpr{
-									pos:  position{line: 895, col: 35, offset: 30740},
+									pos:  position{line: 931, col: 35, offset: 32410},
 									name: "BlankLine",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 895, col: 45, offset: 30750},
+								pos: position{line: 931, col: 45, offset: 32420},
 								expr: &litMatcher{
-									pos:        position{line: 895, col: 45, offset: 30750},
+									pos:        position{line: 931, col: 45, offset: 32420},
 									val:        "> ",
 									ignoreCase: false,
 									want:       "\"> \"",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 895, col: 51, offset: 30756},
+								pos:   position{line: 931, col: 51, offset: 32426},
 								label: "content",
 								expr: &ruleRefExpr{
-									pos:  position{line: 895, col: 60, offset: 30765},
+									pos:  position{line: 931, col: 60, offset: 32435},
 									name: "RawLine",
 								},
 							},
@@ -6537,34 +6835,34 @@ var g = &grammar{
 		},
 		{
 			name: "RawParagraphLine",
-			pos:  position{line: 899, col: 1, offset: 30806},
+			pos:  position{line: 935, col: 1, offset: 32476},
 			expr: &actionExpr{
-				pos: position{line: 899, col: 21, offset: 30826},
+				pos: position{line: 935, col: 21, offset: 32496},
 				run: (*parser).callonRawParagraphLine1,
 				expr: &seqExpr{
-					pos: position{line: 899, col: 21, offset: 30826},
+					pos: position{line: 935, col: 21, offset: 32496},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 899, col: 21, offset: 30826},
+							pos: position{line: 935, col: 21, offset: 32496},
 							expr: &ruleRefExpr{
-								pos:  position{line: 899, col: 22, offset: 30827},
+								pos:  position{line: 935, col: 22, offset: 32497},
 								name: "BlockDelimiter",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 900, col: 5, offset: 30847},
+							pos:   position{line: 936, col: 5, offset: 32517},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 900, col: 14, offset: 30856},
+								pos:  position{line: 936, col: 14, offset: 32526},
 								name: "RawParagraphLineContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 900, col: 39, offset: 30881},
+							pos:  position{line: 936, col: 39, offset: 32551},
 							name: "EOL",
 						},
 						&andCodeExpr{
-							pos: position{line: 900, col: 43, offset: 30885},
+							pos: position{line: 936, col: 43, offset: 32555},
 							run: (*parser).callonRawParagraphLine8,
 						},
 					},
@@ -6573,14 +6871,14 @@ var g = &grammar{
 		},
 		{
 			name: "RawParagraphLineContent",
-			pos:  position{line: 910, col: 1, offset: 31120},
+			pos:  position{line: 946, col: 1, offset: 32790},
 			expr: &actionExpr{
-				pos: position{line: 910, col: 28, offset: 31147},
+				pos: position{line: 946, col: 28, offset: 32817},
 				run: (*parser).callonRawParagraphLineContent1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 910, col: 28, offset: 31147},
+					pos: position{line: 946, col: 28, offset: 32817},
 					expr: &charClassMatcher{
-						pos:        position{line: 910, col: 28, offset: 31147},
+						pos:        position{line: 946, col: 28, offset: 32817},
 						val:        "[^\\r\\n]",
 						chars:      []rune{'\r', '\n'},
 						ignoreCase: false,
@@ -6591,50 +6889,50 @@ var g = &grammar{
 		},
 		{
 			name: "SimpleRawParagraph",
-			pos:  position{line: 915, col: 1, offset: 31264},
+			pos:  position{line: 951, col: 1, offset: 32934},
 			expr: &actionExpr{
-				pos: position{line: 915, col: 23, offset: 31286},
+				pos: position{line: 951, col: 23, offset: 32956},
 				run: (*parser).callonSimpleRawParagraph1,
 				expr: &seqExpr{
-					pos: position{line: 915, col: 23, offset: 31286},
+					pos: position{line: 951, col: 23, offset: 32956},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 915, col: 23, offset: 31286},
+							pos:   position{line: 951, col: 23, offset: 32956},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 915, col: 34, offset: 31297},
+								pos: position{line: 951, col: 34, offset: 32967},
 								expr: &ruleRefExpr{
-									pos:  position{line: 915, col: 35, offset: 31298},
+									pos:  position{line: 951, col: 35, offset: 32968},
 									name: "Attributes",
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 916, col: 5, offset: 31316},
+							pos: position{line: 952, col: 5, offset: 32986},
 							run: (*parser).callonSimpleRawParagraph6,
 						},
 						&labeledExpr{
-							pos:   position{line: 924, col: 5, offset: 31607},
+							pos:   position{line: 960, col: 5, offset: 33277},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 924, col: 16, offset: 31618},
+								pos:  position{line: 960, col: 16, offset: 33288},
 								name: "FirstParagraphRawLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 925, col: 5, offset: 31644},
+							pos:   position{line: 961, col: 5, offset: 33314},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 925, col: 16, offset: 31655},
+								pos: position{line: 961, col: 16, offset: 33325},
 								expr: &choiceExpr{
-									pos: position{line: 925, col: 17, offset: 31656},
+									pos: position{line: 961, col: 17, offset: 33326},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 925, col: 17, offset: 31656},
+											pos:  position{line: 961, col: 17, offset: 33326},
 											name: "SingleLineComment",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 925, col: 37, offset: 31676},
+											pos:  position{line: 961, col: 37, offset: 33346},
 											name: "RawParagraphLine",
 										},
 									},
@@ -6647,34 +6945,34 @@ var g = &grammar{
 		},
 		{
 			name: "FirstParagraphRawLine",
-			pos:  position{line: 929, col: 1, offset: 31808},
+			pos:  position{line: 965, col: 1, offset: 33478},
 			expr: &actionExpr{
-				pos: position{line: 930, col: 5, offset: 31838},
+				pos: position{line: 966, col: 5, offset: 33508},
 				run: (*parser).callonFirstParagraphRawLine1,
 				expr: &seqExpr{
-					pos: position{line: 930, col: 5, offset: 31838},
+					pos: position{line: 966, col: 5, offset: 33508},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 930, col: 5, offset: 31838},
+							pos:   position{line: 966, col: 5, offset: 33508},
 							label: "content",
 							expr: &actionExpr{
-								pos: position{line: 930, col: 14, offset: 31847},
+								pos: position{line: 966, col: 14, offset: 33517},
 								run: (*parser).callonFirstParagraphRawLine4,
 								expr: &seqExpr{
-									pos: position{line: 930, col: 14, offset: 31847},
+									pos: position{line: 966, col: 14, offset: 33517},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 930, col: 14, offset: 31847},
+											pos:   position{line: 966, col: 14, offset: 33517},
 											label: "elements",
 											expr: &ruleRefExpr{
-												pos:  position{line: 930, col: 23, offset: 31856},
+												pos:  position{line: 966, col: 23, offset: 33526},
 												name: "Word",
 											},
 										},
 										&zeroOrMoreExpr{
-											pos: position{line: 930, col: 28, offset: 31861},
+											pos: position{line: 966, col: 28, offset: 33531},
 											expr: &charClassMatcher{
-												pos:        position{line: 930, col: 28, offset: 31861},
+												pos:        position{line: 966, col: 28, offset: 33531},
 												val:        "[^\\r\\n]",
 												chars:      []rune{'\r', '\n'},
 												ignoreCase: false,
@@ -6686,7 +6984,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 930, col: 68, offset: 31901},
+							pos:  position{line: 966, col: 68, offset: 33571},
 							name: "EOL",
 						},
 					},
@@ -6695,46 +6993,46 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedRawParagraph",
-			pos:  position{line: 941, col: 1, offset: 32153},
+			pos:  position{line: 977, col: 1, offset: 33823},
 			expr: &choiceExpr{
-				pos: position{line: 943, col: 5, offset: 32212},
+				pos: position{line: 979, col: 5, offset: 33882},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 943, col: 5, offset: 32212},
+						pos: position{line: 979, col: 5, offset: 33882},
 						run: (*parser).callonContinuedRawParagraph2,
 						expr: &seqExpr{
-							pos: position{line: 943, col: 5, offset: 32212},
+							pos: position{line: 979, col: 5, offset: 33882},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 943, col: 5, offset: 32212},
+									pos:   position{line: 979, col: 5, offset: 33882},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 943, col: 16, offset: 32223},
+										pos: position{line: 979, col: 16, offset: 33893},
 										expr: &ruleRefExpr{
-											pos:  position{line: 943, col: 17, offset: 32224},
+											pos:  position{line: 979, col: 17, offset: 33894},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 943, col: 30, offset: 32237},
+									pos:   position{line: 979, col: 30, offset: 33907},
 									label: "t",
 									expr: &ruleRefExpr{
-										pos:  position{line: 943, col: 33, offset: 32240},
+										pos:  position{line: 979, col: 33, offset: 33910},
 										name: "AdmonitionKind",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 943, col: 49, offset: 32256},
+									pos:        position{line: 979, col: 49, offset: 33926},
 									val:        ": ",
 									ignoreCase: false,
 									want:       "\": \"",
 								},
 								&labeledExpr{
-									pos:   position{line: 943, col: 54, offset: 32261},
+									pos:   position{line: 979, col: 54, offset: 33931},
 									label: "lines",
 									expr: &ruleRefExpr{
-										pos:  position{line: 943, col: 61, offset: 32268},
+										pos:  position{line: 979, col: 61, offset: 33938},
 										name: "ContinuedRawParagraphLines",
 									},
 								},
@@ -6742,27 +7040,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 947, col: 5, offset: 32471},
+						pos: position{line: 983, col: 5, offset: 34141},
 						run: (*parser).callonContinuedRawParagraph12,
 						expr: &seqExpr{
-							pos: position{line: 947, col: 5, offset: 32471},
+							pos: position{line: 983, col: 5, offset: 34141},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 947, col: 5, offset: 32471},
+									pos:   position{line: 983, col: 5, offset: 34141},
 									label: "attributes",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 947, col: 16, offset: 32482},
+										pos: position{line: 983, col: 16, offset: 34152},
 										expr: &ruleRefExpr{
-											pos:  position{line: 947, col: 17, offset: 32483},
+											pos:  position{line: 983, col: 17, offset: 34153},
 											name: "Attributes",
 										},
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 947, col: 30, offset: 32496},
+									pos:   position{line: 983, col: 30, offset: 34166},
 									label: "lines",
 									expr: &ruleRefExpr{
-										pos:  position{line: 947, col: 37, offset: 32503},
+										pos:  position{line: 983, col: 37, offset: 34173},
 										name: "ContinuedRawParagraphLines",
 									},
 								},
@@ -6774,51 +7072,51 @@ var g = &grammar{
 		},
 		{
 			name: "ContinuedRawParagraphLines",
-			pos:  position{line: 951, col: 1, offset: 32607},
+			pos:  position{line: 987, col: 1, offset: 34277},
 			expr: &actionExpr{
-				pos: position{line: 951, col: 31, offset: 32637},
+				pos: position{line: 987, col: 31, offset: 34307},
 				run: (*parser).callonContinuedRawParagraphLines1,
 				expr: &seqExpr{
-					pos: position{line: 951, col: 31, offset: 32637},
+					pos: position{line: 987, col: 31, offset: 34307},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 951, col: 31, offset: 32637},
+							pos:   position{line: 987, col: 31, offset: 34307},
 							label: "firstLine",
 							expr: &ruleRefExpr{
-								pos:  position{line: 951, col: 42, offset: 32648},
+								pos:  position{line: 987, col: 42, offset: 34318},
 								name: "FirstParagraphRawLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 951, col: 65, offset: 32671},
+							pos:   position{line: 987, col: 65, offset: 34341},
 							label: "otherLines",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 951, col: 76, offset: 32682},
+								pos: position{line: 987, col: 76, offset: 34352},
 								expr: &actionExpr{
-									pos: position{line: 951, col: 77, offset: 32683},
+									pos: position{line: 987, col: 77, offset: 34353},
 									run: (*parser).callonContinuedRawParagraphLines7,
 									expr: &seqExpr{
-										pos: position{line: 951, col: 77, offset: 32683},
+										pos: position{line: 987, col: 77, offset: 34353},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 951, col: 77, offset: 32683},
+												pos: position{line: 987, col: 77, offset: 34353},
 												expr: &ruleRefExpr{
-													pos:  position{line: 951, col: 78, offset: 32684},
+													pos:  position{line: 987, col: 78, offset: 34354},
 													name: "ListItemContinuation",
 												},
 											},
 											&labeledExpr{
-												pos:   position{line: 951, col: 99, offset: 32705},
+												pos:   position{line: 987, col: 99, offset: 34375},
 												label: "line",
 												expr: &choiceExpr{
-													pos: position{line: 951, col: 105, offset: 32711},
+													pos: position{line: 987, col: 105, offset: 34381},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 951, col: 105, offset: 32711},
+															pos:  position{line: 987, col: 105, offset: 34381},
 															name: "SingleLineComment",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 951, col: 125, offset: 32731},
+															pos:  position{line: 987, col: 125, offset: 34401},
 															name: "RawParagraphLine",
 														},
 													},
@@ -6835,57 +7133,57 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElements",
-			pos:  position{line: 959, col: 1, offset: 32973},
+			pos:  position{line: 995, col: 1, offset: 34643},
 			expr: &actionExpr{
-				pos: position{line: 959, col: 19, offset: 32991},
+				pos: position{line: 995, col: 19, offset: 34661},
 				run: (*parser).callonInlineElements1,
 				expr: &seqExpr{
-					pos: position{line: 959, col: 19, offset: 32991},
+					pos: position{line: 995, col: 19, offset: 34661},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 959, col: 19, offset: 32991},
+							pos: position{line: 995, col: 19, offset: 34661},
 							expr: &ruleRefExpr{
-								pos:  position{line: 959, col: 20, offset: 32992},
+								pos:  position{line: 995, col: 20, offset: 34662},
 								name: "BlankLine",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 960, col: 5, offset: 33006},
+							pos:   position{line: 996, col: 5, offset: 34676},
 							label: "elements",
 							expr: &choiceExpr{
-								pos: position{line: 960, col: 15, offset: 33016},
+								pos: position{line: 996, col: 15, offset: 34686},
 								alternatives: []interface{}{
 									&actionExpr{
-										pos: position{line: 960, col: 15, offset: 33016},
+										pos: position{line: 996, col: 15, offset: 34686},
 										run: (*parser).callonInlineElements7,
 										expr: &labeledExpr{
-											pos:   position{line: 960, col: 15, offset: 33016},
+											pos:   position{line: 996, col: 15, offset: 34686},
 											label: "comment",
 											expr: &ruleRefExpr{
-												pos:  position{line: 960, col: 24, offset: 33025},
+												pos:  position{line: 996, col: 24, offset: 34695},
 												name: "SingleLineComment",
 											},
 										},
 									},
 									&actionExpr{
-										pos: position{line: 962, col: 9, offset: 33117},
+										pos: position{line: 998, col: 9, offset: 34787},
 										run: (*parser).callonInlineElements10,
 										expr: &seqExpr{
-											pos: position{line: 962, col: 9, offset: 33117},
+											pos: position{line: 998, col: 9, offset: 34787},
 											exprs: []interface{}{
 												&labeledExpr{
-													pos:   position{line: 962, col: 9, offset: 33117},
+													pos:   position{line: 998, col: 9, offset: 34787},
 													label: "elements",
 													expr: &oneOrMoreExpr{
-														pos: position{line: 962, col: 18, offset: 33126},
+														pos: position{line: 998, col: 18, offset: 34796},
 														expr: &ruleRefExpr{
-															pos:  position{line: 962, col: 19, offset: 33127},
+															pos:  position{line: 998, col: 19, offset: 34797},
 															name: "InlineElement",
 														},
 													},
 												},
 												&ruleRefExpr{
-													pos:  position{line: 962, col: 35, offset: 33143},
+													pos:  position{line: 998, col: 35, offset: 34813},
 													name: "EOL",
 												},
 											},
@@ -6900,111 +7198,140 @@ var g = &grammar{
 		},
 		{
 			name: "InlineElement",
-			pos:  position{line: 968, col: 1, offset: 33260},
+			pos:  position{line: 1004, col: 1, offset: 34930},
 			expr: &actionExpr{
-				pos: position{line: 969, col: 5, offset: 33283},
+				pos: position{line: 1004, col: 18, offset: 34947},
 				run: (*parser).callonInlineElement1,
-				expr: &labeledExpr{
-					pos:   position{line: 969, col: 5, offset: 33283},
-					label: "element",
-					expr: &choiceExpr{
-						pos: position{line: 969, col: 14, offset: 33292},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 969, col: 14, offset: 33292},
-								name: "InlineWord",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 970, col: 11, offset: 33353},
-								name: "LineBreak",
-							},
-							&oneOrMoreExpr{
-								pos: position{line: 971, col: 11, offset: 33398},
-								expr: &ruleRefExpr{
-									pos:  position{line: 971, col: 11, offset: 33398},
-									name: "Space",
-								},
-							},
-							&seqExpr{
-								pos: position{line: 972, col: 11, offset: 33416},
-								exprs: []interface{}{
-									&notExpr{
-										pos: position{line: 972, col: 11, offset: 33416},
+				expr: &seqExpr{
+					pos: position{line: 1004, col: 18, offset: 34947},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 1004, col: 18, offset: 34947},
+							run: (*parser).callonInlineElement3,
+						},
+						&labeledExpr{
+							pos:   position{line: 1005, col: 5, offset: 35032},
+							label: "element",
+							expr: &choiceExpr{
+								pos: position{line: 1005, col: 14, offset: 35041},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1005, col: 14, offset: 35041},
+										name: "InlineWord",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1006, col: 11, offset: 35102},
+										name: "AlphanumsWithPlus",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1007, col: 11, offset: 35130},
+										name: "LineBreak",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1008, col: 11, offset: 35175},
+										name: "EmDash",
+									},
+									&oneOrMoreExpr{
+										pos: position{line: 1009, col: 11, offset: 35217},
 										expr: &ruleRefExpr{
-											pos:  position{line: 972, col: 12, offset: 33417},
-											name: "EOL",
+											pos:  position{line: 1009, col: 11, offset: 35217},
+											name: "Space",
 										},
 									},
-									&choiceExpr{
-										pos: position{line: 973, col: 13, offset: 33435},
-										alternatives: []interface{}{
-											&ruleRefExpr{
-												pos:  position{line: 973, col: 13, offset: 33435},
-												name: "QuotedString",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 974, col: 15, offset: 33462},
-												name: "QuotedText",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 975, col: 15, offset: 33487},
-												name: "InlineIcon",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 976, col: 15, offset: 33512},
-												name: "InlineImage",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 977, col: 15, offset: 33539},
-												name: "Link",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 978, col: 15, offset: 33559},
-												name: "InlinePassthrough",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 979, col: 15, offset: 33652},
-												name: "InlineFootnote",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 980, col: 15, offset: 33682},
-												name: "CrossReference",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 981, col: 15, offset: 33750},
-												name: "SpecialCharacter",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 982, col: 15, offset: 33781},
-												name: "Symbol",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 983, col: 15, offset: 33802},
-												name: "InlineUserMacro",
+									&seqExpr{
+										pos: position{line: 1010, col: 11, offset: 35235},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 1010, col: 11, offset: 35235},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1010, col: 12, offset: 35236},
+													name: "EOL",
+												},
 											},
-											&ruleRefExpr{
-												pos:  position{line: 984, col: 15, offset: 33833},
-												name: "AttributeSubstitution",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 985, col: 15, offset: 33870},
-												name: "InlineElementID",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 986, col: 15, offset: 33900},
-												name: "ConcealedIndexTerm",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 987, col: 15, offset: 33933},
-												name: "IndexTerm",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 988, col: 15, offset: 33957},
-												name: "ElementPlaceHolder",
-											},
-											&ruleRefExpr{
-												pos:  position{line: 989, col: 15, offset: 33990},
-												name: "AnyChar",
+											&choiceExpr{
+												pos: position{line: 1011, col: 13, offset: 35254},
+												alternatives: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 1011, col: 13, offset: 35254},
+														name: "QuotedString",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1012, col: 15, offset: 35281},
+														name: "QuotedText",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1013, col: 15, offset: 35306},
+														name: "InlineIcon",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1014, col: 15, offset: 35331},
+														name: "InlineImage",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1015, col: 15, offset: 35358},
+														name: "Link",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1016, col: 15, offset: 35378},
+														name: "InlineEmail",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1017, col: 15, offset: 35404},
+														name: "InlinePassthrough",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1018, col: 15, offset: 35497},
+														name: "InlineFootnote",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1019, col: 15, offset: 35527},
+														name: "CrossReference",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1020, col: 15, offset: 35595},
+														name: "Replacement",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1021, col: 15, offset: 35710},
+														name: "SpecialCharacter",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1022, col: 15, offset: 35741},
+														name: "InlineUIMacro",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1023, col: 15, offset: 35803},
+														name: "InlineUserMacro",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1024, col: 15, offset: 35834},
+														name: "AttributeSubstitution",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1025, col: 15, offset: 35871},
+														name: "BibliographyAnchor",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1026, col: 15, offset: 35938},
+														name: "InlineElementID",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1027, col: 15, offset: 35968},
+														name: "ConcealedIndexTerm",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1028, col: 15, offset: 36001},
+														name: "IndexTerm",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1029, col: 15, offset: 36025},
+														name: "ElementPlaceHolder",
+													},
+													&ruleRefExpr{
+														pos:  position{line: 1030, col: 15, offset: 36058},
+														name: "AnyChar",
+													},
+												},
 											},
 										},
 									},
@@ -7017,34 +7344,34 @@ var g = &grammar{
 		},
 		{
 			name: "LineBreak",
-			pos:  position{line: 996, col: 1, offset: 34213},
+			pos:  position{line: 1037, col: 1, offset: 36281},
 			expr: &actionExpr{
-				pos: position{line: 996, col: 14, offset: 34226},
+				pos: position{line: 1037, col: 14, offset: 36294},
 				run: (*parser).callonLineBreak1,
 				expr: &seqExpr{
-					pos: position{line: 996, col: 14, offset: 34226},
+					pos: position{line: 1037, col: 14, offset: 36294},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 996, col: 14, offset: 34226},
+							pos:  position{line: 1037, col: 14, offset: 36294},
 							name: "Space",
 						},
 						&litMatcher{
-							pos:        position{line: 996, col: 20, offset: 34232},
+							pos:        position{line: 1037, col: 20, offset: 36300},
 							val:        "+",
 							ignoreCase: false,
 							want:       "\"+\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 996, col: 24, offset: 34236},
+							pos: position{line: 1037, col: 24, offset: 36304},
 							expr: &ruleRefExpr{
-								pos:  position{line: 996, col: 24, offset: 34236},
+								pos:  position{line: 1037, col: 24, offset: 36304},
 								name: "Space",
 							},
 						},
 						&andExpr{
-							pos: position{line: 996, col: 31, offset: 34243},
+							pos: position{line: 1037, col: 31, offset: 36311},
 							expr: &ruleRefExpr{
-								pos:  position{line: 996, col: 32, offset: 34244},
+								pos:  position{line: 1037, col: 32, offset: 36312},
 								name: "EOL",
 							},
 						},
@@ -7054,44 +7381,61 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedText",
-			pos:  position{line: 1003, col: 1, offset: 34528},
-			expr: &choiceExpr{
-				pos: position{line: 1003, col: 15, offset: 34542},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 1003, col: 15, offset: 34542},
-						name: "UnconstrainedQuotedText",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1003, col: 41, offset: 34568},
-						name: "ConstrainedQuotedText",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1003, col: 65, offset: 34592},
-						name: "EscapedQuotedText",
+			pos:  position{line: 1044, col: 1, offset: 36596},
+			expr: &actionExpr{
+				pos: position{line: 1044, col: 15, offset: 36610},
+				run: (*parser).callonQuotedText1,
+				expr: &seqExpr{
+					pos: position{line: 1044, col: 15, offset: 36610},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 1044, col: 15, offset: 36610},
+							run: (*parser).callonQuotedText3,
+						},
+						&labeledExpr{
+							pos:   position{line: 1044, col: 44, offset: 36639},
+							label: "text",
+							expr: &choiceExpr{
+								pos: position{line: 1044, col: 50, offset: 36645},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1044, col: 50, offset: 36645},
+										name: "UnconstrainedQuotedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1044, col: 76, offset: 36671},
+										name: "ConstrainedQuotedText",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1044, col: 100, offset: 36695},
+										name: "EscapedQuotedText",
+									},
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
 			name: "ConstrainedQuotedTextMarker",
-			pos:  position{line: 1005, col: 1, offset: 34611},
+			pos:  position{line: 1048, col: 1, offset: 36777},
 			expr: &choiceExpr{
-				pos: position{line: 1005, col: 32, offset: 34642},
+				pos: position{line: 1048, col: 32, offset: 36808},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1005, col: 32, offset: 34642},
+						pos: position{line: 1048, col: 32, offset: 36808},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1005, col: 32, offset: 34642},
+								pos:        position{line: 1048, col: 32, offset: 36808},
 								val:        "*",
 								ignoreCase: false,
 								want:       "\"*\"",
 							},
 							&notExpr{
-								pos: position{line: 1005, col: 36, offset: 34646},
+								pos: position{line: 1048, col: 36, offset: 36812},
 								expr: &litMatcher{
-									pos:        position{line: 1005, col: 37, offset: 34647},
+									pos:        position{line: 1048, col: 37, offset: 36813},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -7100,18 +7444,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 1005, col: 43, offset: 34653},
+						pos: position{line: 1048, col: 43, offset: 36819},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1005, col: 43, offset: 34653},
+								pos:        position{line: 1048, col: 43, offset: 36819},
 								val:        "_",
 								ignoreCase: false,
 								want:       "\"_\"",
 							},
 							&notExpr{
-								pos: position{line: 1005, col: 47, offset: 34657},
+								pos: position{line: 1048, col: 47, offset: 36823},
 								expr: &litMatcher{
-									pos:        position{line: 1005, col: 48, offset: 34658},
+									pos:        position{line: 1048, col: 48, offset: 36824},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -7120,18 +7464,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 1005, col: 54, offset: 34664},
+						pos: position{line: 1048, col: 54, offset: 36830},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1005, col: 54, offset: 34664},
+								pos:        position{line: 1048, col: 54, offset: 36830},
 								val:        "#",
 								ignoreCase: false,
 								want:       "\"#\"",
 							},
 							&notExpr{
-								pos: position{line: 1005, col: 58, offset: 34668},
+								pos: position{line: 1048, col: 58, offset: 36834},
 								expr: &litMatcher{
-									pos:        position{line: 1005, col: 59, offset: 34669},
+									pos:        position{line: 1048, col: 59, offset: 36835},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -7140,18 +7484,18 @@ var g = &grammar{
 						},
 					},
 					&seqExpr{
-						pos: position{line: 1005, col: 65, offset: 34675},
+						pos: position{line: 1048, col: 65, offset: 36841},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1005, col: 65, offset: 34675},
+								pos:        position{line: 1048, col: 65, offset: 36841},
 								val:        "`",
 								ignoreCase: false,
 								want:       "\"`\"",
 							},
 							&notExpr{
-								pos: position{line: 1005, col: 69, offset: 34679},
+								pos: position{line: 1048, col: 69, offset: 36845},
 								expr: &litMatcher{
-									pos:        position{line: 1005, col: 70, offset: 34680},
+									pos:        position{line: 1048, col: 70, offset: 36846},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -7164,42 +7508,42 @@ var g = &grammar{
 		},
 		{
 			name: "UnconstrainedQuotedTextPrefix",
-			pos:  position{line: 1007, col: 1, offset: 34685},
+			pos:  position{line: 1050, col: 1, offset: 36851},
 			expr: &choiceExpr{
-				pos: position{line: 1007, col: 34, offset: 34718},
+				pos: position{line: 1050, col: 34, offset: 36884},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1007, col: 34, offset: 34718},
+						pos:        position{line: 1050, col: 34, offset: 36884},
 						val:        "**",
 						ignoreCase: false,
 						want:       "\"**\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1007, col: 41, offset: 34725},
+						pos:        position{line: 1050, col: 41, offset: 36891},
 						val:        "__",
 						ignoreCase: false,
 						want:       "\"__\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1007, col: 48, offset: 34732},
+						pos:        position{line: 1050, col: 48, offset: 36898},
 						val:        "``",
 						ignoreCase: false,
 						want:       "\"``\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1007, col: 55, offset: 34739},
+						pos:        position{line: 1050, col: 55, offset: 36905},
 						val:        "##",
 						ignoreCase: false,
 						want:       "\"##\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1007, col: 62, offset: 34746},
+						pos:        position{line: 1050, col: 62, offset: 36912},
 						val:        "^",
 						ignoreCase: false,
 						want:       "\"^\"",
 					},
 					&litMatcher{
-						pos:        position{line: 1007, col: 68, offset: 34752},
+						pos:        position{line: 1050, col: 68, offset: 36918},
 						val:        "~",
 						ignoreCase: false,
 						want:       "\"~\"",
@@ -7209,42 +7553,42 @@ var g = &grammar{
 		},
 		{
 			name: "ConstrainedQuotedText",
-			pos:  position{line: 1009, col: 1, offset: 34757},
+			pos:  position{line: 1052, col: 1, offset: 36923},
 			expr: &actionExpr{
-				pos: position{line: 1009, col: 26, offset: 34782},
+				pos: position{line: 1052, col: 26, offset: 36948},
 				run: (*parser).callonConstrainedQuotedText1,
 				expr: &labeledExpr{
-					pos:   position{line: 1009, col: 26, offset: 34782},
+					pos:   position{line: 1052, col: 26, offset: 36948},
 					label: "text",
 					expr: &choiceExpr{
-						pos: position{line: 1009, col: 32, offset: 34788},
+						pos: position{line: 1052, col: 32, offset: 36954},
 						alternatives: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1009, col: 32, offset: 34788},
+								pos:  position{line: 1052, col: 32, offset: 36954},
 								name: "SingleQuoteBoldText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1010, col: 15, offset: 34823},
+								pos:  position{line: 1053, col: 15, offset: 36989},
 								name: "SingleQuoteItalicText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1011, col: 15, offset: 34859},
+								pos:  position{line: 1054, col: 15, offset: 37025},
 								name: "SingleQuoteMarkedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1012, col: 15, offset: 34895},
+								pos:  position{line: 1055, col: 15, offset: 37061},
 								name: "SingleQuoteMonospaceText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1013, col: 15, offset: 34935},
+								pos:  position{line: 1056, col: 15, offset: 37101},
 								name: "SubscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1014, col: 15, offset: 34964},
+								pos:  position{line: 1057, col: 15, offset: 37130},
 								name: "SuperscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1015, col: 15, offset: 34995},
+								pos:  position{line: 1058, col: 15, offset: 37161},
 								name: "SubscriptOrSuperscriptPrefix",
 							},
 						},
@@ -7254,24 +7598,24 @@ var g = &grammar{
 		},
 		{
 			name: "UnconstrainedQuotedText",
-			pos:  position{line: 1019, col: 1, offset: 35149},
+			pos:  position{line: 1062, col: 1, offset: 37315},
 			expr: &choiceExpr{
-				pos: position{line: 1019, col: 28, offset: 35176},
+				pos: position{line: 1062, col: 28, offset: 37342},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1019, col: 28, offset: 35176},
+						pos:  position{line: 1062, col: 28, offset: 37342},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1020, col: 15, offset: 35210},
+						pos:  position{line: 1063, col: 15, offset: 37376},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1021, col: 15, offset: 35246},
+						pos:  position{line: 1064, col: 15, offset: 37412},
 						name: "DoubleQuoteMarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1022, col: 15, offset: 35282},
+						pos:  position{line: 1065, col: 15, offset: 37448},
 						name: "DoubleQuoteMonospaceText",
 					},
 				},
@@ -7279,32 +7623,32 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedQuotedText",
-			pos:  position{line: 1024, col: 1, offset: 35308},
+			pos:  position{line: 1067, col: 1, offset: 37474},
 			expr: &choiceExpr{
-				pos: position{line: 1024, col: 22, offset: 35329},
+				pos: position{line: 1067, col: 22, offset: 37495},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1024, col: 22, offset: 35329},
+						pos:  position{line: 1067, col: 22, offset: 37495},
 						name: "EscapedBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1025, col: 15, offset: 35360},
+						pos:  position{line: 1068, col: 15, offset: 37526},
 						name: "EscapedItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1026, col: 15, offset: 35392},
+						pos:  position{line: 1069, col: 15, offset: 37558},
 						name: "EscapedMarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1027, col: 15, offset: 35424},
+						pos:  position{line: 1070, col: 15, offset: 37590},
 						name: "EscapedMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1028, col: 15, offset: 35460},
+						pos:  position{line: 1071, col: 15, offset: 37626},
 						name: "EscapedSubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1029, col: 15, offset: 35496},
+						pos:  position{line: 1072, col: 15, offset: 37662},
 						name: "EscapedSuperscriptText",
 					},
 				},
@@ -7312,21 +7656,21 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptOrSuperscriptPrefix",
-			pos:  position{line: 1031, col: 1, offset: 35520},
+			pos:  position{line: 1074, col: 1, offset: 37686},
 			expr: &choiceExpr{
-				pos: position{line: 1031, col: 33, offset: 35552},
+				pos: position{line: 1074, col: 33, offset: 37718},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1031, col: 33, offset: 35552},
+						pos:        position{line: 1074, col: 33, offset: 37718},
 						val:        "^",
 						ignoreCase: false,
 						want:       "\"^\"",
 					},
 					&actionExpr{
-						pos: position{line: 1031, col: 39, offset: 35558},
+						pos: position{line: 1074, col: 39, offset: 37724},
 						run: (*parser).callonSubscriptOrSuperscriptPrefix3,
 						expr: &litMatcher{
-							pos:        position{line: 1031, col: 39, offset: 35558},
+							pos:        position{line: 1074, col: 39, offset: 37724},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
@@ -7337,14 +7681,14 @@ var g = &grammar{
 		},
 		{
 			name: "OneOrMoreBackslashes",
-			pos:  position{line: 1035, col: 1, offset: 35691},
+			pos:  position{line: 1078, col: 1, offset: 37857},
 			expr: &actionExpr{
-				pos: position{line: 1035, col: 25, offset: 35715},
+				pos: position{line: 1078, col: 25, offset: 37881},
 				run: (*parser).callonOneOrMoreBackslashes1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1035, col: 25, offset: 35715},
+					pos: position{line: 1078, col: 25, offset: 37881},
 					expr: &litMatcher{
-						pos:        position{line: 1035, col: 25, offset: 35715},
+						pos:        position{line: 1078, col: 25, offset: 37881},
 						val:        "\\",
 						ignoreCase: false,
 						want:       "\"\\\\\"",
@@ -7354,23 +7698,23 @@ var g = &grammar{
 		},
 		{
 			name: "TwoOrMoreBackslashes",
-			pos:  position{line: 1039, col: 1, offset: 35756},
+			pos:  position{line: 1082, col: 1, offset: 37922},
 			expr: &actionExpr{
-				pos: position{line: 1039, col: 25, offset: 35780},
+				pos: position{line: 1082, col: 25, offset: 37946},
 				run: (*parser).callonTwoOrMoreBackslashes1,
 				expr: &seqExpr{
-					pos: position{line: 1039, col: 25, offset: 35780},
+					pos: position{line: 1082, col: 25, offset: 37946},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1039, col: 25, offset: 35780},
+							pos:        position{line: 1082, col: 25, offset: 37946},
 							val:        "\\\\",
 							ignoreCase: false,
 							want:       "\"\\\\\\\\\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1039, col: 30, offset: 35785},
+							pos: position{line: 1082, col: 30, offset: 37951},
 							expr: &litMatcher{
-								pos:        position{line: 1039, col: 30, offset: 35785},
+								pos:        position{line: 1082, col: 30, offset: 37951},
 								val:        "\\",
 								ignoreCase: false,
 								want:       "\"\\\\\"",
@@ -7382,16 +7726,16 @@ var g = &grammar{
 		},
 		{
 			name: "BoldText",
-			pos:  position{line: 1047, col: 1, offset: 35882},
+			pos:  position{line: 1090, col: 1, offset: 38048},
 			expr: &choiceExpr{
-				pos: position{line: 1047, col: 13, offset: 35894},
+				pos: position{line: 1090, col: 13, offset: 38060},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1047, col: 13, offset: 35894},
+						pos:  position{line: 1090, col: 13, offset: 38060},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1047, col: 35, offset: 35916},
+						pos:  position{line: 1090, col: 35, offset: 38082},
 						name: "SingleQuoteBoldText",
 					},
 				},
@@ -7399,40 +7743,40 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldText",
-			pos:  position{line: 1049, col: 1, offset: 35983},
+			pos:  position{line: 1092, col: 1, offset: 38149},
 			expr: &actionExpr{
-				pos: position{line: 1049, col: 24, offset: 36006},
+				pos: position{line: 1092, col: 24, offset: 38172},
 				run: (*parser).callonDoubleQuoteBoldText1,
 				expr: &seqExpr{
-					pos: position{line: 1049, col: 24, offset: 36006},
+					pos: position{line: 1092, col: 24, offset: 38172},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1049, col: 24, offset: 36006},
+							pos:   position{line: 1092, col: 24, offset: 38172},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1049, col: 30, offset: 36012},
+								pos: position{line: 1092, col: 30, offset: 38178},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1049, col: 31, offset: 36013},
+									pos:  position{line: 1092, col: 31, offset: 38179},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1049, col: 49, offset: 36031},
+							pos:        position{line: 1092, col: 49, offset: 38197},
 							val:        "**",
 							ignoreCase: false,
 							want:       "\"**\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1049, col: 54, offset: 36036},
+							pos:   position{line: 1092, col: 54, offset: 38202},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1049, col: 64, offset: 36046},
+								pos:  position{line: 1092, col: 64, offset: 38212},
 								name: "DoubleQuoteBoldTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1049, col: 93, offset: 36075},
+							pos:        position{line: 1092, col: 93, offset: 38241},
 							val:        "**",
 							ignoreCase: false,
 							want:       "\"**\"",
@@ -7443,97 +7787,106 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextElements",
-			pos:  position{line: 1053, col: 1, offset: 36162},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 1053, col: 32, offset: 36193},
-				expr: &ruleRefExpr{
-					pos:  position{line: 1053, col: 32, offset: 36193},
-					name: "DoubleQuoteBoldTextElement",
+			pos:  position{line: 1096, col: 1, offset: 38328},
+			expr: &seqExpr{
+				pos: position{line: 1096, col: 32, offset: 38359},
+				exprs: []interface{}{
+					&andCodeExpr{
+						pos: position{line: 1096, col: 32, offset: 38359},
+						run: (*parser).callonDoubleQuoteBoldTextElements2,
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1096, col: 61, offset: 38388},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1096, col: 61, offset: 38388},
+							name: "DoubleQuoteBoldTextElement",
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "DoubleQuoteBoldTextElement",
-			pos:  position{line: 1055, col: 1, offset: 36224},
+			pos:  position{line: 1098, col: 1, offset: 38419},
 			expr: &actionExpr{
-				pos: position{line: 1055, col: 31, offset: 36254},
+				pos: position{line: 1098, col: 31, offset: 38449},
 				run: (*parser).callonDoubleQuoteBoldTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 1055, col: 31, offset: 36254},
+					pos: position{line: 1098, col: 31, offset: 38449},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1055, col: 31, offset: 36254},
+							pos: position{line: 1098, col: 31, offset: 38449},
 							expr: &litMatcher{
-								pos:        position{line: 1055, col: 33, offset: 36256},
+								pos:        position{line: 1098, col: 33, offset: 38451},
 								val:        "**",
 								ignoreCase: false,
 								want:       "\"**\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1055, col: 39, offset: 36262},
+							pos:   position{line: 1098, col: 39, offset: 38457},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1055, col: 48, offset: 36271},
+								pos: position{line: 1098, col: 48, offset: 38466},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1055, col: 48, offset: 36271},
+										pos:  position{line: 1098, col: 48, offset: 38466},
 										name: "Word",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1056, col: 11, offset: 36286},
+										pos:  position{line: 1099, col: 11, offset: 38481},
 										name: "Space",
 									},
 									&seqExpr{
-										pos: position{line: 1057, col: 11, offset: 36335},
+										pos: position{line: 1100, col: 11, offset: 38530},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1057, col: 11, offset: 36335},
+												pos:  position{line: 1100, col: 11, offset: 38530},
 												name: "Newline",
 											},
 											&notExpr{
-												pos: position{line: 1057, col: 19, offset: 36343},
+												pos: position{line: 1100, col: 19, offset: 38538},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1057, col: 20, offset: 36344},
+													pos:  position{line: 1100, col: 20, offset: 38539},
 													name: "Newline",
 												},
 											},
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1058, col: 11, offset: 36362},
+										pos:  position{line: 1101, col: 11, offset: 38557},
 										name: "SingleQuoteBoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1059, col: 11, offset: 36392},
+										pos:  position{line: 1102, col: 11, offset: 38587},
 										name: "QuotedString",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1060, col: 11, offset: 36415},
+										pos:  position{line: 1103, col: 11, offset: 38610},
 										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1061, col: 11, offset: 36436},
+										pos:  position{line: 1104, col: 11, offset: 38631},
 										name: "MarkedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1062, col: 11, offset: 36457},
+										pos:  position{line: 1105, col: 11, offset: 38652},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1063, col: 11, offset: 36481},
+										pos:  position{line: 1106, col: 11, offset: 38676},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1064, col: 11, offset: 36505},
+										pos:  position{line: 1107, col: 11, offset: 38700},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1065, col: 11, offset: 36531},
+										pos:  position{line: 1108, col: 11, offset: 38726},
 										name: "ElementPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1066, col: 11, offset: 36560},
+										pos:  position{line: 1109, col: 11, offset: 38755},
 										name: "DoubleQuoteBoldTextFallbackCharacter",
 									},
 								},
@@ -7545,31 +7898,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteBoldTextFallbackCharacter",
-			pos:  position{line: 1070, col: 1, offset: 36627},
+			pos:  position{line: 1113, col: 1, offset: 38822},
 			expr: &choiceExpr{
-				pos: position{line: 1071, col: 5, offset: 36671},
+				pos: position{line: 1114, col: 5, offset: 38866},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1071, col: 5, offset: 36671},
+						pos:        position{line: 1114, col: 5, offset: 38866},
 						val:        "[^\\r\\n*]",
 						chars:      []rune{'\r', '\n', '*'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1072, col: 7, offset: 36768},
+						pos: position{line: 1115, col: 7, offset: 38963},
 						run: (*parser).callonDoubleQuoteBoldTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1072, col: 7, offset: 36768},
+							pos: position{line: 1115, col: 7, offset: 38963},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1072, col: 7, offset: 36768},
+									pos:        position{line: 1115, col: 7, offset: 38963},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1072, col: 12, offset: 36773},
+									pos:  position{line: 1115, col: 12, offset: 38968},
 									name: "Alphanums",
 								},
 							},
@@ -7580,40 +7933,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldText",
-			pos:  position{line: 1076, col: 1, offset: 36936},
+			pos:  position{line: 1119, col: 1, offset: 39131},
 			expr: &choiceExpr{
-				pos: position{line: 1076, col: 24, offset: 36959},
+				pos: position{line: 1119, col: 24, offset: 39154},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1076, col: 24, offset: 36959},
+						pos: position{line: 1119, col: 24, offset: 39154},
 						run: (*parser).callonSingleQuoteBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 1076, col: 24, offset: 36959},
+							pos: position{line: 1119, col: 24, offset: 39154},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1076, col: 24, offset: 36959},
+									pos:   position{line: 1119, col: 24, offset: 39154},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1076, col: 30, offset: 36965},
+										pos: position{line: 1119, col: 30, offset: 39160},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1076, col: 31, offset: 36966},
+											pos:  position{line: 1119, col: 31, offset: 39161},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 1076, col: 51, offset: 36986},
+									pos: position{line: 1119, col: 51, offset: 39181},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1076, col: 51, offset: 36986},
+											pos:        position{line: 1119, col: 51, offset: 39181},
 											val:        "*",
 											ignoreCase: false,
 											want:       "\"*\"",
 										},
 										&notExpr{
-											pos: position{line: 1076, col: 55, offset: 36990},
+											pos: position{line: 1119, col: 55, offset: 39185},
 											expr: &litMatcher{
-												pos:        position{line: 1076, col: 56, offset: 36991},
+												pos:        position{line: 1119, col: 56, offset: 39186},
 												val:        "*",
 												ignoreCase: false,
 												want:       "\"*\"",
@@ -7622,25 +7975,25 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1076, col: 61, offset: 36996},
+									pos:   position{line: 1119, col: 61, offset: 39191},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1076, col: 71, offset: 37006},
+										pos:  position{line: 1119, col: 71, offset: 39201},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1076, col: 100, offset: 37035},
+									pos:        position{line: 1119, col: 100, offset: 39230},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&andExpr{
-									pos: position{line: 1076, col: 104, offset: 37039},
+									pos: position{line: 1119, col: 104, offset: 39234},
 									expr: &notExpr{
-										pos: position{line: 1076, col: 106, offset: 37041},
+										pos: position{line: 1119, col: 106, offset: 39236},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1076, col: 107, offset: 37042},
+											pos:  position{line: 1119, col: 107, offset: 39237},
 											name: "Alphanum",
 										},
 									},
@@ -7649,49 +8002,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1078, col: 5, offset: 37236},
+						pos: position{line: 1121, col: 5, offset: 39431},
 						run: (*parser).callonSingleQuoteBoldText17,
 						expr: &seqExpr{
-							pos: position{line: 1078, col: 5, offset: 37236},
+							pos: position{line: 1121, col: 5, offset: 39431},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1078, col: 5, offset: 37236},
+									pos:   position{line: 1121, col: 5, offset: 39431},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1078, col: 11, offset: 37242},
+										pos: position{line: 1121, col: 11, offset: 39437},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1078, col: 12, offset: 37243},
+											pos:  position{line: 1121, col: 12, offset: 39438},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1078, col: 30, offset: 37261},
+									pos:        position{line: 1121, col: 30, offset: 39456},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1078, col: 34, offset: 37265},
+									pos:   position{line: 1121, col: 34, offset: 39460},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1078, col: 44, offset: 37275},
+										pos: position{line: 1121, col: 44, offset: 39470},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1078, col: 44, offset: 37275},
+												pos:        position{line: 1121, col: 44, offset: 39470},
 												val:        "*",
 												ignoreCase: false,
 												want:       "\"*\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1078, col: 48, offset: 37279},
+												pos:  position{line: 1121, col: 48, offset: 39474},
 												name: "SingleQuoteBoldTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1078, col: 77, offset: 37308},
+									pos:        position{line: 1121, col: 77, offset: 39503},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -7704,21 +8057,25 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextElements",
-			pos:  position{line: 1082, col: 1, offset: 37514},
+			pos:  position{line: 1125, col: 1, offset: 39709},
 			expr: &seqExpr{
-				pos: position{line: 1082, col: 32, offset: 37545},
+				pos: position{line: 1125, col: 32, offset: 39740},
 				exprs: []interface{}{
+					&andCodeExpr{
+						pos: position{line: 1125, col: 32, offset: 39740},
+						run: (*parser).callonSingleQuoteBoldTextElements2,
+					},
 					&notExpr{
-						pos: position{line: 1082, col: 32, offset: 37545},
+						pos: position{line: 1125, col: 61, offset: 39769},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1082, col: 33, offset: 37546},
+							pos:  position{line: 1125, col: 62, offset: 39770},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1082, col: 39, offset: 37552},
+						pos: position{line: 1125, col: 68, offset: 39776},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1082, col: 39, offset: 37552},
+							pos:  position{line: 1125, col: 68, offset: 39776},
 							name: "SingleQuoteBoldTextElement",
 						},
 					},
@@ -7727,63 +8084,63 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextElement",
-			pos:  position{line: 1084, col: 1, offset: 37581},
+			pos:  position{line: 1127, col: 1, offset: 39805},
 			expr: &choiceExpr{
-				pos: position{line: 1084, col: 31, offset: 37611},
+				pos: position{line: 1127, col: 31, offset: 39835},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1084, col: 31, offset: 37611},
+						pos:  position{line: 1127, col: 31, offset: 39835},
 						name: "Word",
 					},
 					&seqExpr{
-						pos: position{line: 1085, col: 11, offset: 37626},
+						pos: position{line: 1128, col: 11, offset: 39850},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1085, col: 11, offset: 37626},
+								pos:  position{line: 1128, col: 11, offset: 39850},
 								name: "Newline",
 							},
 							&notExpr{
-								pos: position{line: 1085, col: 19, offset: 37634},
+								pos: position{line: 1128, col: 19, offset: 39858},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1085, col: 20, offset: 37635},
+									pos:  position{line: 1128, col: 20, offset: 39859},
 									name: "Newline",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1086, col: 11, offset: 37653},
+						pos:  position{line: 1129, col: 11, offset: 39877},
 						name: "DoubleQuoteBoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1087, col: 11, offset: 37683},
+						pos:  position{line: 1130, col: 11, offset: 39907},
 						name: "QuotedString",
 					},
 					&seqExpr{
-						pos: position{line: 1088, col: 11, offset: 37706},
+						pos: position{line: 1131, col: 11, offset: 39930},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1088, col: 11, offset: 37706},
+								pos: position{line: 1131, col: 11, offset: 39930},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1088, col: 11, offset: 37706},
+									pos:  position{line: 1131, col: 11, offset: 39930},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1088, col: 18, offset: 37713},
+								pos: position{line: 1131, col: 18, offset: 39937},
 								expr: &seqExpr{
-									pos: position{line: 1088, col: 19, offset: 37714},
+									pos: position{line: 1131, col: 19, offset: 39938},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1088, col: 19, offset: 37714},
+											pos:        position{line: 1131, col: 19, offset: 39938},
 											val:        "*",
 											ignoreCase: false,
 											want:       "\"*\"",
 										},
 										&notExpr{
-											pos: position{line: 1088, col: 23, offset: 37718},
+											pos: position{line: 1131, col: 23, offset: 39942},
 											expr: &litMatcher{
-												pos:        position{line: 1088, col: 24, offset: 37719},
+												pos:        position{line: 1131, col: 24, offset: 39943},
 												val:        "*",
 												ignoreCase: false,
 												want:       "\"*\"",
@@ -7795,31 +8152,31 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1089, col: 11, offset: 37735},
+						pos:  position{line: 1132, col: 11, offset: 39959},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1090, col: 11, offset: 37756},
+						pos:  position{line: 1133, col: 11, offset: 39980},
 						name: "MarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1091, col: 11, offset: 37777},
+						pos:  position{line: 1134, col: 11, offset: 40001},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1092, col: 11, offset: 37801},
+						pos:  position{line: 1135, col: 11, offset: 40025},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1093, col: 11, offset: 37825},
+						pos:  position{line: 1136, col: 11, offset: 40049},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1094, col: 11, offset: 37851},
+						pos:  position{line: 1137, col: 11, offset: 40075},
 						name: "ElementPlaceHolder",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1095, col: 11, offset: 37880},
+						pos:  position{line: 1138, col: 11, offset: 40104},
 						name: "SingleQuoteBoldTextFallbackCharacter",
 					},
 				},
@@ -7827,31 +8184,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteBoldTextFallbackCharacter",
-			pos:  position{line: 1097, col: 1, offset: 37918},
+			pos:  position{line: 1140, col: 1, offset: 40142},
 			expr: &choiceExpr{
-				pos: position{line: 1098, col: 5, offset: 37962},
+				pos: position{line: 1141, col: 5, offset: 40186},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1098, col: 5, offset: 37962},
+						pos:        position{line: 1141, col: 5, offset: 40186},
 						val:        "[^\\r\\n*]",
 						chars:      []rune{'\r', '\n', '*'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1099, col: 7, offset: 38059},
+						pos: position{line: 1142, col: 7, offset: 40283},
 						run: (*parser).callonSingleQuoteBoldTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1099, col: 7, offset: 38059},
+							pos: position{line: 1142, col: 7, offset: 40283},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1099, col: 7, offset: 38059},
+									pos:        position{line: 1142, col: 7, offset: 40283},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1099, col: 11, offset: 38063},
+									pos:  position{line: 1142, col: 11, offset: 40287},
 									name: "Alphanums",
 								},
 							},
@@ -7862,40 +8219,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedBoldText",
-			pos:  position{line: 1103, col: 1, offset: 38226},
+			pos:  position{line: 1146, col: 1, offset: 40450},
 			expr: &choiceExpr{
-				pos: position{line: 1104, col: 5, offset: 38250},
+				pos: position{line: 1147, col: 5, offset: 40474},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1104, col: 5, offset: 38250},
+						pos: position{line: 1147, col: 5, offset: 40474},
 						run: (*parser).callonEscapedBoldText2,
 						expr: &seqExpr{
-							pos: position{line: 1104, col: 5, offset: 38250},
+							pos: position{line: 1147, col: 5, offset: 40474},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1104, col: 5, offset: 38250},
+									pos:   position{line: 1147, col: 5, offset: 40474},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1104, col: 18, offset: 38263},
+										pos:  position{line: 1147, col: 18, offset: 40487},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1104, col: 40, offset: 38285},
+									pos:        position{line: 1147, col: 40, offset: 40509},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1104, col: 45, offset: 38290},
+									pos:   position{line: 1147, col: 45, offset: 40514},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1104, col: 55, offset: 38300},
+										pos:  position{line: 1147, col: 55, offset: 40524},
 										name: "DoubleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1104, col: 84, offset: 38329},
+									pos:        position{line: 1147, col: 84, offset: 40553},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
@@ -7904,35 +8261,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1106, col: 9, offset: 38486},
+						pos: position{line: 1149, col: 9, offset: 40710},
 						run: (*parser).callonEscapedBoldText10,
 						expr: &seqExpr{
-							pos: position{line: 1106, col: 9, offset: 38486},
+							pos: position{line: 1149, col: 9, offset: 40710},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1106, col: 9, offset: 38486},
+									pos:   position{line: 1149, col: 9, offset: 40710},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1106, col: 22, offset: 38499},
+										pos:  position{line: 1149, col: 22, offset: 40723},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1106, col: 44, offset: 38521},
+									pos:        position{line: 1149, col: 44, offset: 40745},
 									val:        "**",
 									ignoreCase: false,
 									want:       "\"**\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1106, col: 49, offset: 38526},
+									pos:   position{line: 1149, col: 49, offset: 40750},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1106, col: 59, offset: 38536},
+										pos:  position{line: 1149, col: 59, offset: 40760},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1106, col: 88, offset: 38565},
+									pos:        position{line: 1149, col: 88, offset: 40789},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -7941,35 +8298,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1109, col: 9, offset: 38765},
+						pos: position{line: 1152, col: 9, offset: 40989},
 						run: (*parser).callonEscapedBoldText18,
 						expr: &seqExpr{
-							pos: position{line: 1109, col: 9, offset: 38765},
+							pos: position{line: 1152, col: 9, offset: 40989},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1109, col: 9, offset: 38765},
+									pos:   position{line: 1152, col: 9, offset: 40989},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1109, col: 22, offset: 38778},
+										pos:  position{line: 1152, col: 22, offset: 41002},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1109, col: 44, offset: 38800},
+									pos:        position{line: 1152, col: 44, offset: 41024},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1109, col: 48, offset: 38804},
+									pos:   position{line: 1152, col: 48, offset: 41028},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1109, col: 58, offset: 38814},
+										pos:  position{line: 1152, col: 58, offset: 41038},
 										name: "SingleQuoteBoldTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1109, col: 87, offset: 38843},
+									pos:        position{line: 1152, col: 87, offset: 41067},
 									val:        "*",
 									ignoreCase: false,
 									want:       "\"*\"",
@@ -7982,16 +8339,16 @@ var g = &grammar{
 		},
 		{
 			name: "ItalicText",
-			pos:  position{line: 1117, col: 1, offset: 39051},
+			pos:  position{line: 1160, col: 1, offset: 41275},
 			expr: &choiceExpr{
-				pos: position{line: 1117, col: 15, offset: 39065},
+				pos: position{line: 1160, col: 15, offset: 41289},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1117, col: 15, offset: 39065},
+						pos:  position{line: 1160, col: 15, offset: 41289},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1117, col: 39, offset: 39089},
+						pos:  position{line: 1160, col: 39, offset: 41313},
 						name: "SingleQuoteItalicText",
 					},
 				},
@@ -7999,40 +8356,40 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicText",
-			pos:  position{line: 1119, col: 1, offset: 39112},
+			pos:  position{line: 1162, col: 1, offset: 41336},
 			expr: &actionExpr{
-				pos: position{line: 1119, col: 26, offset: 39137},
+				pos: position{line: 1162, col: 26, offset: 41361},
 				run: (*parser).callonDoubleQuoteItalicText1,
 				expr: &seqExpr{
-					pos: position{line: 1119, col: 26, offset: 39137},
+					pos: position{line: 1162, col: 26, offset: 41361},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1119, col: 26, offset: 39137},
+							pos:   position{line: 1162, col: 26, offset: 41361},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1119, col: 32, offset: 39143},
+								pos: position{line: 1162, col: 32, offset: 41367},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1119, col: 33, offset: 39144},
+									pos:  position{line: 1162, col: 33, offset: 41368},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1119, col: 51, offset: 39162},
+							pos:        position{line: 1162, col: 51, offset: 41386},
 							val:        "__",
 							ignoreCase: false,
 							want:       "\"__\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1119, col: 56, offset: 39167},
+							pos:   position{line: 1162, col: 56, offset: 41391},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1119, col: 66, offset: 39177},
+								pos:  position{line: 1162, col: 66, offset: 41401},
 								name: "DoubleQuoteItalicTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1119, col: 97, offset: 39208},
+							pos:        position{line: 1162, col: 97, offset: 41432},
 							val:        "__",
 							ignoreCase: false,
 							want:       "\"__\"",
@@ -8043,97 +8400,106 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextElements",
-			pos:  position{line: 1123, col: 1, offset: 39342},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 1123, col: 34, offset: 39375},
-				expr: &ruleRefExpr{
-					pos:  position{line: 1123, col: 34, offset: 39375},
-					name: "DoubleQuoteItalicTextElement",
+			pos:  position{line: 1166, col: 1, offset: 41566},
+			expr: &seqExpr{
+				pos: position{line: 1166, col: 34, offset: 41599},
+				exprs: []interface{}{
+					&andCodeExpr{
+						pos: position{line: 1166, col: 34, offset: 41599},
+						run: (*parser).callonDoubleQuoteItalicTextElements2,
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1166, col: 63, offset: 41628},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1166, col: 63, offset: 41628},
+							name: "DoubleQuoteItalicTextElement",
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "DoubleQuoteItalicTextElement",
-			pos:  position{line: 1125, col: 1, offset: 39407},
+			pos:  position{line: 1168, col: 1, offset: 41660},
 			expr: &actionExpr{
-				pos: position{line: 1125, col: 33, offset: 39439},
+				pos: position{line: 1168, col: 33, offset: 41692},
 				run: (*parser).callonDoubleQuoteItalicTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 1125, col: 33, offset: 39439},
+					pos: position{line: 1168, col: 33, offset: 41692},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1125, col: 33, offset: 39439},
+							pos: position{line: 1168, col: 33, offset: 41692},
 							expr: &litMatcher{
-								pos:        position{line: 1125, col: 35, offset: 39441},
+								pos:        position{line: 1168, col: 35, offset: 41694},
 								val:        "__",
 								ignoreCase: false,
 								want:       "\"__\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1125, col: 41, offset: 39447},
+							pos:   position{line: 1168, col: 41, offset: 41700},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1125, col: 50, offset: 39456},
+								pos: position{line: 1168, col: 50, offset: 41709},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1125, col: 50, offset: 39456},
+										pos:  position{line: 1168, col: 50, offset: 41709},
 										name: "Word",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1126, col: 11, offset: 39471},
+										pos:  position{line: 1169, col: 11, offset: 41724},
 										name: "Space",
 									},
 									&seqExpr{
-										pos: position{line: 1127, col: 11, offset: 39520},
+										pos: position{line: 1170, col: 11, offset: 41773},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1127, col: 11, offset: 39520},
+												pos:  position{line: 1170, col: 11, offset: 41773},
 												name: "Newline",
 											},
 											&notExpr{
-												pos: position{line: 1127, col: 19, offset: 39528},
+												pos: position{line: 1170, col: 19, offset: 41781},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1127, col: 20, offset: 39529},
+													pos:  position{line: 1170, col: 20, offset: 41782},
 													name: "Newline",
 												},
 											},
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1128, col: 11, offset: 39547},
+										pos:  position{line: 1171, col: 11, offset: 41800},
 										name: "SingleQuoteItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1129, col: 11, offset: 39579},
+										pos:  position{line: 1172, col: 11, offset: 41832},
 										name: "QuotedString",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1130, col: 11, offset: 39602},
+										pos:  position{line: 1173, col: 11, offset: 41855},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1131, col: 11, offset: 39621},
+										pos:  position{line: 1174, col: 11, offset: 41874},
 										name: "MarkedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1132, col: 11, offset: 39642},
+										pos:  position{line: 1175, col: 11, offset: 41895},
 										name: "MonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1133, col: 11, offset: 39666},
+										pos:  position{line: 1176, col: 11, offset: 41919},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1134, col: 11, offset: 39690},
+										pos:  position{line: 1177, col: 11, offset: 41943},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1135, col: 11, offset: 39716},
+										pos:  position{line: 1178, col: 11, offset: 41969},
 										name: "ElementPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1136, col: 11, offset: 39745},
+										pos:  position{line: 1179, col: 11, offset: 41998},
 										name: "DoubleQuoteItalicTextFallbackCharacter",
 									},
 								},
@@ -8145,31 +8511,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteItalicTextFallbackCharacter",
-			pos:  position{line: 1140, col: 1, offset: 39814},
+			pos:  position{line: 1183, col: 1, offset: 42067},
 			expr: &choiceExpr{
-				pos: position{line: 1141, col: 5, offset: 39860},
+				pos: position{line: 1184, col: 5, offset: 42113},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1141, col: 5, offset: 39860},
+						pos:        position{line: 1184, col: 5, offset: 42113},
 						val:        "[^\\r\\n_]",
 						chars:      []rune{'\r', '\n', '_'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1142, col: 7, offset: 39959},
+						pos: position{line: 1185, col: 7, offset: 42212},
 						run: (*parser).callonDoubleQuoteItalicTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1142, col: 7, offset: 39959},
+							pos: position{line: 1185, col: 7, offset: 42212},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1142, col: 7, offset: 39959},
+									pos:        position{line: 1185, col: 7, offset: 42212},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1142, col: 12, offset: 39964},
+									pos:  position{line: 1185, col: 12, offset: 42217},
 									name: "Alphanums",
 								},
 							},
@@ -8180,40 +8546,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicText",
-			pos:  position{line: 1146, col: 1, offset: 40129},
+			pos:  position{line: 1189, col: 1, offset: 42382},
 			expr: &choiceExpr{
-				pos: position{line: 1146, col: 26, offset: 40154},
+				pos: position{line: 1189, col: 26, offset: 42407},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1146, col: 26, offset: 40154},
+						pos: position{line: 1189, col: 26, offset: 42407},
 						run: (*parser).callonSingleQuoteItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 1146, col: 26, offset: 40154},
+							pos: position{line: 1189, col: 26, offset: 42407},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1146, col: 26, offset: 40154},
+									pos:   position{line: 1189, col: 26, offset: 42407},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1146, col: 32, offset: 40160},
+										pos: position{line: 1189, col: 32, offset: 42413},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1146, col: 33, offset: 40161},
+											pos:  position{line: 1189, col: 33, offset: 42414},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 1146, col: 52, offset: 40180},
+									pos: position{line: 1189, col: 52, offset: 42433},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1146, col: 52, offset: 40180},
+											pos:        position{line: 1189, col: 52, offset: 42433},
 											val:        "_",
 											ignoreCase: false,
 											want:       "\"_\"",
 										},
 										&notExpr{
-											pos: position{line: 1146, col: 56, offset: 40184},
+											pos: position{line: 1189, col: 56, offset: 42437},
 											expr: &litMatcher{
-												pos:        position{line: 1146, col: 57, offset: 40185},
+												pos:        position{line: 1189, col: 57, offset: 42438},
 												val:        "_",
 												ignoreCase: false,
 												want:       "\"_\"",
@@ -8222,15 +8588,15 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1146, col: 62, offset: 40190},
+									pos:   position{line: 1189, col: 62, offset: 42443},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1146, col: 72, offset: 40200},
+										pos:  position{line: 1189, col: 72, offset: 42453},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1146, col: 103, offset: 40231},
+									pos:        position{line: 1189, col: 103, offset: 42484},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -8239,49 +8605,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1148, col: 5, offset: 40421},
+						pos: position{line: 1191, col: 5, offset: 42674},
 						run: (*parser).callonSingleQuoteItalicText14,
 						expr: &seqExpr{
-							pos: position{line: 1148, col: 5, offset: 40421},
+							pos: position{line: 1191, col: 5, offset: 42674},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1148, col: 5, offset: 40421},
+									pos:   position{line: 1191, col: 5, offset: 42674},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1148, col: 11, offset: 40427},
+										pos: position{line: 1191, col: 11, offset: 42680},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1148, col: 12, offset: 40428},
+											pos:  position{line: 1191, col: 12, offset: 42681},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1148, col: 30, offset: 40446},
+									pos:        position{line: 1191, col: 30, offset: 42699},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1148, col: 34, offset: 40450},
+									pos:   position{line: 1191, col: 34, offset: 42703},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1148, col: 44, offset: 40460},
+										pos: position{line: 1191, col: 44, offset: 42713},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1148, col: 44, offset: 40460},
+												pos:        position{line: 1191, col: 44, offset: 42713},
 												val:        "_",
 												ignoreCase: false,
 												want:       "\"_\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1148, col: 48, offset: 40464},
+												pos:  position{line: 1191, col: 48, offset: 42717},
 												name: "SingleQuoteItalicTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1148, col: 79, offset: 40495},
+									pos:        position{line: 1191, col: 79, offset: 42748},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -8294,21 +8660,25 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextElements",
-			pos:  position{line: 1152, col: 1, offset: 40705},
+			pos:  position{line: 1195, col: 1, offset: 42958},
 			expr: &seqExpr{
-				pos: position{line: 1152, col: 34, offset: 40738},
+				pos: position{line: 1195, col: 34, offset: 42991},
 				exprs: []interface{}{
+					&andCodeExpr{
+						pos: position{line: 1195, col: 34, offset: 42991},
+						run: (*parser).callonSingleQuoteItalicTextElements2,
+					},
 					&notExpr{
-						pos: position{line: 1152, col: 34, offset: 40738},
+						pos: position{line: 1195, col: 63, offset: 43020},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1152, col: 35, offset: 40739},
+							pos:  position{line: 1195, col: 64, offset: 43021},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1152, col: 41, offset: 40745},
+						pos: position{line: 1195, col: 70, offset: 43027},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1152, col: 41, offset: 40745},
+							pos:  position{line: 1195, col: 70, offset: 43027},
 							name: "SingleQuoteItalicTextElement",
 						},
 					},
@@ -8317,63 +8687,63 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextElement",
-			pos:  position{line: 1154, col: 1, offset: 40776},
+			pos:  position{line: 1197, col: 1, offset: 43058},
 			expr: &choiceExpr{
-				pos: position{line: 1154, col: 33, offset: 40808},
+				pos: position{line: 1197, col: 33, offset: 43090},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1154, col: 33, offset: 40808},
+						pos:  position{line: 1197, col: 33, offset: 43090},
 						name: "Word",
 					},
 					&seqExpr{
-						pos: position{line: 1155, col: 11, offset: 40823},
+						pos: position{line: 1198, col: 11, offset: 43105},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1155, col: 11, offset: 40823},
+								pos:  position{line: 1198, col: 11, offset: 43105},
 								name: "Newline",
 							},
 							&notExpr{
-								pos: position{line: 1155, col: 19, offset: 40831},
+								pos: position{line: 1198, col: 19, offset: 43113},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1155, col: 20, offset: 40832},
+									pos:  position{line: 1198, col: 20, offset: 43114},
 									name: "Newline",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1156, col: 11, offset: 40850},
+						pos:  position{line: 1199, col: 11, offset: 43132},
 						name: "DoubleQuoteItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1157, col: 11, offset: 40882},
+						pos:  position{line: 1200, col: 11, offset: 43164},
 						name: "QuotedString",
 					},
 					&seqExpr{
-						pos: position{line: 1158, col: 11, offset: 40905},
+						pos: position{line: 1201, col: 11, offset: 43187},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1158, col: 11, offset: 40905},
+								pos: position{line: 1201, col: 11, offset: 43187},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1158, col: 11, offset: 40905},
+									pos:  position{line: 1201, col: 11, offset: 43187},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1158, col: 18, offset: 40912},
+								pos: position{line: 1201, col: 18, offset: 43194},
 								expr: &seqExpr{
-									pos: position{line: 1158, col: 19, offset: 40913},
+									pos: position{line: 1201, col: 19, offset: 43195},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1158, col: 19, offset: 40913},
+											pos:        position{line: 1201, col: 19, offset: 43195},
 											val:        "_",
 											ignoreCase: false,
 											want:       "\"_\"",
 										},
 										&notExpr{
-											pos: position{line: 1158, col: 23, offset: 40917},
+											pos: position{line: 1201, col: 23, offset: 43199},
 											expr: &litMatcher{
-												pos:        position{line: 1158, col: 24, offset: 40918},
+												pos:        position{line: 1201, col: 24, offset: 43200},
 												val:        "_",
 												ignoreCase: false,
 												want:       "\"_\"",
@@ -8385,31 +8755,31 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1159, col: 11, offset: 40934},
+						pos:  position{line: 1202, col: 11, offset: 43216},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1160, col: 11, offset: 40953},
+						pos:  position{line: 1203, col: 11, offset: 43235},
 						name: "MarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1161, col: 11, offset: 40974},
+						pos:  position{line: 1204, col: 11, offset: 43256},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1162, col: 11, offset: 40998},
+						pos:  position{line: 1205, col: 11, offset: 43280},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1163, col: 11, offset: 41022},
+						pos:  position{line: 1206, col: 11, offset: 43304},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1164, col: 11, offset: 41048},
+						pos:  position{line: 1207, col: 11, offset: 43330},
 						name: "ElementPlaceHolder",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1165, col: 11, offset: 41077},
+						pos:  position{line: 1208, col: 11, offset: 43359},
 						name: "SingleQuoteItalicTextFallbackCharacter",
 					},
 				},
@@ -8417,31 +8787,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteItalicTextFallbackCharacter",
-			pos:  position{line: 1167, col: 1, offset: 41117},
+			pos:  position{line: 1210, col: 1, offset: 43399},
 			expr: &choiceExpr{
-				pos: position{line: 1168, col: 5, offset: 41163},
+				pos: position{line: 1211, col: 5, offset: 43445},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1168, col: 5, offset: 41163},
+						pos:        position{line: 1211, col: 5, offset: 43445},
 						val:        "[^\\r\\n_]",
 						chars:      []rune{'\r', '\n', '_'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1169, col: 7, offset: 41262},
+						pos: position{line: 1212, col: 7, offset: 43544},
 						run: (*parser).callonSingleQuoteItalicTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1169, col: 7, offset: 41262},
+							pos: position{line: 1212, col: 7, offset: 43544},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1169, col: 7, offset: 41262},
+									pos:        position{line: 1212, col: 7, offset: 43544},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1169, col: 11, offset: 41266},
+									pos:  position{line: 1212, col: 11, offset: 43548},
 									name: "Alphanums",
 								},
 							},
@@ -8452,40 +8822,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedItalicText",
-			pos:  position{line: 1173, col: 1, offset: 41432},
+			pos:  position{line: 1216, col: 1, offset: 43714},
 			expr: &choiceExpr{
-				pos: position{line: 1174, col: 5, offset: 41458},
+				pos: position{line: 1217, col: 5, offset: 43740},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1174, col: 5, offset: 41458},
+						pos: position{line: 1217, col: 5, offset: 43740},
 						run: (*parser).callonEscapedItalicText2,
 						expr: &seqExpr{
-							pos: position{line: 1174, col: 5, offset: 41458},
+							pos: position{line: 1217, col: 5, offset: 43740},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1174, col: 5, offset: 41458},
+									pos:   position{line: 1217, col: 5, offset: 43740},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1174, col: 18, offset: 41471},
+										pos:  position{line: 1217, col: 18, offset: 43753},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1174, col: 40, offset: 41493},
+									pos:        position{line: 1217, col: 40, offset: 43775},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1174, col: 45, offset: 41498},
+									pos:   position{line: 1217, col: 45, offset: 43780},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1174, col: 55, offset: 41508},
+										pos:  position{line: 1217, col: 55, offset: 43790},
 										name: "DoubleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1174, col: 86, offset: 41539},
+									pos:        position{line: 1217, col: 86, offset: 43821},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
@@ -8494,35 +8864,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1176, col: 9, offset: 41696},
+						pos: position{line: 1219, col: 9, offset: 43978},
 						run: (*parser).callonEscapedItalicText10,
 						expr: &seqExpr{
-							pos: position{line: 1176, col: 9, offset: 41696},
+							pos: position{line: 1219, col: 9, offset: 43978},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1176, col: 9, offset: 41696},
+									pos:   position{line: 1219, col: 9, offset: 43978},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1176, col: 22, offset: 41709},
+										pos:  position{line: 1219, col: 22, offset: 43991},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1176, col: 44, offset: 41731},
+									pos:        position{line: 1219, col: 44, offset: 44013},
 									val:        "__",
 									ignoreCase: false,
 									want:       "\"__\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1176, col: 49, offset: 41736},
+									pos:   position{line: 1219, col: 49, offset: 44018},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1176, col: 59, offset: 41746},
+										pos:  position{line: 1219, col: 59, offset: 44028},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1176, col: 90, offset: 41777},
+									pos:        position{line: 1219, col: 90, offset: 44059},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -8531,35 +8901,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1179, col: 9, offset: 41977},
+						pos: position{line: 1222, col: 9, offset: 44259},
 						run: (*parser).callonEscapedItalicText18,
 						expr: &seqExpr{
-							pos: position{line: 1179, col: 9, offset: 41977},
+							pos: position{line: 1222, col: 9, offset: 44259},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1179, col: 9, offset: 41977},
+									pos:   position{line: 1222, col: 9, offset: 44259},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1179, col: 22, offset: 41990},
+										pos:  position{line: 1222, col: 22, offset: 44272},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1179, col: 44, offset: 42012},
+									pos:        position{line: 1222, col: 44, offset: 44294},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1179, col: 48, offset: 42016},
+									pos:   position{line: 1222, col: 48, offset: 44298},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1179, col: 58, offset: 42026},
+										pos:  position{line: 1222, col: 58, offset: 44308},
 										name: "SingleQuoteItalicTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1179, col: 89, offset: 42057},
+									pos:        position{line: 1222, col: 89, offset: 44339},
 									val:        "_",
 									ignoreCase: false,
 									want:       "\"_\"",
@@ -8572,16 +8942,16 @@ var g = &grammar{
 		},
 		{
 			name: "MonospaceText",
-			pos:  position{line: 1186, col: 1, offset: 42267},
+			pos:  position{line: 1229, col: 1, offset: 44549},
 			expr: &choiceExpr{
-				pos: position{line: 1186, col: 18, offset: 42284},
+				pos: position{line: 1229, col: 18, offset: 44566},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1186, col: 18, offset: 42284},
+						pos:  position{line: 1229, col: 18, offset: 44566},
 						name: "DoubleQuoteMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1186, col: 45, offset: 42311},
+						pos:  position{line: 1229, col: 45, offset: 44593},
 						name: "SingleQuoteMonospaceText",
 					},
 				},
@@ -8589,40 +8959,40 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceText",
-			pos:  position{line: 1188, col: 1, offset: 42337},
+			pos:  position{line: 1231, col: 1, offset: 44619},
 			expr: &actionExpr{
-				pos: position{line: 1188, col: 29, offset: 42365},
+				pos: position{line: 1231, col: 29, offset: 44647},
 				run: (*parser).callonDoubleQuoteMonospaceText1,
 				expr: &seqExpr{
-					pos: position{line: 1188, col: 29, offset: 42365},
+					pos: position{line: 1231, col: 29, offset: 44647},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1188, col: 29, offset: 42365},
+							pos:   position{line: 1231, col: 29, offset: 44647},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1188, col: 35, offset: 42371},
+								pos: position{line: 1231, col: 35, offset: 44653},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1188, col: 36, offset: 42372},
+									pos:  position{line: 1231, col: 36, offset: 44654},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1188, col: 54, offset: 42390},
+							pos:        position{line: 1231, col: 54, offset: 44672},
 							val:        "``",
 							ignoreCase: false,
 							want:       "\"``\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1188, col: 59, offset: 42395},
+							pos:   position{line: 1231, col: 59, offset: 44677},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1188, col: 69, offset: 42405},
+								pos:  position{line: 1231, col: 69, offset: 44687},
 								name: "DoubleQuoteMonospaceTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1188, col: 103, offset: 42439},
+							pos:        position{line: 1231, col: 103, offset: 44721},
 							val:        "``",
 							ignoreCase: false,
 							want:       "\"``\"",
@@ -8633,105 +9003,114 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextElements",
-			pos:  position{line: 1192, col: 1, offset: 42576},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 1192, col: 37, offset: 42612},
-				expr: &ruleRefExpr{
-					pos:  position{line: 1192, col: 37, offset: 42612},
-					name: "DoubleQuoteMonospaceTextElement",
+			pos:  position{line: 1235, col: 1, offset: 44858},
+			expr: &seqExpr{
+				pos: position{line: 1235, col: 37, offset: 44894},
+				exprs: []interface{}{
+					&andCodeExpr{
+						pos: position{line: 1235, col: 37, offset: 44894},
+						run: (*parser).callonDoubleQuoteMonospaceTextElements2,
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1235, col: 66, offset: 44923},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1235, col: 66, offset: 44923},
+							name: "DoubleQuoteMonospaceTextElement",
+						},
+					},
 				},
 			},
 		},
 		{
 			name: "DoubleQuoteMonospaceTextElement",
-			pos:  position{line: 1194, col: 1, offset: 42679},
+			pos:  position{line: 1237, col: 1, offset: 44990},
 			expr: &actionExpr{
-				pos: position{line: 1194, col: 36, offset: 42714},
+				pos: position{line: 1237, col: 36, offset: 45025},
 				run: (*parser).callonDoubleQuoteMonospaceTextElement1,
 				expr: &seqExpr{
-					pos: position{line: 1194, col: 36, offset: 42714},
+					pos: position{line: 1237, col: 36, offset: 45025},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 1194, col: 36, offset: 42714},
+							pos: position{line: 1237, col: 36, offset: 45025},
 							expr: &litMatcher{
-								pos:        position{line: 1194, col: 38, offset: 42716},
+								pos:        position{line: 1237, col: 38, offset: 45027},
 								val:        "``",
 								ignoreCase: false,
 								want:       "\"``\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1194, col: 44, offset: 42722},
+							pos:   position{line: 1237, col: 44, offset: 45033},
 							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1194, col: 53, offset: 42731},
+								pos: position{line: 1237, col: 53, offset: 45042},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1194, col: 53, offset: 42731},
+										pos:  position{line: 1237, col: 53, offset: 45042},
 										name: "Word",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1195, col: 11, offset: 42746},
+										pos:  position{line: 1238, col: 11, offset: 45057},
 										name: "Space",
 									},
 									&seqExpr{
-										pos: position{line: 1196, col: 11, offset: 42795},
+										pos: position{line: 1239, col: 11, offset: 45106},
 										exprs: []interface{}{
 											&ruleRefExpr{
-												pos:  position{line: 1196, col: 11, offset: 42795},
+												pos:  position{line: 1239, col: 11, offset: 45106},
 												name: "Newline",
 											},
 											&notExpr{
-												pos: position{line: 1196, col: 19, offset: 42803},
+												pos: position{line: 1239, col: 19, offset: 45114},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1196, col: 20, offset: 42804},
+													pos:  position{line: 1239, col: 20, offset: 45115},
 													name: "Newline",
 												},
 											},
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1197, col: 11, offset: 42822},
+										pos:  position{line: 1240, col: 11, offset: 45133},
 										name: "QuotedString",
 									},
 									&actionExpr{
-										pos: position{line: 1198, col: 11, offset: 42845},
+										pos: position{line: 1241, col: 11, offset: 45156},
 										run: (*parser).callonDoubleQuoteMonospaceTextElement14,
 										expr: &ruleRefExpr{
-											pos:  position{line: 1198, col: 11, offset: 42845},
+											pos:  position{line: 1241, col: 11, offset: 45156},
 											name: "Apostrophe",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1202, col: 11, offset: 43029},
+										pos:  position{line: 1245, col: 11, offset: 45340},
 										name: "SingleQuoteMonospaceText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1203, col: 11, offset: 43064},
+										pos:  position{line: 1246, col: 11, offset: 45375},
 										name: "BoldText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1204, col: 11, offset: 43083},
+										pos:  position{line: 1247, col: 11, offset: 45394},
 										name: "ItalicText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1205, col: 11, offset: 43104},
+										pos:  position{line: 1248, col: 11, offset: 45415},
 										name: "MarkedText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1206, col: 11, offset: 43125},
+										pos:  position{line: 1249, col: 11, offset: 45436},
 										name: "SubscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1207, col: 11, offset: 43149},
+										pos:  position{line: 1250, col: 11, offset: 45460},
 										name: "SuperscriptText",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1208, col: 11, offset: 43175},
+										pos:  position{line: 1251, col: 11, offset: 45486},
 										name: "ElementPlaceHolder",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1209, col: 11, offset: 43204},
+										pos:  position{line: 1252, col: 11, offset: 45515},
 										name: "DoubleQuoteMonospaceTextFallbackCharacter",
 									},
 								},
@@ -8743,31 +9122,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMonospaceTextFallbackCharacter",
-			pos:  position{line: 1213, col: 1, offset: 43276},
+			pos:  position{line: 1256, col: 1, offset: 45587},
 			expr: &choiceExpr{
-				pos: position{line: 1214, col: 5, offset: 43325},
+				pos: position{line: 1257, col: 5, offset: 45636},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1214, col: 5, offset: 43325},
+						pos:        position{line: 1257, col: 5, offset: 45636},
 						val:        "[^\\r\\n`]",
 						chars:      []rune{'\r', '\n', '`'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1215, col: 7, offset: 43427},
+						pos: position{line: 1258, col: 7, offset: 45738},
 						run: (*parser).callonDoubleQuoteMonospaceTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1215, col: 7, offset: 43427},
+							pos: position{line: 1258, col: 7, offset: 45738},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1215, col: 7, offset: 43427},
+									pos:        position{line: 1258, col: 7, offset: 45738},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1215, col: 12, offset: 43432},
+									pos:  position{line: 1258, col: 12, offset: 45743},
 									name: "Alphanums",
 								},
 							},
@@ -8778,40 +9157,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceText",
-			pos:  position{line: 1219, col: 1, offset: 43600},
+			pos:  position{line: 1262, col: 1, offset: 45911},
 			expr: &choiceExpr{
-				pos: position{line: 1219, col: 29, offset: 43628},
+				pos: position{line: 1262, col: 29, offset: 45939},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1219, col: 29, offset: 43628},
+						pos: position{line: 1262, col: 29, offset: 45939},
 						run: (*parser).callonSingleQuoteMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1219, col: 29, offset: 43628},
+							pos: position{line: 1262, col: 29, offset: 45939},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1219, col: 29, offset: 43628},
+									pos:   position{line: 1262, col: 29, offset: 45939},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1219, col: 35, offset: 43634},
+										pos: position{line: 1262, col: 35, offset: 45945},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1219, col: 36, offset: 43635},
+											pos:  position{line: 1262, col: 36, offset: 45946},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 1219, col: 55, offset: 43654},
+									pos: position{line: 1262, col: 55, offset: 45965},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1219, col: 55, offset: 43654},
+											pos:        position{line: 1262, col: 55, offset: 45965},
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 										&notExpr{
-											pos: position{line: 1219, col: 59, offset: 43658},
+											pos: position{line: 1262, col: 59, offset: 45969},
 											expr: &litMatcher{
-												pos:        position{line: 1219, col: 60, offset: 43659},
+												pos:        position{line: 1262, col: 60, offset: 45970},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
@@ -8820,15 +9199,15 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1219, col: 65, offset: 43664},
+									pos:   position{line: 1262, col: 65, offset: 45975},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1219, col: 75, offset: 43674},
+										pos:  position{line: 1262, col: 75, offset: 45985},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1219, col: 109, offset: 43708},
+									pos:        position{line: 1262, col: 109, offset: 46019},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -8837,49 +9216,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1221, col: 5, offset: 43901},
+						pos: position{line: 1264, col: 5, offset: 46212},
 						run: (*parser).callonSingleQuoteMonospaceText14,
 						expr: &seqExpr{
-							pos: position{line: 1221, col: 5, offset: 43901},
+							pos: position{line: 1264, col: 5, offset: 46212},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1221, col: 5, offset: 43901},
+									pos:   position{line: 1264, col: 5, offset: 46212},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1221, col: 11, offset: 43907},
+										pos: position{line: 1264, col: 11, offset: 46218},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1221, col: 12, offset: 43908},
+											pos:  position{line: 1264, col: 12, offset: 46219},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1221, col: 30, offset: 43926},
+									pos:        position{line: 1264, col: 30, offset: 46237},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1221, col: 34, offset: 43930},
+									pos:   position{line: 1264, col: 34, offset: 46241},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1221, col: 44, offset: 43940},
+										pos: position{line: 1264, col: 44, offset: 46251},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1221, col: 44, offset: 43940},
+												pos:        position{line: 1264, col: 44, offset: 46251},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1221, col: 48, offset: 43944},
+												pos:  position{line: 1264, col: 48, offset: 46255},
 												name: "SingleQuoteMonospaceTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1221, col: 82, offset: 43978},
+									pos:        position{line: 1264, col: 82, offset: 46289},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -8892,21 +9271,25 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextElements",
-			pos:  position{line: 1225, col: 1, offset: 44192},
+			pos:  position{line: 1268, col: 1, offset: 46503},
 			expr: &seqExpr{
-				pos: position{line: 1225, col: 37, offset: 44228},
+				pos: position{line: 1268, col: 37, offset: 46539},
 				exprs: []interface{}{
+					&andCodeExpr{
+						pos: position{line: 1268, col: 37, offset: 46539},
+						run: (*parser).callonSingleQuoteMonospaceTextElements2,
+					},
 					&notExpr{
-						pos: position{line: 1225, col: 37, offset: 44228},
+						pos: position{line: 1268, col: 66, offset: 46568},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1225, col: 38, offset: 44229},
+							pos:  position{line: 1268, col: 67, offset: 46569},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1225, col: 44, offset: 44235},
+						pos: position{line: 1268, col: 73, offset: 46575},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1225, col: 44, offset: 44235},
+							pos:  position{line: 1268, col: 73, offset: 46575},
 							name: "SingleQuoteMonospaceTextElement",
 						},
 					},
@@ -8915,63 +9298,63 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextElement",
-			pos:  position{line: 1227, col: 1, offset: 44269},
+			pos:  position{line: 1270, col: 1, offset: 46609},
 			expr: &choiceExpr{
-				pos: position{line: 1227, col: 37, offset: 44305},
+				pos: position{line: 1270, col: 37, offset: 46645},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1227, col: 37, offset: 44305},
+						pos:  position{line: 1270, col: 37, offset: 46645},
 						name: "Word",
 					},
 					&seqExpr{
-						pos: position{line: 1228, col: 11, offset: 44320},
+						pos: position{line: 1271, col: 11, offset: 46660},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1228, col: 11, offset: 44320},
+								pos:  position{line: 1271, col: 11, offset: 46660},
 								name: "Newline",
 							},
 							&notExpr{
-								pos: position{line: 1228, col: 19, offset: 44328},
+								pos: position{line: 1271, col: 19, offset: 46668},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1228, col: 20, offset: 44329},
+									pos:  position{line: 1271, col: 20, offset: 46669},
 									name: "Newline",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1229, col: 11, offset: 44347},
+						pos:  position{line: 1272, col: 11, offset: 46687},
 						name: "DoubleQuoteMonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1230, col: 11, offset: 44382},
+						pos:  position{line: 1273, col: 11, offset: 46722},
 						name: "QuotedString",
 					},
 					&seqExpr{
-						pos: position{line: 1231, col: 11, offset: 44405},
+						pos: position{line: 1274, col: 11, offset: 46745},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1231, col: 11, offset: 44405},
+								pos: position{line: 1274, col: 11, offset: 46745},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1231, col: 11, offset: 44405},
+									pos:  position{line: 1274, col: 11, offset: 46745},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1231, col: 18, offset: 44412},
+								pos: position{line: 1274, col: 18, offset: 46752},
 								expr: &seqExpr{
-									pos: position{line: 1231, col: 19, offset: 44413},
+									pos: position{line: 1274, col: 19, offset: 46753},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1231, col: 19, offset: 44413},
+											pos:        position{line: 1274, col: 19, offset: 46753},
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 										&notExpr{
-											pos: position{line: 1231, col: 23, offset: 44417},
+											pos: position{line: 1274, col: 23, offset: 46757},
 											expr: &litMatcher{
-												pos:        position{line: 1231, col: 24, offset: 44418},
+												pos:        position{line: 1274, col: 24, offset: 46758},
 												val:        "`",
 												ignoreCase: false,
 												want:       "\"`\"",
@@ -8983,39 +9366,39 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1232, col: 11, offset: 44546},
+						pos:  position{line: 1275, col: 11, offset: 46886},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1233, col: 11, offset: 44565},
+						pos:  position{line: 1276, col: 11, offset: 46905},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1234, col: 11, offset: 44586},
+						pos:  position{line: 1277, col: 11, offset: 46926},
 						name: "MarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1235, col: 11, offset: 44607},
+						pos:  position{line: 1278, col: 11, offset: 46947},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1236, col: 11, offset: 44631},
+						pos:  position{line: 1279, col: 11, offset: 46971},
 						name: "SuperscriptText",
 					},
 					&actionExpr{
-						pos: position{line: 1237, col: 11, offset: 44657},
+						pos: position{line: 1280, col: 11, offset: 46997},
 						run: (*parser).callonSingleQuoteMonospaceTextElement22,
 						expr: &ruleRefExpr{
-							pos:  position{line: 1237, col: 11, offset: 44657},
+							pos:  position{line: 1280, col: 11, offset: 46997},
 							name: "Apostrophe",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1241, col: 11, offset: 44798},
+						pos:  position{line: 1284, col: 11, offset: 47138},
 						name: "ElementPlaceHolder",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1242, col: 11, offset: 44827},
+						pos:  position{line: 1285, col: 11, offset: 47167},
 						name: "SingleQuoteMonospaceTextFallbackCharacter",
 					},
 				},
@@ -9023,31 +9406,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMonospaceTextFallbackCharacter",
-			pos:  position{line: 1244, col: 1, offset: 44870},
+			pos:  position{line: 1287, col: 1, offset: 47210},
 			expr: &choiceExpr{
-				pos: position{line: 1245, col: 5, offset: 44919},
+				pos: position{line: 1288, col: 5, offset: 47259},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1245, col: 5, offset: 44919},
+						pos:        position{line: 1288, col: 5, offset: 47259},
 						val:        "[^\\r\\n`]",
 						chars:      []rune{'\r', '\n', '`'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1246, col: 7, offset: 45021},
+						pos: position{line: 1289, col: 7, offset: 47361},
 						run: (*parser).callonSingleQuoteMonospaceTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1246, col: 7, offset: 45021},
+							pos: position{line: 1289, col: 7, offset: 47361},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1246, col: 7, offset: 45021},
+									pos:        position{line: 1289, col: 7, offset: 47361},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1246, col: 11, offset: 45025},
+									pos:  position{line: 1289, col: 11, offset: 47365},
 									name: "Alphanums",
 								},
 							},
@@ -9058,40 +9441,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedMonospaceText",
-			pos:  position{line: 1250, col: 1, offset: 45194},
+			pos:  position{line: 1293, col: 1, offset: 47534},
 			expr: &choiceExpr{
-				pos: position{line: 1251, col: 5, offset: 45223},
+				pos: position{line: 1294, col: 5, offset: 47563},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1251, col: 5, offset: 45223},
+						pos: position{line: 1294, col: 5, offset: 47563},
 						run: (*parser).callonEscapedMonospaceText2,
 						expr: &seqExpr{
-							pos: position{line: 1251, col: 5, offset: 45223},
+							pos: position{line: 1294, col: 5, offset: 47563},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1251, col: 5, offset: 45223},
+									pos:   position{line: 1294, col: 5, offset: 47563},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1251, col: 18, offset: 45236},
+										pos:  position{line: 1294, col: 18, offset: 47576},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1251, col: 40, offset: 45258},
+									pos:        position{line: 1294, col: 40, offset: 47598},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1251, col: 45, offset: 45263},
+									pos:   position{line: 1294, col: 45, offset: 47603},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1251, col: 55, offset: 45273},
+										pos:  position{line: 1294, col: 55, offset: 47613},
 										name: "DoubleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1251, col: 89, offset: 45307},
+									pos:        position{line: 1294, col: 89, offset: 47647},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
@@ -9100,35 +9483,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1253, col: 9, offset: 45464},
+						pos: position{line: 1296, col: 9, offset: 47804},
 						run: (*parser).callonEscapedMonospaceText10,
 						expr: &seqExpr{
-							pos: position{line: 1253, col: 9, offset: 45464},
+							pos: position{line: 1296, col: 9, offset: 47804},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1253, col: 9, offset: 45464},
+									pos:   position{line: 1296, col: 9, offset: 47804},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1253, col: 22, offset: 45477},
+										pos:  position{line: 1296, col: 22, offset: 47817},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1253, col: 44, offset: 45499},
+									pos:        position{line: 1296, col: 44, offset: 47839},
 									val:        "``",
 									ignoreCase: false,
 									want:       "\"``\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1253, col: 49, offset: 45504},
+									pos:   position{line: 1296, col: 49, offset: 47844},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1253, col: 59, offset: 45514},
+										pos:  position{line: 1296, col: 59, offset: 47854},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1253, col: 93, offset: 45548},
+									pos:        position{line: 1296, col: 93, offset: 47888},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -9137,35 +9520,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1256, col: 9, offset: 45748},
+						pos: position{line: 1299, col: 9, offset: 48088},
 						run: (*parser).callonEscapedMonospaceText18,
 						expr: &seqExpr{
-							pos: position{line: 1256, col: 9, offset: 45748},
+							pos: position{line: 1299, col: 9, offset: 48088},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1256, col: 9, offset: 45748},
+									pos:   position{line: 1299, col: 9, offset: 48088},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1256, col: 22, offset: 45761},
+										pos:  position{line: 1299, col: 22, offset: 48101},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1256, col: 44, offset: 45783},
+									pos:        position{line: 1299, col: 44, offset: 48123},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1256, col: 48, offset: 45787},
+									pos:   position{line: 1299, col: 48, offset: 48127},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1256, col: 58, offset: 45797},
+										pos:  position{line: 1299, col: 58, offset: 48137},
 										name: "SingleQuoteMonospaceTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1256, col: 92, offset: 45831},
+									pos:        position{line: 1299, col: 92, offset: 48171},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
@@ -9178,16 +9561,16 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedString",
-			pos:  position{line: 1264, col: 1, offset: 46156},
+			pos:  position{line: 1307, col: 1, offset: 48496},
 			expr: &choiceExpr{
-				pos: position{line: 1264, col: 17, offset: 46172},
+				pos: position{line: 1307, col: 17, offset: 48512},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1264, col: 17, offset: 46172},
+						pos:  position{line: 1307, col: 17, offset: 48512},
 						name: "SingleQuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1264, col: 38, offset: 46193},
+						pos:  position{line: 1307, col: 38, offset: 48533},
 						name: "DoubleQuotedString",
 					},
 				},
@@ -9195,27 +9578,27 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedString",
-			pos:  position{line: 1266, col: 1, offset: 46213},
+			pos:  position{line: 1309, col: 1, offset: 48553},
 			expr: &actionExpr{
-				pos: position{line: 1266, col: 23, offset: 46235},
+				pos: position{line: 1309, col: 23, offset: 48575},
 				run: (*parser).callonSingleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 1266, col: 23, offset: 46235},
+					pos: position{line: 1309, col: 23, offset: 48575},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1266, col: 23, offset: 46235},
+							pos:  position{line: 1309, col: 23, offset: 48575},
 							name: "SingleQuoteStringStart",
 						},
 						&labeledExpr{
-							pos:   position{line: 1266, col: 46, offset: 46258},
+							pos:   position{line: 1309, col: 46, offset: 48598},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1266, col: 55, offset: 46267},
+								pos:  position{line: 1309, col: 55, offset: 48607},
 								name: "SingleQuotedStringElements",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1266, col: 82, offset: 46294},
+							pos:  position{line: 1309, col: 82, offset: 48634},
 							name: "SingleQuoteStringEnd",
 						},
 					},
@@ -9224,17 +9607,17 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedStringElements",
-			pos:  position{line: 1270, col: 1, offset: 46398},
+			pos:  position{line: 1313, col: 1, offset: 48738},
 			expr: &actionExpr{
-				pos: position{line: 1270, col: 31, offset: 46428},
+				pos: position{line: 1313, col: 31, offset: 48768},
 				run: (*parser).callonSingleQuotedStringElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 1270, col: 31, offset: 46428},
+					pos:   position{line: 1313, col: 31, offset: 48768},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1270, col: 41, offset: 46438},
+						pos: position{line: 1313, col: 41, offset: 48778},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1270, col: 41, offset: 46438},
+							pos:  position{line: 1313, col: 41, offset: 48778},
 							name: "SingleQuotedStringElement",
 						},
 					},
@@ -9243,20 +9626,20 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteStringStart",
-			pos:  position{line: 1274, col: 1, offset: 46516},
+			pos:  position{line: 1317, col: 1, offset: 48856},
 			expr: &seqExpr{
-				pos: position{line: 1274, col: 27, offset: 46542},
+				pos: position{line: 1317, col: 27, offset: 48882},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1274, col: 27, offset: 46542},
+						pos:        position{line: 1317, col: 27, offset: 48882},
 						val:        "'`",
 						ignoreCase: false,
 						want:       "\"'`\"",
 					},
 					&notExpr{
-						pos: position{line: 1274, col: 32, offset: 46547},
+						pos: position{line: 1317, col: 32, offset: 48887},
 						expr: &charClassMatcher{
-							pos:        position{line: 1274, col: 33, offset: 46548},
+							pos:        position{line: 1317, col: 33, offset: 48888},
 							val:        "[ \\t\\r\\n]",
 							chars:      []rune{' ', '\t', '\r', '\n'},
 							ignoreCase: false,
@@ -9268,9 +9651,9 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteStringEnd",
-			pos:  position{line: 1276, col: 1, offset: 46559},
+			pos:  position{line: 1319, col: 1, offset: 48899},
 			expr: &litMatcher{
-				pos:        position{line: 1276, col: 25, offset: 46583},
+				pos:        position{line: 1319, col: 25, offset: 48923},
 				val:        "`'",
 				ignoreCase: false,
 				want:       "\"`'\"",
@@ -9278,113 +9661,113 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedStringElement",
-			pos:  position{line: 1279, col: 1, offset: 46671},
+			pos:  position{line: 1322, col: 1, offset: 49011},
 			expr: &actionExpr{
-				pos: position{line: 1279, col: 30, offset: 46700},
+				pos: position{line: 1322, col: 30, offset: 49040},
 				run: (*parser).callonSingleQuotedStringElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 1279, col: 30, offset: 46700},
+					pos:   position{line: 1322, col: 30, offset: 49040},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 1280, col: 9, offset: 46718},
+						pos: position{line: 1323, col: 9, offset: 49058},
 						alternatives: []interface{}{
 							&seqExpr{
-								pos: position{line: 1280, col: 9, offset: 46718},
+								pos: position{line: 1323, col: 9, offset: 49058},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1280, col: 9, offset: 46718},
+										pos:  position{line: 1323, col: 9, offset: 49058},
 										name: "LineBreak",
 									},
 									&notExpr{
-										pos: position{line: 1280, col: 19, offset: 46728},
+										pos: position{line: 1323, col: 19, offset: 49068},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1280, col: 20, offset: 46729},
+											pos:  position{line: 1323, col: 20, offset: 49069},
 											name: "SingleQuoteStringEnd",
 										},
 									},
 								},
 							},
 							&seqExpr{
-								pos: position{line: 1281, col: 11, offset: 46785},
+								pos: position{line: 1324, col: 11, offset: 49125},
 								exprs: []interface{}{
 									&oneOrMoreExpr{
-										pos: position{line: 1281, col: 11, offset: 46785},
+										pos: position{line: 1324, col: 11, offset: 49125},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1281, col: 11, offset: 46785},
+											pos:  position{line: 1324, col: 11, offset: 49125},
 											name: "Space",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1281, col: 18, offset: 46792},
+										pos: position{line: 1324, col: 18, offset: 49132},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1281, col: 19, offset: 46793},
+											pos:  position{line: 1324, col: 19, offset: 49133},
 											name: "SingleQuoteStringEnd",
 										},
 									},
 								},
 							},
 							&seqExpr{
-								pos: position{line: 1282, col: 11, offset: 46824},
+								pos: position{line: 1325, col: 11, offset: 49164},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1282, col: 11, offset: 46824},
+										pos: position{line: 1325, col: 11, offset: 49164},
 										expr: &litMatcher{
-											pos:        position{line: 1282, col: 12, offset: 46825},
+											pos:        position{line: 1325, col: 12, offset: 49165},
 											val:        "`",
 											ignoreCase: false,
 											want:       "\"`\"",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1282, col: 16, offset: 46829},
+										pos:  position{line: 1325, col: 16, offset: 49169},
 										name: "Symbol",
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1283, col: 11, offset: 46877},
+								pos:  position{line: 1326, col: 11, offset: 49217},
 								name: "BoldText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1284, col: 11, offset: 46896},
+								pos:  position{line: 1327, col: 11, offset: 49236},
 								name: "ItalicText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1285, col: 11, offset: 46917},
+								pos:  position{line: 1328, col: 11, offset: 49257},
 								name: "MarkedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1286, col: 11, offset: 46938},
+								pos:  position{line: 1329, col: 11, offset: 49278},
 								name: "SubscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1287, col: 11, offset: 46962},
+								pos:  position{line: 1330, col: 11, offset: 49302},
 								name: "SuperscriptText",
 							},
 							&seqExpr{
-								pos: position{line: 1288, col: 11, offset: 46988},
+								pos: position{line: 1331, col: 11, offset: 49328},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1288, col: 11, offset: 46988},
+										pos: position{line: 1331, col: 11, offset: 49328},
 										expr: &litMatcher{
-											pos:        position{line: 1288, col: 12, offset: 46989},
+											pos:        position{line: 1331, col: 12, offset: 49329},
 											val:        "`'",
 											ignoreCase: false,
 											want:       "\"`'\"",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1288, col: 17, offset: 46994},
+										pos:  position{line: 1331, col: 17, offset: 49334},
 										name: "MonospaceText",
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1289, col: 11, offset: 47018},
+								pos:  position{line: 1332, col: 11, offset: 49358},
 								name: "DoubleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1290, col: 11, offset: 47047},
+								pos:  position{line: 1333, col: 11, offset: 49387},
 								name: "SingleQuotedStringFallbackCharacter",
 							},
 						},
@@ -9394,33 +9777,33 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuotedStringFallbackCharacter",
-			pos:  position{line: 1294, col: 1, offset: 47113},
+			pos:  position{line: 1337, col: 1, offset: 49453},
 			expr: &choiceExpr{
-				pos: position{line: 1294, col: 41, offset: 47153},
+				pos: position{line: 1337, col: 41, offset: 49493},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1294, col: 41, offset: 47153},
+						pos:        position{line: 1337, col: 41, offset: 49493},
 						val:        "[^\\r\\n\\t `]",
 						chars:      []rune{'\r', '\n', '\t', ' ', '`'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1294, col: 55, offset: 47167},
+						pos: position{line: 1337, col: 55, offset: 49507},
 						run: (*parser).callonSingleQuotedStringFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1294, col: 55, offset: 47167},
+							pos: position{line: 1337, col: 55, offset: 49507},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1294, col: 55, offset: 47167},
+									pos:        position{line: 1337, col: 55, offset: 49507},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&notExpr{
-									pos: position{line: 1294, col: 59, offset: 47171},
+									pos: position{line: 1337, col: 59, offset: 49511},
 									expr: &litMatcher{
-										pos:        position{line: 1294, col: 60, offset: 47172},
+										pos:        position{line: 1337, col: 60, offset: 49512},
 										val:        "'",
 										ignoreCase: false,
 										want:       "\"'\"",
@@ -9434,27 +9817,27 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedString",
-			pos:  position{line: 1298, col: 1, offset: 47231},
+			pos:  position{line: 1341, col: 1, offset: 49571},
 			expr: &actionExpr{
-				pos: position{line: 1298, col: 23, offset: 47253},
+				pos: position{line: 1341, col: 23, offset: 49593},
 				run: (*parser).callonDoubleQuotedString1,
 				expr: &seqExpr{
-					pos: position{line: 1298, col: 23, offset: 47253},
+					pos: position{line: 1341, col: 23, offset: 49593},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1298, col: 23, offset: 47253},
+							pos:  position{line: 1341, col: 23, offset: 49593},
 							name: "DoubleQuoteStringStart",
 						},
 						&labeledExpr{
-							pos:   position{line: 1298, col: 46, offset: 47276},
+							pos:   position{line: 1341, col: 46, offset: 49616},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1298, col: 55, offset: 47285},
+								pos:  position{line: 1341, col: 55, offset: 49625},
 								name: "DoubleQuotedStringElements",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1298, col: 82, offset: 47312},
+							pos:  position{line: 1341, col: 82, offset: 49652},
 							name: "DoubleQuoteStringEnd",
 						},
 					},
@@ -9463,17 +9846,17 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedStringElements",
-			pos:  position{line: 1302, col: 1, offset: 47416},
+			pos:  position{line: 1345, col: 1, offset: 49756},
 			expr: &actionExpr{
-				pos: position{line: 1302, col: 31, offset: 47446},
+				pos: position{line: 1345, col: 31, offset: 49786},
 				run: (*parser).callonDoubleQuotedStringElements1,
 				expr: &labeledExpr{
-					pos:   position{line: 1302, col: 31, offset: 47446},
+					pos:   position{line: 1345, col: 31, offset: 49786},
 					label: "elements",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 1302, col: 41, offset: 47456},
+						pos: position{line: 1345, col: 41, offset: 49796},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1302, col: 41, offset: 47456},
+							pos:  position{line: 1345, col: 41, offset: 49796},
 							name: "DoubleQuotedStringElement",
 						},
 					},
@@ -9482,95 +9865,95 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedStringElement",
-			pos:  position{line: 1307, col: 1, offset: 47616},
+			pos:  position{line: 1350, col: 1, offset: 49956},
 			expr: &actionExpr{
-				pos: position{line: 1307, col: 30, offset: 47645},
+				pos: position{line: 1350, col: 30, offset: 49985},
 				run: (*parser).callonDoubleQuotedStringElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 1307, col: 30, offset: 47645},
+					pos:   position{line: 1350, col: 30, offset: 49985},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 1308, col: 9, offset: 47663},
+						pos: position{line: 1351, col: 9, offset: 50003},
 						alternatives: []interface{}{
 							&seqExpr{
-								pos: position{line: 1308, col: 9, offset: 47663},
+								pos: position{line: 1351, col: 9, offset: 50003},
 								exprs: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1308, col: 9, offset: 47663},
+										pos:  position{line: 1351, col: 9, offset: 50003},
 										name: "LineBreak",
 									},
 									&notExpr{
-										pos: position{line: 1308, col: 19, offset: 47673},
+										pos: position{line: 1351, col: 19, offset: 50013},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1308, col: 20, offset: 47674},
+											pos:  position{line: 1351, col: 20, offset: 50014},
 											name: "DoubleQuoteStringEnd",
 										},
 									},
 								},
 							},
 							&seqExpr{
-								pos: position{line: 1309, col: 11, offset: 47730},
+								pos: position{line: 1352, col: 11, offset: 50070},
 								exprs: []interface{}{
 									&oneOrMoreExpr{
-										pos: position{line: 1309, col: 11, offset: 47730},
+										pos: position{line: 1352, col: 11, offset: 50070},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1309, col: 11, offset: 47730},
+											pos:  position{line: 1352, col: 11, offset: 50070},
 											name: "Space",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1309, col: 18, offset: 47737},
+										pos: position{line: 1352, col: 18, offset: 50077},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1309, col: 19, offset: 47738},
+											pos:  position{line: 1352, col: 19, offset: 50078},
 											name: "DoubleQuoteStringEnd",
 										},
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1310, col: 11, offset: 47769},
+								pos:  position{line: 1353, col: 11, offset: 50109},
 								name: "BoldText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1311, col: 11, offset: 47788},
+								pos:  position{line: 1354, col: 11, offset: 50128},
 								name: "ItalicText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1312, col: 11, offset: 47809},
+								pos:  position{line: 1355, col: 11, offset: 50149},
 								name: "MarkedText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1313, col: 11, offset: 47830},
+								pos:  position{line: 1356, col: 11, offset: 50170},
 								name: "SubscriptText",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1314, col: 11, offset: 47854},
+								pos:  position{line: 1357, col: 11, offset: 50194},
 								name: "SuperscriptText",
 							},
 							&seqExpr{
-								pos: position{line: 1315, col: 11, offset: 47880},
+								pos: position{line: 1358, col: 11, offset: 50220},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1315, col: 11, offset: 47880},
+										pos: position{line: 1358, col: 11, offset: 50220},
 										expr: &litMatcher{
-											pos:        position{line: 1315, col: 12, offset: 47881},
+											pos:        position{line: 1358, col: 12, offset: 50221},
 											val:        "`\"",
 											ignoreCase: false,
 											want:       "\"`\\\"\"",
 										},
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1315, col: 18, offset: 47887},
+										pos:  position{line: 1358, col: 18, offset: 50227},
 										name: "MonospaceText",
 									},
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1316, col: 10, offset: 47910},
+								pos:  position{line: 1359, col: 10, offset: 50250},
 								name: "SingleQuotedString",
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1317, col: 11, offset: 47939},
+								pos:  position{line: 1360, col: 11, offset: 50279},
 								name: "DoubleQuotedStringFallbackCharacter",
 							},
 						},
@@ -9580,20 +9963,20 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteStringStart",
-			pos:  position{line: 1321, col: 1, offset: 48013},
+			pos:  position{line: 1364, col: 1, offset: 50353},
 			expr: &seqExpr{
-				pos: position{line: 1321, col: 27, offset: 48039},
+				pos: position{line: 1364, col: 27, offset: 50379},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1321, col: 27, offset: 48039},
+						pos:        position{line: 1364, col: 27, offset: 50379},
 						val:        "\"`",
 						ignoreCase: false,
 						want:       "\"\\\"`\"",
 					},
 					&notExpr{
-						pos: position{line: 1321, col: 33, offset: 48045},
+						pos: position{line: 1364, col: 33, offset: 50385},
 						expr: &charClassMatcher{
-							pos:        position{line: 1321, col: 34, offset: 48046},
+							pos:        position{line: 1364, col: 34, offset: 50386},
 							val:        "[ \\t\\r\\n]",
 							chars:      []rune{' ', '\t', '\r', '\n'},
 							ignoreCase: false,
@@ -9605,9 +9988,9 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteStringEnd",
-			pos:  position{line: 1323, col: 1, offset: 48057},
+			pos:  position{line: 1366, col: 1, offset: 50397},
 			expr: &litMatcher{
-				pos:        position{line: 1323, col: 25, offset: 48081},
+				pos:        position{line: 1366, col: 25, offset: 50421},
 				val:        "`\"",
 				ignoreCase: false,
 				want:       "\"`\\\"\"",
@@ -9615,33 +9998,33 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuotedStringFallbackCharacter",
-			pos:  position{line: 1325, col: 1, offset: 48088},
+			pos:  position{line: 1368, col: 1, offset: 50428},
 			expr: &actionExpr{
-				pos: position{line: 1325, col: 41, offset: 48128},
+				pos: position{line: 1368, col: 41, offset: 50468},
 				run: (*parser).callonDoubleQuotedStringFallbackCharacter1,
 				expr: &choiceExpr{
-					pos: position{line: 1325, col: 42, offset: 48129},
+					pos: position{line: 1368, col: 42, offset: 50469},
 					alternatives: []interface{}{
 						&charClassMatcher{
-							pos:        position{line: 1325, col: 42, offset: 48129},
+							pos:        position{line: 1368, col: 42, offset: 50469},
 							val:        "[^\\r\\n\\t `]",
 							chars:      []rune{'\r', '\n', '\t', ' ', '`'},
 							ignoreCase: false,
 							inverted:   true,
 						},
 						&seqExpr{
-							pos: position{line: 1325, col: 56, offset: 48143},
+							pos: position{line: 1368, col: 56, offset: 50483},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1325, col: 56, offset: 48143},
+									pos:        position{line: 1368, col: 56, offset: 50483},
 									val:        "`",
 									ignoreCase: false,
 									want:       "\"`\"",
 								},
 								&notExpr{
-									pos: position{line: 1325, col: 60, offset: 48147},
+									pos: position{line: 1368, col: 60, offset: 50487},
 									expr: &litMatcher{
-										pos:        position{line: 1325, col: 61, offset: 48148},
+										pos:        position{line: 1368, col: 61, offset: 50488},
 										val:        "\"",
 										ignoreCase: false,
 										want:       "\"\\\"\"",
@@ -9655,16 +10038,16 @@ var g = &grammar{
 		},
 		{
 			name: "MarkedText",
-			pos:  position{line: 1334, col: 1, offset: 48268},
+			pos:  position{line: 1377, col: 1, offset: 50608},
 			expr: &choiceExpr{
-				pos: position{line: 1334, col: 15, offset: 48282},
+				pos: position{line: 1377, col: 15, offset: 50622},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1334, col: 15, offset: 48282},
+						pos:  position{line: 1377, col: 15, offset: 50622},
 						name: "DoubleQuoteMarkedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1334, col: 39, offset: 48306},
+						pos:  position{line: 1377, col: 39, offset: 50646},
 						name: "SingleQuoteMarkedText",
 					},
 				},
@@ -9672,40 +10055,40 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMarkedText",
-			pos:  position{line: 1336, col: 1, offset: 48329},
+			pos:  position{line: 1379, col: 1, offset: 50669},
 			expr: &actionExpr{
-				pos: position{line: 1336, col: 26, offset: 48354},
+				pos: position{line: 1379, col: 26, offset: 50694},
 				run: (*parser).callonDoubleQuoteMarkedText1,
 				expr: &seqExpr{
-					pos: position{line: 1336, col: 26, offset: 48354},
+					pos: position{line: 1379, col: 26, offset: 50694},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1336, col: 26, offset: 48354},
+							pos:   position{line: 1379, col: 26, offset: 50694},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1336, col: 32, offset: 48360},
+								pos: position{line: 1379, col: 32, offset: 50700},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1336, col: 33, offset: 48361},
+									pos:  position{line: 1379, col: 33, offset: 50701},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1336, col: 51, offset: 48379},
+							pos:        position{line: 1379, col: 51, offset: 50719},
 							val:        "##",
 							ignoreCase: false,
 							want:       "\"##\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1336, col: 56, offset: 48384},
+							pos:   position{line: 1379, col: 56, offset: 50724},
 							label: "elements",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1336, col: 66, offset: 48394},
+								pos:  position{line: 1379, col: 66, offset: 50734},
 								name: "DoubleQuoteMarkedTextElements",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1336, col: 97, offset: 48425},
+							pos:        position{line: 1379, col: 97, offset: 50765},
 							val:        "##",
 							ignoreCase: false,
 							want:       "\"##\"",
@@ -9716,37 +10099,41 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMarkedTextElements",
-			pos:  position{line: 1340, col: 1, offset: 48559},
+			pos:  position{line: 1383, col: 1, offset: 50899},
 			expr: &seqExpr{
-				pos: position{line: 1340, col: 34, offset: 48592},
+				pos: position{line: 1383, col: 34, offset: 50932},
 				exprs: []interface{}{
+					&andCodeExpr{
+						pos: position{line: 1383, col: 34, offset: 50932},
+						run: (*parser).callonDoubleQuoteMarkedTextElements2,
+					},
 					&ruleRefExpr{
-						pos:  position{line: 1340, col: 34, offset: 48592},
+						pos:  position{line: 1383, col: 63, offset: 50961},
 						name: "DoubleQuoteMarkedTextElement",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1340, col: 63, offset: 48621},
+						pos: position{line: 1383, col: 92, offset: 50990},
 						expr: &seqExpr{
-							pos: position{line: 1340, col: 64, offset: 48622},
+							pos: position{line: 1383, col: 93, offset: 50991},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1340, col: 64, offset: 48622},
+									pos: position{line: 1383, col: 93, offset: 50991},
 									expr: &litMatcher{
-										pos:        position{line: 1340, col: 66, offset: 48624},
+										pos:        position{line: 1383, col: 95, offset: 50993},
 										val:        "##",
 										ignoreCase: false,
 										want:       "\"##\"",
 									},
 								},
 								&choiceExpr{
-									pos: position{line: 1340, col: 73, offset: 48631},
+									pos: position{line: 1383, col: 102, offset: 51000},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1340, col: 73, offset: 48631},
+											pos:  position{line: 1383, col: 102, offset: 51000},
 											name: "Space",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1340, col: 81, offset: 48639},
+											pos:  position{line: 1383, col: 110, offset: 51008},
 											name: "DoubleQuoteMarkedTextElement",
 										},
 									},
@@ -9759,64 +10146,64 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMarkedTextElement",
-			pos:  position{line: 1342, col: 1, offset: 48706},
+			pos:  position{line: 1385, col: 1, offset: 51075},
 			expr: &choiceExpr{
-				pos: position{line: 1342, col: 33, offset: 48738},
+				pos: position{line: 1385, col: 33, offset: 51107},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1342, col: 33, offset: 48738},
+						pos:  position{line: 1385, col: 33, offset: 51107},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1343, col: 11, offset: 48753},
+						pos:  position{line: 1386, col: 11, offset: 51122},
 						name: "SingleQuoteMarkedText",
 					},
 					&seqExpr{
-						pos: position{line: 1344, col: 11, offset: 48785},
+						pos: position{line: 1387, col: 11, offset: 51154},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1344, col: 11, offset: 48785},
+								pos:  position{line: 1387, col: 11, offset: 51154},
 								name: "Newline",
 							},
 							&notExpr{
-								pos: position{line: 1344, col: 19, offset: 48793},
+								pos: position{line: 1387, col: 19, offset: 51162},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1344, col: 20, offset: 48794},
+									pos:  position{line: 1387, col: 20, offset: 51163},
 									name: "Newline",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1345, col: 11, offset: 48812},
+						pos:  position{line: 1388, col: 11, offset: 51181},
 						name: "QuotedString",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1346, col: 11, offset: 48835},
+						pos:  position{line: 1389, col: 11, offset: 51204},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1347, col: 11, offset: 48854},
+						pos:  position{line: 1390, col: 11, offset: 51223},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1348, col: 11, offset: 48875},
+						pos:  position{line: 1391, col: 11, offset: 51244},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1349, col: 11, offset: 48899},
+						pos:  position{line: 1392, col: 11, offset: 51268},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1350, col: 11, offset: 48923},
+						pos:  position{line: 1393, col: 11, offset: 51292},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1351, col: 11, offset: 48949},
+						pos:  position{line: 1394, col: 11, offset: 51318},
 						name: "ElementPlaceHolder",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1352, col: 11, offset: 48978},
+						pos:  position{line: 1395, col: 11, offset: 51347},
 						name: "DoubleQuoteMarkedTextFallbackCharacter",
 					},
 				},
@@ -9824,31 +10211,31 @@ var g = &grammar{
 		},
 		{
 			name: "DoubleQuoteMarkedTextFallbackCharacter",
-			pos:  position{line: 1354, col: 1, offset: 49018},
+			pos:  position{line: 1397, col: 1, offset: 51387},
 			expr: &choiceExpr{
-				pos: position{line: 1355, col: 5, offset: 49064},
+				pos: position{line: 1398, col: 5, offset: 51433},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1355, col: 5, offset: 49064},
+						pos:        position{line: 1398, col: 5, offset: 51433},
 						val:        "[^\\r\\n#]",
 						chars:      []rune{'\r', '\n', '#'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1356, col: 7, offset: 49163},
+						pos: position{line: 1399, col: 7, offset: 51532},
 						run: (*parser).callonDoubleQuoteMarkedTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1356, col: 7, offset: 49163},
+							pos: position{line: 1399, col: 7, offset: 51532},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1356, col: 7, offset: 49163},
+									pos:        position{line: 1399, col: 7, offset: 51532},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1356, col: 12, offset: 49168},
+									pos:  position{line: 1399, col: 12, offset: 51537},
 									name: "Alphanums",
 								},
 							},
@@ -9859,40 +10246,40 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMarkedText",
-			pos:  position{line: 1360, col: 1, offset: 49333},
+			pos:  position{line: 1403, col: 1, offset: 51702},
 			expr: &choiceExpr{
-				pos: position{line: 1360, col: 26, offset: 49358},
+				pos: position{line: 1403, col: 26, offset: 51727},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1360, col: 26, offset: 49358},
+						pos: position{line: 1403, col: 26, offset: 51727},
 						run: (*parser).callonSingleQuoteMarkedText2,
 						expr: &seqExpr{
-							pos: position{line: 1360, col: 26, offset: 49358},
+							pos: position{line: 1403, col: 26, offset: 51727},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1360, col: 26, offset: 49358},
+									pos:   position{line: 1403, col: 26, offset: 51727},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1360, col: 32, offset: 49364},
+										pos: position{line: 1403, col: 32, offset: 51733},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1360, col: 33, offset: 49365},
+											pos:  position{line: 1403, col: 33, offset: 51734},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&seqExpr{
-									pos: position{line: 1360, col: 52, offset: 49384},
+									pos: position{line: 1403, col: 52, offset: 51753},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1360, col: 52, offset: 49384},
+											pos:        position{line: 1403, col: 52, offset: 51753},
 											val:        "#",
 											ignoreCase: false,
 											want:       "\"#\"",
 										},
 										&notExpr{
-											pos: position{line: 1360, col: 56, offset: 49388},
+											pos: position{line: 1403, col: 56, offset: 51757},
 											expr: &litMatcher{
-												pos:        position{line: 1360, col: 57, offset: 49389},
+												pos:        position{line: 1403, col: 57, offset: 51758},
 												val:        "#",
 												ignoreCase: false,
 												want:       "\"#\"",
@@ -9901,15 +10288,15 @@ var g = &grammar{
 									},
 								},
 								&labeledExpr{
-									pos:   position{line: 1360, col: 62, offset: 49394},
+									pos:   position{line: 1403, col: 62, offset: 51763},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1360, col: 72, offset: 49404},
+										pos:  position{line: 1403, col: 72, offset: 51773},
 										name: "SingleQuoteMarkedTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1360, col: 103, offset: 49435},
+									pos:        position{line: 1403, col: 103, offset: 51804},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -9918,49 +10305,49 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1362, col: 5, offset: 49625},
+						pos: position{line: 1405, col: 5, offset: 51994},
 						run: (*parser).callonSingleQuoteMarkedText14,
 						expr: &seqExpr{
-							pos: position{line: 1362, col: 5, offset: 49625},
+							pos: position{line: 1405, col: 5, offset: 51994},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1362, col: 5, offset: 49625},
+									pos:   position{line: 1405, col: 5, offset: 51994},
 									label: "attrs",
 									expr: &zeroOrOneExpr{
-										pos: position{line: 1362, col: 11, offset: 49631},
+										pos: position{line: 1405, col: 11, offset: 52000},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1362, col: 12, offset: 49632},
+											pos:  position{line: 1405, col: 12, offset: 52001},
 											name: "QuotedTextAttrs",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1362, col: 30, offset: 49650},
+									pos:        position{line: 1405, col: 30, offset: 52019},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1362, col: 34, offset: 49654},
+									pos:   position{line: 1405, col: 34, offset: 52023},
 									label: "elements",
 									expr: &seqExpr{
-										pos: position{line: 1362, col: 44, offset: 49664},
+										pos: position{line: 1405, col: 44, offset: 52033},
 										exprs: []interface{}{
 											&litMatcher{
-												pos:        position{line: 1362, col: 44, offset: 49664},
+												pos:        position{line: 1405, col: 44, offset: 52033},
 												val:        "#",
 												ignoreCase: false,
 												want:       "\"#\"",
 											},
 											&ruleRefExpr{
-												pos:  position{line: 1362, col: 48, offset: 49668},
+												pos:  position{line: 1405, col: 48, offset: 52037},
 												name: "SingleQuoteMarkedTextElements",
 											},
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1362, col: 79, offset: 49699},
+									pos:        position{line: 1405, col: 79, offset: 52068},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -9973,21 +10360,25 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMarkedTextElements",
-			pos:  position{line: 1366, col: 1, offset: 49908},
+			pos:  position{line: 1409, col: 1, offset: 52277},
 			expr: &seqExpr{
-				pos: position{line: 1366, col: 34, offset: 49941},
+				pos: position{line: 1409, col: 34, offset: 52310},
 				exprs: []interface{}{
+					&andCodeExpr{
+						pos: position{line: 1409, col: 34, offset: 52310},
+						run: (*parser).callonSingleQuoteMarkedTextElements2,
+					},
 					&notExpr{
-						pos: position{line: 1366, col: 34, offset: 49941},
+						pos: position{line: 1409, col: 63, offset: 52339},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1366, col: 35, offset: 49942},
+							pos:  position{line: 1409, col: 64, offset: 52340},
 							name: "Space",
 						},
 					},
 					&oneOrMoreExpr{
-						pos: position{line: 1366, col: 41, offset: 49948},
+						pos: position{line: 1409, col: 70, offset: 52346},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1366, col: 41, offset: 49948},
+							pos:  position{line: 1409, col: 70, offset: 52346},
 							name: "SingleQuoteMarkedTextElement",
 						},
 					},
@@ -9996,63 +10387,63 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMarkedTextElement",
-			pos:  position{line: 1368, col: 1, offset: 49979},
+			pos:  position{line: 1411, col: 1, offset: 52377},
 			expr: &choiceExpr{
-				pos: position{line: 1368, col: 33, offset: 50011},
+				pos: position{line: 1411, col: 33, offset: 52409},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1368, col: 33, offset: 50011},
+						pos:  position{line: 1411, col: 33, offset: 52409},
 						name: "Word",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1369, col: 11, offset: 50026},
+						pos:  position{line: 1412, col: 11, offset: 52424},
 						name: "DoubleQuoteMarkedText",
 					},
 					&seqExpr{
-						pos: position{line: 1370, col: 11, offset: 50058},
+						pos: position{line: 1413, col: 11, offset: 52456},
 						exprs: []interface{}{
 							&ruleRefExpr{
-								pos:  position{line: 1370, col: 11, offset: 50058},
+								pos:  position{line: 1413, col: 11, offset: 52456},
 								name: "Newline",
 							},
 							&notExpr{
-								pos: position{line: 1370, col: 19, offset: 50066},
+								pos: position{line: 1413, col: 19, offset: 52464},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1370, col: 20, offset: 50067},
+									pos:  position{line: 1413, col: 20, offset: 52465},
 									name: "Newline",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1371, col: 11, offset: 50085},
+						pos:  position{line: 1414, col: 11, offset: 52483},
 						name: "QuotedString",
 					},
 					&seqExpr{
-						pos: position{line: 1372, col: 11, offset: 50108},
+						pos: position{line: 1415, col: 11, offset: 52506},
 						exprs: []interface{}{
 							&oneOrMoreExpr{
-								pos: position{line: 1372, col: 11, offset: 50108},
+								pos: position{line: 1415, col: 11, offset: 52506},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1372, col: 11, offset: 50108},
+									pos:  position{line: 1415, col: 11, offset: 52506},
 									name: "Space",
 								},
 							},
 							&zeroOrOneExpr{
-								pos: position{line: 1372, col: 18, offset: 50115},
+								pos: position{line: 1415, col: 18, offset: 52513},
 								expr: &seqExpr{
-									pos: position{line: 1372, col: 19, offset: 50116},
+									pos: position{line: 1415, col: 19, offset: 52514},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1372, col: 19, offset: 50116},
+											pos:        position{line: 1415, col: 19, offset: 52514},
 											val:        "#",
 											ignoreCase: false,
 											want:       "\"#\"",
 										},
 										&notExpr{
-											pos: position{line: 1372, col: 23, offset: 50120},
+											pos: position{line: 1415, col: 23, offset: 52518},
 											expr: &litMatcher{
-												pos:        position{line: 1372, col: 24, offset: 50121},
+												pos:        position{line: 1415, col: 24, offset: 52519},
 												val:        "#",
 												ignoreCase: false,
 												want:       "\"#\"",
@@ -10064,31 +10455,31 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1373, col: 11, offset: 50137},
+						pos:  position{line: 1416, col: 11, offset: 52535},
 						name: "BoldText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1374, col: 11, offset: 50156},
+						pos:  position{line: 1417, col: 11, offset: 52554},
 						name: "ItalicText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1375, col: 11, offset: 50177},
+						pos:  position{line: 1418, col: 11, offset: 52575},
 						name: "MonospaceText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1376, col: 11, offset: 50201},
+						pos:  position{line: 1419, col: 11, offset: 52599},
 						name: "SubscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1377, col: 11, offset: 50225},
+						pos:  position{line: 1420, col: 11, offset: 52623},
 						name: "SuperscriptText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1378, col: 11, offset: 50251},
+						pos:  position{line: 1421, col: 11, offset: 52649},
 						name: "ElementPlaceHolder",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1379, col: 11, offset: 50280},
+						pos:  position{line: 1422, col: 11, offset: 52678},
 						name: "SingleQuoteMarkedTextFallbackCharacter",
 					},
 				},
@@ -10096,31 +10487,31 @@ var g = &grammar{
 		},
 		{
 			name: "SingleQuoteMarkedTextFallbackCharacter",
-			pos:  position{line: 1381, col: 1, offset: 50320},
+			pos:  position{line: 1424, col: 1, offset: 52718},
 			expr: &choiceExpr{
-				pos: position{line: 1382, col: 5, offset: 50366},
+				pos: position{line: 1425, col: 5, offset: 52764},
 				alternatives: []interface{}{
 					&charClassMatcher{
-						pos:        position{line: 1382, col: 5, offset: 50366},
+						pos:        position{line: 1425, col: 5, offset: 52764},
 						val:        "[^\\r\\n#]",
 						chars:      []rune{'\r', '\n', '#'},
 						ignoreCase: false,
 						inverted:   true,
 					},
 					&actionExpr{
-						pos: position{line: 1383, col: 7, offset: 50463},
+						pos: position{line: 1426, col: 7, offset: 52861},
 						run: (*parser).callonSingleQuoteMarkedTextFallbackCharacter3,
 						expr: &seqExpr{
-							pos: position{line: 1383, col: 7, offset: 50463},
+							pos: position{line: 1426, col: 7, offset: 52861},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1383, col: 7, offset: 50463},
+									pos:        position{line: 1426, col: 7, offset: 52861},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1383, col: 11, offset: 50467},
+									pos:  position{line: 1426, col: 11, offset: 52865},
 									name: "Alphanums",
 								},
 							},
@@ -10131,40 +10522,40 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedMarkedText",
-			pos:  position{line: 1387, col: 1, offset: 50630},
+			pos:  position{line: 1430, col: 1, offset: 53028},
 			expr: &choiceExpr{
-				pos: position{line: 1388, col: 5, offset: 50655},
+				pos: position{line: 1431, col: 5, offset: 53053},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1388, col: 5, offset: 50655},
+						pos: position{line: 1431, col: 5, offset: 53053},
 						run: (*parser).callonEscapedMarkedText2,
 						expr: &seqExpr{
-							pos: position{line: 1388, col: 5, offset: 50655},
+							pos: position{line: 1431, col: 5, offset: 53053},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1388, col: 5, offset: 50655},
+									pos:   position{line: 1431, col: 5, offset: 53053},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1388, col: 18, offset: 50668},
+										pos:  position{line: 1431, col: 18, offset: 53066},
 										name: "TwoOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1388, col: 40, offset: 50690},
+									pos:        position{line: 1431, col: 40, offset: 53088},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1388, col: 45, offset: 50695},
+									pos:   position{line: 1431, col: 45, offset: 53093},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1388, col: 55, offset: 50705},
+										pos:  position{line: 1431, col: 55, offset: 53103},
 										name: "DoubleQuoteMarkedTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1388, col: 86, offset: 50736},
+									pos:        position{line: 1431, col: 86, offset: 53134},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
@@ -10173,35 +10564,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1390, col: 9, offset: 50893},
+						pos: position{line: 1433, col: 9, offset: 53291},
 						run: (*parser).callonEscapedMarkedText10,
 						expr: &seqExpr{
-							pos: position{line: 1390, col: 9, offset: 50893},
+							pos: position{line: 1433, col: 9, offset: 53291},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1390, col: 9, offset: 50893},
+									pos:   position{line: 1433, col: 9, offset: 53291},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1390, col: 22, offset: 50906},
+										pos:  position{line: 1433, col: 22, offset: 53304},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1390, col: 44, offset: 50928},
+									pos:        position{line: 1433, col: 44, offset: 53326},
 									val:        "##",
 									ignoreCase: false,
 									want:       "\"##\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1390, col: 49, offset: 50933},
+									pos:   position{line: 1433, col: 49, offset: 53331},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1390, col: 59, offset: 50943},
+										pos:  position{line: 1433, col: 59, offset: 53341},
 										name: "SingleQuoteMarkedTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1390, col: 90, offset: 50974},
+									pos:        position{line: 1433, col: 90, offset: 53372},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -10210,35 +10601,35 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1393, col: 9, offset: 51174},
+						pos: position{line: 1436, col: 9, offset: 53572},
 						run: (*parser).callonEscapedMarkedText18,
 						expr: &seqExpr{
-							pos: position{line: 1393, col: 9, offset: 51174},
+							pos: position{line: 1436, col: 9, offset: 53572},
 							exprs: []interface{}{
 								&labeledExpr{
-									pos:   position{line: 1393, col: 9, offset: 51174},
+									pos:   position{line: 1436, col: 9, offset: 53572},
 									label: "backslashes",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1393, col: 22, offset: 51187},
+										pos:  position{line: 1436, col: 22, offset: 53585},
 										name: "OneOrMoreBackslashes",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1393, col: 44, offset: 51209},
+									pos:        position{line: 1436, col: 44, offset: 53607},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1393, col: 48, offset: 51213},
+									pos:   position{line: 1436, col: 48, offset: 53611},
 									label: "elements",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1393, col: 58, offset: 51223},
+										pos:  position{line: 1436, col: 58, offset: 53621},
 										name: "SingleQuoteMarkedTextElements",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1393, col: 89, offset: 51254},
+									pos:        position{line: 1436, col: 89, offset: 53652},
 									val:        "#",
 									ignoreCase: false,
 									want:       "\"#\"",
@@ -10251,40 +10642,40 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptText",
-			pos:  position{line: 1398, col: 1, offset: 51404},
+			pos:  position{line: 1441, col: 1, offset: 53802},
 			expr: &actionExpr{
-				pos: position{line: 1398, col: 18, offset: 51421},
+				pos: position{line: 1441, col: 18, offset: 53819},
 				run: (*parser).callonSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1398, col: 18, offset: 51421},
+					pos: position{line: 1441, col: 18, offset: 53819},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1398, col: 18, offset: 51421},
+							pos:   position{line: 1441, col: 18, offset: 53819},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1398, col: 24, offset: 51427},
+								pos: position{line: 1441, col: 24, offset: 53825},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1398, col: 25, offset: 51428},
+									pos:  position{line: 1441, col: 25, offset: 53826},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1398, col: 43, offset: 51446},
+							pos:        position{line: 1441, col: 43, offset: 53844},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1398, col: 47, offset: 51450},
+							pos:   position{line: 1441, col: 47, offset: 53848},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1398, col: 56, offset: 51459},
+								pos:  position{line: 1441, col: 56, offset: 53857},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1398, col: 78, offset: 51481},
+							pos:        position{line: 1441, col: 78, offset: 53879},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
@@ -10295,16 +10686,16 @@ var g = &grammar{
 		},
 		{
 			name: "SubscriptTextElement",
-			pos:  position{line: 1402, col: 1, offset: 51577},
+			pos:  position{line: 1445, col: 1, offset: 53975},
 			expr: &choiceExpr{
-				pos: position{line: 1402, col: 25, offset: 51601},
+				pos: position{line: 1445, col: 25, offset: 53999},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1402, col: 25, offset: 51601},
+						pos:  position{line: 1445, col: 25, offset: 53999},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1402, col: 38, offset: 51614},
+						pos:  position{line: 1445, col: 38, offset: 54012},
 						name: "NonSubscriptText",
 					},
 				},
@@ -10312,14 +10703,14 @@ var g = &grammar{
 		},
 		{
 			name: "NonSubscriptText",
-			pos:  position{line: 1404, col: 1, offset: 51633},
+			pos:  position{line: 1447, col: 1, offset: 54031},
 			expr: &actionExpr{
-				pos: position{line: 1404, col: 21, offset: 51653},
+				pos: position{line: 1447, col: 21, offset: 54051},
 				run: (*parser).callonNonSubscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1404, col: 21, offset: 51653},
+					pos: position{line: 1447, col: 21, offset: 54051},
 					expr: &charClassMatcher{
-						pos:        position{line: 1404, col: 21, offset: 51653},
+						pos:        position{line: 1447, col: 21, offset: 54051},
 						val:        "[^\\r\\n ~]",
 						chars:      []rune{'\r', '\n', ' ', '~'},
 						ignoreCase: false,
@@ -10330,37 +10721,37 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSubscriptText",
-			pos:  position{line: 1408, col: 1, offset: 51730},
+			pos:  position{line: 1451, col: 1, offset: 54128},
 			expr: &actionExpr{
-				pos: position{line: 1408, col: 25, offset: 51754},
+				pos: position{line: 1451, col: 25, offset: 54152},
 				run: (*parser).callonEscapedSubscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1408, col: 25, offset: 51754},
+					pos: position{line: 1451, col: 25, offset: 54152},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1408, col: 25, offset: 51754},
+							pos:   position{line: 1451, col: 25, offset: 54152},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1408, col: 38, offset: 51767},
+								pos:  position{line: 1451, col: 38, offset: 54165},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1408, col: 60, offset: 51789},
+							pos:        position{line: 1451, col: 60, offset: 54187},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1408, col: 64, offset: 51793},
+							pos:   position{line: 1451, col: 64, offset: 54191},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1408, col: 73, offset: 51802},
+								pos:  position{line: 1451, col: 73, offset: 54200},
 								name: "SubscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1408, col: 95, offset: 51824},
+							pos:        position{line: 1451, col: 95, offset: 54222},
 							val:        "~",
 							ignoreCase: false,
 							want:       "\"~\"",
@@ -10371,40 +10762,40 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptText",
-			pos:  position{line: 1412, col: 1, offset: 51953},
+			pos:  position{line: 1455, col: 1, offset: 54351},
 			expr: &actionExpr{
-				pos: position{line: 1412, col: 20, offset: 51972},
+				pos: position{line: 1455, col: 20, offset: 54370},
 				run: (*parser).callonSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1412, col: 20, offset: 51972},
+					pos: position{line: 1455, col: 20, offset: 54370},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1412, col: 20, offset: 51972},
+							pos:   position{line: 1455, col: 20, offset: 54370},
 							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1412, col: 26, offset: 51978},
+								pos: position{line: 1455, col: 26, offset: 54376},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1412, col: 27, offset: 51979},
+									pos:  position{line: 1455, col: 27, offset: 54377},
 									name: "QuotedTextAttrs",
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1412, col: 45, offset: 51997},
+							pos:        position{line: 1455, col: 45, offset: 54395},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1412, col: 49, offset: 52001},
+							pos:   position{line: 1455, col: 49, offset: 54399},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1412, col: 58, offset: 52010},
+								pos:  position{line: 1455, col: 58, offset: 54408},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1412, col: 82, offset: 52034},
+							pos:        position{line: 1455, col: 82, offset: 54432},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
@@ -10415,16 +10806,16 @@ var g = &grammar{
 		},
 		{
 			name: "SuperscriptTextElement",
-			pos:  position{line: 1416, col: 1, offset: 52132},
+			pos:  position{line: 1459, col: 1, offset: 54530},
 			expr: &choiceExpr{
-				pos: position{line: 1416, col: 27, offset: 52158},
+				pos: position{line: 1459, col: 27, offset: 54556},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1416, col: 27, offset: 52158},
+						pos:  position{line: 1459, col: 27, offset: 54556},
 						name: "QuotedText",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1416, col: 40, offset: 52171},
+						pos:  position{line: 1459, col: 40, offset: 54569},
 						name: "NonSuperscriptText",
 					},
 				},
@@ -10432,14 +10823,14 @@ var g = &grammar{
 		},
 		{
 			name: "NonSuperscriptText",
-			pos:  position{line: 1418, col: 1, offset: 52192},
+			pos:  position{line: 1461, col: 1, offset: 54590},
 			expr: &actionExpr{
-				pos: position{line: 1418, col: 23, offset: 52214},
+				pos: position{line: 1461, col: 23, offset: 54612},
 				run: (*parser).callonNonSuperscriptText1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 1418, col: 23, offset: 52214},
+					pos: position{line: 1461, col: 23, offset: 54612},
 					expr: &charClassMatcher{
-						pos:        position{line: 1418, col: 23, offset: 52214},
+						pos:        position{line: 1461, col: 23, offset: 54612},
 						val:        "[^\\r\\n ^]",
 						chars:      []rune{'\r', '\n', ' ', '^'},
 						ignoreCase: false,
@@ -10450,37 +10841,37 @@ var g = &grammar{
 		},
 		{
 			name: "EscapedSuperscriptText",
-			pos:  position{line: 1422, col: 1, offset: 52291},
+			pos:  position{line: 1465, col: 1, offset: 54689},
 			expr: &actionExpr{
-				pos: position{line: 1422, col: 27, offset: 52317},
+				pos: position{line: 1465, col: 27, offset: 54715},
 				run: (*parser).callonEscapedSuperscriptText1,
 				expr: &seqExpr{
-					pos: position{line: 1422, col: 27, offset: 52317},
+					pos: position{line: 1465, col: 27, offset: 54715},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1422, col: 27, offset: 52317},
+							pos:   position{line: 1465, col: 27, offset: 54715},
 							label: "backslashes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1422, col: 40, offset: 52330},
+								pos:  position{line: 1465, col: 40, offset: 54728},
 								name: "OneOrMoreBackslashes",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1422, col: 62, offset: 52352},
+							pos:        position{line: 1465, col: 62, offset: 54750},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1422, col: 66, offset: 52356},
+							pos:   position{line: 1465, col: 66, offset: 54754},
 							label: "element",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1422, col: 75, offset: 52365},
+								pos:  position{line: 1465, col: 75, offset: 54763},
 								name: "SuperscriptTextElement",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1422, col: 99, offset: 52389},
+							pos:        position{line: 1465, col: 99, offset: 54787},
 							val:        "^",
 							ignoreCase: false,
 							want:       "\"^\"",
@@ -10491,30 +10882,34 @@ var g = &grammar{
 		},
 		{
 			name: "InlinePassthrough",
-			pos:  position{line: 1429, col: 1, offset: 52631},
+			pos:  position{line: 1472, col: 1, offset: 55029},
 			expr: &choiceExpr{
-				pos: position{line: 1429, col: 22, offset: 52652},
+				pos: position{line: 1472, col: 22, offset: 55050},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1429, col: 22, offset: 52652},
+						pos:  position{line: 1472, col: 22, offset: 55050},
 						name: "TriplePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1429, col: 46, offset: 52676},
+						pos:  position{line: 1472, col: 46, offset: 55074},
 						name: "SinglePlusPassthrough",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1429, col: 70, offset: 52700},
+						pos:  position{line: 1472, col: 70, offset: 55098},
 						name: "PassthroughMacro",
 					},
+					&ruleRefExpr{
+						pos:  position{line: 1472, col: 89, offset: 55117},
+						name: "InlineStem",
+					},
 				},
 			},
 		},
 		{
 			name: "SinglePlusPassthroughPrefix",
-			pos:  position{line: 1431, col: 1, offset: 52718},
+			pos:  position{line: 1474, col: 1, offset: 55129},
 			expr: &litMatcher{
-				pos:        position{line: 1431, col: 32, offset: 52749},
+				pos:        position{line: 1474, col: 32, offset: 55160},
 				val:        "+",
 				ignoreCase: false,
 				want:       "\"+\"",
@@ -10522,33 +10917,33 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthrough",
-			pos:  position{line: 1433, col: 1, offset: 52754},
+			pos:  position{line: 1476, col: 1, offset: 55165},
 			expr: &actionExpr{
-				pos: position{line: 1433, col: 26, offset: 52779},
+				pos: position{line: 1476, col: 26, offset: 55190},
 				run: (*parser).callonSinglePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1433, col: 26, offset: 52779},
+					pos: position{line: 1476, col: 26, offset: 55190},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1433, col: 26, offset: 52779},
+							pos:  position{line: 1476, col: 26, offset: 55190},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1433, col: 54, offset: 52807},
+							pos:   position{line: 1476, col: 54, offset: 55218},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1433, col: 63, offset: 52816},
+								pos:  position{line: 1476, col: 63, offset: 55227},
 								name: "SinglePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1433, col: 93, offset: 52846},
+							pos:  position{line: 1476, col: 93, offset: 55257},
 							name: "SinglePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1433, col: 121, offset: 52874},
+							pos: position{line: 1476, col: 121, offset: 55285},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1433, col: 122, offset: 52875},
+								pos:  position{line: 1476, col: 122, offset: 55286},
 								name: "Alphanum",
 							},
 						},
@@ -10558,85 +10953,85 @@ var g = &grammar{
 		},
 		{
 			name: "SinglePlusPassthroughContent",
-			pos:  position{line: 1437, col: 1, offset: 52980},
+			pos:  position{line: 1480, col: 1, offset: 55391},
 			expr: &choiceExpr{
-				pos: position{line: 1437, col: 33, offset: 53012},
+				pos: position{line: 1480, col: 33, offset: 55423},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1437, col: 34, offset: 53013},
+						pos: position{line: 1480, col: 34, offset: 55424},
 						run: (*parser).callonSinglePlusPassthroughContent2,
 						expr: &seqExpr{
-							pos: position{line: 1437, col: 34, offset: 53013},
+							pos: position{line: 1480, col: 34, offset: 55424},
 							exprs: []interface{}{
 								&seqExpr{
-									pos: position{line: 1437, col: 35, offset: 53014},
+									pos: position{line: 1480, col: 35, offset: 55425},
 									exprs: []interface{}{
 										&notExpr{
-											pos: position{line: 1437, col: 35, offset: 53014},
+											pos: position{line: 1480, col: 35, offset: 55425},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1437, col: 36, offset: 53015},
+												pos:  position{line: 1480, col: 36, offset: 55426},
 												name: "SinglePlusPassthroughPrefix",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1437, col: 64, offset: 53043},
+											pos: position{line: 1480, col: 64, offset: 55454},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1437, col: 65, offset: 53044},
+												pos:  position{line: 1480, col: 65, offset: 55455},
 												name: "Space",
 											},
 										},
 										&notExpr{
-											pos: position{line: 1437, col: 71, offset: 53050},
+											pos: position{line: 1480, col: 71, offset: 55461},
 											expr: &ruleRefExpr{
-												pos:  position{line: 1437, col: 72, offset: 53051},
+												pos:  position{line: 1480, col: 72, offset: 55462},
 												name: "Newline",
 											},
 										},
 										&anyMatcher{
-											line: 1437, col: 80, offset: 53059,
+											line: 1480, col: 80, offset: 55470,
 										},
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1437, col: 83, offset: 53062},
+									pos: position{line: 1480, col: 83, offset: 55473},
 									expr: &seqExpr{
-										pos: position{line: 1437, col: 84, offset: 53063},
+										pos: position{line: 1480, col: 84, offset: 55474},
 										exprs: []interface{}{
 											&notExpr{
-												pos: position{line: 1437, col: 84, offset: 53063},
+												pos: position{line: 1480, col: 84, offset: 55474},
 												expr: &seqExpr{
-													pos: position{line: 1437, col: 86, offset: 53065},
+													pos: position{line: 1480, col: 86, offset: 55476},
 													exprs: []interface{}{
 														&oneOrMoreExpr{
-															pos: position{line: 1437, col: 86, offset: 53065},
+															pos: position{line: 1480, col: 86, offset: 55476},
 															expr: &ruleRefExpr{
-																pos:  position{line: 1437, col: 86, offset: 53065},
+																pos:  position{line: 1480, col: 86, offset: 55476},
 																name: "Space",
 															},
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1437, col: 93, offset: 53072},
+															pos:  position{line: 1480, col: 93, offset: 55483},
 															name: "SinglePlusPassthroughPrefix",
 														},
 													},
 												},
 											},
 											&notExpr{
-												pos: position{line: 1437, col: 122, offset: 53101},
+												pos: position{line: 1480, col: 122, offset: 55512},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1437, col: 123, offset: 53102},
+													pos:  position{line: 1480, col: 123, offset: 55513},
 													name: "SinglePlusPassthroughPrefix",
 												},
 											},
 											&notExpr{
-												pos: position{line: 1437, col: 151, offset: 53130},
+												pos: position{line: 1480, col: 151, offset: 55541},
 												expr: &ruleRefExpr{
-													pos:  position{line: 1437, col: 152, offset: 53131},
+													pos:  position{line: 1480, col: 152, offset: 55542},
 													name: "Newline",
 												},
 											},
 											&anyMatcher{
-												line: 1437, col: 160, offset: 53139,
+												line: 1480, col: 160, offset: 55550,
 											},
 										},
 									},
@@ -10645,34 +11040,77 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1439, col: 7, offset: 53281},
+						pos: position{line: 1482, col: 7, offset: 55692},
 						run: (*parser).callonSinglePlusPassthroughContent24,
 						expr: &seqExpr{
-							pos: position{line: 1439, col: 8, offset: 53282},
+							pos: position{line: 1482, col: 8, offset: 55693},
 							exprs: []interface{}{
 								&notExpr{
-									pos: position{line: 1439, col: 8, offset: 53282},
+									pos: position{line: 1482, col: 8, offset: 55693},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1439, col: 9, offset: 53283},
+										pos:  position{line: 1482, col: 9, offset: 55694},
 										name: "Space",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1439, col: 15, offset: 53289},
+									pos: position{line: 1482, col: 15, offset: 55700},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1439, col: 16, offset: 53290},
+										pos:  position{line: 1482, col: 16, offset: 55701},
 										name: "Newline",
 									},
 								},
 								&notExpr{
-									pos: position{line: 1439, col: 24, offset: 53298},
+									pos: position{line: 1482, col: 24, offset: 55709},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1439, col: 25, offset: 53299},
+										pos:  position{line: 1482, col: 25, offset: 55710},
 										name: "SinglePlusPassthroughPrefix",
 									},
 								},
 								&anyMatcher{
-									line: 1439, col: 53, offset: 53327,
+									line: 1482, col: 53, offset: 55738,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "AlphanumsWithPlus",
+			pos:  position{line: 1488, col: 1, offset: 55959},
+			expr: &actionExpr{
+				pos: position{line: 1488, col: 22, offset: 55980},
+				run: (*parser).callonAlphanumsWithPlus1,
+				expr: &seqExpr{
+					pos: position{line: 1488, col: 22, offset: 55980},
+					exprs: []interface{}{
+						&oneOrMoreExpr{
+							pos: position{line: 1488, col: 22, offset: 55980},
+							expr: &charClassMatcher{
+								pos:        position{line: 1488, col: 22, offset: 55980},
+								val:        "[\\pL0-9]",
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 1488, col: 32, offset: 55990},
+							expr: &seqExpr{
+								pos: position{line: 1488, col: 33, offset: 55991},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 1488, col: 33, offset: 55991},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1488, col: 34, offset: 55992},
+											name: "TriplePlusPassthroughPrefix",
+										},
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1488, col: 62, offset: 56020},
+										name: "SinglePlusPassthroughPrefix",
+									},
 								},
 							},
 						},
@@ -10682,9 +11120,9 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughPrefix",
-			pos:  position{line: 1443, col: 1, offset: 53409},
+			pos:  position{line: 1492, col: 1, offset: 56105},
 			expr: &litMatcher{
-				pos:        position{line: 1443, col: 32, offset: 53440},
+				pos:        position{line: 1492, col: 32, offset: 56136},
 				val:        "+++",
 				ignoreCase: false,
 				want:       "\"+++\"",
@@ -10692,33 +11130,33 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthrough",
-			pos:  position{line: 1445, col: 1, offset: 53447},
+			pos:  position{line: 1494, col: 1, offset: 56143},
 			expr: &actionExpr{
-				pos: position{line: 1445, col: 26, offset: 53472},
+				pos: position{line: 1494, col: 26, offset: 56168},
 				run: (*parser).callonTriplePlusPassthrough1,
 				expr: &seqExpr{
-					pos: position{line: 1445, col: 26, offset: 53472},
+					pos: position{line: 1494, col: 26, offset: 56168},
 					exprs: []interface{}{
 						&ruleRefExpr{
-							pos:  position{line: 1445, col: 26, offset: 53472},
+							pos:  position{line: 1494, col: 26, offset: 56168},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&labeledExpr{
-							pos:   position{line: 1445, col: 54, offset: 53500},
+							pos:   position{line: 1494, col: 54, offset: 56196},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1445, col: 63, offset: 53509},
+								pos:  position{line: 1494, col: 63, offset: 56205},
 								name: "TriplePlusPassthroughContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1445, col: 93, offset: 53539},
+							pos:  position{line: 1494, col: 93, offset: 56235},
 							name: "TriplePlusPassthroughPrefix",
 						},
 						&notExpr{
-							pos: position{line: 1445, col: 121, offset: 53567},
+							pos: position{line: 1494, col: 121, offset: 56263},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1445, col: 122, offset: 53568},
+								pos:  position{line: 1494, col: 122, offset: 56264},
 								name: "Alphanum",
 							},
 						},
@@ -10728,63 +11166,63 @@ var g = &grammar{
 		},
 		{
 			name: "TriplePlusPassthroughContent",
-			pos:  position{line: 1449, col: 1, offset: 53673},
+			pos:  position{line: 1498, col: 1, offset: 56369},
 			expr: &choiceExpr{
-				pos: position{line: 1449, col: 33, offset: 53705},
+				pos: position{line: 1498, col: 33, offset: 56401},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1449, col: 34, offset: 53706},
+						pos: position{line: 1498, col: 34, offset: 56402},
 						run: (*parser).callonTriplePlusPassthroughContent2,
 						expr: &zeroOrMoreExpr{
-							pos: position{line: 1449, col: 34, offset: 53706},
+							pos: position{line: 1498, col: 34, offset: 56402},
 							expr: &seqExpr{
-								pos: position{line: 1449, col: 35, offset: 53707},
+								pos: position{line: 1498, col: 35, offset: 56403},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1449, col: 35, offset: 53707},
+										pos: position{line: 1498, col: 35, offset: 56403},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1449, col: 36, offset: 53708},
+											pos:  position{line: 1498, col: 36, offset: 56404},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1449, col: 64, offset: 53736,
+										line: 1498, col: 64, offset: 56432,
 									},
 								},
 							},
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1451, col: 7, offset: 53901},
+						pos: position{line: 1500, col: 7, offset: 56597},
 						run: (*parser).callonTriplePlusPassthroughContent8,
 						expr: &zeroOrOneExpr{
-							pos: position{line: 1451, col: 7, offset: 53901},
+							pos: position{line: 1500, col: 7, offset: 56597},
 							expr: &seqExpr{
-								pos: position{line: 1451, col: 8, offset: 53902},
+								pos: position{line: 1500, col: 8, offset: 56598},
 								exprs: []interface{}{
 									&notExpr{
-										pos: position{line: 1451, col: 8, offset: 53902},
+										pos: position{line: 1500, col: 8, offset: 56598},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1451, col: 9, offset: 53903},
+											pos:  position{line: 1500, col: 9, offset: 56599},
 											name: "Space",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1451, col: 15, offset: 53909},
+										pos: position{line: 1500, col: 15, offset: 56605},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1451, col: 16, offset: 53910},
+											pos:  position{line: 1500, col: 16, offset: 56606},
 											name: "Newline",
 										},
 									},
 									&notExpr{
-										pos: position{line: 1451, col: 24, offset: 53918},
+										pos: position{line: 1500, col: 24, offset: 56614},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1451, col: 25, offset: 53919},
+											pos:  position{line: 1500, col: 25, offset: 56615},
 											name: "TriplePlusPassthroughPrefix",
 										},
 									},
 									&anyMatcher{
-										line: 1451, col: 53, offset: 53947,
+										line: 1500, col: 53, offset: 56643,
 									},
 								},
 							},
@@ -10795,35 +11233,35 @@ var g = &grammar{
 		},
 		{
 			name: "PassthroughMacro",
-			pos:  position{line: 1455, col: 1, offset: 54030},
+			pos:  position{line: 1504, col: 1, offset: 56726},
 			expr: &choiceExpr{
-				pos: position{line: 1455, col: 21, offset: 54050},
+				pos: position{line: 1504, col: 21, offset: 56746},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1455, col: 21, offset: 54050},
+						pos: position{line: 1504, col: 21, offset: 56746},
 						run: (*parser).callonPassthroughMacro2,
 						expr: &seqExpr{
-							pos: position{line: 1455, col: 21, offset: 54050},
+							pos: position{line: 1504, col: 21, offset: 56746},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1455, col: 21, offset: 54050},
+									pos:        position{line: 1504, col: 21, offset: 56746},
 									val:        "pass:[",
 									ignoreCase: false,
 									want:       "\"pass:[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1455, col: 30, offset: 54059},
+									pos:   position{line: 1504, col: 30, offset: 56755},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1455, col: 38, offset: 54067},
+										pos: position{line: 1504, col: 38, offset: 56763},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1455, col: 39, offset: 54068},
+											pos:  position{line: 1504, col: 39, offset: 56764},
 											name: "PassthroughMacroCharacter",
 										},
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1455, col: 67, offset: 54096},
+									pos:        position{line: 1504, col: 67, offset: 56792},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -10832,31 +11270,31 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1457, col: 5, offset: 54192},
+						pos: position{line: 1506, col: 5, offset: 56888},
 						run: (*parser).callonPassthroughMacro9,
 						expr: &seqExpr{
-							pos: position{line: 1457, col: 5, offset: 54192},
+							pos: position{line: 1506, col: 5, offset: 56888},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1457, col: 5, offset: 54192},
+									pos:        position{line: 1506, col: 5, offset: 56888},
 									val:        "pass:q[",
 									ignoreCase: false,
 									want:       "\"pass:q[\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1457, col: 15, offset: 54202},
+									pos:   position{line: 1506, col: 15, offset: 56898},
 									label: "content",
 									expr: &zeroOrMoreExpr{
-										pos: position{line: 1457, col: 23, offset: 54210},
+										pos: position{line: 1506, col: 23, offset: 56906},
 										expr: &choiceExpr{
-											pos: position{line: 1457, col: 24, offset: 54211},
+											pos: position{line: 1506, col: 24, offset: 56907},
 											alternatives: []interface{}{
 												&ruleRefExpr{
-													pos:  position{line: 1457, col: 24, offset: 54211},
+													pos:  position{line: 1506, col: 24, offset: 56907},
 													name: "QuotedText",
 												},
 												&ruleRefExpr{
-													pos:  position{line: 1457, col: 37, offset: 54224},
+													pos:  position{line: 1506, col: 37, offset: 56920},
 													name: "PassthroughMacroCharacter",
 												},
 											},
@@ -10864,7 +11302,7 @@ var g = &grammar{
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1457, col: 65, offset: 54252},
+									pos:        position{line: 1506, col: 65, offset: 56948},
 									val:        "]",
 									ignoreCase: false,
 									want:       "\"]\"",
@@ -10875,14 +11313,107 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "InlineStem",
+			pos:  position{line: 1511, col: 1, offset: 57139},
+			expr: &actionExpr{
+				pos: position{line: 1511, col: 15, offset: 57153},
+				run: (*parser).callonInlineStem1,
+				expr: &seqExpr{
+					pos: position{line: 1511, col: 15, offset: 57153},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1511, col: 15, offset: 57153},
+							label: "kind",
+							expr: &actionExpr{
+								pos: position{line: 1511, col: 21, offset: 57159},
+								run: (*parser).callonInlineStem4,
+								expr: &choiceExpr{
+									pos: position{line: 1511, col: 22, offset: 57160},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 1511, col: 22, offset: 57160},
+											val:        "stem",
+											ignoreCase: false,
+											want:       "\"stem\"",
+										},
+										&litMatcher{
+											pos:        position{line: 1511, col: 31, offset: 57169},
+											val:        "latexmath",
+											ignoreCase: false,
+											want:       "\"latexmath\"",
+										},
+										&litMatcher{
+											pos:        position{line: 1511, col: 45, offset: 57183},
+											val:        "asciimath",
+											ignoreCase: false,
+											want:       "\"asciimath\"",
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1511, col: 90, offset: 57228},
+							val:        ":[",
+							ignoreCase: false,
+							want:       "\":[\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 1511, col: 95, offset: 57233},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1511, col: 104, offset: 57242},
+								name: "InlineStemContent",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1511, col: 123, offset: 57261},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineStemContent",
+			pos:  position{line: 1515, col: 1, offset: 57334},
+			expr: &actionExpr{
+				pos: position{line: 1515, col: 22, offset: 57355},
+				run: (*parser).callonInlineStemContent1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 1515, col: 22, offset: 57355},
+					expr: &choiceExpr{
+						pos: position{line: 1515, col: 23, offset: 57356},
+						alternatives: []interface{}{
+							&litMatcher{
+								pos:        position{line: 1515, col: 23, offset: 57356},
+								val:        "\\]",
+								ignoreCase: false,
+								want:       "\"\\\\]\"",
+							},
+							&charClassMatcher{
+								pos:        position{line: 1515, col: 31, offset: 57364},
+								val:        "[^\\]]",
+								chars:      []rune{']'},
+								ignoreCase: false,
+								inverted:   true,
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "PassthroughMacroCharacter",
-			pos:  position{line: 1461, col: 1, offset: 54348},
+			pos:  position{line: 1519, col: 1, offset: 57408},
 			expr: &actionExpr{
-				pos: position{line: 1461, col: 30, offset: 54377},
+				pos: position{line: 1519, col: 30, offset: 57437},
 				run: (*parser).callonPassthroughMacroCharacter1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1461, col: 30, offset: 54377},
+					pos:        position{line: 1519, col: 30, offset: 57437},
 					val:        "[^\\]]",
 					chars:      []rune{']'},
 					ignoreCase: false,
@@ -10892,16 +11423,16 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReference",
-			pos:  position{line: 1468, col: 1, offset: 54550},
+			pos:  position{line: 1526, col: 1, offset: 57610},
 			expr: &choiceExpr{
-				pos: position{line: 1468, col: 19, offset: 54568},
+				pos: position{line: 1526, col: 19, offset: 57628},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1468, col: 19, offset: 54568},
+						pos:  position{line: 1526, col: 19, offset: 57628},
 						name: "InternalCrossReference",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1468, col: 44, offset: 54593},
+						pos:  position{line: 1526, col: 44, offset: 57653},
 						name: "ExternalCrossReference",
 					},
 				},
@@ -10909,53 +11440,53 @@ var g = &grammar{
 		},
 		{
 			name: "InternalCrossReference",
-			pos:  position{line: 1470, col: 1, offset: 54618},
+			pos:  position{line: 1528, col: 1, offset: 57678},
 			expr: &choiceExpr{
-				pos: position{line: 1470, col: 27, offset: 54644},
+				pos: position{line: 1528, col: 27, offset: 57704},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 1470, col: 27, offset: 54644},
+						pos: position{line: 1528, col: 27, offset: 57704},
 						run: (*parser).callonInternalCrossReference2,
 						expr: &seqExpr{
-							pos: position{line: 1470, col: 27, offset: 54644},
+							pos: position{line: 1528, col: 27, offset: 57704},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1470, col: 27, offset: 54644},
+									pos:        position{line: 1528, col: 27, offset: 57704},
 									val:        "<<",
 									ignoreCase: false,
 									want:       "\"<<\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1470, col: 32, offset: 54649},
+									pos:   position{line: 1528, col: 32, offset: 57709},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1470, col: 36, offset: 54653},
+										pos:  position{line: 1528, col: 36, offset: 57713},
 										name: "ID",
 									},
 								},
 								&zeroOrMoreExpr{
-									pos: position{line: 1470, col: 40, offset: 54657},
+									pos: position{line: 1528, col: 40, offset: 57717},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1470, col: 40, offset: 54657},
+										pos:  position{line: 1528, col: 40, offset: 57717},
 										name: "Space",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1470, col: 47, offset: 54664},
+									pos:        position{line: 1528, col: 47, offset: 57724},
 									val:        ",",
 									ignoreCase: false,
 									want:       "\",\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1470, col: 51, offset: 54668},
+									pos:   position{line: 1528, col: 51, offset: 57728},
 									label: "label",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1470, col: 58, offset: 54675},
+										pos:  position{line: 1528, col: 58, offset: 57735},
 										name: "CrossReferenceLabel",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1470, col: 79, offset: 54696},
+									pos:        position{line: 1528, col: 79, offset: 57756},
 									val:        ">>",
 									ignoreCase: false,
 									want:       "\">>\"",
@@ -10964,27 +11495,27 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 1472, col: 5, offset: 54779},
+						pos: position{line: 1530, col: 5, offset: 57853},
 						run: (*parser).callonInternalCrossReference13,
 						expr: &seqExpr{
-							pos: position{line: 1472, col: 5, offset: 54779},
+							pos: position{line: 1530, col: 5, offset: 57853},
 							exprs: []interface{}{
 								&litMatcher{
-									pos:        position{line: 1472, col: 5, offset: 54779},
+									pos:        position{line: 1530, col: 5, offset: 57853},
 									val:        "<<",
 									ignoreCase: false,
 									want:       "\"<<\"",
 								},
 								&labeledExpr{
-									pos:   position{line: 1472, col: 10, offset: 54784},
+									pos:   position{line: 1530, col: 10, offset: 57858},
 									label: "id",
 									expr: &ruleRefExpr{
-										pos:  position{line: 1472, col: 14, offset: 54788},
+										pos:  position{line: 1530, col: 14, offset: 57862},
 										name: "ID",
 									},
 								},
 								&litMatcher{
-									pos:        position{line: 1472, col: 18, offset: 54792},
+									pos:        position{line: 1530, col: 18, offset: 57866},
 									val:        ">>",
 									ignoreCase: false,
 									want:       "\">>\"",
@@ -10997,32 +11528,32 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalCrossReference",
-			pos:  position{line: 1476, col: 1, offset: 54864},
+			pos:  position{line: 1534, col: 1, offset: 57952},
 			expr: &actionExpr{
-				pos: position{line: 1476, col: 27, offset: 54890},
+				pos: position{line: 1534, col: 27, offset: 57978},
 				run: (*parser).callonExternalCrossReference1,
 				expr: &seqExpr{
-					pos: position{line: 1476, col: 27, offset: 54890},
+					pos: position{line: 1534, col: 27, offset: 57978},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1476, col: 27, offset: 54890},
+							pos:        position{line: 1534, col: 27, offset: 57978},
 							val:        "xref:",
 							ignoreCase: false,
 							want:       "\"xref:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1476, col: 35, offset: 54898},
+							pos:   position{line: 1534, col: 35, offset: 57986},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1476, col: 40, offset: 54903},
+								pos:  position{line: 1534, col: 40, offset: 57991},
 								name: "FileLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1476, col: 54, offset: 54917},
+							pos:   position{line: 1534, col: 54, offset: 58005},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1476, col: 72, offset: 54935},
+								pos:  position{line: 1534, col: 72, offset: 58023},
 								name: "LinkAttributes",
 							},
 						},
@@ -11032,24 +11563,24 @@ var g = &grammar{
 		},
 		{
 			name: "CrossReferenceLabel",
-			pos:  position{line: 1480, col: 1, offset: 55058},
+			pos:  position{line: 1538, col: 1, offset: 58146},
 			expr: &ruleRefExpr{
-				pos:  position{line: 1480, col: 24, offset: 55081},
+				pos:  position{line: 1538, col: 24, offset: 58169},
 				name: "ElementTitleContent",
 			},
 		},
 		{
 			name: "Link",
-			pos:  position{line: 1485, col: 1, offset: 55203},
+			pos:  position{line: 1543, col: 1, offset: 58291},
 			expr: &choiceExpr{
-				pos: position{line: 1485, col: 9, offset: 55211},
+				pos: position{line: 1543, col: 9, offset: 58299},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1485, col: 9, offset: 55211},
+						pos:  position{line: 1543, col: 9, offset: 58299},
 						name: "RelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1485, col: 24, offset: 55226},
+						pos:  position{line: 1543, col: 24, offset: 58314},
 						name: "ExternalLink",
 					},
 				},
@@ -11057,32 +11588,32 @@ var g = &grammar{
 		},
 		{
 			name: "RelativeLink",
-			pos:  position{line: 1488, col: 1, offset: 55307},
+			pos:  position{line: 1546, col: 1, offset: 58395},
 			expr: &actionExpr{
-				pos: position{line: 1488, col: 17, offset: 55323},
+				pos: position{line: 1546, col: 17, offset: 58411},
 				run: (*parser).callonRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1488, col: 17, offset: 55323},
+					pos: position{line: 1546, col: 17, offset: 58411},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1488, col: 17, offset: 55323},
+							pos:        position{line: 1546, col: 17, offset: 58411},
 							val:        "link:",
 							ignoreCase: false,
 							want:       "\"link:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1488, col: 25, offset: 55331},
+							pos:   position{line: 1546, col: 25, offset: 58419},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1488, col: 30, offset: 55336},
+								pos:  position{line: 1546, col: 30, offset: 58424},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1488, col: 40, offset: 55346},
+							pos:   position{line: 1546, col: 40, offset: 58434},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1488, col: 58, offset: 55364},
+								pos:  position{line: 1546, col: 58, offset: 58452},
 								name: "LinkAttributes",
 							},
 						},
@@ -11092,29 +11623,68 @@ var g = &grammar{
 		},
 		{
 			name: "ExternalLink",
-			pos:  position{line: 1492, col: 1, offset: 55475},
-			expr: &actionExpr{
-				pos: position{line: 1492, col: 17, offset: 55491},
-				run: (*parser).callonExternalLink1,
-				expr: &seqExpr{
-					pos: position{line: 1492, col: 17, offset: 55491},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1492, col: 17, offset: 55491},
+			pos:  position{line: 1550, col: 1, offset: 58563},
+			expr: &choiceExpr{
+				pos: position{line: 1550, col: 17, offset: 58579},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1550, col: 17, offset: 58579},
+						run: (*parser).callonExternalLink2,
+						expr: &seqExpr{
+							pos: position{line: 1550, col: 17, offset: 58579},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1550, col: 17, offset: 58579},
+									label: "url",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1550, col: 22, offset: 58584},
+										name: "LocationWithScheme",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 1550, col: 42, offset: 58604},
+									label: "inlineAttributes",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1550, col: 60, offset: 58622},
+										name: "LinkAttributes",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 1552, col: 5, offset: 58734},
+						run: (*parser).callonExternalLink8,
+						expr: &labeledExpr{
+							pos:   position{line: 1552, col: 5, offset: 58734},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1492, col: 22, offset: 55496},
-								name: "LocationWithScheme",
+								pos:  position{line: 1552, col: 10, offset: 58739},
+								name: "BareLocationWithScheme",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 1492, col: 42, offset: 55516},
-							label: "inlineAttributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1492, col: 59, offset: 55533},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1492, col: 60, offset: 55534},
-									name: "LinkAttributes",
+					},
+					&actionExpr{
+						pos: position{line: 1554, col: 5, offset: 58827},
+						run: (*parser).callonExternalLink11,
+						expr: &seqExpr{
+							pos: position{line: 1554, col: 5, offset: 58827},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 1554, col: 5, offset: 58827},
+									name: "LessThan",
+								},
+								&labeledExpr{
+									pos:   position{line: 1554, col: 14, offset: 58836},
+									label: "url",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1554, col: 19, offset: 58841},
+										name: "BareLocationWithScheme",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1554, col: 43, offset: 58865},
+									name: "GreaterThan",
 								},
 							},
 						},
@@ -11123,51 +11693,229 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LinkAttributes",
-			pos:  position{line: 1496, col: 1, offset: 55627},
-			expr: &actionExpr{
-				pos: position{line: 1496, col: 19, offset: 55645},
-				run: (*parser).callonLinkAttributes1,
-				expr: &seqExpr{
-					pos: position{line: 1496, col: 19, offset: 55645},
-					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 1496, col: 19, offset: 55645},
-							val:        "[",
-							ignoreCase: false,
-							want:       "\"[\"",
+			name: "InlineEmail",
+			pos:  position{line: 1559, col: 1, offset: 59086},
+			expr: &choiceExpr{
+				pos: position{line: 1559, col: 16, offset: 59101},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1559, col: 16, offset: 59101},
+						run: (*parser).callonInlineEmail2,
+						expr: &seqExpr{
+							pos: position{line: 1559, col: 16, offset: 59101},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1559, col: 16, offset: 59101},
+									val:        "\\",
+									ignoreCase: false,
+									want:       "\"\\\\\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 1559, col: 21, offset: 59106},
+									label: "address",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1559, col: 30, offset: 59115},
+										name: "EmailAddress",
+									},
+								},
+							},
 						},
-						&labeledExpr{
-							pos:   position{line: 1496, col: 23, offset: 55649},
-							label: "firstAttr",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 1496, col: 33, offset: 55659},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1496, col: 34, offset: 55660},
-									name: "FirstLinkAttributeElement",
+					},
+					&actionExpr{
+						pos: position{line: 1561, col: 5, offset: 59188},
+						run: (*parser).callonInlineEmail7,
+						expr: &seqExpr{
+							pos: position{line: 1561, col: 5, offset: 59188},
+							exprs: []interface{}{
+								&charClassMatcher{
+									pos:        position{line: 1561, col: 5, offset: 59188},
+									val:        "[:/]",
+									chars:      []rune{':', '/'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1561, col: 10, offset: 59193},
+									name: "EmailAddress",
 								},
 							},
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 1497, col: 5, offset: 55692},
+					},
+					&actionExpr{
+						pos: position{line: 1563, col: 5, offset: 59314},
+						run: (*parser).callonInlineEmail11,
+						expr: &labeledExpr{
+							pos:   position{line: 1563, col: 5, offset: 59314},
+							label: "address",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1497, col: 5, offset: 55692},
-								name: "Space",
+								pos:  position{line: 1563, col: 14, offset: 59323},
+								name: "EmailAddress",
 							},
 						},
-						&labeledExpr{
-							pos:   position{line: 1497, col: 12, offset: 55699},
-							label: "otherattrs",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 1497, col: 23, offset: 55710},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1497, col: 24, offset: 55711},
-									name: "GenericAttribute",
-								},
+					},
+				},
+			},
+		},
+		{
+			name: "EmailAddress",
+			pos:  position{line: 1567, col: 1, offset: 59390},
+			expr: &actionExpr{
+				pos: position{line: 1567, col: 17, offset: 59406},
+				run: (*parser).callonEmailAddress1,
+				expr: &seqExpr{
+					pos: position{line: 1567, col: 17, offset: 59406},
+					exprs: []interface{}{
+						&charClassMatcher{
+							pos:        position{line: 1567, col: 17, offset: 59406},
+							val:        "[\\pL0-9_]",
+							chars:      []rune{'_'},
+							ranges:     []rune{'0', '9'},
+							classes:    []*unicode.RangeTable{rangeTable("L")},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1567, col: 27, offset: 59416},
+							expr: &charClassMatcher{
+								pos:        position{line: 1567, col: 27, offset: 59416},
+								val:        "[\\pL0-9_.%+-]",
+								chars:      []rune{'_', '.', '%', '+', '-'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1567, col: 42, offset: 59431},
+							val:        "@",
+							ignoreCase: false,
+							want:       "\"@\"",
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 1567, col: 46, offset: 59435},
+							expr: &seqExpr{
+								pos: position{line: 1567, col: 47, offset: 59436},
+								exprs: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 1567, col: 47, offset: 59436},
+										val:        "[\\pL0-9]",
+										ranges:     []rune{'0', '9'},
+										classes:    []*unicode.RangeTable{rangeTable("L")},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&zeroOrMoreExpr{
+										pos: position{line: 1567, col: 56, offset: 59445},
+										expr: &charClassMatcher{
+											pos:        position{line: 1567, col: 56, offset: 59445},
+											val:        "[\\pL0-9-]",
+											chars:      []rune{'-'},
+											ranges:     []rune{'0', '9'},
+											classes:    []*unicode.RangeTable{rangeTable("L")},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+									&litMatcher{
+										pos:        position{line: 1567, col: 67, offset: 59456},
+										val:        ".",
+										ignoreCase: false,
+										want:       "\".\"",
+									},
+									&andExpr{
+										pos: position{line: 1567, col: 71, offset: 59460},
+										expr: &charClassMatcher{
+											pos:        position{line: 1567, col: 72, offset: 59461},
+											val:        "[\\pL0-9]",
+											ranges:     []rune{'0', '9'},
+											classes:    []*unicode.RangeTable{rangeTable("L")},
+											ignoreCase: false,
+											inverted:   false,
+										},
+									},
+								},
+							},
+						},
+						&charClassMatcher{
+							pos:        position{line: 1567, col: 83, offset: 59472},
+							val:        "[\\pL]",
+							classes:    []*unicode.RangeTable{rangeTable("L")},
+							ignoreCase: false,
+							inverted:   false,
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 1567, col: 89, offset: 59478},
+							expr: &charClassMatcher{
+								pos:        position{line: 1567, col: 89, offset: 59478},
+								val:        "[\\pL]",
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+						&notExpr{
+							pos: position{line: 1567, col: 96, offset: 59485},
+							expr: &charClassMatcher{
+								pos:        position{line: 1567, col: 97, offset: 59486},
+								val:        "[\\pL0-9_-]",
+								chars:      []rune{'_', '-'},
+								ranges:     []rune{'0', '9'},
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LinkAttributes",
+			pos:  position{line: 1571, col: 1, offset: 59533},
+			expr: &actionExpr{
+				pos: position{line: 1571, col: 19, offset: 59551},
+				run: (*parser).callonLinkAttributes1,
+				expr: &seqExpr{
+					pos: position{line: 1571, col: 19, offset: 59551},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1571, col: 19, offset: 59551},
+							val:        "[",
+							ignoreCase: false,
+							want:       "\"[\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 1571, col: 23, offset: 59555},
+							label: "firstAttr",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1571, col: 33, offset: 59565},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1571, col: 34, offset: 59566},
+									name: "FirstLinkAttributeElement",
+								},
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1572, col: 5, offset: 59598},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1572, col: 5, offset: 59598},
+								name: "Space",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1572, col: 12, offset: 59605},
+							label: "otherattrs",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1572, col: 23, offset: 59616},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1572, col: 24, offset: 59617},
+									name: "GenericAttribute",
+								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1497, col: 43, offset: 55730},
+							pos:        position{line: 1572, col: 43, offset: 59636},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -11178,50 +11926,50 @@ var g = &grammar{
 		},
 		{
 			name: "FirstLinkAttributeElement",
-			pos:  position{line: 1501, col: 1, offset: 55847},
+			pos:  position{line: 1576, col: 1, offset: 59753},
 			expr: &actionExpr{
-				pos: position{line: 1501, col: 30, offset: 55876},
+				pos: position{line: 1576, col: 30, offset: 59782},
 				run: (*parser).callonFirstLinkAttributeElement1,
 				expr: &labeledExpr{
-					pos:   position{line: 1501, col: 30, offset: 55876},
+					pos:   position{line: 1576, col: 30, offset: 59782},
 					label: "element",
 					expr: &choiceExpr{
-						pos: position{line: 1503, col: 5, offset: 55927},
+						pos: position{line: 1578, col: 5, offset: 59833},
 						alternatives: []interface{}{
 							&actionExpr{
-								pos: position{line: 1503, col: 6, offset: 55928},
+								pos: position{line: 1578, col: 6, offset: 59834},
 								run: (*parser).callonFirstLinkAttributeElement4,
 								expr: &seqExpr{
-									pos: position{line: 1503, col: 6, offset: 55928},
+									pos: position{line: 1578, col: 6, offset: 59834},
 									exprs: []interface{}{
 										&litMatcher{
-											pos:        position{line: 1503, col: 6, offset: 55928},
+											pos:        position{line: 1578, col: 6, offset: 59834},
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&labeledExpr{
-											pos:   position{line: 1503, col: 11, offset: 55933},
+											pos:   position{line: 1578, col: 11, offset: 59839},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 1503, col: 20, offset: 55942},
+												pos: position{line: 1578, col: 20, offset: 59848},
 												expr: &choiceExpr{
-													pos: position{line: 1503, col: 21, offset: 55943},
+													pos: position{line: 1578, col: 21, offset: 59849},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1503, col: 21, offset: 55943},
+															pos:  position{line: 1578, col: 21, offset: 59849},
 															name: "QuotedString",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1503, col: 36, offset: 55958},
+															pos:  position{line: 1578, col: 36, offset: 59864},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1503, col: 49, offset: 55971},
+															pos:  position{line: 1578, col: 49, offset: 59877},
 															name: "ElementPlaceHolder",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1503, col: 70, offset: 55992},
+															pos:  position{line: 1578, col: 70, offset: 59898},
 															name: "QuotedAttributeChar",
 														},
 													},
@@ -11229,17 +11977,17 @@ var g = &grammar{
 											},
 										},
 										&litMatcher{
-											pos:        position{line: 1503, col: 92, offset: 56014},
+											pos:        position{line: 1578, col: 92, offset: 59920},
 											val:        "\"",
 											ignoreCase: false,
 											want:       "\"\\\"\"",
 										},
 										&andExpr{
-											pos: position{line: 1503, col: 97, offset: 56019},
+											pos: position{line: 1578, col: 97, offset: 59925},
 											expr: &notExpr{
-												pos: position{line: 1503, col: 99, offset: 56021},
+												pos: position{line: 1578, col: 99, offset: 59927},
 												expr: &litMatcher{
-													pos:        position{line: 1503, col: 100, offset: 56022},
+													pos:        position{line: 1578, col: 100, offset: 59928},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
@@ -11247,9 +11995,9 @@ var g = &grammar{
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 1503, col: 105, offset: 56027},
+											pos: position{line: 1578, col: 105, offset: 59933},
 											expr: &litMatcher{
-												pos:        position{line: 1503, col: 105, offset: 56027},
+												pos:        position{line: 1578, col: 105, offset: 59933},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
@@ -11259,33 +12007,33 @@ var g = &grammar{
 								},
 							},
 							&actionExpr{
-								pos: position{line: 1507, col: 6, offset: 56154},
+								pos: position{line: 1582, col: 6, offset: 60060},
 								run: (*parser).callonFirstLinkAttributeElement20,
 								expr: &seqExpr{
-									pos: position{line: 1507, col: 6, offset: 56154},
+									pos: position{line: 1582, col: 6, offset: 60060},
 									exprs: []interface{}{
 										&labeledExpr{
-											pos:   position{line: 1507, col: 6, offset: 56154},
+											pos:   position{line: 1582, col: 6, offset: 60060},
 											label: "elements",
 											expr: &oneOrMoreExpr{
-												pos: position{line: 1507, col: 15, offset: 56163},
+												pos: position{line: 1582, col: 15, offset: 60069},
 												expr: &choiceExpr{
-													pos: position{line: 1507, col: 16, offset: 56164},
+													pos: position{line: 1582, col: 16, offset: 60070},
 													alternatives: []interface{}{
 														&ruleRefExpr{
-															pos:  position{line: 1507, col: 16, offset: 56164},
+															pos:  position{line: 1582, col: 16, offset: 60070},
 															name: "QuotedString",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1507, col: 31, offset: 56179},
+															pos:  position{line: 1582, col: 31, offset: 60085},
 															name: "QuotedText",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1507, col: 44, offset: 56192},
+															pos:  position{line: 1582, col: 44, offset: 60098},
 															name: "ElementPlaceHolder",
 														},
 														&ruleRefExpr{
-															pos:  position{line: 1507, col: 65, offset: 56213},
+															pos:  position{line: 1582, col: 65, offset: 60119},
 															name: "UnquotedAttributeChar",
 														},
 													},
@@ -11293,11 +12041,11 @@ var g = &grammar{
 											},
 										},
 										&andExpr{
-											pos: position{line: 1507, col: 89, offset: 56237},
+											pos: position{line: 1582, col: 89, offset: 60143},
 											expr: &notExpr{
-												pos: position{line: 1507, col: 91, offset: 56239},
+												pos: position{line: 1582, col: 91, offset: 60145},
 												expr: &litMatcher{
-													pos:        position{line: 1507, col: 92, offset: 56240},
+													pos:        position{line: 1582, col: 92, offset: 60146},
 													val:        "=",
 													ignoreCase: false,
 													want:       "\"=\"",
@@ -11305,9 +12053,9 @@ var g = &grammar{
 											},
 										},
 										&zeroOrOneExpr{
-											pos: position{line: 1507, col: 97, offset: 56245},
+											pos: position{line: 1582, col: 97, offset: 60151},
 											expr: &litMatcher{
-												pos:        position{line: 1507, col: 97, offset: 56245},
+												pos:        position{line: 1582, col: 97, offset: 60151},
 												val:        ",",
 												ignoreCase: false,
 												want:       "\",\"",
@@ -11323,12 +12071,12 @@ var g = &grammar{
 		},
 		{
 			name: "AttributeChar",
-			pos:  position{line: 1513, col: 1, offset: 56359},
+			pos:  position{line: 1588, col: 1, offset: 60265},
 			expr: &actionExpr{
-				pos: position{line: 1513, col: 18, offset: 56376},
+				pos: position{line: 1588, col: 18, offset: 60282},
 				run: (*parser).callonAttributeChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1513, col: 18, offset: 56376},
+					pos:        position{line: 1588, col: 18, offset: 60282},
 					val:        "[^\\r\\n\"=\\],]",
 					chars:      []rune{'\r', '\n', '"', '=', ']', ','},
 					ignoreCase: false,
@@ -11338,12 +12086,12 @@ var g = &grammar{
 		},
 		{
 			name: "QuotedAttributeChar",
-			pos:  position{line: 1517, col: 1, offset: 56462},
+			pos:  position{line: 1592, col: 1, offset: 60368},
 			expr: &actionExpr{
-				pos: position{line: 1517, col: 24, offset: 56485},
+				pos: position{line: 1592, col: 24, offset: 60391},
 				run: (*parser).callonQuotedAttributeChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1517, col: 24, offset: 56485},
+					pos:        position{line: 1592, col: 24, offset: 60391},
 					val:        "[^\\r\\n\"=\\]]",
 					chars:      []rune{'\r', '\n', '"', '=', ']'},
 					ignoreCase: false,
@@ -11353,12 +12101,12 @@ var g = &grammar{
 		},
 		{
 			name: "UnquotedAttributeChar",
-			pos:  position{line: 1521, col: 1, offset: 56578},
+			pos:  position{line: 1596, col: 1, offset: 60484},
 			expr: &actionExpr{
-				pos: position{line: 1521, col: 26, offset: 56603},
+				pos: position{line: 1596, col: 26, offset: 60509},
 				run: (*parser).callonUnquotedAttributeChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 1521, col: 26, offset: 56603},
+					pos:        position{line: 1596, col: 26, offset: 60509},
 					val:        "[^\\r\\n\"=\\],]",
 					chars:      []rune{'\r', '\n', '"', '=', ']', ','},
 					ignoreCase: false,
@@ -11368,16 +12116,16 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLink",
-			pos:  position{line: 1525, col: 1, offset: 56689},
+			pos:  position{line: 1600, col: 1, offset: 60595},
 			expr: &choiceExpr{
-				pos: position{line: 1525, col: 17, offset: 56705},
+				pos: position{line: 1600, col: 17, offset: 60611},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1525, col: 17, offset: 56705},
+						pos:  position{line: 1600, col: 17, offset: 60611},
 						name: "ResolvedRelativeLink",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1525, col: 40, offset: 56728},
+						pos:  position{line: 1600, col: 40, offset: 60634},
 						name: "ResolvedExternalLink",
 					},
 				},
@@ -11385,41 +12133,41 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedRelativeLink",
-			pos:  position{line: 1528, col: 1, offset: 56856},
+			pos:  position{line: 1603, col: 1, offset: 60762},
 			expr: &actionExpr{
-				pos: position{line: 1528, col: 25, offset: 56880},
+				pos: position{line: 1603, col: 25, offset: 60786},
 				run: (*parser).callonResolvedRelativeLink1,
 				expr: &seqExpr{
-					pos: position{line: 1528, col: 25, offset: 56880},
+					pos: position{line: 1603, col: 25, offset: 60786},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1528, col: 25, offset: 56880},
+							pos:        position{line: 1603, col: 25, offset: 60786},
 							val:        "link:",
 							ignoreCase: false,
 							want:       "\"link:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1528, col: 33, offset: 56888},
+							pos:   position{line: 1603, col: 33, offset: 60794},
 							label: "url",
 							expr: &choiceExpr{
-								pos: position{line: 1528, col: 38, offset: 56893},
+								pos: position{line: 1603, col: 38, offset: 60799},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1528, col: 38, offset: 56893},
+										pos:  position{line: 1603, col: 38, offset: 60799},
 										name: "ResolvedLocation",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1528, col: 57, offset: 56912},
+										pos:  position{line: 1603, col: 57, offset: 60818},
 										name: "ResolvedFileLocation",
 									},
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1528, col: 79, offset: 56934},
+							pos:   position{line: 1603, col: 79, offset: 60840},
 							label: "inlineAttributes",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1528, col: 97, offset: 56952},
+								pos:  position{line: 1603, col: 97, offset: 60858},
 								name: "LinkAttributes",
 							},
 						},
@@ -11429,28 +12177,28 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedExternalLink",
-			pos:  position{line: 1532, col: 1, offset: 57063},
+			pos:  position{line: 1607, col: 1, offset: 60969},
 			expr: &actionExpr{
-				pos: position{line: 1532, col: 25, offset: 57087},
+				pos: position{line: 1607, col: 25, offset: 60993},
 				run: (*parser).callonResolvedExternalLink1,
 				expr: &seqExpr{
-					pos: position{line: 1532, col: 25, offset: 57087},
+					pos: position{line: 1607, col: 25, offset: 60993},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1532, col: 25, offset: 57087},
+							pos:   position{line: 1607, col: 25, offset: 60993},
 							label: "url",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1532, col: 30, offset: 57092},
+								pos:  position{line: 1607, col: 30, offset: 60998},
 								name: "ResolvedLocation",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1532, col: 48, offset: 57110},
+							pos:   position{line: 1607, col: 48, offset: 61016},
 							label: "inlineAttributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1532, col: 65, offset: 57127},
+								pos: position{line: 1607, col: 65, offset: 61033},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1532, col: 66, offset: 57128},
+									pos:  position{line: 1607, col: 66, offset: 61034},
 									name: "LinkAttributes",
 								},
 							},
@@ -11461,55 +12209,63 @@ var g = &grammar{
 		},
 		{
 			name: "ImageBlock",
-			pos:  position{line: 1539, col: 1, offset: 57323},
+			pos:  position{line: 1614, col: 1, offset: 61229},
 			expr: &actionExpr{
-				pos: position{line: 1539, col: 15, offset: 57337},
+				pos: position{line: 1614, col: 15, offset: 61243},
 				run: (*parser).callonImageBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1539, col: 15, offset: 57337},
+					pos: position{line: 1614, col: 15, offset: 61243},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1539, col: 15, offset: 57337},
+							pos:   position{line: 1614, col: 15, offset: 61243},
 							label: "attributes",
 							expr: &zeroOrMoreExpr{
-								pos: position{line: 1539, col: 26, offset: 57348},
+								pos: position{line: 1614, col: 26, offset: 61254},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1539, col: 27, offset: 57349},
+									pos:  position{line: 1614, col: 27, offset: 61255},
 									name: "BlockImageAttrs",
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 1614, col: 45, offset: 61273},
+							label: "pos",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1614, col: 50, offset: 61278},
+								name: "SourcePosition",
+							},
+						},
 						&litMatcher{
-							pos:        position{line: 1539, col: 45, offset: 57367},
+							pos:        position{line: 1614, col: 66, offset: 61294},
 							val:        "image::",
 							ignoreCase: false,
 							want:       "\"image::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1539, col: 55, offset: 57377},
+							pos:   position{line: 1614, col: 76, offset: 61304},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1539, col: 61, offset: 57383},
+								pos:  position{line: 1614, col: 82, offset: 61310},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1539, col: 71, offset: 57393},
+							pos:   position{line: 1614, col: 92, offset: 61320},
 							label: "inlineAttrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1539, col: 84, offset: 57406},
+								pos:  position{line: 1614, col: 105, offset: 61333},
 								name: "InlineImageAttrs",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1539, col: 102, offset: 57424},
+							pos: position{line: 1614, col: 123, offset: 61351},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1539, col: 102, offset: 57424},
+								pos:  position{line: 1614, col: 123, offset: 61351},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1539, col: 109, offset: 57431},
+							pos:  position{line: 1614, col: 130, offset: 61358},
 							name: "EOL",
 						},
 					},
@@ -11518,41 +12274,41 @@ var g = &grammar{
 		},
 		{
 			name: "InlineImage",
-			pos:  position{line: 1544, col: 1, offset: 57641},
+			pos:  position{line: 1619, col: 1, offset: 61590},
 			expr: &actionExpr{
-				pos: position{line: 1544, col: 16, offset: 57656},
+				pos: position{line: 1619, col: 16, offset: 61605},
 				run: (*parser).callonInlineImage1,
 				expr: &seqExpr{
-					pos: position{line: 1544, col: 16, offset: 57656},
+					pos: position{line: 1619, col: 16, offset: 61605},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1544, col: 16, offset: 57656},
+							pos:        position{line: 1619, col: 16, offset: 61605},
 							val:        "image:",
 							ignoreCase: false,
 							want:       "\"image:\"",
 						},
 						&notExpr{
-							pos: position{line: 1544, col: 25, offset: 57665},
+							pos: position{line: 1619, col: 25, offset: 61614},
 							expr: &litMatcher{
-								pos:        position{line: 1544, col: 26, offset: 57666},
+								pos:        position{line: 1619, col: 26, offset: 61615},
 								val:        ":",
 								ignoreCase: false,
 								want:       "\":\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1544, col: 30, offset: 57670},
+							pos:   position{line: 1619, col: 30, offset: 61619},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1544, col: 36, offset: 57676},
+								pos:  position{line: 1619, col: 36, offset: 61625},
 								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1544, col: 46, offset: 57686},
+							pos:   position{line: 1619, col: 46, offset: 61635},
 							label: "inlineAttrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1544, col: 59, offset: 57699},
+								pos:  position{line: 1619, col: 59, offset: 61648},
 								name: "InlineImageAttrs",
 							},
 						},
@@ -11562,53 +12318,53 @@ var g = &grammar{
 		},
 		{
 			name: "InlineImageAttrs",
-			pos:  position{line: 1548, col: 1, offset: 57837},
+			pos:  position{line: 1623, col: 1, offset: 61800},
 			expr: &actionExpr{
-				pos: position{line: 1548, col: 21, offset: 57857},
+				pos: position{line: 1623, col: 21, offset: 61820},
 				run: (*parser).callonInlineImageAttrs1,
 				expr: &seqExpr{
-					pos: position{line: 1548, col: 21, offset: 57857},
+					pos: position{line: 1623, col: 21, offset: 61820},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1548, col: 21, offset: 57857},
+							pos:        position{line: 1623, col: 21, offset: 61820},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1548, col: 25, offset: 57861},
+							pos:   position{line: 1623, col: 25, offset: 61824},
 							label: "alt",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1548, col: 29, offset: 57865},
+								pos:  position{line: 1623, col: 29, offset: 61828},
 								name: "ImageAltInline",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1548, col: 44, offset: 57880},
+							pos:   position{line: 1623, col: 44, offset: 61843},
 							label: "w",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1548, col: 46, offset: 57882},
+								pos:  position{line: 1623, col: 46, offset: 61845},
 								name: "ImageWidth",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1548, col: 57, offset: 57893},
+							pos:   position{line: 1623, col: 57, offset: 61856},
 							label: "h",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1548, col: 59, offset: 57895},
+								pos:  position{line: 1623, col: 59, offset: 61858},
 								name: "ImageHeight",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1548, col: 71, offset: 57907},
+							pos:   position{line: 1623, col: 71, offset: 61870},
 							label: "nv",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1548, col: 74, offset: 57910},
+								pos:  position{line: 1623, col: 74, offset: 61873},
 								name: "NamedAttrs",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1548, col: 85, offset: 57921},
+							pos:        position{line: 1623, col: 85, offset: 61884},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -11619,20 +12375,20 @@ var g = &grammar{
 		},
 		{
 			name: "BlockImageAttrs",
-			pos:  position{line: 1552, col: 1, offset: 57983},
+			pos:  position{line: 1627, col: 1, offset: 61946},
 			expr: &choiceExpr{
-				pos: position{line: 1552, col: 20, offset: 58002},
+				pos: position{line: 1627, col: 20, offset: 61965},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 1552, col: 20, offset: 58002},
+						pos:  position{line: 1627, col: 20, offset: 61965},
 						name: "ImageAttrList",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1552, col: 36, offset: 58018},
+						pos:  position{line: 1627, col: 36, offset: 61981},
 						name: "ElementTitle",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1552, col: 51, offset: 58033},
+						pos:  position{line: 1627, col: 51, offset: 61996},
 						name: "ElementID",
 					},
 				},
@@ -11640,57 +12396,57 @@ var g = &grammar{
 		},
 		{
 			name: "ImageAttrList",
-			pos:  position{line: 1554, col: 1, offset: 58044},
+			pos:  position{line: 1629, col: 1, offset: 62007},
 			expr: &actionExpr{
-				pos: position{line: 1554, col: 18, offset: 58061},
+				pos: position{line: 1629, col: 18, offset: 62024},
 				run: (*parser).callonImageAttrList1,
 				expr: &seqExpr{
-					pos: position{line: 1554, col: 18, offset: 58061},
+					pos: position{line: 1629, col: 18, offset: 62024},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1554, col: 18, offset: 58061},
+							pos:        position{line: 1629, col: 18, offset: 62024},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1554, col: 22, offset: 58065},
+							pos:   position{line: 1629, col: 22, offset: 62028},
 							label: "attrs",
 							expr: &seqExpr{
-								pos: position{line: 1554, col: 29, offset: 58072},
+								pos: position{line: 1629, col: 29, offset: 62035},
 								exprs: []interface{}{
 									&zeroOrOneExpr{
-										pos: position{line: 1554, col: 29, offset: 58072},
+										pos: position{line: 1629, col: 29, offset: 62035},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1554, col: 29, offset: 58072},
+											pos:  position{line: 1629, col: 29, offset: 62035},
 											name: "ImageAltAttr",
 										},
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 1554, col: 43, offset: 58086},
+										pos: position{line: 1629, col: 43, offset: 62049},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1554, col: 43, offset: 58086},
+											pos:  position{line: 1629, col: 43, offset: 62049},
 											name: "ShortHandAttr",
 										},
 									},
 									&zeroOrOneExpr{
-										pos: position{line: 1554, col: 58, offset: 58101},
+										pos: position{line: 1629, col: 58, offset: 62064},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1554, col: 58, offset: 58101},
+											pos:  position{line: 1629, col: 58, offset: 62064},
 											name: "ImageWidthAttr",
 										},
 									},
 									&zeroOrOneExpr{
-										pos: position{line: 1554, col: 74, offset: 58117},
+										pos: position{line: 1629, col: 74, offset: 62080},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1554, col: 74, offset: 58117},
+											pos:  position{line: 1629, col: 74, offset: 62080},
 											name: "ImageHeightAttr",
 										},
 									},
 									&zeroOrMoreExpr{
-										pos: position{line: 1554, col: 91, offset: 58134},
+										pos: position{line: 1629, col: 91, offset: 62097},
 										expr: &ruleRefExpr{
-											pos:  position{line: 1554, col: 91, offset: 58134},
+											pos:  position{line: 1629, col: 91, offset: 62097},
 											name: "NamedAttr",
 										},
 									},
@@ -11698,20 +12454,20 @@ var g = &grammar{
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1554, col: 103, offset: 58146},
+							pos:        position{line: 1629, col: 103, offset: 62109},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1554, col: 107, offset: 58150},
+							pos: position{line: 1629, col: 107, offset: 62113},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1554, col: 107, offset: 58150},
+								pos:  position{line: 1629, col: 107, offset: 62113},
 								name: "Space",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1554, col: 114, offset: 58157},
+							pos:  position{line: 1629, col: 114, offset: 62120},
 							name: "EOL",
 						},
 					},
@@ -11720,17 +12476,17 @@ var g = &grammar{
 		},
 		{
 			name: "ImageAltInline",
-			pos:  position{line: 1558, col: 1, offset: 58211},
+			pos:  position{line: 1633, col: 1, offset: 62174},
 			expr: &actionExpr{
-				pos: position{line: 1558, col: 19, offset: 58229},
+				pos: position{line: 1633, col: 19, offset: 62192},
 				run: (*parser).callonImageAltInline1,
 				expr: &labeledExpr{
-					pos:   position{line: 1558, col: 19, offset: 58229},
+					pos:   position{line: 1633, col: 19, offset: 62192},
 					label: "value",
 					expr: &zeroOrOneExpr{
-						pos: position{line: 1558, col: 25, offset: 58235},
+						pos: position{line: 1633, col: 25, offset: 62198},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1558, col: 25, offset: 58235},
+							pos:  position{line: 1633, col: 25, offset: 62198},
 							name: "InlineVal",
 						},
 					},
@@ -11739,29 +12495,29 @@ var g = &grammar{
 		},
 		{
 			name: "ImageWidth",
-			pos:  position{line: 1562, col: 1, offset: 58314},
+			pos:  position{line: 1637, col: 1, offset: 62277},
 			expr: &actionExpr{
-				pos: position{line: 1562, col: 15, offset: 58328},
+				pos: position{line: 1637, col: 15, offset: 62291},
 				run: (*parser).callonImageWidth1,
 				expr: &seqExpr{
-					pos: position{line: 1562, col: 15, offset: 58328},
+					pos: position{line: 1637, col: 15, offset: 62291},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 1562, col: 15, offset: 58328},
+							pos: position{line: 1637, col: 15, offset: 62291},
 							expr: &litMatcher{
-								pos:        position{line: 1562, col: 15, offset: 58328},
+								pos:        position{line: 1637, col: 15, offset: 62291},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1562, col: 20, offset: 58333},
+							pos:   position{line: 1637, col: 20, offset: 62296},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1562, col: 26, offset: 58339},
+								pos: position{line: 1637, col: 26, offset: 62302},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1562, col: 26, offset: 58339},
+									pos:  position{line: 1637, col: 26, offset: 62302},
 									name: "InlineVal",
 								},
 							},
@@ -11772,29 +12528,29 @@ var g = &grammar{
 		},
 		{
 			name: "ImageHeight",
-			pos:  position{line: 1566, col: 1, offset: 58415},
+			pos:  position{line: 1641, col: 1, offset: 62378},
 			expr: &actionExpr{
-				pos: position{line: 1566, col: 16, offset: 58430},
+				pos: position{line: 1641, col: 16, offset: 62393},
 				run: (*parser).callonImageHeight1,
 				expr: &seqExpr{
-					pos: position{line: 1566, col: 16, offset: 58430},
+					pos: position{line: 1641, col: 16, offset: 62393},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 1566, col: 16, offset: 58430},
+							pos: position{line: 1641, col: 16, offset: 62393},
 							expr: &litMatcher{
-								pos:        position{line: 1566, col: 16, offset: 58430},
+								pos:        position{line: 1641, col: 16, offset: 62393},
 								val:        ",",
 								ignoreCase: false,
 								want:       "\",\"",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1566, col: 21, offset: 58435},
+							pos:   position{line: 1641, col: 21, offset: 62398},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1566, col: 27, offset: 58441},
+								pos: position{line: 1641, col: 27, offset: 62404},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1566, col: 27, offset: 58441},
+									pos:  position{line: 1641, col: 27, offset: 62404},
 									name: "InlineVal",
 								},
 							},
@@ -11805,32 +12561,32 @@ var g = &grammar{
 		},
 		{
 			name: "ImageAltAttr",
-			pos:  position{line: 1570, col: 1, offset: 58523},
+			pos:  position{line: 1645, col: 1, offset: 62486},
 			expr: &actionExpr{
-				pos: position{line: 1570, col: 17, offset: 58539},
+				pos: position{line: 1645, col: 17, offset: 62502},
 				run: (*parser).callonImageAltAttr1,
 				expr: &seqExpr{
-					pos: position{line: 1570, col: 17, offset: 58539},
+					pos: position{line: 1645, col: 17, offset: 62502},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 1570, col: 17, offset: 58539},
+							pos: position{line: 1645, col: 17, offset: 62502},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1570, col: 17, offset: 58539},
+								pos:  position{line: 1645, col: 17, offset: 62502},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1570, col: 24, offset: 58546},
+							pos:   position{line: 1645, col: 24, offset: 62509},
 							label: "value",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1570, col: 30, offset: 58552},
+								pos:  position{line: 1645, col: 30, offset: 62515},
 								name: "PositionalValue",
 							},
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1570, col: 46, offset: 58568},
+							pos: position{line: 1645, col: 46, offset: 62531},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1570, col: 46, offset: 58568},
+								pos:  position{line: 1645, col: 46, offset: 62531},
 								name: "Space",
 							},
 						},
@@ -11840,40 +12596,40 @@ var g = &grammar{
 		},
 		{
 			name: "ImageWidthAttr",
-			pos:  position{line: 1574, col: 1, offset: 58644},
+			pos:  position{line: 1649, col: 1, offset: 62607},
 			expr: &actionExpr{
-				pos: position{line: 1574, col: 19, offset: 58662},
+				pos: position{line: 1649, col: 19, offset: 62625},
 				run: (*parser).callonImageWidthAttr1,
 				expr: &seqExpr{
-					pos: position{line: 1574, col: 19, offset: 58662},
+					pos: position{line: 1649, col: 19, offset: 62625},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 1574, col: 19, offset: 58662},
+							pos: position{line: 1649, col: 19, offset: 62625},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1574, col: 19, offset: 58662},
+								pos:  position{line: 1649, col: 19, offset: 62625},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1574, col: 26, offset: 58669},
+							pos:        position{line: 1649, col: 26, offset: 62632},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1574, col: 30, offset: 58673},
+							pos: position{line: 1649, col: 30, offset: 62636},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1574, col: 30, offset: 58673},
+								pos:  position{line: 1649, col: 30, offset: 62636},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1574, col: 37, offset: 58680},
+							pos:   position{line: 1649, col: 37, offset: 62643},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1574, col: 43, offset: 58686},
+								pos: position{line: 1649, col: 43, offset: 62649},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1574, col: 43, offset: 58686},
+									pos:  position{line: 1649, col: 43, offset: 62649},
 									name: "PositionalValue",
 								},
 							},
@@ -11884,40 +12640,40 @@ var g = &grammar{
 		},
 		{
 			name: "ImageHeightAttr",
-			pos:  position{line: 1581, col: 1, offset: 58821},
+			pos:  position{line: 1656, col: 1, offset: 62784},
 			expr: &actionExpr{
-				pos: position{line: 1581, col: 20, offset: 58840},
+				pos: position{line: 1656, col: 20, offset: 62803},
 				run: (*parser).callonImageHeightAttr1,
 				expr: &seqExpr{
-					pos: position{line: 1581, col: 20, offset: 58840},
+					pos: position{line: 1656, col: 20, offset: 62803},
 					exprs: []interface{}{
 						&zeroOrMoreExpr{
-							pos: position{line: 1581, col: 20, offset: 58840},
+							pos: position{line: 1656, col: 20, offset: 62803},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1581, col: 20, offset: 58840},
+								pos:  position{line: 1656, col: 20, offset: 62803},
 								name: "Space",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1581, col: 27, offset: 58847},
+							pos:        position{line: 1656, col: 27, offset: 62810},
 							val:        ",",
 							ignoreCase: false,
 							want:       "\",\"",
 						},
 						&zeroOrMoreExpr{
-							pos: position{line: 1581, col: 31, offset: 58851},
+							pos: position{line: 1656, col: 31, offset: 62814},
 							expr: &ruleRefExpr{
-								pos:  position{line: 1581, col: 31, offset: 58851},
+								pos:  position{line: 1656, col: 31, offset: 62814},
 								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1581, col: 38, offset: 58858},
+							pos:   position{line: 1656, col: 38, offset: 62821},
 							label: "value",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1581, col: 44, offset: 58864},
+								pos: position{line: 1656, col: 44, offset: 62827},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1581, col: 44, offset: 58864},
+									pos:  position{line: 1656, col: 44, offset: 62827},
 									name: "PositionalValue",
 								},
 							},
@@ -11927,85 +12683,111 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "InlineIcon",
-			pos:  position{line: 1592, col: 1, offset: 59198},
+			name: "VideoBlock",
+			pos:  position{line: 1667, col: 1, offset: 63166},
 			expr: &actionExpr{
-				pos: position{line: 1592, col: 15, offset: 59212},
-				run: (*parser).callonInlineIcon1,
+				pos: position{line: 1667, col: 15, offset: 63180},
+				run: (*parser).callonVideoBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1592, col: 15, offset: 59212},
+					pos: position{line: 1667, col: 15, offset: 63180},
 					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1667, col: 15, offset: 63180},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1667, col: 26, offset: 63191},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1667, col: 27, offset: 63192},
+									name: "BlockAttrs",
+								},
+							},
+						},
 						&litMatcher{
-							pos:        position{line: 1592, col: 15, offset: 59212},
-							val:        "icon:",
+							pos:        position{line: 1667, col: 40, offset: 63205},
+							val:        "video::",
 							ignoreCase: false,
-							want:       "\"icon:\"",
+							want:       "\"video::\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1592, col: 23, offset: 59220},
-							label: "icon",
-							expr: &actionExpr{
-								pos: position{line: 1592, col: 29, offset: 59226},
-								run: (*parser).callonInlineIcon5,
-								expr: &oneOrMoreExpr{
-									pos: position{line: 1592, col: 29, offset: 59226},
-									expr: &charClassMatcher{
-										pos:        position{line: 1592, col: 29, offset: 59226},
-										val:        "[\\pL0-9_-]",
-										chars:      []rune{'_', '-'},
-										ranges:     []rune{'0', '9'},
-										classes:    []*unicode.RangeTable{rangeTable("L")},
-										ignoreCase: false,
-										inverted:   false,
-									},
-								},
+							pos:   position{line: 1667, col: 50, offset: 63215},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1667, col: 56, offset: 63221},
+								name: "Location",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1592, col: 73, offset: 59270},
-							label: "attrs",
+							pos:   position{line: 1667, col: 66, offset: 63231},
+							label: "inlineAttrs",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1592, col: 80, offset: 59277},
-								name: "IconAttrs",
+								pos:  position{line: 1667, col: 79, offset: 63244},
+								name: "VideoAttrs",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1667, col: 91, offset: 63256},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1667, col: 91, offset: 63256},
+								name: "Space",
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 1667, col: 98, offset: 63263},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "IconAttrs",
-			pos:  position{line: 1596, col: 1, offset: 59359},
+			name: "VideoAttrs",
+			pos:  position{line: 1672, col: 1, offset: 63473},
 			expr: &actionExpr{
-				pos: position{line: 1596, col: 14, offset: 59372},
-				run: (*parser).callonIconAttrs1,
+				pos: position{line: 1672, col: 15, offset: 63487},
+				run: (*parser).callonVideoAttrs1,
 				expr: &seqExpr{
-					pos: position{line: 1596, col: 14, offset: 59372},
+					pos: position{line: 1672, col: 15, offset: 63487},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1596, col: 14, offset: 59372},
+							pos:        position{line: 1672, col: 15, offset: 63487},
 							val:        "[",
 							ignoreCase: false,
 							want:       "\"[\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1596, col: 18, offset: 59376},
-							label: "size",
+							pos:   position{line: 1672, col: 19, offset: 63491},
+							label: "poster",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1596, col: 23, offset: 59381},
-								name: "IconSize",
+								pos:  position{line: 1672, col: 26, offset: 63498},
+								name: "VideoPoster",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1672, col: 38, offset: 63510},
+							label: "w",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1672, col: 40, offset: 63512},
+								name: "ImageWidth",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1672, col: 51, offset: 63523},
+							label: "h",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1672, col: 53, offset: 63525},
+								name: "ImageHeight",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 1596, col: 32, offset: 59390},
+							pos:   position{line: 1672, col: 65, offset: 63537},
 							label: "nv",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1596, col: 35, offset: 59393},
+								pos:  position{line: 1672, col: 68, offset: 63540},
 								name: "NamedAttrs",
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1596, col: 46, offset: 59404},
+							pos:        position{line: 1672, col: 79, offset: 63551},
 							val:        "]",
 							ignoreCase: false,
 							want:       "\"]\"",
@@ -12015,18 +12797,18 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IconSize",
-			pos:  position{line: 1600, col: 1, offset: 59461},
+			name: "VideoPoster",
+			pos:  position{line: 1676, col: 1, offset: 63616},
 			expr: &actionExpr{
-				pos: position{line: 1600, col: 13, offset: 59473},
-				run: (*parser).callonIconSize1,
+				pos: position{line: 1676, col: 16, offset: 63631},
+				run: (*parser).callonVideoPoster1,
 				expr: &labeledExpr{
-					pos:   position{line: 1600, col: 13, offset: 59473},
+					pos:   position{line: 1676, col: 16, offset: 63631},
 					label: "value",
 					expr: &zeroOrOneExpr{
-						pos: position{line: 1600, col: 19, offset: 59479},
+						pos: position{line: 1676, col: 22, offset: 63637},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1600, col: 19, offset: 59479},
+							pos:  position{line: 1676, col: 22, offset: 63637},
 							name: "InlineVal",
 						},
 					},
@@ -12034,188 +12816,236 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "InlineFootnote",
-			pos:  position{line: 1607, col: 1, offset: 59755},
-			expr: &choiceExpr{
-				pos: position{line: 1607, col: 19, offset: 59773},
-				alternatives: []interface{}{
-					&actionExpr{
-						pos: position{line: 1607, col: 19, offset: 59773},
-						run: (*parser).callonInlineFootnote2,
-						expr: &seqExpr{
-							pos: position{line: 1607, col: 19, offset: 59773},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1607, col: 19, offset: 59773},
-									val:        "footnote:[",
-									ignoreCase: false,
-									want:       "\"footnote:[\"",
-								},
-								&labeledExpr{
-									pos:   position{line: 1607, col: 32, offset: 59786},
-									label: "content",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1607, col: 41, offset: 59795},
-										name: "FootnoteContent",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1607, col: 58, offset: 59812},
-									val:        "]",
-									ignoreCase: false,
-									want:       "\"]\"",
+			name: "AudioBlock",
+			pos:  position{line: 1680, col: 1, offset: 63714},
+			expr: &actionExpr{
+				pos: position{line: 1680, col: 15, offset: 63728},
+				run: (*parser).callonAudioBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1680, col: 15, offset: 63728},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1680, col: 15, offset: 63728},
+							label: "attributes",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 1680, col: 26, offset: 63739},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1680, col: 27, offset: 63740},
+									name: "BlockAttrs",
 								},
 							},
 						},
-					},
-					&actionExpr{
-						pos: position{line: 1609, col: 5, offset: 59880},
-						run: (*parser).callonInlineFootnote8,
-						expr: &seqExpr{
-							pos: position{line: 1609, col: 5, offset: 59880},
-							exprs: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1609, col: 5, offset: 59880},
-									val:        "footnote:",
-									ignoreCase: false,
-									want:       "\"footnote:\"",
-								},
-								&labeledExpr{
-									pos:   position{line: 1609, col: 17, offset: 59892},
-									label: "ref",
-									expr: &ruleRefExpr{
-										pos:  position{line: 1609, col: 22, offset: 59897},
-										name: "FootnoteRef",
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1609, col: 35, offset: 59910},
-									val:        "[",
-									ignoreCase: false,
-									want:       "\"[\"",
-								},
-								&labeledExpr{
-									pos:   position{line: 1609, col: 39, offset: 59914},
-									label: "content",
-									expr: &zeroOrOneExpr{
-										pos: position{line: 1609, col: 47, offset: 59922},
-										expr: &ruleRefExpr{
-											pos:  position{line: 1609, col: 48, offset: 59923},
-											name: "FootnoteContent",
-										},
-									},
-								},
-								&litMatcher{
-									pos:        position{line: 1609, col: 66, offset: 59941},
-									val:        "]",
-									ignoreCase: false,
-									want:       "\"]\"",
-								},
+						&litMatcher{
+							pos:        position{line: 1680, col: 40, offset: 63753},
+							val:        "audio::",
+							ignoreCase: false,
+							want:       "\"audio::\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 1680, col: 50, offset: 63763},
+							label: "path",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1680, col: 56, offset: 63769},
+								name: "Location",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 1680, col: 66, offset: 63779},
+							label: "inlineAttrs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1680, col: 79, offset: 63792},
+								name: "AudioAttrs",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 1680, col: 91, offset: 63804},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1680, col: 91, offset: 63804},
+								name: "Space",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1680, col: 98, offset: 63811},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "FootnoteRef",
-			pos:  position{line: 1613, col: 1, offset: 60002},
-			expr: &ruleRefExpr{
-				pos:  position{line: 1613, col: 16, offset: 60017},
-				name: "Alphanums",
-			},
-		},
-		{
-			name: "FootnoteContent",
-			pos:  position{line: 1615, col: 1, offset: 60028},
+			name: "AudioAttrs",
+			pos:  position{line: 1685, col: 1, offset: 64021},
 			expr: &actionExpr{
-				pos: position{line: 1615, col: 20, offset: 60047},
-				run: (*parser).callonFootnoteContent1,
-				expr: &labeledExpr{
-					pos:   position{line: 1615, col: 20, offset: 60047},
-					label: "elements",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 1615, col: 29, offset: 60056},
-						expr: &seqExpr{
-							pos: position{line: 1615, col: 30, offset: 60057},
-							exprs: []interface{}{
-								&notExpr{
-									pos: position{line: 1615, col: 30, offset: 60057},
-									expr: &litMatcher{
-										pos:        position{line: 1615, col: 31, offset: 60058},
-										val:        "]",
-										ignoreCase: false,
-										want:       "\"]\"",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1615, col: 35, offset: 60062},
-									name: "InlineElement",
-								},
+				pos: position{line: 1685, col: 15, offset: 64035},
+				run: (*parser).callonAudioAttrs1,
+				expr: &seqExpr{
+					pos: position{line: 1685, col: 15, offset: 64035},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1685, col: 15, offset: 64035},
+							val:        "[",
+							ignoreCase: false,
+							want:       "\"[\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 1685, col: 19, offset: 64039},
+							label: "nv",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1685, col: 22, offset: 64042},
+								name: "NamedAttrs",
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 1685, col: 33, offset: 64053},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Callout",
-			pos:  position{line: 1623, col: 1, offset: 60378},
+			name: "InlineIcon",
+			pos:  position{line: 1692, col: 1, offset: 64296},
 			expr: &actionExpr{
-				pos: position{line: 1623, col: 12, offset: 60389},
-				run: (*parser).callonCallout1,
+				pos: position{line: 1692, col: 15, offset: 64310},
+				run: (*parser).callonInlineIcon1,
 				expr: &seqExpr{
-					pos: position{line: 1623, col: 12, offset: 60389},
+					pos: position{line: 1692, col: 15, offset: 64310},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1623, col: 12, offset: 60389},
-							val:        "<",
+							pos:        position{line: 1692, col: 15, offset: 64310},
+							val:        "icon:",
 							ignoreCase: false,
-							want:       "\"<\"",
+							want:       "\"icon:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1623, col: 16, offset: 60393},
-							label: "ref",
+							pos:   position{line: 1692, col: 23, offset: 64318},
+							label: "icon",
 							expr: &actionExpr{
-								pos: position{line: 1623, col: 21, offset: 60398},
-								run: (*parser).callonCallout5,
+								pos: position{line: 1692, col: 29, offset: 64324},
+								run: (*parser).callonInlineIcon5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1623, col: 21, offset: 60398},
+									pos: position{line: 1692, col: 29, offset: 64324},
 									expr: &charClassMatcher{
-										pos:        position{line: 1623, col: 21, offset: 60398},
-										val:        "[0-9]",
+										pos:        position{line: 1692, col: 29, offset: 64324},
+										val:        "[\\pL0-9_-]",
+										chars:      []rune{'_', '-'},
 										ranges:     []rune{'0', '9'},
+										classes:    []*unicode.RangeTable{rangeTable("L")},
 										ignoreCase: false,
 										inverted:   false,
 									},
 								},
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 1692, col: 73, offset: 64368},
+							label: "attrs",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1692, col: 80, offset: 64375},
+								name: "IconAttrs",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IconAttrs",
+			pos:  position{line: 1696, col: 1, offset: 64457},
+			expr: &actionExpr{
+				pos: position{line: 1696, col: 14, offset: 64470},
+				run: (*parser).callonIconAttrs1,
+				expr: &seqExpr{
+					pos: position{line: 1696, col: 14, offset: 64470},
+					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1623, col: 69, offset: 60446},
-							val:        ">",
+							pos:        position{line: 1696, col: 14, offset: 64470},
+							val:        "[",
 							ignoreCase: false,
-							want:       "\">\"",
+							want:       "\"[\"",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 1623, col: 73, offset: 60450},
+						&labeledExpr{
+							pos:   position{line: 1696, col: 18, offset: 64474},
+							label: "size",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1623, col: 73, offset: 60450},
-								name: "Space",
+								pos:  position{line: 1696, col: 23, offset: 64479},
+								name: "IconSize",
 							},
 						},
-						&andExpr{
-							pos: position{line: 1623, col: 80, offset: 60457},
+						&labeledExpr{
+							pos:   position{line: 1696, col: 32, offset: 64488},
+							label: "nv",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1696, col: 35, offset: 64491},
+								name: "NamedAttrs",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 1696, col: 46, offset: 64502},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IconSize",
+			pos:  position{line: 1700, col: 1, offset: 64559},
+			expr: &actionExpr{
+				pos: position{line: 1700, col: 13, offset: 64571},
+				run: (*parser).callonIconSize1,
+				expr: &labeledExpr{
+					pos:   position{line: 1700, col: 13, offset: 64571},
+					label: "value",
+					expr: &zeroOrOneExpr{
+						pos: position{line: 1700, col: 19, offset: 64577},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1700, col: 19, offset: 64577},
+							name: "InlineVal",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineUIMacro",
+			pos:  position{line: 1707, col: 1, offset: 64930},
+			expr: &actionExpr{
+				pos: position{line: 1707, col: 18, offset: 64947},
+				run: (*parser).callonInlineUIMacro1,
+				expr: &seqExpr{
+					pos: position{line: 1707, col: 18, offset: 64947},
+					exprs: []interface{}{
+						&andCodeExpr{
+							pos: position{line: 1707, col: 18, offset: 64947},
+							run: (*parser).callonInlineUIMacro3,
+						},
+						&labeledExpr{
+							pos:   position{line: 1707, col: 49, offset: 64978},
+							label: "element",
 							expr: &choiceExpr{
-								pos: position{line: 1623, col: 82, offset: 60459},
+								pos: position{line: 1707, col: 58, offset: 64987},
 								alternatives: []interface{}{
 									&ruleRefExpr{
-										pos:  position{line: 1623, col: 82, offset: 60459},
-										name: "EOL",
+										pos:  position{line: 1707, col: 58, offset: 64987},
+										name: "InlineKeyboard",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1707, col: 75, offset: 65004},
+										name: "InlineButton",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1707, col: 90, offset: 65019},
+										name: "InlineMenu",
 									},
 									&ruleRefExpr{
-										pos:  position{line: 1623, col: 88, offset: 60465},
-										name: "Callout",
+										pos:  position{line: 1707, col: 103, offset: 65032},
+										name: "InlineMenuShorthand",
 									},
 								},
 							},
@@ -12225,485 +13055,486 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "CalloutListItem",
-			pos:  position{line: 1627, col: 1, offset: 60518},
+			name: "InlineKeyboard",
+			pos:  position{line: 1711, col: 1, offset: 65082},
 			expr: &actionExpr{
-				pos: position{line: 1627, col: 20, offset: 60537},
-				run: (*parser).callonCalloutListItem1,
+				pos: position{line: 1711, col: 19, offset: 65100},
+				run: (*parser).callonInlineKeyboard1,
 				expr: &seqExpr{
-					pos: position{line: 1627, col: 20, offset: 60537},
+					pos: position{line: 1711, col: 19, offset: 65100},
 					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1711, col: 19, offset: 65100},
+							val:        "kbd:[",
+							ignoreCase: false,
+							want:       "\"kbd:[\"",
+						},
 						&labeledExpr{
-							pos:   position{line: 1627, col: 20, offset: 60537},
-							label: "ref",
+							pos:   position{line: 1711, col: 27, offset: 65108},
+							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1627, col: 25, offset: 60542},
-								name: "CalloutListItemPrefix",
+								pos:  position{line: 1711, col: 36, offset: 65117},
+								name: "UIMacroContent",
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 1711, col: 52, offset: 65133},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "InlineButton",
+			pos:  position{line: 1715, col: 1, offset: 65191},
+			expr: &actionExpr{
+				pos: position{line: 1715, col: 17, offset: 65207},
+				run: (*parser).callonInlineButton1,
+				expr: &seqExpr{
+					pos: position{line: 1715, col: 17, offset: 65207},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1715, col: 17, offset: 65207},
+							val:        "btn:[",
+							ignoreCase: false,
+							want:       "\"btn:[\"",
+						},
 						&labeledExpr{
-							pos:   position{line: 1627, col: 48, offset: 60565},
-							label: "description",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 1627, col: 61, offset: 60578},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1627, col: 61, offset: 60578},
-									name: "ListParagraph",
-								},
+							pos:   position{line: 1715, col: 25, offset: 65215},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1715, col: 34, offset: 65224},
+								name: "UIMacroContent",
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 1715, col: 50, offset: 65240},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "CalloutListItemPrefix",
-			pos:  position{line: 1631, col: 1, offset: 60675},
+			name: "InlineMenu",
+			pos:  position{line: 1719, col: 1, offset: 65296},
 			expr: &actionExpr{
-				pos: position{line: 1631, col: 26, offset: 60700},
-				run: (*parser).callonCalloutListItemPrefix1,
+				pos: position{line: 1719, col: 15, offset: 65310},
+				run: (*parser).callonInlineMenu1,
 				expr: &seqExpr{
-					pos: position{line: 1631, col: 26, offset: 60700},
+					pos: position{line: 1719, col: 15, offset: 65310},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1631, col: 26, offset: 60700},
-							val:        "<",
+							pos:        position{line: 1719, col: 15, offset: 65310},
+							val:        "menu:",
 							ignoreCase: false,
-							want:       "\"<\"",
+							want:       "\"menu:\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1631, col: 30, offset: 60704},
-							label: "ref",
+							pos:   position{line: 1719, col: 23, offset: 65318},
+							label: "menu",
 							expr: &actionExpr{
-								pos: position{line: 1631, col: 35, offset: 60709},
-								run: (*parser).callonCalloutListItemPrefix5,
+								pos: position{line: 1719, col: 29, offset: 65324},
+								run: (*parser).callonInlineMenu5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1631, col: 35, offset: 60709},
+									pos: position{line: 1719, col: 29, offset: 65324},
 									expr: &charClassMatcher{
-										pos:        position{line: 1631, col: 35, offset: 60709},
-										val:        "[0-9]",
-										ranges:     []rune{'0', '9'},
+										pos:        position{line: 1719, col: 29, offset: 65324},
+										val:        "[^ \\t\\r\\n[]",
+										chars:      []rune{' ', '\t', '\r', '\n', '['},
 										ignoreCase: false,
-										inverted:   false,
+										inverted:   true,
 									},
 								},
 							},
 						},
 						&litMatcher{
-							pos:        position{line: 1631, col: 83, offset: 60757},
-							val:        ">",
+							pos:        position{line: 1719, col: 74, offset: 65369},
+							val:        "[",
 							ignoreCase: false,
-							want:       "\">\"",
+							want:       "\"[\"",
 						},
-						&oneOrMoreExpr{
-							pos: position{line: 1631, col: 87, offset: 60761},
+						&labeledExpr{
+							pos:   position{line: 1719, col: 78, offset: 65373},
+							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1631, col: 87, offset: 60761},
-								name: "Space",
+								pos:  position{line: 1719, col: 87, offset: 65382},
+								name: "UIMacroContent",
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 1719, col: 103, offset: 65398},
+							val:        "]",
+							ignoreCase: false,
+							want:       "\"]\"",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ThematicBreak",
-			pos:  position{line: 1640, col: 1, offset: 61008},
+			name: "UIMacroContent",
+			pos:  position{line: 1724, col: 1, offset: 65541},
 			expr: &actionExpr{
-				pos: position{line: 1640, col: 18, offset: 61025},
-				run: (*parser).callonThematicBreak1,
-				expr: &seqExpr{
-					pos: position{line: 1640, col: 18, offset: 61025},
-					exprs: []interface{}{
-						&choiceExpr{
-							pos: position{line: 1640, col: 19, offset: 61026},
-							alternatives: []interface{}{
-								&litMatcher{
-									pos:        position{line: 1640, col: 19, offset: 61026},
-									val:        "***",
-									ignoreCase: false,
-									want:       "\"***\"",
-								},
-								&litMatcher{
-									pos:        position{line: 1640, col: 27, offset: 61034},
-									val:        "* * *",
-									ignoreCase: false,
-									want:       "\"* * *\"",
-								},
-								&litMatcher{
-									pos:        position{line: 1640, col: 37, offset: 61044},
-									val:        "---",
-									ignoreCase: false,
-									want:       "\"---\"",
-								},
-								&litMatcher{
-									pos:        position{line: 1640, col: 45, offset: 61052},
-									val:        "- - -",
-									ignoreCase: false,
-									want:       "\"- - -\"",
-								},
-								&litMatcher{
-									pos:        position{line: 1640, col: 55, offset: 61062},
-									val:        "___",
-									ignoreCase: false,
-									want:       "\"___\"",
-								},
-								&litMatcher{
-									pos:        position{line: 1640, col: 63, offset: 61070},
-									val:        "_ _ _",
-									ignoreCase: false,
-									want:       "\"_ _ _\"",
-								},
+				pos: position{line: 1724, col: 19, offset: 65559},
+				run: (*parser).callonUIMacroContent1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 1724, col: 19, offset: 65559},
+					expr: &choiceExpr{
+						pos: position{line: 1724, col: 20, offset: 65560},
+						alternatives: []interface{}{
+							&litMatcher{
+								pos:        position{line: 1724, col: 20, offset: 65560},
+								val:        "\\]",
+								ignoreCase: false,
+								want:       "\"\\\\]\"",
+							},
+							&charClassMatcher{
+								pos:        position{line: 1724, col: 27, offset: 65567},
+								val:        "[^\\]\\r\\n]",
+								chars:      []rune{']', '\r', '\n'},
+								ignoreCase: false,
+								inverted:   true,
 							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 1640, col: 72, offset: 61079},
-							name: "EOL",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "DelimitedBlock",
-			pos:  position{line: 1650, col: 1, offset: 61329},
+			name: "InlineMenuShorthand",
+			pos:  position{line: 1729, col: 1, offset: 65638},
 			expr: &actionExpr{
-				pos: position{line: 1650, col: 19, offset: 61347},
-				run: (*parser).callonDelimitedBlock1,
+				pos: position{line: 1729, col: 24, offset: 65661},
+				run: (*parser).callonInlineMenuShorthand1,
 				expr: &seqExpr{
-					pos: position{line: 1650, col: 19, offset: 61347},
+					pos: position{line: 1729, col: 24, offset: 65661},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 1650, col: 19, offset: 61347},
-							expr: &ruleRefExpr{
-								pos:  position{line: 1650, col: 20, offset: 61348},
-								name: "Alphanum",
-							},
+						&litMatcher{
+							pos:        position{line: 1729, col: 24, offset: 65661},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1651, col: 5, offset: 61436},
-							label: "block",
-							expr: &choiceExpr{
-								pos: position{line: 1651, col: 12, offset: 61443},
-								alternatives: []interface{}{
-									&ruleRefExpr{
-										pos:  position{line: 1651, col: 12, offset: 61443},
-										name: "FencedBlock",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1652, col: 11, offset: 61466},
-										name: "ListingBlock",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1653, col: 11, offset: 61490},
-										name: "ExampleBlock",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1654, col: 11, offset: 61514},
-										name: "VerseBlock",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1655, col: 11, offset: 61535},
-										name: "QuoteBlock",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1656, col: 11, offset: 61556},
-										name: "SidebarBlock",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1657, col: 11, offset: 61579},
-										name: "PassthroughBlock",
-									},
-									&ruleRefExpr{
-										pos:  position{line: 1658, col: 11, offset: 61606},
-										name: "CommentBlock",
+							pos:   position{line: 1729, col: 29, offset: 65666},
+							label: "content",
+							expr: &actionExpr{
+								pos: position{line: 1729, col: 38, offset: 65675},
+								run: (*parser).callonInlineMenuShorthand5,
+								expr: &seqExpr{
+									pos: position{line: 1729, col: 38, offset: 65675},
+									exprs: []interface{}{
+										&charClassMatcher{
+											pos:        position{line: 1729, col: 38, offset: 65675},
+											val:        "[\\pL0-9_\\uFFFD]",
+											chars:      []rune{'_', '�'},
+											ranges:     []rune{'0', '9'},
+											classes:    []*unicode.RangeTable{rangeTable("L")},
+											ignoreCase: false,
+											inverted:   false,
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 1729, col: 54, offset: 65691},
+											expr: &charClassMatcher{
+												pos:        position{line: 1729, col: 54, offset: 65691},
+												val:        "[^\"\\r\\n]",
+												chars:      []rune{'"', '\r', '\n'},
+												ignoreCase: false,
+												inverted:   true,
+											},
+										},
 									},
 								},
 							},
 						},
+						&litMatcher{
+							pos:        position{line: 1729, col: 96, offset: 65733},
+							val:        "\"",
+							ignoreCase: false,
+							want:       "\"\\\"\"",
+						},
+						&andCodeExpr{
+							pos: position{line: 1729, col: 101, offset: 65738},
+							run: (*parser).callonInlineMenuShorthand11,
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "BlockDelimiter",
-			pos:  position{line: 1662, col: 1, offset: 61647},
+			name: "InlineFootnote",
+			pos:  position{line: 1736, col: 1, offset: 66042},
 			expr: &choiceExpr{
-				pos: position{line: 1662, col: 19, offset: 61665},
+				pos: position{line: 1736, col: 19, offset: 66060},
 				alternatives: []interface{}{
-					&seqExpr{
-						pos: position{line: 1662, col: 19, offset: 61665},
-						exprs: []interface{}{
-							&notExpr{
-								pos: position{line: 1662, col: 19, offset: 61665},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1662, col: 21, offset: 61667},
-									name: "Alphanum",
+					&actionExpr{
+						pos: position{line: 1736, col: 19, offset: 66060},
+						run: (*parser).callonInlineFootnote2,
+						expr: &seqExpr{
+							pos: position{line: 1736, col: 19, offset: 66060},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1736, col: 19, offset: 66060},
+									val:        "footnote:[",
+									ignoreCase: false,
+									want:       "\"footnote:[\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 1736, col: 32, offset: 66073},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1736, col: 41, offset: 66082},
+										name: "FootnoteContent",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1736, col: 58, offset: 66099},
+									val:        "]",
+									ignoreCase: false,
+									want:       "\"]\"",
 								},
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1662, col: 31, offset: 61677},
-								name: "LiteralBlockDelimiter",
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1663, col: 19, offset: 61748},
-						name: "FencedBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1664, col: 19, offset: 61788},
-						name: "ListingBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1665, col: 19, offset: 61829},
-						name: "ExampleBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1666, col: 19, offset: 61870},
-						name: "CommentBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1667, col: 19, offset: 61911},
-						name: "QuoteBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1668, col: 19, offset: 61949},
-						name: "SidebarBlockDelimiter",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1669, col: 19, offset: 61989},
-						name: "PassthroughBlockDelimiter",
-					},
-				},
-			},
-		},
-		{
-			name: "ExampleBlock",
-			pos:  position{line: 1674, col: 1, offset: 62212},
-			expr: &actionExpr{
-				pos: position{line: 1674, col: 17, offset: 62228},
-				run: (*parser).callonExampleBlock1,
-				expr: &seqExpr{
-					pos: position{line: 1674, col: 17, offset: 62228},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1674, col: 17, offset: 62228},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1674, col: 28, offset: 62239},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1674, col: 29, offset: 62240},
-									name: "Attributes",
+					&actionExpr{
+						pos: position{line: 1738, col: 5, offset: 66167},
+						run: (*parser).callonInlineFootnote8,
+						expr: &seqExpr{
+							pos: position{line: 1738, col: 5, offset: 66167},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1738, col: 5, offset: 66167},
+									val:        "footnote:",
+									ignoreCase: false,
+									want:       "\"footnote:\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 1738, col: 17, offset: 66179},
+									label: "ref",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1738, col: 22, offset: 66184},
+										name: "FootnoteRef",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1738, col: 35, offset: 66197},
+									val:        "[",
+									ignoreCase: false,
+									want:       "\"[\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 1738, col: 39, offset: 66201},
+									label: "content",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 1738, col: 47, offset: 66209},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1738, col: 48, offset: 66210},
+											name: "FootnoteContent",
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1738, col: 66, offset: 66228},
+									val:        "]",
+									ignoreCase: false,
+									want:       "\"]\"",
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 1674, col: 42, offset: 62253},
-							name: "ExampleBlockStartDelimiter",
-						},
-						&labeledExpr{
-							pos:   position{line: 1674, col: 69, offset: 62280},
-							label: "blocks",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1674, col: 77, offset: 62288},
-								name: "ExampleBlockRawContent",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 1674, col: 101, offset: 62312},
-							name: "ExampleBlockEndDelimiter",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "ExampleBlockDelimiter",
-			pos:  position{line: 1678, col: 1, offset: 62411},
-			expr: &seqExpr{
-				pos: position{line: 1678, col: 26, offset: 62436},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 1678, col: 26, offset: 62436},
-						val:        "====",
-						ignoreCase: false,
-						want:       "\"====\"",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 1678, col: 33, offset: 62443},
-						expr: &ruleRefExpr{
-							pos:  position{line: 1678, col: 33, offset: 62443},
-							name: "Space",
-						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1678, col: 40, offset: 62450},
-						name: "EOL",
-					},
-				},
+			name: "FootnoteRef",
+			pos:  position{line: 1742, col: 1, offset: 66289},
+			expr: &ruleRefExpr{
+				pos:  position{line: 1742, col: 16, offset: 66304},
+				name: "Alphanums",
 			},
 		},
 		{
-			name: "ExampleBlockStartDelimiter",
-			pos:  position{line: 1680, col: 1, offset: 62455},
-			expr: &seqExpr{
-				pos: position{line: 1680, col: 31, offset: 62485},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 1680, col: 31, offset: 62485},
-						val:        "====",
-						ignoreCase: false,
-						want:       "\"====\"",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 1680, col: 38, offset: 62492},
-						expr: &ruleRefExpr{
-							pos:  position{line: 1680, col: 38, offset: 62492},
-							name: "Space",
+			name: "FootnoteContent",
+			pos:  position{line: 1744, col: 1, offset: 66315},
+			expr: &actionExpr{
+				pos: position{line: 1744, col: 20, offset: 66334},
+				run: (*parser).callonFootnoteContent1,
+				expr: &labeledExpr{
+					pos:   position{line: 1744, col: 20, offset: 66334},
+					label: "elements",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 1744, col: 29, offset: 66343},
+						expr: &seqExpr{
+							pos: position{line: 1744, col: 30, offset: 66344},
+							exprs: []interface{}{
+								&notExpr{
+									pos: position{line: 1744, col: 30, offset: 66344},
+									expr: &litMatcher{
+										pos:        position{line: 1744, col: 31, offset: 66345},
+										val:        "]",
+										ignoreCase: false,
+										want:       "\"]\"",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1744, col: 35, offset: 66349},
+									name: "InlineElement",
+								},
+							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1680, col: 45, offset: 62499},
-						name: "EOL",
-					},
 				},
 			},
 		},
 		{
-			name: "ExampleBlockEndDelimiter",
-			pos:  position{line: 1682, col: 1, offset: 62504},
+			name: "Callout",
+			pos:  position{line: 1754, col: 1, offset: 66859},
 			expr: &choiceExpr{
-				pos: position{line: 1682, col: 29, offset: 62532},
+				pos: position{line: 1754, col: 12, offset: 66870},
 				alternatives: []interface{}{
-					&seqExpr{
-						pos: position{line: 1682, col: 30, offset: 62533},
-						exprs: []interface{}{
-							&litMatcher{
-								pos:        position{line: 1682, col: 30, offset: 62533},
-								val:        "====",
-								ignoreCase: false,
-								want:       "\"====\"",
-							},
-							&zeroOrMoreExpr{
-								pos: position{line: 1682, col: 37, offset: 62540},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1682, col: 37, offset: 62540},
-									name: "Space",
+					&actionExpr{
+						pos: position{line: 1754, col: 12, offset: 66870},
+						run: (*parser).callonCallout2,
+						expr: &seqExpr{
+							pos: position{line: 1754, col: 12, offset: 66870},
+							exprs: []interface{}{
+								&zeroOrOneExpr{
+									pos: position{line: 1754, col: 12, offset: 66870},
+									expr: &seqExpr{
+										pos: position{line: 1754, col: 13, offset: 66871},
+										exprs: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1754, col: 13, offset: 66871},
+												name: "CalloutCommentPrefix",
+											},
+											&zeroOrOneExpr{
+												pos: position{line: 1754, col: 34, offset: 66892},
+												expr: &ruleRefExpr{
+													pos:  position{line: 1754, col: 34, offset: 66892},
+													name: "Space",
+												},
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1754, col: 43, offset: 66901},
+									val:        "<",
+									ignoreCase: false,
+									want:       "\"<\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 1754, col: 47, offset: 66905},
+									label: "ref",
+									expr: &actionExpr{
+										pos: position{line: 1754, col: 52, offset: 66910},
+										run: (*parser).callonCallout11,
+										expr: &oneOrMoreExpr{
+											pos: position{line: 1754, col: 52, offset: 66910},
+											expr: &charClassMatcher{
+												pos:        position{line: 1754, col: 52, offset: 66910},
+												val:        "[0-9]",
+												ranges:     []rune{'0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1754, col: 100, offset: 66958},
+									val:        ">",
+									ignoreCase: false,
+									want:       "\">\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1754, col: 104, offset: 66962},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1754, col: 104, offset: 66962},
+										name: "Space",
+									},
+								},
+								&andExpr{
+									pos: position{line: 1754, col: 111, offset: 66969},
+									expr: &choiceExpr{
+										pos: position{line: 1754, col: 113, offset: 66971},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1754, col: 113, offset: 66971},
+												name: "EOL",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1754, col: 119, offset: 66977},
+												name: "Callout",
+											},
+										},
+									},
 								},
-							},
-							&ruleRefExpr{
-								pos:  position{line: 1682, col: 44, offset: 62547},
-								name: "EOL",
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1682, col: 51, offset: 62554},
-						name: "EOF",
-					},
-				},
-			},
-		},
-		{
-			name: "ExampleBlockRawContent",
-			pos:  position{line: 1684, col: 1, offset: 62559},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 1684, col: 27, offset: 62585},
-				expr: &actionExpr{
-					pos: position{line: 1685, col: 8, offset: 62594},
-					run: (*parser).callonExampleBlockRawContent2,
-					expr: &seqExpr{
-						pos: position{line: 1685, col: 8, offset: 62594},
-						exprs: []interface{}{
-							&notExpr{
-								pos: position{line: 1685, col: 8, offset: 62594},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1685, col: 9, offset: 62595},
-									name: "ExampleBlockEndDelimiter",
+					&actionExpr{
+						pos: position{line: 1756, col: 5, offset: 67031},
+						run: (*parser).callonCallout21,
+						expr: &seqExpr{
+							pos: position{line: 1756, col: 5, offset: 67031},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1756, col: 5, offset: 67031},
+									val:        "<!--",
+									ignoreCase: false,
+									want:       "\"<!--\"",
 								},
-							},
-							&labeledExpr{
-								pos:   position{line: 1686, col: 8, offset: 62628},
-								label: "element",
-								expr: &choiceExpr{
-									pos: position{line: 1686, col: 17, offset: 62637},
-									alternatives: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 1686, col: 17, offset: 62637},
-											name: "BlankLine",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1687, col: 15, offset: 62662},
-											name: "ImageBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1688, col: 15, offset: 62687},
-											name: "ThematicBreak",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1689, col: 15, offset: 62715},
-											name: "OrderedListItem",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1690, col: 15, offset: 62746},
-											name: "UnorderedListItem",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1691, col: 15, offset: 62779},
-											name: "LabeledListItem",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1692, col: 15, offset: 62810},
-											name: "ContinuedListItemElement",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1693, col: 15, offset: 62849},
-											name: "FencedBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1694, col: 15, offset: 62876},
-											name: "ListingBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1695, col: 15, offset: 62904},
-											name: "VerseBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1696, col: 15, offset: 62929},
-											name: "QuoteBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1697, col: 15, offset: 62954},
-											name: "SidebarBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1698, col: 15, offset: 62981},
-											name: "SingleLineComment",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1699, col: 15, offset: 63013},
-											name: "PassthroughBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1700, col: 15, offset: 63044},
-											name: "Table",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1701, col: 15, offset: 63064},
-											name: "CommentBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1702, col: 15, offset: 63091},
-											name: "LiteralBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1703, col: 15, offset: 63119},
-											name: "RawParagraph",
+								&labeledExpr{
+									pos:   position{line: 1756, col: 12, offset: 67038},
+									label: "ref",
+									expr: &actionExpr{
+										pos: position{line: 1756, col: 17, offset: 67043},
+										run: (*parser).callonCallout25,
+										expr: &oneOrMoreExpr{
+											pos: position{line: 1756, col: 17, offset: 67043},
+											expr: &charClassMatcher{
+												pos:        position{line: 1756, col: 17, offset: 67043},
+												val:        "[0-9]",
+												ranges:     []rune{'0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
 										},
-										&ruleRefExpr{
-											pos:  position{line: 1704, col: 15, offset: 63146},
-											name: "StandaloneAttributes",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 1756, col: 65, offset: 67091},
+									val:        "-->",
+									ignoreCase: false,
+									want:       "\"-->\"",
+								},
+								&zeroOrMoreExpr{
+									pos: position{line: 1756, col: 71, offset: 67097},
+									expr: &ruleRefExpr{
+										pos:  position{line: 1756, col: 71, offset: 67097},
+										name: "Space",
+									},
+								},
+								&andExpr{
+									pos: position{line: 1756, col: 78, offset: 67104},
+									expr: &choiceExpr{
+										pos: position{line: 1756, col: 80, offset: 67106},
+										alternatives: []interface{}{
+											&ruleRefExpr{
+												pos:  position{line: 1756, col: 80, offset: 67106},
+												name: "EOL",
+											},
+											&ruleRefExpr{
+												pos:  position{line: 1756, col: 86, offset: 67112},
+												name: "Callout",
+											},
 										},
 									},
 								},
@@ -12714,236 +13545,235 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "QuoteBlock",
-			pos:  position{line: 1711, col: 1, offset: 63402},
-			expr: &actionExpr{
-				pos: position{line: 1711, col: 15, offset: 63416},
-				run: (*parser).callonQuoteBlock1,
-				expr: &seqExpr{
-					pos: position{line: 1711, col: 15, offset: 63416},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1711, col: 15, offset: 63416},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1711, col: 26, offset: 63427},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1711, col: 27, offset: 63428},
-									name: "Attributes",
-								},
-							},
-						},
-						&andCodeExpr{
-							pos: position{line: 1712, col: 5, offset: 63446},
-							run: (*parser).callonQuoteBlock6,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 1723, col: 5, offset: 63794},
-							name: "QuoteBlockStartDelimiter",
-						},
-						&labeledExpr{
-							pos:   position{line: 1723, col: 30, offset: 63819},
-							label: "content",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1723, col: 39, offset: 63828},
-								name: "QuoteBlockRawContent",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 1723, col: 61, offset: 63850},
-							name: "QuoteBlockEndDelimiter",
-						},
+			name: "CalloutCommentPrefix",
+			pos:  position{line: 1760, col: 1, offset: 67165},
+			expr: &choiceExpr{
+				pos: position{line: 1760, col: 25, offset: 67189},
+				alternatives: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1760, col: 25, offset: 67189},
+						val:        "//",
+						ignoreCase: false,
+						want:       "\"//\"",
 					},
-				},
-			},
-		},
-		{
-			name: "QuoteBlockDelimiter",
-			pos:  position{line: 1727, col: 1, offset: 63954},
-			expr: &seqExpr{
-				pos: position{line: 1727, col: 24, offset: 63977},
-				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1727, col: 24, offset: 63977},
-						val:        "____",
+						pos:        position{line: 1760, col: 32, offset: 67196},
+						val:        "#",
 						ignoreCase: false,
-						want:       "\"____\"",
+						want:       "\"#\"",
 					},
-					&zeroOrMoreExpr{
-						pos: position{line: 1727, col: 31, offset: 63984},
-						expr: &ruleRefExpr{
-							pos:  position{line: 1727, col: 31, offset: 63984},
-							name: "Space",
-						},
+					&litMatcher{
+						pos:        position{line: 1760, col: 38, offset: 67202},
+						val:        "--",
+						ignoreCase: false,
+						want:       "\"--\"",
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1727, col: 38, offset: 63991},
-						name: "EOL",
+					&litMatcher{
+						pos:        position{line: 1760, col: 45, offset: 67209},
+						val:        ";;",
+						ignoreCase: false,
+						want:       "\";;\"",
 					},
 				},
 			},
 		},
 		{
-			name: "QuoteBlockStartDelimiter",
-			pos:  position{line: 1729, col: 1, offset: 64021},
-			expr: &seqExpr{
-				pos: position{line: 1729, col: 29, offset: 64049},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 1729, col: 29, offset: 64049},
-						val:        "____",
-						ignoreCase: false,
-						want:       "\"____\"",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 1729, col: 36, offset: 64056},
-						expr: &ruleRefExpr{
-							pos:  position{line: 1729, col: 36, offset: 64056},
-							name: "Space",
+			name: "CalloutListItem",
+			pos:  position{line: 1762, col: 1, offset: 67215},
+			expr: &actionExpr{
+				pos: position{line: 1762, col: 20, offset: 67234},
+				run: (*parser).callonCalloutListItem1,
+				expr: &seqExpr{
+					pos: position{line: 1762, col: 20, offset: 67234},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 1762, col: 20, offset: 67234},
+							label: "ref",
+							expr: &ruleRefExpr{
+								pos:  position{line: 1762, col: 25, offset: 67239},
+								name: "CalloutListItemPrefix",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1762, col: 48, offset: 67262},
+							label: "description",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 1762, col: 61, offset: 67275},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1762, col: 61, offset: 67275},
+									name: "ListParagraph",
+								},
+							},
 						},
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1729, col: 43, offset: 64063},
-						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "QuoteBlockEndDelimiter",
-			pos:  position{line: 1731, col: 1, offset: 64093},
-			expr: &choiceExpr{
-				pos: position{line: 1731, col: 27, offset: 64119},
-				alternatives: []interface{}{
-					&seqExpr{
-						pos: position{line: 1731, col: 28, offset: 64120},
-						exprs: []interface{}{
-							&litMatcher{
-								pos:        position{line: 1731, col: 28, offset: 64120},
-								val:        "____",
-								ignoreCase: false,
-								want:       "\"____\"",
-							},
-							&zeroOrMoreExpr{
-								pos: position{line: 1731, col: 35, offset: 64127},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1731, col: 35, offset: 64127},
-									name: "Space",
+			name: "CalloutListItemPrefix",
+			pos:  position{line: 1766, col: 1, offset: 67372},
+			expr: &actionExpr{
+				pos: position{line: 1766, col: 26, offset: 67397},
+				run: (*parser).callonCalloutListItemPrefix1,
+				expr: &seqExpr{
+					pos: position{line: 1766, col: 26, offset: 67397},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 1766, col: 26, offset: 67397},
+							val:        "<",
+							ignoreCase: false,
+							want:       "\"<\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 1766, col: 30, offset: 67401},
+							label: "ref",
+							expr: &actionExpr{
+								pos: position{line: 1766, col: 35, offset: 67406},
+								run: (*parser).callonCalloutListItemPrefix5,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 1766, col: 35, offset: 67406},
+									expr: &charClassMatcher{
+										pos:        position{line: 1766, col: 35, offset: 67406},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
 								},
 							},
-							&ruleRefExpr{
-								pos:  position{line: 1731, col: 42, offset: 64134},
-								name: "EOL",
+						},
+						&litMatcher{
+							pos:        position{line: 1766, col: 83, offset: 67454},
+							val:        ">",
+							ignoreCase: false,
+							want:       "\">\"",
+						},
+						&oneOrMoreExpr{
+							pos: position{line: 1766, col: 87, offset: 67458},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1766, col: 87, offset: 67458},
+								name: "Space",
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1731, col: 49, offset: 64141},
-						name: "EOF",
-					},
 				},
 			},
 		},
 		{
-			name: "QuoteBlockRawContent",
-			pos:  position{line: 1733, col: 1, offset: 64171},
-			expr: &zeroOrMoreExpr{
-				pos: position{line: 1733, col: 25, offset: 64195},
-				expr: &actionExpr{
-					pos: position{line: 1734, col: 8, offset: 64204},
-					run: (*parser).callonQuoteBlockRawContent2,
-					expr: &seqExpr{
-						pos: position{line: 1734, col: 8, offset: 64204},
-						exprs: []interface{}{
-							&notExpr{
-								pos: position{line: 1734, col: 8, offset: 64204},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1734, col: 9, offset: 64205},
-									name: "QuoteBlockEndDelimiter",
+			name: "ThematicBreak",
+			pos:  position{line: 1775, col: 1, offset: 67705},
+			expr: &actionExpr{
+				pos: position{line: 1775, col: 18, offset: 67722},
+				run: (*parser).callonThematicBreak1,
+				expr: &seqExpr{
+					pos: position{line: 1775, col: 18, offset: 67722},
+					exprs: []interface{}{
+						&choiceExpr{
+							pos: position{line: 1775, col: 19, offset: 67723},
+							alternatives: []interface{}{
+								&litMatcher{
+									pos:        position{line: 1775, col: 19, offset: 67723},
+									val:        "***",
+									ignoreCase: false,
+									want:       "\"***\"",
 								},
-							},
-							&labeledExpr{
-								pos:   position{line: 1735, col: 8, offset: 64236},
-								label: "element",
-								expr: &choiceExpr{
-									pos: position{line: 1735, col: 17, offset: 64245},
-									alternatives: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 1735, col: 17, offset: 64245},
-											name: "BlankLine",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1736, col: 15, offset: 64270},
-											name: "ImageBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1737, col: 15, offset: 64295},
-											name: "ThematicBreak",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1738, col: 15, offset: 64323},
-											name: "OrderedListItem",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1739, col: 15, offset: 64354},
-											name: "UnorderedListItem",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1740, col: 15, offset: 64387},
-											name: "LabeledListItem",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1741, col: 15, offset: 64418},
-											name: "ContinuedListItemElement",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1742, col: 15, offset: 64457},
-											name: "FencedBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1743, col: 15, offset: 64484},
-											name: "ListingBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1744, col: 15, offset: 64512},
-											name: "VerseBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1745, col: 15, offset: 64537},
-											name: "ExampleBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1746, col: 15, offset: 64564},
-											name: "SidebarBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1747, col: 15, offset: 64591},
-											name: "SingleLineComment",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1748, col: 15, offset: 64623},
-											name: "PassthroughBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1749, col: 15, offset: 64654},
-											name: "Table",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1750, col: 15, offset: 64674},
-											name: "CommentBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1751, col: 15, offset: 64701},
-											name: "LiteralBlock",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1752, col: 15, offset: 64729},
-											name: "RawParagraph",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1753, col: 15, offset: 64756},
-											name: "StandaloneAttributes",
-										},
+								&litMatcher{
+									pos:        position{line: 1775, col: 27, offset: 67731},
+									val:        "* * *",
+									ignoreCase: false,
+									want:       "\"* * *\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1775, col: 37, offset: 67741},
+									val:        "---",
+									ignoreCase: false,
+									want:       "\"---\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1775, col: 45, offset: 67749},
+									val:        "- - -",
+									ignoreCase: false,
+									want:       "\"- - -\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1775, col: 55, offset: 67759},
+									val:        "___",
+									ignoreCase: false,
+									want:       "\"___\"",
+								},
+								&litMatcher{
+									pos:        position{line: 1775, col: 63, offset: 67767},
+									val:        "_ _ _",
+									ignoreCase: false,
+									want:       "\"_ _ _\"",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 1775, col: 72, offset: 67776},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "DelimitedBlock",
+			pos:  position{line: 1785, col: 1, offset: 68026},
+			expr: &actionExpr{
+				pos: position{line: 1785, col: 19, offset: 68044},
+				run: (*parser).callonDelimitedBlock1,
+				expr: &seqExpr{
+					pos: position{line: 1785, col: 19, offset: 68044},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 1785, col: 19, offset: 68044},
+							expr: &ruleRefExpr{
+								pos:  position{line: 1785, col: 20, offset: 68045},
+								name: "Alphanum",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 1786, col: 5, offset: 68133},
+							label: "block",
+							expr: &choiceExpr{
+								pos: position{line: 1786, col: 12, offset: 68140},
+								alternatives: []interface{}{
+									&ruleRefExpr{
+										pos:  position{line: 1786, col: 12, offset: 68140},
+										name: "FencedBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1787, col: 11, offset: 68163},
+										name: "ListingBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1788, col: 11, offset: 68187},
+										name: "ExampleBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1789, col: 11, offset: 68211},
+										name: "VerseBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1790, col: 11, offset: 68232},
+										name: "QuoteBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1791, col: 11, offset: 68253},
+										name: "SidebarBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1792, col: 11, offset: 68276},
+										name: "OpenBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1793, col: 11, offset: 68296},
+										name: "PassthroughBlock",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 1794, col: 11, offset: 68323},
+										name: "CommentBlock",
 									},
 								},
 							},
@@ -12953,230 +13783,303 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SidebarBlock",
-			pos:  position{line: 1760, col: 1, offset: 65014},
+			name: "BlockDelimiter",
+			pos:  position{line: 1798, col: 1, offset: 68364},
+			expr: &choiceExpr{
+				pos: position{line: 1798, col: 19, offset: 68382},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 1798, col: 19, offset: 68382},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1798, col: 19, offset: 68382},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1798, col: 21, offset: 68384},
+									name: "Alphanum",
+								},
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1798, col: 31, offset: 68394},
+								name: "LiteralBlockDelimiter",
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1799, col: 19, offset: 68465},
+						name: "FencedBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1800, col: 19, offset: 68505},
+						name: "ListingBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1801, col: 19, offset: 68546},
+						name: "ExampleBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1802, col: 19, offset: 68587},
+						name: "CommentBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1803, col: 19, offset: 68628},
+						name: "QuoteBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1804, col: 19, offset: 68666},
+						name: "SidebarBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1805, col: 19, offset: 68706},
+						name: "PassthroughBlockDelimiter",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1806, col: 19, offset: 68750},
+						name: "OpenBlockDelimiter",
+					},
+				},
+			},
+		},
+		{
+			name: "ExampleBlock",
+			pos:  position{line: 1811, col: 1, offset: 68966},
 			expr: &actionExpr{
-				pos: position{line: 1760, col: 17, offset: 65030},
-				run: (*parser).callonSidebarBlock1,
+				pos: position{line: 1811, col: 17, offset: 68982},
+				run: (*parser).callonExampleBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1760, col: 17, offset: 65030},
+					pos: position{line: 1811, col: 17, offset: 68982},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1760, col: 17, offset: 65030},
+							pos:   position{line: 1811, col: 17, offset: 68982},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1760, col: 28, offset: 65041},
+								pos: position{line: 1811, col: 28, offset: 68993},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1760, col: 29, offset: 65042},
+									pos:  position{line: 1811, col: 29, offset: 68994},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1760, col: 42, offset: 65055},
-							name: "SidebarBlockStartDelimiter",
+							pos:  position{line: 1811, col: 42, offset: 69007},
+							name: "ExampleBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1760, col: 69, offset: 65082},
-							label: "content",
+							pos:   position{line: 1811, col: 69, offset: 69034},
+							label: "blocks",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1760, col: 78, offset: 65091},
-								name: "SidebarBlockRawContent",
+								pos:  position{line: 1811, col: 77, offset: 69042},
+								name: "ExampleBlockRawContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1760, col: 102, offset: 65115},
-							name: "SidebarBlockEndDelimiter",
+							pos:  position{line: 1811, col: 101, offset: 69066},
+							name: "ExampleBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "SidebarBlockDelimiter",
-			pos:  position{line: 1764, col: 1, offset: 65215},
+			name: "ExampleBlockDelimiter",
+			pos:  position{line: 1815, col: 1, offset: 69231},
 			expr: &seqExpr{
-				pos: position{line: 1764, col: 26, offset: 65240},
+				pos: position{line: 1815, col: 26, offset: 69256},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1764, col: 26, offset: 65240},
-						val:        "****",
+						pos:        position{line: 1815, col: 26, offset: 69256},
+						val:        "====",
 						ignoreCase: false,
-						want:       "\"****\"",
+						want:       "\"====\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1764, col: 33, offset: 65247},
+						pos: position{line: 1815, col: 33, offset: 69263},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1764, col: 33, offset: 65247},
+							pos:  position{line: 1815, col: 33, offset: 69263},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1764, col: 40, offset: 65254},
+						pos:  position{line: 1815, col: 40, offset: 69270},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "SidebarBlockStartDelimiter",
-			pos:  position{line: 1766, col: 1, offset: 65259},
+			name: "ExampleBlockStartDelimiter",
+			pos:  position{line: 1817, col: 1, offset: 69275},
 			expr: &seqExpr{
-				pos: position{line: 1766, col: 31, offset: 65289},
+				pos: position{line: 1817, col: 31, offset: 69305},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1766, col: 31, offset: 65289},
-						val:        "****",
+						pos:        position{line: 1817, col: 31, offset: 69305},
+						val:        "====",
 						ignoreCase: false,
-						want:       "\"****\"",
+						want:       "\"====\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1766, col: 38, offset: 65296},
+						pos: position{line: 1817, col: 38, offset: 69312},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1766, col: 38, offset: 65296},
+							pos:  position{line: 1817, col: 38, offset: 69312},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1766, col: 45, offset: 65303},
+						pos:  position{line: 1817, col: 45, offset: 69319},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "SidebarBlockEndDelimiter",
-			pos:  position{line: 1768, col: 1, offset: 65308},
+			name: "ExampleBlockEndDelimiter",
+			pos:  position{line: 1819, col: 1, offset: 69324},
 			expr: &choiceExpr{
-				pos: position{line: 1768, col: 29, offset: 65336},
+				pos: position{line: 1819, col: 29, offset: 69352},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1768, col: 30, offset: 65337},
+						pos: position{line: 1819, col: 30, offset: 69353},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1768, col: 30, offset: 65337},
-								val:        "****",
+								pos:        position{line: 1819, col: 30, offset: 69353},
+								val:        "====",
 								ignoreCase: false,
-								want:       "\"****\"",
+								want:       "\"====\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1768, col: 37, offset: 65344},
+								pos: position{line: 1819, col: 37, offset: 69360},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1768, col: 37, offset: 65344},
+									pos:  position{line: 1819, col: 37, offset: 69360},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1768, col: 44, offset: 65351},
+								pos:  position{line: 1819, col: 44, offset: 69367},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1768, col: 51, offset: 65358},
+						pos:  position{line: 1819, col: 51, offset: 69374},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "SidebarBlockRawContent",
-			pos:  position{line: 1770, col: 1, offset: 65363},
+			name: "ExampleBlockRawContent",
+			pos:  position{line: 1821, col: 1, offset: 69379},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1770, col: 27, offset: 65389},
+				pos: position{line: 1821, col: 27, offset: 69405},
 				expr: &actionExpr{
-					pos: position{line: 1771, col: 8, offset: 65398},
-					run: (*parser).callonSidebarBlockRawContent2,
+					pos: position{line: 1822, col: 8, offset: 69414},
+					run: (*parser).callonExampleBlockRawContent2,
 					expr: &seqExpr{
-						pos: position{line: 1771, col: 8, offset: 65398},
+						pos: position{line: 1822, col: 8, offset: 69414},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1771, col: 8, offset: 65398},
+								pos: position{line: 1822, col: 8, offset: 69414},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1771, col: 9, offset: 65399},
-									name: "SidebarBlockEndDelimiter",
+									pos:  position{line: 1822, col: 9, offset: 69415},
+									name: "ExampleBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1772, col: 8, offset: 65432},
+								pos:   position{line: 1823, col: 8, offset: 69448},
 								label: "element",
 								expr: &choiceExpr{
-									pos: position{line: 1772, col: 17, offset: 65441},
+									pos: position{line: 1823, col: 17, offset: 69457},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 1772, col: 17, offset: 65441},
+											pos:  position{line: 1823, col: 17, offset: 69457},
 											name: "BlankLine",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1773, col: 15, offset: 65466},
+											pos:  position{line: 1824, col: 15, offset: 69482},
+											name: "DiscreteHeading",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1825, col: 15, offset: 69512},
 											name: "ImageBlock",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1774, col: 15, offset: 65491},
+											pos:  position{line: 1826, col: 15, offset: 69537},
+											name: "VideoBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1827, col: 15, offset: 69562},
+											name: "AudioBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1828, col: 15, offset: 69587},
 											name: "ThematicBreak",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1775, col: 15, offset: 65519},
+											pos:  position{line: 1829, col: 15, offset: 69615},
 											name: "OrderedListItem",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1776, col: 15, offset: 65550},
+											pos:  position{line: 1830, col: 15, offset: 69646},
 											name: "UnorderedListItem",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1777, col: 15, offset: 65583},
+											pos:  position{line: 1831, col: 15, offset: 69679},
 											name: "LabeledListItem",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1778, col: 15, offset: 65614},
+											pos:  position{line: 1832, col: 15, offset: 69710},
 											name: "ContinuedListItemElement",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1779, col: 15, offset: 65653},
+											pos:  position{line: 1833, col: 15, offset: 69749},
 											name: "FencedBlock",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1780, col: 15, offset: 65680},
+											pos:  position{line: 1834, col: 15, offset: 69776},
 											name: "ListingBlock",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1781, col: 15, offset: 65707},
+											pos:  position{line: 1835, col: 15, offset: 69804},
 											name: "VerseBlock",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1782, col: 15, offset: 65733},
-											name: "ExampleBlock",
+											pos:  position{line: 1836, col: 15, offset: 69829},
+											name: "QuoteBlock",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1783, col: 15, offset: 65760},
-											name: "QuoteBlock",
+											pos:  position{line: 1837, col: 15, offset: 69854},
+											name: "SidebarBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1838, col: 15, offset: 69881},
+											name: "OpenBlock",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1784, col: 15, offset: 65785},
+											pos:  position{line: 1839, col: 15, offset: 69905},
 											name: "SingleLineComment",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1785, col: 15, offset: 65817},
+											pos:  position{line: 1840, col: 15, offset: 69937},
 											name: "PassthroughBlock",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1786, col: 15, offset: 65848},
+											pos:  position{line: 1841, col: 15, offset: 69968},
 											name: "Table",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1787, col: 15, offset: 65868},
+											pos:  position{line: 1842, col: 15, offset: 69988},
 											name: "CommentBlock",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1788, col: 15, offset: 65895},
+											pos:  position{line: 1843, col: 15, offset: 70015},
 											name: "LiteralBlock",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1789, col: 15, offset: 65923},
+											pos:  position{line: 1844, col: 15, offset: 70043},
 											name: "RawParagraph",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 1790, col: 15, offset: 65950},
+											pos:  position{line: 1845, col: 15, offset: 70070},
 											name: "StandaloneAttributes",
 										},
 									},
@@ -13188,156 +14091,249 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "FencedBlock",
-			pos:  position{line: 1797, col: 1, offset: 66207},
+			name: "QuoteBlock",
+			pos:  position{line: 1852, col: 1, offset: 70326},
 			expr: &actionExpr{
-				pos: position{line: 1797, col: 16, offset: 66222},
-				run: (*parser).callonFencedBlock1,
+				pos: position{line: 1852, col: 15, offset: 70340},
+				run: (*parser).callonQuoteBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1797, col: 16, offset: 66222},
+					pos: position{line: 1852, col: 15, offset: 70340},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1797, col: 16, offset: 66222},
+							pos:   position{line: 1852, col: 15, offset: 70340},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1797, col: 27, offset: 66233},
+								pos: position{line: 1852, col: 26, offset: 70351},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1797, col: 28, offset: 66234},
+									pos:  position{line: 1852, col: 27, offset: 70352},
 									name: "Attributes",
 								},
 							},
 						},
+						&andCodeExpr{
+							pos: position{line: 1853, col: 5, offset: 70370},
+							run: (*parser).callonQuoteBlock6,
+						},
 						&ruleRefExpr{
-							pos:  position{line: 1797, col: 41, offset: 66247},
-							name: "FencedBlockStartDelimiter",
+							pos:  position{line: 1864, col: 5, offset: 70718},
+							name: "QuoteBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1797, col: 67, offset: 66273},
+							pos:   position{line: 1864, col: 30, offset: 70743},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1797, col: 76, offset: 66282},
-								name: "FencedBlockRawContent",
+								pos:  position{line: 1864, col: 39, offset: 70752},
+								name: "QuoteBlockRawContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1797, col: 99, offset: 66305},
-							name: "FencedBlockEndDelimiter",
+							pos:  position{line: 1864, col: 61, offset: 70774},
+							name: "QuoteBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlockDelimiter",
-			pos:  position{line: 1801, col: 1, offset: 66403},
+			name: "QuoteBlockDelimiter",
+			pos:  position{line: 1868, col: 1, offset: 70878},
 			expr: &seqExpr{
-				pos: position{line: 1801, col: 25, offset: 66427},
+				pos: position{line: 1868, col: 24, offset: 70901},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1801, col: 25, offset: 66427},
-						val:        "```",
+						pos:        position{line: 1868, col: 24, offset: 70901},
+						val:        "____",
 						ignoreCase: false,
-						want:       "\"```\"",
+						want:       "\"____\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1801, col: 31, offset: 66433},
+						pos: position{line: 1868, col: 31, offset: 70908},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1801, col: 31, offset: 66433},
+							pos:  position{line: 1868, col: 31, offset: 70908},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1801, col: 38, offset: 66440},
+						pos:  position{line: 1868, col: 38, offset: 70915},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlockStartDelimiter",
-			pos:  position{line: 1803, col: 1, offset: 66500},
+			name: "QuoteBlockStartDelimiter",
+			pos:  position{line: 1870, col: 1, offset: 70945},
 			expr: &seqExpr{
-				pos: position{line: 1803, col: 30, offset: 66529},
+				pos: position{line: 1870, col: 29, offset: 70973},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1803, col: 30, offset: 66529},
-						val:        "```",
+						pos:        position{line: 1870, col: 29, offset: 70973},
+						val:        "____",
 						ignoreCase: false,
-						want:       "\"```\"",
+						want:       "\"____\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1803, col: 36, offset: 66535},
+						pos: position{line: 1870, col: 36, offset: 70980},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1803, col: 36, offset: 66535},
+							pos:  position{line: 1870, col: 36, offset: 70980},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1803, col: 43, offset: 66542},
+						pos:  position{line: 1870, col: 43, offset: 70987},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlockEndDelimiter",
-			pos:  position{line: 1805, col: 1, offset: 66547},
+			name: "QuoteBlockEndDelimiter",
+			pos:  position{line: 1872, col: 1, offset: 71017},
 			expr: &choiceExpr{
-				pos: position{line: 1805, col: 28, offset: 66574},
+				pos: position{line: 1872, col: 27, offset: 71043},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1805, col: 29, offset: 66575},
+						pos: position{line: 1872, col: 28, offset: 71044},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1805, col: 29, offset: 66575},
-								val:        "```",
+								pos:        position{line: 1872, col: 28, offset: 71044},
+								val:        "____",
 								ignoreCase: false,
-								want:       "\"```\"",
+								want:       "\"____\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1805, col: 35, offset: 66581},
+								pos: position{line: 1872, col: 35, offset: 71051},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1805, col: 35, offset: 66581},
+									pos:  position{line: 1872, col: 35, offset: 71051},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1805, col: 42, offset: 66588},
+								pos:  position{line: 1872, col: 42, offset: 71058},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1805, col: 49, offset: 66595},
+						pos:  position{line: 1872, col: 49, offset: 71065},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "FencedBlockRawContent",
-			pos:  position{line: 1807, col: 1, offset: 66600},
+			name: "QuoteBlockRawContent",
+			pos:  position{line: 1874, col: 1, offset: 71095},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1807, col: 26, offset: 66625},
+				pos: position{line: 1874, col: 25, offset: 71119},
 				expr: &actionExpr{
-					pos: position{line: 1807, col: 27, offset: 66626},
-					run: (*parser).callonFencedBlockRawContent2,
+					pos: position{line: 1875, col: 8, offset: 71128},
+					run: (*parser).callonQuoteBlockRawContent2,
 					expr: &seqExpr{
-						pos: position{line: 1807, col: 27, offset: 66626},
+						pos: position{line: 1875, col: 8, offset: 71128},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1807, col: 27, offset: 66626},
+								pos: position{line: 1875, col: 8, offset: 71128},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1807, col: 28, offset: 66627},
-									name: "FencedBlockEndDelimiter",
+									pos:  position{line: 1875, col: 9, offset: 71129},
+									name: "QuoteBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1807, col: 52, offset: 66651},
-								label: "line",
-								expr: &ruleRefExpr{
-									pos:  position{line: 1807, col: 58, offset: 66657},
-									name: "RawLine",
+								pos:   position{line: 1876, col: 8, offset: 71160},
+								label: "element",
+								expr: &choiceExpr{
+									pos: position{line: 1876, col: 17, offset: 71169},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 1876, col: 17, offset: 71169},
+											name: "BlankLine",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1877, col: 15, offset: 71194},
+											name: "DiscreteHeading",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1878, col: 15, offset: 71224},
+											name: "ImageBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1879, col: 15, offset: 71249},
+											name: "VideoBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1880, col: 15, offset: 71274},
+											name: "AudioBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1881, col: 15, offset: 71299},
+											name: "ThematicBreak",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1882, col: 15, offset: 71327},
+											name: "OrderedListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1883, col: 15, offset: 71358},
+											name: "UnorderedListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1884, col: 15, offset: 71391},
+											name: "LabeledListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1885, col: 15, offset: 71422},
+											name: "ContinuedListItemElement",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1886, col: 15, offset: 71461},
+											name: "FencedBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1887, col: 15, offset: 71488},
+											name: "ListingBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1888, col: 15, offset: 71516},
+											name: "VerseBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1889, col: 15, offset: 71541},
+											name: "ExampleBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1890, col: 15, offset: 71568},
+											name: "SidebarBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1891, col: 15, offset: 71595},
+											name: "SingleLineComment",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1892, col: 15, offset: 71627},
+											name: "PassthroughBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1893, col: 15, offset: 71658},
+											name: "Table",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1894, col: 15, offset: 71678},
+											name: "CommentBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1895, col: 15, offset: 71705},
+											name: "LiteralBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1896, col: 15, offset: 71733},
+											name: "RawParagraph",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1897, col: 15, offset: 71760},
+											name: "StandaloneAttributes",
+										},
+									},
 								},
 							},
 						},
@@ -13346,156 +14342,249 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ListingBlock",
-			pos:  position{line: 1814, col: 1, offset: 66891},
+			name: "SidebarBlock",
+			pos:  position{line: 1904, col: 1, offset: 72018},
 			expr: &actionExpr{
-				pos: position{line: 1814, col: 17, offset: 66907},
-				run: (*parser).callonListingBlock1,
+				pos: position{line: 1904, col: 17, offset: 72034},
+				run: (*parser).callonSidebarBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1814, col: 17, offset: 66907},
+					pos: position{line: 1904, col: 17, offset: 72034},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1814, col: 17, offset: 66907},
+							pos:   position{line: 1904, col: 17, offset: 72034},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1814, col: 28, offset: 66918},
+								pos: position{line: 1904, col: 28, offset: 72045},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1814, col: 29, offset: 66919},
+									pos:  position{line: 1904, col: 29, offset: 72046},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1814, col: 42, offset: 66932},
-							name: "ListingBlockStartDelimiter",
+							pos:  position{line: 1904, col: 42, offset: 72059},
+							name: "SidebarBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1814, col: 69, offset: 66959},
+							pos:   position{line: 1904, col: 69, offset: 72086},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1814, col: 78, offset: 66968},
-								name: "ListingBlockRawContent",
+								pos:  position{line: 1904, col: 78, offset: 72095},
+								name: "SidebarBlockRawContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1814, col: 102, offset: 66992},
-							name: "ListingBlockEndDelimiter",
+							pos:  position{line: 1904, col: 102, offset: 72119},
+							name: "SidebarBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ListingBlockDelimiter",
-			pos:  position{line: 1818, col: 1, offset: 67092},
+			name: "SidebarBlockDelimiter",
+			pos:  position{line: 1908, col: 1, offset: 72219},
 			expr: &seqExpr{
-				pos: position{line: 1818, col: 26, offset: 67117},
+				pos: position{line: 1908, col: 26, offset: 72244},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1818, col: 26, offset: 67117},
-						val:        "----",
+						pos:        position{line: 1908, col: 26, offset: 72244},
+						val:        "****",
 						ignoreCase: false,
-						want:       "\"----\"",
+						want:       "\"****\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1818, col: 33, offset: 67124},
+						pos: position{line: 1908, col: 33, offset: 72251},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1818, col: 33, offset: 67124},
+							pos:  position{line: 1908, col: 33, offset: 72251},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1818, col: 40, offset: 67131},
+						pos:  position{line: 1908, col: 40, offset: 72258},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "ListingBlockStartDelimiter",
-			pos:  position{line: 1820, col: 1, offset: 67136},
+			name: "SidebarBlockStartDelimiter",
+			pos:  position{line: 1910, col: 1, offset: 72263},
 			expr: &seqExpr{
-				pos: position{line: 1820, col: 31, offset: 67166},
+				pos: position{line: 1910, col: 31, offset: 72293},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1820, col: 31, offset: 67166},
-						val:        "----",
+						pos:        position{line: 1910, col: 31, offset: 72293},
+						val:        "****",
 						ignoreCase: false,
-						want:       "\"----\"",
+						want:       "\"****\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1820, col: 38, offset: 67173},
+						pos: position{line: 1910, col: 38, offset: 72300},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1820, col: 38, offset: 67173},
+							pos:  position{line: 1910, col: 38, offset: 72300},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1820, col: 45, offset: 67180},
+						pos:  position{line: 1910, col: 45, offset: 72307},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "ListingBlockEndDelimiter",
-			pos:  position{line: 1822, col: 1, offset: 67185},
+			name: "SidebarBlockEndDelimiter",
+			pos:  position{line: 1912, col: 1, offset: 72312},
 			expr: &choiceExpr{
-				pos: position{line: 1822, col: 29, offset: 67213},
+				pos: position{line: 1912, col: 29, offset: 72340},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1822, col: 30, offset: 67214},
+						pos: position{line: 1912, col: 30, offset: 72341},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1822, col: 30, offset: 67214},
-								val:        "----",
+								pos:        position{line: 1912, col: 30, offset: 72341},
+								val:        "****",
 								ignoreCase: false,
-								want:       "\"----\"",
+								want:       "\"****\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1822, col: 37, offset: 67221},
+								pos: position{line: 1912, col: 37, offset: 72348},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1822, col: 37, offset: 67221},
+									pos:  position{line: 1912, col: 37, offset: 72348},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1822, col: 44, offset: 67228},
+								pos:  position{line: 1912, col: 44, offset: 72355},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1822, col: 51, offset: 67235},
+						pos:  position{line: 1912, col: 51, offset: 72362},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "ListingBlockRawContent",
-			pos:  position{line: 1824, col: 1, offset: 67240},
+			name: "SidebarBlockRawContent",
+			pos:  position{line: 1914, col: 1, offset: 72367},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1824, col: 27, offset: 67266},
+				pos: position{line: 1914, col: 27, offset: 72393},
 				expr: &actionExpr{
-					pos: position{line: 1824, col: 28, offset: 67267},
-					run: (*parser).callonListingBlockRawContent2,
+					pos: position{line: 1915, col: 8, offset: 72402},
+					run: (*parser).callonSidebarBlockRawContent2,
 					expr: &seqExpr{
-						pos: position{line: 1824, col: 28, offset: 67267},
+						pos: position{line: 1915, col: 8, offset: 72402},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1824, col: 28, offset: 67267},
+								pos: position{line: 1915, col: 8, offset: 72402},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1824, col: 29, offset: 67268},
-									name: "ListingBlockEndDelimiter",
+									pos:  position{line: 1915, col: 9, offset: 72403},
+									name: "SidebarBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1824, col: 54, offset: 67293},
-								label: "line",
-								expr: &ruleRefExpr{
-									pos:  position{line: 1824, col: 60, offset: 67299},
-									name: "RawLine",
+								pos:   position{line: 1916, col: 8, offset: 72436},
+								label: "element",
+								expr: &choiceExpr{
+									pos: position{line: 1916, col: 17, offset: 72445},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 1916, col: 17, offset: 72445},
+											name: "BlankLine",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1917, col: 15, offset: 72470},
+											name: "DiscreteHeading",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1918, col: 15, offset: 72500},
+											name: "ImageBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1919, col: 15, offset: 72525},
+											name: "VideoBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1920, col: 15, offset: 72550},
+											name: "AudioBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1921, col: 15, offset: 72575},
+											name: "ThematicBreak",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1922, col: 15, offset: 72603},
+											name: "OrderedListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1923, col: 15, offset: 72634},
+											name: "UnorderedListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1924, col: 15, offset: 72667},
+											name: "LabeledListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1925, col: 15, offset: 72698},
+											name: "ContinuedListItemElement",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1926, col: 15, offset: 72737},
+											name: "FencedBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1927, col: 15, offset: 72764},
+											name: "ListingBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1928, col: 15, offset: 72791},
+											name: "VerseBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1929, col: 15, offset: 72817},
+											name: "ExampleBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1930, col: 15, offset: 72844},
+											name: "QuoteBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1931, col: 15, offset: 72869},
+											name: "OpenBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1932, col: 15, offset: 72893},
+											name: "SingleLineComment",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1933, col: 15, offset: 72925},
+											name: "PassthroughBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1934, col: 15, offset: 72956},
+											name: "Table",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1935, col: 15, offset: 72976},
+											name: "CommentBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1936, col: 15, offset: 73003},
+											name: "LiteralBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1937, col: 15, offset: 73031},
+											name: "RawParagraph",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1938, col: 15, offset: 73058},
+											name: "StandaloneAttributes",
+										},
+									},
 								},
 							},
 						},
@@ -13504,72 +14593,200 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "VerseBlock",
-			pos:  position{line: 1831, col: 1, offset: 67531},
-			expr: &actionExpr{
-				pos: position{line: 1831, col: 15, offset: 67545},
-				run: (*parser).callonVerseBlock1,
-				expr: &seqExpr{
-					pos: position{line: 1831, col: 15, offset: 67545},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 1831, col: 15, offset: 67545},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 1831, col: 26, offset: 67556},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1831, col: 27, offset: 67557},
-									name: "Attributes",
+			name: "OpenBlock",
+			pos:  position{line: 1945, col: 1, offset: 73313},
+			expr: &choiceExpr{
+				pos: position{line: 1945, col: 14, offset: 73326},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 1945, col: 14, offset: 73326},
+						run: (*parser).callonOpenBlock2,
+						expr: &seqExpr{
+							pos: position{line: 1945, col: 14, offset: 73326},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1945, col: 14, offset: 73326},
+									label: "attributes",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 1945, col: 25, offset: 73337},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1945, col: 26, offset: 73338},
+											name: "Attributes",
+										},
+									},
+								},
+								&andCodeExpr{
+									pos: position{line: 1946, col: 5, offset: 73356},
+									run: (*parser).callonOpenBlock7,
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1950, col: 5, offset: 73520},
+									name: "OpenBlockStartDelimiter",
+								},
+								&labeledExpr{
+									pos:   position{line: 1950, col: 29, offset: 73544},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1950, col: 38, offset: 73553},
+										name: "OpenBlockRawLines",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1950, col: 57, offset: 73572},
+									name: "OpenBlockEndDelimiter",
 								},
 							},
 						},
-						&andCodeExpr{
-							pos: position{line: 1832, col: 5, offset: 67575},
-							run: (*parser).callonVerseBlock6,
-						},
-						&ruleRefExpr{
-							pos:  position{line: 1839, col: 5, offset: 67785},
-							name: "QuoteBlockStartDelimiter",
-						},
-						&labeledExpr{
-							pos:   position{line: 1839, col: 30, offset: 67810},
-							label: "content",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1839, col: 39, offset: 67819},
-								name: "VerseBlockRawContent",
+					},
+					&actionExpr{
+						pos: position{line: 1953, col: 7, offset: 73690},
+						run: (*parser).callonOpenBlock12,
+						expr: &seqExpr{
+							pos: position{line: 1953, col: 7, offset: 73690},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 1953, col: 7, offset: 73690},
+									label: "attributes",
+									expr: &zeroOrOneExpr{
+										pos: position{line: 1953, col: 18, offset: 73701},
+										expr: &ruleRefExpr{
+											pos:  position{line: 1953, col: 19, offset: 73702},
+											name: "Attributes",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1953, col: 32, offset: 73715},
+									name: "OpenBlockStartDelimiter",
+								},
+								&labeledExpr{
+									pos:   position{line: 1953, col: 56, offset: 73739},
+									label: "content",
+									expr: &ruleRefExpr{
+										pos:  position{line: 1953, col: 65, offset: 73748},
+										name: "OpenBlockRawContent",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 1953, col: 86, offset: 73769},
+									name: "OpenBlockEndDelimiter",
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 1839, col: 61, offset: 67841},
-							name: "QuoteBlockEndDelimiter",
+					},
+				},
+			},
+		},
+		{
+			name: "OpenBlockDelimiter",
+			pos:  position{line: 1957, col: 1, offset: 73937},
+			expr: &seqExpr{
+				pos: position{line: 1957, col: 23, offset: 73959},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1957, col: 23, offset: 73959},
+						val:        "--",
+						ignoreCase: false,
+						want:       "\"--\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1957, col: 28, offset: 73964},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1957, col: 28, offset: 73964},
+							name: "Space",
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 1957, col: 35, offset: 73971},
+						name: "EOL",
+					},
 				},
 			},
 		},
 		{
-			name: "VerseBlockRawContent",
-			pos:  position{line: 1843, col: 1, offset: 67945},
+			name: "OpenBlockStartDelimiter",
+			pos:  position{line: 1959, col: 1, offset: 73976},
+			expr: &seqExpr{
+				pos: position{line: 1959, col: 28, offset: 74003},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 1959, col: 28, offset: 74003},
+						val:        "--",
+						ignoreCase: false,
+						want:       "\"--\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 1959, col: 33, offset: 74008},
+						expr: &ruleRefExpr{
+							pos:  position{line: 1959, col: 33, offset: 74008},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1959, col: 40, offset: 74015},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "OpenBlockEndDelimiter",
+			pos:  position{line: 1961, col: 1, offset: 74020},
+			expr: &choiceExpr{
+				pos: position{line: 1961, col: 26, offset: 74045},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 1961, col: 27, offset: 74046},
+						exprs: []interface{}{
+							&litMatcher{
+								pos:        position{line: 1961, col: 27, offset: 74046},
+								val:        "--",
+								ignoreCase: false,
+								want:       "\"--\"",
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 1961, col: 32, offset: 74051},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1961, col: 32, offset: 74051},
+									name: "Space",
+								},
+							},
+							&ruleRefExpr{
+								pos:  position{line: 1961, col: 39, offset: 74058},
+								name: "EOL",
+							},
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 1961, col: 46, offset: 74065},
+						name: "EOF",
+					},
+				},
+			},
+		},
+		{
+			name: "OpenBlockRawLines",
+			pos:  position{line: 1963, col: 1, offset: 74070},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1843, col: 25, offset: 67969},
+				pos: position{line: 1963, col: 22, offset: 74091},
 				expr: &actionExpr{
-					pos: position{line: 1843, col: 26, offset: 67970},
-					run: (*parser).callonVerseBlockRawContent2,
+					pos: position{line: 1963, col: 23, offset: 74092},
+					run: (*parser).callonOpenBlockRawLines2,
 					expr: &seqExpr{
-						pos: position{line: 1843, col: 26, offset: 67970},
+						pos: position{line: 1963, col: 23, offset: 74092},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1843, col: 26, offset: 67970},
+								pos: position{line: 1963, col: 23, offset: 74092},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1843, col: 27, offset: 67971},
-									name: "QuoteBlockEndDelimiter",
+									pos:  position{line: 1963, col: 24, offset: 74093},
+									name: "OpenBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1843, col: 50, offset: 67994},
+								pos:   position{line: 1963, col: 46, offset: 74115},
 								label: "line",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1843, col: 56, offset: 68000},
+									pos:  position{line: 1963, col: 52, offset: 74121},
 									name: "RawLine",
 								},
 							},
@@ -13579,155 +14796,279 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "PassthroughBlock",
-			pos:  position{line: 1850, col: 1, offset: 68238},
+			name: "OpenBlockRawContent",
+			pos:  position{line: 1967, col: 1, offset: 74159},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 1967, col: 24, offset: 74182},
+				expr: &actionExpr{
+					pos: position{line: 1968, col: 8, offset: 74191},
+					run: (*parser).callonOpenBlockRawContent2,
+					expr: &seqExpr{
+						pos: position{line: 1968, col: 8, offset: 74191},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 1968, col: 8, offset: 74191},
+								expr: &ruleRefExpr{
+									pos:  position{line: 1968, col: 9, offset: 74192},
+									name: "OpenBlockEndDelimiter",
+								},
+							},
+							&labeledExpr{
+								pos:   position{line: 1969, col: 8, offset: 74222},
+								label: "element",
+								expr: &choiceExpr{
+									pos: position{line: 1969, col: 17, offset: 74231},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 1969, col: 17, offset: 74231},
+											name: "BlankLine",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1970, col: 15, offset: 74256},
+											name: "DiscreteHeading",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1971, col: 15, offset: 74286},
+											name: "ImageBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1972, col: 15, offset: 74311},
+											name: "VideoBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1973, col: 15, offset: 74336},
+											name: "AudioBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1974, col: 15, offset: 74361},
+											name: "ThematicBreak",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1975, col: 15, offset: 74389},
+											name: "OrderedListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1976, col: 15, offset: 74420},
+											name: "UnorderedListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1977, col: 15, offset: 74453},
+											name: "LabeledListItem",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1978, col: 15, offset: 74484},
+											name: "ContinuedListItemElement",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1979, col: 15, offset: 74523},
+											name: "FencedBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1980, col: 15, offset: 74550},
+											name: "ListingBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1981, col: 15, offset: 74578},
+											name: "VerseBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1982, col: 15, offset: 74603},
+											name: "ExampleBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1983, col: 15, offset: 74630},
+											name: "QuoteBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1984, col: 15, offset: 74655},
+											name: "SidebarBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1985, col: 15, offset: 74682},
+											name: "SingleLineComment",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1986, col: 15, offset: 74714},
+											name: "PassthroughBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1987, col: 15, offset: 74745},
+											name: "Table",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1988, col: 15, offset: 74765},
+											name: "CommentBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1989, col: 15, offset: 74792},
+											name: "LiteralBlock",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1990, col: 15, offset: 74820},
+											name: "RawParagraph",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 1991, col: 15, offset: 74847},
+											name: "StandaloneAttributes",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "FencedBlock",
+			pos:  position{line: 1998, col: 1, offset: 75104},
 			expr: &actionExpr{
-				pos: position{line: 1850, col: 21, offset: 68258},
-				run: (*parser).callonPassthroughBlock1,
+				pos: position{line: 1998, col: 16, offset: 75119},
+				run: (*parser).callonFencedBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1850, col: 21, offset: 68258},
+					pos: position{line: 1998, col: 16, offset: 75119},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 1850, col: 21, offset: 68258},
+							pos:   position{line: 1998, col: 16, offset: 75119},
 							label: "attributes",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 1850, col: 32, offset: 68269},
+								pos: position{line: 1998, col: 27, offset: 75130},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1850, col: 33, offset: 68270},
+									pos:  position{line: 1998, col: 28, offset: 75131},
 									name: "Attributes",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1850, col: 46, offset: 68283},
-							name: "PassthroughBlockStartDelimiter",
+							pos:  position{line: 1998, col: 41, offset: 75144},
+							name: "FencedBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1850, col: 77, offset: 68314},
+							pos:   position{line: 1998, col: 67, offset: 75170},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1850, col: 86, offset: 68323},
-								name: "PassthroughBlockRawContent",
+								pos:  position{line: 1998, col: 76, offset: 75179},
+								name: "FencedBlockRawContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1850, col: 114, offset: 68351},
-							name: "PassthroughBlockEndDelimiter",
+							pos:  position{line: 1998, col: 99, offset: 75202},
+							name: "FencedBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "PassthroughBlockDelimiter",
-			pos:  position{line: 1854, col: 1, offset: 68459},
+			name: "FencedBlockDelimiter",
+			pos:  position{line: 2002, col: 1, offset: 75300},
 			expr: &seqExpr{
-				pos: position{line: 1854, col: 30, offset: 68488},
+				pos: position{line: 2002, col: 25, offset: 75324},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1854, col: 30, offset: 68488},
-						val:        "++++",
+						pos:        position{line: 2002, col: 25, offset: 75324},
+						val:        "```",
 						ignoreCase: false,
-						want:       "\"++++\"",
+						want:       "\"```\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1854, col: 37, offset: 68495},
+						pos: position{line: 2002, col: 31, offset: 75330},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1854, col: 37, offset: 68495},
+							pos:  position{line: 2002, col: 31, offset: 75330},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1854, col: 44, offset: 68502},
+						pos:  position{line: 2002, col: 38, offset: 75337},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "PassthroughBlockStartDelimiter",
-			pos:  position{line: 1856, col: 1, offset: 68507},
+			name: "FencedBlockStartDelimiter",
+			pos:  position{line: 2004, col: 1, offset: 75397},
 			expr: &seqExpr{
-				pos: position{line: 1856, col: 35, offset: 68541},
+				pos: position{line: 2004, col: 30, offset: 75426},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1856, col: 35, offset: 68541},
-						val:        "++++",
+						pos:        position{line: 2004, col: 30, offset: 75426},
+						val:        "```",
 						ignoreCase: false,
-						want:       "\"++++\"",
+						want:       "\"```\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1856, col: 42, offset: 68548},
+						pos: position{line: 2004, col: 36, offset: 75432},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1856, col: 42, offset: 68548},
+							pos:  position{line: 2004, col: 36, offset: 75432},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1856, col: 49, offset: 68555},
+						pos:  position{line: 2004, col: 43, offset: 75439},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "PassthroughBlockEndDelimiter",
-			pos:  position{line: 1858, col: 1, offset: 68560},
+			name: "FencedBlockEndDelimiter",
+			pos:  position{line: 2006, col: 1, offset: 75444},
 			expr: &choiceExpr{
-				pos: position{line: 1858, col: 33, offset: 68592},
+				pos: position{line: 2006, col: 28, offset: 75471},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1858, col: 34, offset: 68593},
+						pos: position{line: 2006, col: 29, offset: 75472},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1858, col: 34, offset: 68593},
-								val:        "++++",
+								pos:        position{line: 2006, col: 29, offset: 75472},
+								val:        "```",
 								ignoreCase: false,
-								want:       "\"++++\"",
+								want:       "\"```\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1858, col: 41, offset: 68600},
+								pos: position{line: 2006, col: 35, offset: 75478},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1858, col: 41, offset: 68600},
+									pos:  position{line: 2006, col: 35, offset: 75478},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1858, col: 48, offset: 68607},
+								pos:  position{line: 2006, col: 42, offset: 75485},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1858, col: 55, offset: 68614},
+						pos:  position{line: 2006, col: 49, offset: 75492},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "PassthroughBlockRawContent",
-			pos:  position{line: 1860, col: 1, offset: 68619},
+			name: "FencedBlockRawContent",
+			pos:  position{line: 2008, col: 1, offset: 75497},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1860, col: 31, offset: 68649},
+				pos: position{line: 2008, col: 26, offset: 75522},
 				expr: &actionExpr{
-					pos: position{line: 1860, col: 32, offset: 68650},
-					run: (*parser).callonPassthroughBlockRawContent2,
+					pos: position{line: 2008, col: 27, offset: 75523},
+					run: (*parser).callonFencedBlockRawContent2,
 					expr: &seqExpr{
-						pos: position{line: 1860, col: 32, offset: 68650},
+						pos: position{line: 2008, col: 27, offset: 75523},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1860, col: 32, offset: 68650},
+								pos: position{line: 2008, col: 27, offset: 75523},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1860, col: 33, offset: 68651},
-									name: "PassthroughBlockEndDelimiter",
+									pos:  position{line: 2008, col: 28, offset: 75524},
+									name: "FencedBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1860, col: 62, offset: 68680},
+								pos:   position{line: 2008, col: 52, offset: 75548},
 								label: "line",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1860, col: 68, offset: 68686},
+									pos:  position{line: 2008, col: 58, offset: 75554},
 									name: "RawLine",
 								},
 							},
@@ -13737,144 +15078,155 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "CommentBlockDelimiter",
-			pos:  position{line: 1867, col: 1, offset: 68920},
+			name: "ListingBlock",
+			pos:  position{line: 2015, col: 1, offset: 75788},
+			expr: &actionExpr{
+				pos: position{line: 2015, col: 17, offset: 75804},
+				run: (*parser).callonListingBlock1,
+				expr: &seqExpr{
+					pos: position{line: 2015, col: 17, offset: 75804},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2015, col: 17, offset: 75804},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2015, col: 28, offset: 75815},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2015, col: 29, offset: 75816},
+									name: "Attributes",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2015, col: 42, offset: 75829},
+							name: "ListingBlockStartDelimiter",
+						},
+						&labeledExpr{
+							pos:   position{line: 2015, col: 69, offset: 75856},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2015, col: 78, offset: 75865},
+								name: "ListingBlockRawContent",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2015, col: 102, offset: 75889},
+							name: "ListingBlockEndDelimiter",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ListingBlockDelimiter",
+			pos:  position{line: 2019, col: 1, offset: 76055},
 			expr: &seqExpr{
-				pos: position{line: 1867, col: 26, offset: 68945},
+				pos: position{line: 2019, col: 26, offset: 76080},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1867, col: 26, offset: 68945},
-						val:        "////",
+						pos:        position{line: 2019, col: 26, offset: 76080},
+						val:        "----",
 						ignoreCase: false,
-						want:       "\"////\"",
+						want:       "\"----\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1867, col: 33, offset: 68952},
+						pos: position{line: 2019, col: 33, offset: 76087},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1867, col: 33, offset: 68952},
+							pos:  position{line: 2019, col: 33, offset: 76087},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1867, col: 40, offset: 68959},
+						pos:  position{line: 2019, col: 40, offset: 76094},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "CommentBlockStartDelimiter",
-			pos:  position{line: 1869, col: 1, offset: 68964},
+			name: "ListingBlockStartDelimiter",
+			pos:  position{line: 2021, col: 1, offset: 76099},
 			expr: &seqExpr{
-				pos: position{line: 1869, col: 31, offset: 68994},
+				pos: position{line: 2021, col: 31, offset: 76129},
 				exprs: []interface{}{
 					&litMatcher{
-						pos:        position{line: 1869, col: 31, offset: 68994},
-						val:        "////",
+						pos:        position{line: 2021, col: 31, offset: 76129},
+						val:        "----",
 						ignoreCase: false,
-						want:       "\"////\"",
+						want:       "\"----\"",
 					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1869, col: 38, offset: 69001},
+						pos: position{line: 2021, col: 38, offset: 76136},
 						expr: &ruleRefExpr{
-							pos:  position{line: 1869, col: 38, offset: 69001},
+							pos:  position{line: 2021, col: 38, offset: 76136},
 							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1869, col: 45, offset: 69008},
+						pos:  position{line: 2021, col: 45, offset: 76143},
 						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "CommentBlockEndDelimiter",
-			pos:  position{line: 1871, col: 1, offset: 69013},
+			name: "ListingBlockEndDelimiter",
+			pos:  position{line: 2023, col: 1, offset: 76148},
 			expr: &choiceExpr{
-				pos: position{line: 1871, col: 29, offset: 69041},
+				pos: position{line: 2023, col: 29, offset: 76176},
 				alternatives: []interface{}{
 					&seqExpr{
-						pos: position{line: 1871, col: 30, offset: 69042},
+						pos: position{line: 2023, col: 30, offset: 76177},
 						exprs: []interface{}{
 							&litMatcher{
-								pos:        position{line: 1871, col: 30, offset: 69042},
-								val:        "////",
+								pos:        position{line: 2023, col: 30, offset: 76177},
+								val:        "----",
 								ignoreCase: false,
-								want:       "\"////\"",
+								want:       "\"----\"",
 							},
 							&zeroOrMoreExpr{
-								pos: position{line: 1871, col: 37, offset: 69049},
+								pos: position{line: 2023, col: 37, offset: 76184},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1871, col: 37, offset: 69049},
+									pos:  position{line: 2023, col: 37, offset: 76184},
 									name: "Space",
 								},
 							},
 							&ruleRefExpr{
-								pos:  position{line: 1871, col: 44, offset: 69056},
+								pos:  position{line: 2023, col: 44, offset: 76191},
 								name: "EOL",
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1871, col: 51, offset: 69063},
+						pos:  position{line: 2023, col: 51, offset: 76198},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "CommentBlock",
-			pos:  position{line: 1873, col: 1, offset: 69068},
-			expr: &actionExpr{
-				pos: position{line: 1873, col: 17, offset: 69084},
-				run: (*parser).callonCommentBlock1,
-				expr: &seqExpr{
-					pos: position{line: 1873, col: 17, offset: 69084},
-					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 1873, col: 17, offset: 69084},
-							name: "CommentBlockStartDelimiter",
-						},
-						&labeledExpr{
-							pos:   position{line: 1873, col: 44, offset: 69111},
-							label: "content",
-							expr: &ruleRefExpr{
-								pos:  position{line: 1873, col: 53, offset: 69120},
-								name: "CommentBlockRawContent",
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 1873, col: 78, offset: 69145},
-							name: "CommentBlockEndDelimiter",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "CommentBlockRawContent",
-			pos:  position{line: 1877, col: 1, offset: 69238},
+			name: "ListingBlockRawContent",
+			pos:  position{line: 2025, col: 1, offset: 76203},
 			expr: &zeroOrMoreExpr{
-				pos: position{line: 1877, col: 27, offset: 69264},
+				pos: position{line: 2025, col: 27, offset: 76229},
 				expr: &actionExpr{
-					pos: position{line: 1877, col: 28, offset: 69265},
-					run: (*parser).callonCommentBlockRawContent2,
+					pos: position{line: 2025, col: 28, offset: 76230},
+					run: (*parser).callonListingBlockRawContent2,
 					expr: &seqExpr{
-						pos: position{line: 1877, col: 28, offset: 69265},
+						pos: position{line: 2025, col: 28, offset: 76230},
 						exprs: []interface{}{
 							&notExpr{
-								pos: position{line: 1877, col: 28, offset: 69265},
+								pos: position{line: 2025, col: 28, offset: 76230},
 								expr: &ruleRefExpr{
-									pos:  position{line: 1877, col: 29, offset: 69266},
-									name: "CommentBlockEndDelimiter",
+									pos:  position{line: 2025, col: 29, offset: 76231},
+									name: "ListingBlockEndDelimiter",
 								},
 							},
 							&labeledExpr{
-								pos:   position{line: 1877, col: 54, offset: 69291},
+								pos:   position{line: 2025, col: 54, offset: 76256},
 								label: "line",
 								expr: &ruleRefExpr{
-									pos:  position{line: 1877, col: 60, offset: 69297},
+									pos:  position{line: 2025, col: 60, offset: 76262},
 									name: "RawLine",
 								},
 							},
@@ -13884,471 +15236,417 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "SingleLineComment",
-			pos:  position{line: 1881, col: 1, offset: 69335},
+			name: "VerseBlock",
+			pos:  position{line: 2032, col: 1, offset: 76494},
 			expr: &actionExpr{
-				pos: position{line: 1881, col: 22, offset: 69356},
-				run: (*parser).callonSingleLineComment1,
+				pos: position{line: 2032, col: 15, offset: 76508},
+				run: (*parser).callonVerseBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1881, col: 22, offset: 69356},
+					pos: position{line: 2032, col: 15, offset: 76508},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 1881, col: 22, offset: 69356},
-							expr: &ruleRefExpr{
-								pos:  position{line: 1881, col: 23, offset: 69357},
-								name: "CommentBlockDelimiter",
+						&labeledExpr{
+							pos:   position{line: 2032, col: 15, offset: 76508},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2032, col: 26, offset: 76519},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2032, col: 27, offset: 76520},
+									name: "Attributes",
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 1881, col: 45, offset: 69379},
-							val:        "//",
-							ignoreCase: false,
-							want:       "\"//\"",
+						&andCodeExpr{
+							pos: position{line: 2033, col: 5, offset: 76538},
+							run: (*parser).callonVerseBlock6,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2040, col: 5, offset: 76748},
+							name: "QuoteBlockStartDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 1881, col: 50, offset: 69384},
+							pos:   position{line: 2040, col: 30, offset: 76773},
 							label: "content",
 							expr: &ruleRefExpr{
-								pos:  position{line: 1881, col: 59, offset: 69393},
-								name: "SingleLineCommentContent",
+								pos:  position{line: 2040, col: 39, offset: 76782},
+								name: "VerseBlockRawContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1881, col: 85, offset: 69419},
-							name: "EOL",
+							pos:  position{line: 2040, col: 61, offset: 76804},
+							name: "QuoteBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "SingleLineCommentContent",
-			pos:  position{line: 1885, col: 1, offset: 69484},
-			expr: &actionExpr{
-				pos: position{line: 1885, col: 29, offset: 69512},
-				run: (*parser).callonSingleLineCommentContent1,
-				expr: &zeroOrMoreExpr{
-					pos: position{line: 1885, col: 29, offset: 69512},
-					expr: &charClassMatcher{
-						pos:        position{line: 1885, col: 29, offset: 69512},
-						val:        "[^\\r\\n]",
-						chars:      []rune{'\r', '\n'},
-						ignoreCase: false,
-						inverted:   true,
-					},
-				},
-			},
-		},
-		{
-			name: "InlineMacros",
-			pos:  position{line: 1893, col: 1, offset: 69801},
-			expr: &choiceExpr{
-				pos: position{line: 1893, col: 17, offset: 69817},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 1893, col: 17, offset: 69817},
-						name: "InlineIcon",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1894, col: 19, offset: 69846},
-						name: "InlineImage",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1895, col: 19, offset: 69877},
-						name: "Link",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1896, col: 19, offset: 69901},
-						name: "InlinePassthrough",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1897, col: 19, offset: 69938},
-						name: "InlineFootnote",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1898, col: 19, offset: 69972},
-						name: "CrossReference",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1899, col: 19, offset: 70006},
-						name: "InlineUserMacro",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1900, col: 19, offset: 70041},
-						name: "InlineElementID",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1901, col: 19, offset: 70075},
-						name: "ConcealedIndexTerm",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 1902, col: 19, offset: 70112},
-						name: "IndexTerm",
+			name: "VerseBlockRawContent",
+			pos:  position{line: 2044, col: 1, offset: 76908},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 2044, col: 25, offset: 76932},
+				expr: &actionExpr{
+					pos: position{line: 2044, col: 26, offset: 76933},
+					run: (*parser).callonVerseBlockRawContent2,
+					expr: &seqExpr{
+						pos: position{line: 2044, col: 26, offset: 76933},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 2044, col: 26, offset: 76933},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2044, col: 27, offset: 76934},
+									name: "QuoteBlockEndDelimiter",
+								},
+							},
+							&labeledExpr{
+								pos:   position{line: 2044, col: 50, offset: 76957},
+								label: "line",
+								expr: &ruleRefExpr{
+									pos:  position{line: 2044, col: 56, offset: 76963},
+									name: "RawLine",
+								},
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ElementPlaceHolder",
-			pos:  position{line: 1904, col: 1, offset: 70123},
+			name: "PassthroughBlock",
+			pos:  position{line: 2051, col: 1, offset: 77201},
 			expr: &actionExpr{
-				pos: position{line: 1904, col: 23, offset: 70145},
-				run: (*parser).callonElementPlaceHolder1,
+				pos: position{line: 2051, col: 21, offset: 77221},
+				run: (*parser).callonPassthroughBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1904, col: 23, offset: 70145},
+					pos: position{line: 2051, col: 21, offset: 77221},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 1904, col: 23, offset: 70145},
-							val:        "�",
-							ignoreCase: false,
-							want:       "\"�\"",
-						},
 						&labeledExpr{
-							pos:   position{line: 1904, col: 32, offset: 70154},
-							label: "ref",
-							expr: &actionExpr{
-								pos: position{line: 1904, col: 37, offset: 70159},
-								run: (*parser).callonElementPlaceHolder5,
-								expr: &oneOrMoreExpr{
-									pos: position{line: 1904, col: 37, offset: 70159},
-									expr: &charClassMatcher{
-										pos:        position{line: 1904, col: 37, offset: 70159},
-										val:        "[0-9]",
-										ranges:     []rune{'0', '9'},
-										ignoreCase: false,
-										inverted:   false,
-									},
+							pos:   position{line: 2051, col: 21, offset: 77221},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2051, col: 32, offset: 77232},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2051, col: 33, offset: 77233},
+									name: "Attributes",
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 1904, col: 76, offset: 70198},
-							val:        "�",
-							ignoreCase: false,
-							want:       "\"�\"",
+						&ruleRefExpr{
+							pos:  position{line: 2051, col: 46, offset: 77246},
+							name: "PassthroughBlockStartDelimiter",
+						},
+						&labeledExpr{
+							pos:   position{line: 2051, col: 77, offset: 77277},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2051, col: 86, offset: 77286},
+								name: "PassthroughBlockRawContent",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2051, col: 114, offset: 77314},
+							name: "PassthroughBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "InlinePassthroughSubs",
-			pos:  position{line: 1909, col: 1, offset: 70350},
+			name: "PassthroughBlockDelimiter",
+			pos:  position{line: 2055, col: 1, offset: 77432},
 			expr: &seqExpr{
-				pos: position{line: 1910, col: 5, offset: 70380},
+				pos: position{line: 2055, col: 30, offset: 77461},
 				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 2055, col: 30, offset: 77461},
+						val:        "++++",
+						ignoreCase: false,
+						want:       "\"++++\"",
+					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1910, col: 5, offset: 70380},
-						expr: &choiceExpr{
-							pos: position{line: 1910, col: 6, offset: 70381},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 1910, col: 6, offset: 70381},
-									name: "InlinePassthrough",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1911, col: 11, offset: 70410},
-									name: "InlineWord",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1912, col: 11, offset: 70471},
-									name: "ElementPlaceHolder",
-								},
-								&oneOrMoreExpr{
-									pos: position{line: 1913, col: 11, offset: 70500},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1913, col: 11, offset: 70500},
-										name: "Space",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1914, col: 11, offset: 70518},
-									name: "AnyChar",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1915, col: 11, offset: 70536},
-									name: "Newline",
-								},
-							},
+						pos: position{line: 2055, col: 37, offset: 77468},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2055, col: 37, offset: 77468},
+							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1915, col: 21, offset: 70546},
-						name: "EOF",
+						pos:  position{line: 2055, col: 44, offset: 77475},
+						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "SpecialCharacterSubs",
-			pos:  position{line: 1918, col: 1, offset: 70667},
+			name: "PassthroughBlockStartDelimiter",
+			pos:  position{line: 2057, col: 1, offset: 77480},
 			expr: &seqExpr{
-				pos: position{line: 1919, col: 5, offset: 70696},
+				pos: position{line: 2057, col: 35, offset: 77514},
 				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 2057, col: 35, offset: 77514},
+						val:        "++++",
+						ignoreCase: false,
+						want:       "\"++++\"",
+					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1919, col: 5, offset: 70696},
-						expr: &choiceExpr{
-							pos: position{line: 1919, col: 6, offset: 70697},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 1919, col: 6, offset: 70697},
-									name: "InlineWord",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1920, col: 11, offset: 70758},
-									name: "SpecialCharacter",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1921, col: 11, offset: 70785},
-									name: "ElementPlaceHolder",
-								},
-								&oneOrMoreExpr{
-									pos: position{line: 1922, col: 11, offset: 70814},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1922, col: 11, offset: 70814},
-										name: "Space",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1923, col: 11, offset: 70831},
-									name: "AnyChar",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1924, col: 11, offset: 70849},
-									name: "Newline",
+						pos: position{line: 2057, col: 42, offset: 77521},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2057, col: 42, offset: 77521},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2057, col: 49, offset: 77528},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "PassthroughBlockEndDelimiter",
+			pos:  position{line: 2059, col: 1, offset: 77533},
+			expr: &choiceExpr{
+				pos: position{line: 2059, col: 33, offset: 77565},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 2059, col: 34, offset: 77566},
+						exprs: []interface{}{
+							&litMatcher{
+								pos:        position{line: 2059, col: 34, offset: 77566},
+								val:        "++++",
+								ignoreCase: false,
+								want:       "\"++++\"",
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 2059, col: 41, offset: 77573},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2059, col: 41, offset: 77573},
+									name: "Space",
 								},
 							},
+							&ruleRefExpr{
+								pos:  position{line: 2059, col: 48, offset: 77580},
+								name: "EOL",
+							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1924, col: 21, offset: 70859},
+						pos:  position{line: 2059, col: 55, offset: 77587},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "QuotedTextSubs",
-			pos:  position{line: 1927, col: 1, offset: 70913},
-			expr: &seqExpr{
-				pos: position{line: 1928, col: 5, offset: 70936},
-				exprs: []interface{}{
-					&zeroOrMoreExpr{
-						pos: position{line: 1928, col: 5, offset: 70936},
-						expr: &choiceExpr{
-							pos: position{line: 1928, col: 6, offset: 70937},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 1928, col: 6, offset: 70937},
-									name: "InlineWord",
-								},
-								&oneOrMoreExpr{
-									pos: position{line: 1929, col: 11, offset: 70998},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1929, col: 11, offset: 70998},
-										name: "Space",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1930, col: 11, offset: 71016},
-									name: "QuotedText",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1931, col: 11, offset: 71038},
-									name: "QuotedString",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1932, col: 11, offset: 71061},
-									name: "ElementPlaceHolder",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1933, col: 11, offset: 71090},
-									name: "AnyChar",
+			name: "PassthroughBlockRawContent",
+			pos:  position{line: 2061, col: 1, offset: 77592},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 2061, col: 31, offset: 77622},
+				expr: &actionExpr{
+					pos: position{line: 2061, col: 32, offset: 77623},
+					run: (*parser).callonPassthroughBlockRawContent2,
+					expr: &seqExpr{
+						pos: position{line: 2061, col: 32, offset: 77623},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 2061, col: 32, offset: 77623},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2061, col: 33, offset: 77624},
+									name: "PassthroughBlockEndDelimiter",
 								},
-								&ruleRefExpr{
-									pos:  position{line: 1934, col: 11, offset: 71108},
-									name: "Newline",
+							},
+							&labeledExpr{
+								pos:   position{line: 2061, col: 62, offset: 77653},
+								label: "line",
+								expr: &ruleRefExpr{
+									pos:  position{line: 2061, col: 68, offset: 77659},
+									name: "RawLine",
 								},
 							},
 						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 1934, col: 21, offset: 71118},
-						name: "EOF",
-					},
 				},
 			},
 		},
 		{
-			name: "AttributeSubs",
-			pos:  position{line: 1937, col: 1, offset: 71176},
+			name: "CommentBlockDelimiter",
+			pos:  position{line: 2068, col: 1, offset: 77893},
 			expr: &seqExpr{
-				pos: position{line: 1938, col: 5, offset: 71198},
+				pos: position{line: 2068, col: 26, offset: 77918},
 				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 2068, col: 26, offset: 77918},
+						val:        "////",
+						ignoreCase: false,
+						want:       "\"////\"",
+					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1938, col: 5, offset: 71198},
-						expr: &choiceExpr{
-							pos: position{line: 1938, col: 6, offset: 71199},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 1938, col: 6, offset: 71199},
-									name: "InlineWord",
-								},
-								&oneOrMoreExpr{
-									pos: position{line: 1939, col: 11, offset: 71260},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1939, col: 11, offset: 71260},
-										name: "Space",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1940, col: 11, offset: 71278},
-									name: "AttributeSubstitution",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1941, col: 11, offset: 71310},
-									name: "ElementPlaceHolder",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1942, col: 11, offset: 71339},
-									name: "AnyChar",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1943, col: 11, offset: 71357},
-									name: "Newline",
-								},
-							},
+						pos: position{line: 2068, col: 33, offset: 77925},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2068, col: 33, offset: 77925},
+							name: "Space",
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1943, col: 21, offset: 71367},
-						name: "EOF",
+						pos:  position{line: 2068, col: 40, offset: 77932},
+						name: "EOL",
 					},
 				},
 			},
 		},
 		{
-			name: "InlineMacroSubs",
-			pos:  position{line: 1946, col: 1, offset: 71421},
+			name: "CommentBlockStartDelimiter",
+			pos:  position{line: 2070, col: 1, offset: 77937},
 			expr: &seqExpr{
-				pos: position{line: 1947, col: 5, offset: 71445},
+				pos: position{line: 2070, col: 31, offset: 77967},
 				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 2070, col: 31, offset: 77967},
+						val:        "////",
+						ignoreCase: false,
+						want:       "\"////\"",
+					},
 					&zeroOrMoreExpr{
-						pos: position{line: 1947, col: 5, offset: 71445},
-						expr: &choiceExpr{
-							pos: position{line: 1947, col: 6, offset: 71446},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 1947, col: 6, offset: 71446},
-									name: "InlineWord",
-								},
-								&oneOrMoreExpr{
-									pos: position{line: 1948, col: 11, offset: 71507},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1948, col: 11, offset: 71507},
-										name: "Space",
-									},
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1949, col: 11, offset: 71525},
-									name: "InlineMacros",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1950, col: 11, offset: 71548},
-									name: "ElementPlaceHolder",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1951, col: 11, offset: 71577},
-									name: "AnyChar",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 1952, col: 11, offset: 71595},
-									name: "Newline",
+						pos: position{line: 2070, col: 38, offset: 77974},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2070, col: 38, offset: 77974},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2070, col: 45, offset: 77981},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "CommentBlockEndDelimiter",
+			pos:  position{line: 2072, col: 1, offset: 77986},
+			expr: &choiceExpr{
+				pos: position{line: 2072, col: 29, offset: 78014},
+				alternatives: []interface{}{
+					&seqExpr{
+						pos: position{line: 2072, col: 30, offset: 78015},
+						exprs: []interface{}{
+							&litMatcher{
+								pos:        position{line: 2072, col: 30, offset: 78015},
+								val:        "////",
+								ignoreCase: false,
+								want:       "\"////\"",
+							},
+							&zeroOrMoreExpr{
+								pos: position{line: 2072, col: 37, offset: 78022},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2072, col: 37, offset: 78022},
+									name: "Space",
 								},
 							},
+							&ruleRefExpr{
+								pos:  position{line: 2072, col: 44, offset: 78029},
+								name: "EOL",
+							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1952, col: 21, offset: 71605},
+						pos:  position{line: 2072, col: 51, offset: 78036},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "MarkdownQuoteMacroSubs",
-			pos:  position{line: 1955, col: 1, offset: 71685},
+			name: "CommentBlock",
+			pos:  position{line: 2074, col: 1, offset: 78041},
 			expr: &actionExpr{
-				pos: position{line: 1955, col: 27, offset: 71711},
-				run: (*parser).callonMarkdownQuoteMacroSubs1,
+				pos: position{line: 2074, col: 17, offset: 78057},
+				run: (*parser).callonCommentBlock1,
 				expr: &seqExpr{
-					pos: position{line: 1955, col: 27, offset: 71711},
+					pos: position{line: 2074, col: 17, offset: 78057},
 					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 2074, col: 17, offset: 78057},
+							name: "CommentBlockStartDelimiter",
+						},
 						&labeledExpr{
-							pos:   position{line: 1955, col: 27, offset: 71711},
-							label: "lines",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 1955, col: 33, offset: 71717},
-								expr: &ruleRefExpr{
-									pos:  position{line: 1955, col: 34, offset: 71718},
-									name: "MarkdownQuoteLine",
-								},
+							pos:   position{line: 2074, col: 44, offset: 78084},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2074, col: 53, offset: 78093},
+								name: "CommentBlockRawContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1955, col: 54, offset: 71738},
-							name: "EOF",
+							pos:  position{line: 2074, col: 78, offset: 78118},
+							name: "CommentBlockEndDelimiter",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "MarkdownQuoteLine",
-			pos:  position{line: 1959, col: 1, offset: 71805},
+			name: "CommentBlockRawContent",
+			pos:  position{line: 2078, col: 1, offset: 78211},
+			expr: &zeroOrMoreExpr{
+				pos: position{line: 2078, col: 27, offset: 78237},
+				expr: &actionExpr{
+					pos: position{line: 2078, col: 28, offset: 78238},
+					run: (*parser).callonCommentBlockRawContent2,
+					expr: &seqExpr{
+						pos: position{line: 2078, col: 28, offset: 78238},
+						exprs: []interface{}{
+							&notExpr{
+								pos: position{line: 2078, col: 28, offset: 78238},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2078, col: 29, offset: 78239},
+									name: "CommentBlockEndDelimiter",
+								},
+							},
+							&labeledExpr{
+								pos:   position{line: 2078, col: 54, offset: 78264},
+								label: "line",
+								expr: &ruleRefExpr{
+									pos:  position{line: 2078, col: 60, offset: 78270},
+									name: "RawLine",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "SingleLineComment",
+			pos:  position{line: 2082, col: 1, offset: 78308},
 			expr: &actionExpr{
-				pos: position{line: 1960, col: 5, offset: 71831},
-				run: (*parser).callonMarkdownQuoteLine1,
+				pos: position{line: 2082, col: 22, offset: 78329},
+				run: (*parser).callonSingleLineComment1,
 				expr: &seqExpr{
-					pos: position{line: 1960, col: 5, offset: 71831},
+					pos: position{line: 2082, col: 22, offset: 78329},
 					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 2082, col: 22, offset: 78329},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2082, col: 23, offset: 78330},
+								name: "CommentBlockDelimiter",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 2082, col: 45, offset: 78352},
+							val:        "//",
+							ignoreCase: false,
+							want:       "\"//\"",
+						},
 						&labeledExpr{
-							pos:   position{line: 1960, col: 5, offset: 71831},
-							label: "elements",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 1960, col: 14, offset: 71840},
-								expr: &choiceExpr{
-									pos: position{line: 1960, col: 15, offset: 71841},
-									alternatives: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 1960, col: 15, offset: 71841},
-											name: "InlineWord",
-										},
-										&oneOrMoreExpr{
-											pos: position{line: 1961, col: 11, offset: 71902},
-											expr: &ruleRefExpr{
-												pos:  position{line: 1961, col: 11, offset: 71902},
-												name: "Space",
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1962, col: 11, offset: 71920},
-											name: "InlineMacros",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1963, col: 11, offset: 71943},
-											name: "ElementPlaceHolder",
-										},
-										&ruleRefExpr{
-											pos:  position{line: 1964, col: 11, offset: 71972},
-											name: "AnyChar",
-										},
-									},
-								},
+							pos:   position{line: 2082, col: 50, offset: 78357},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2082, col: 59, offset: 78366},
+								name: "SingleLineCommentContent",
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 1964, col: 21, offset: 71982},
+							pos:  position{line: 2082, col: 85, offset: 78392},
 							name: "EOL",
 						},
 					},
@@ -14356,711 +15654,809 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "MarkdownQuoteAttribution",
-			pos:  position{line: 1968, col: 1, offset: 72053},
+			name: "SingleLineCommentContent",
+			pos:  position{line: 2086, col: 1, offset: 78457},
 			expr: &actionExpr{
-				pos: position{line: 1968, col: 29, offset: 72081},
-				run: (*parser).callonMarkdownQuoteAttribution1,
+				pos: position{line: 2086, col: 29, offset: 78485},
+				run: (*parser).callonSingleLineCommentContent1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 2086, col: 29, offset: 78485},
+					expr: &charClassMatcher{
+						pos:        position{line: 2086, col: 29, offset: 78485},
+						val:        "[^\\r\\n]",
+						chars:      []rune{'\r', '\n'},
+						ignoreCase: false,
+						inverted:   true,
+					},
+				},
+			},
+		},
+		{
+			name: "InlineMacros",
+			pos:  position{line: 2094, col: 1, offset: 78774},
+			expr: &choiceExpr{
+				pos: position{line: 2094, col: 17, offset: 78790},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 2094, col: 17, offset: 78790},
+						name: "InlineIcon",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2095, col: 19, offset: 78819},
+						name: "InlineImage",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2096, col: 19, offset: 78850},
+						name: "Link",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2097, col: 19, offset: 78874},
+						name: "InlineEmail",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2098, col: 19, offset: 78904},
+						name: "InlinePassthrough",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2099, col: 19, offset: 78941},
+						name: "InlineFootnote",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2100, col: 19, offset: 78975},
+						name: "CrossReference",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2101, col: 19, offset: 79009},
+						name: "InlineUIMacro",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2102, col: 19, offset: 79075},
+						name: "InlineUserMacro",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2103, col: 19, offset: 79110},
+						name: "BibliographyAnchor",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2104, col: 19, offset: 79181},
+						name: "InlineElementID",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2105, col: 19, offset: 79215},
+						name: "ConcealedIndexTerm",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2106, col: 19, offset: 79252},
+						name: "IndexTerm",
+					},
+				},
+			},
+		},
+		{
+			name: "ElementPlaceHolder",
+			pos:  position{line: 2108, col: 1, offset: 79263},
+			expr: &actionExpr{
+				pos: position{line: 2108, col: 23, offset: 79285},
+				run: (*parser).callonElementPlaceHolder1,
 				expr: &seqExpr{
-					pos: position{line: 1968, col: 29, offset: 72081},
+					pos: position{line: 2108, col: 23, offset: 79285},
 					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 1968, col: 29, offset: 72081},
-							val:        "-- ",
+							pos:        position{line: 2108, col: 23, offset: 79285},
+							val:        "�",
 							ignoreCase: false,
-							want:       "\"-- \"",
+							want:       "\"�\"",
 						},
 						&labeledExpr{
-							pos:   position{line: 1968, col: 35, offset: 72087},
-							label: "author",
+							pos:   position{line: 2108, col: 32, offset: 79294},
+							label: "ref",
 							expr: &actionExpr{
-								pos: position{line: 1968, col: 43, offset: 72095},
-								run: (*parser).callonMarkdownQuoteAttribution5,
+								pos: position{line: 2108, col: 37, offset: 79299},
+								run: (*parser).callonElementPlaceHolder5,
 								expr: &oneOrMoreExpr{
-									pos: position{line: 1968, col: 44, offset: 72096},
+									pos: position{line: 2108, col: 37, offset: 79299},
 									expr: &charClassMatcher{
-										pos:        position{line: 1968, col: 44, offset: 72096},
-										val:        "[^\\r\\n]",
-										chars:      []rune{'\r', '\n'},
+										pos:        position{line: 2108, col: 37, offset: 79299},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
 										ignoreCase: false,
-										inverted:   true,
+										inverted:   false,
 									},
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 1970, col: 8, offset: 72146},
-							name: "EOL",
+						&litMatcher{
+							pos:        position{line: 2108, col: 76, offset: 79338},
+							val:        "�",
+							ignoreCase: false,
+							want:       "\"�\"",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ReplacementSubs",
-			pos:  position{line: 1975, col: 1, offset: 72233},
+			name: "InlinePassthroughSubs",
+			pos:  position{line: 2113, col: 1, offset: 79490},
 			expr: &seqExpr{
-				pos: position{line: 1976, col: 5, offset: 72257},
+				pos: position{line: 2114, col: 5, offset: 79520},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 1976, col: 5, offset: 72257},
+						pos: position{line: 2114, col: 5, offset: 79520},
 						expr: &choiceExpr{
-							pos: position{line: 1976, col: 6, offset: 72258},
+							pos: position{line: 2114, col: 6, offset: 79521},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1976, col: 6, offset: 72258},
-									name: "InlineWord",
+									pos:  position{line: 2114, col: 6, offset: 79521},
+									name: "InlinePassthrough",
 								},
-								&oneOrMoreExpr{
-									pos: position{line: 1977, col: 11, offset: 72319},
-									expr: &ruleRefExpr{
-										pos:  position{line: 1977, col: 11, offset: 72319},
-										name: "Space",
-									},
+								&ruleRefExpr{
+									pos:  position{line: 2115, col: 11, offset: 79550},
+									name: "InlineWord",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1978, col: 11, offset: 72337},
-									name: "Symbol",
+									pos:  position{line: 2116, col: 11, offset: 79611},
+									name: "AlphanumsWithPlus",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1979, col: 11, offset: 72355},
+									pos:  position{line: 2117, col: 11, offset: 79639},
 									name: "ElementPlaceHolder",
 								},
+								&oneOrMoreExpr{
+									pos: position{line: 2118, col: 11, offset: 79668},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2118, col: 11, offset: 79668},
+										name: "Space",
+									},
+								},
 								&ruleRefExpr{
-									pos:  position{line: 1980, col: 11, offset: 72384},
+									pos:  position{line: 2119, col: 11, offset: 79686},
 									name: "AnyChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1981, col: 11, offset: 72402},
+									pos:  position{line: 2120, col: 11, offset: 79704},
 									name: "Newline",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1981, col: 21, offset: 72412},
+						pos:  position{line: 2120, col: 21, offset: 79714},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "PostReplacementSubs",
-			pos:  position{line: 1985, col: 1, offset: 72560},
+			name: "SpecialCharacterSubs",
+			pos:  position{line: 2123, col: 1, offset: 79835},
 			expr: &seqExpr{
-				pos: position{line: 1985, col: 24, offset: 72583},
+				pos: position{line: 2124, col: 5, offset: 79864},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 1985, col: 24, offset: 72583},
+						pos: position{line: 2124, col: 5, offset: 79864},
 						expr: &choiceExpr{
-							pos: position{line: 1986, col: 5, offset: 72589},
+							pos: position{line: 2124, col: 6, offset: 79865},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1986, col: 5, offset: 72589},
+									pos:  position{line: 2124, col: 6, offset: 79865},
 									name: "InlineWord",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1987, col: 7, offset: 72646},
-									name: "ElementPlaceHolder",
+									pos:  position{line: 2125, col: 11, offset: 79926},
+									name: "SpecialCharacter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1988, col: 7, offset: 72671},
-									name: "LineBreak",
+									pos:  position{line: 2126, col: 11, offset: 79953},
+									name: "ElementPlaceHolder",
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 1989, col: 7, offset: 72714},
+									pos: position{line: 2127, col: 11, offset: 79982},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1989, col: 7, offset: 72714},
+										pos:  position{line: 2127, col: 11, offset: 79982},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1990, col: 7, offset: 72728},
+									pos:  position{line: 2128, col: 11, offset: 79999},
 									name: "AnyChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1991, col: 7, offset: 72742},
+									pos:  position{line: 2129, col: 11, offset: 80017},
 									name: "Newline",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 1991, col: 17, offset: 72752},
+						pos:  position{line: 2129, col: 21, offset: 80027},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "CalloutSubs",
-			pos:  position{line: 1994, col: 1, offset: 72809},
+			name: "QuotedTextSubs",
+			pos:  position{line: 2132, col: 1, offset: 80081},
 			expr: &seqExpr{
-				pos: position{line: 1995, col: 5, offset: 72829},
+				pos: position{line: 2133, col: 5, offset: 80104},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 1995, col: 5, offset: 72829},
+						pos: position{line: 2133, col: 5, offset: 80104},
 						expr: &choiceExpr{
-							pos: position{line: 1995, col: 6, offset: 72830},
+							pos: position{line: 2133, col: 6, offset: 80105},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 1995, col: 6, offset: 72830},
+									pos:  position{line: 2133, col: 6, offset: 80105},
 									name: "InlineWord",
 								},
-								&ruleRefExpr{
-									pos:  position{line: 1996, col: 11, offset: 72891},
-									name: "ElementPlaceHolder",
-								},
 								&oneOrMoreExpr{
-									pos: position{line: 1997, col: 11, offset: 72920},
+									pos: position{line: 2134, col: 11, offset: 80166},
 									expr: &ruleRefExpr{
-										pos:  position{line: 1997, col: 11, offset: 72920},
+										pos:  position{line: 2134, col: 11, offset: 80166},
 										name: "Space",
 									},
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1998, col: 11, offset: 72937},
-									name: "Callout",
+									pos:  position{line: 2135, col: 11, offset: 80184},
+									name: "QuotedText",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2136, col: 11, offset: 80206},
+									name: "QuotedString",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2137, col: 11, offset: 80229},
+									name: "ElementPlaceHolder",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 1999, col: 11, offset: 72955},
+									pos:  position{line: 2138, col: 11, offset: 80258},
 									name: "AnyChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2000, col: 11, offset: 72973},
+									pos:  position{line: 2139, col: 11, offset: 80276},
 									name: "Newline",
 								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2000, col: 21, offset: 72983},
+						pos:  position{line: 2139, col: 21, offset: 80286},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "NoneSubs",
-			pos:  position{line: 2003, col: 1, offset: 73035},
+			name: "AttributeSubs",
+			pos:  position{line: 2142, col: 1, offset: 80344},
 			expr: &seqExpr{
-				pos: position{line: 2003, col: 13, offset: 73047},
+				pos: position{line: 2143, col: 5, offset: 80366},
 				exprs: []interface{}{
 					&zeroOrMoreExpr{
-						pos: position{line: 2003, col: 13, offset: 73047},
+						pos: position{line: 2143, col: 5, offset: 80366},
 						expr: &choiceExpr{
-							pos: position{line: 2004, col: 5, offset: 73053},
+							pos: position{line: 2143, col: 6, offset: 80367},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 2004, col: 5, offset: 73053},
-									name: "ElementPlaceHolder",
+									pos:  position{line: 2143, col: 6, offset: 80367},
+									name: "InlineWord",
 								},
-								&actionExpr{
-									pos: position{line: 2005, col: 8, offset: 73080},
-									run: (*parser).callonNoneSubs5,
-									expr: &seqExpr{
-										pos: position{line: 2005, col: 8, offset: 73080},
-										exprs: []interface{}{
-											&notExpr{
-												pos: position{line: 2005, col: 8, offset: 73080},
-												expr: &ruleRefExpr{
-													pos:  position{line: 2005, col: 9, offset: 73081},
-													name: "EOF",
-												},
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 2005, col: 13, offset: 73085},
-												expr: &charClassMatcher{
-													pos:        position{line: 2005, col: 13, offset: 73085},
-													val:        "[^\\r\\n]",
-													chars:      []rune{'\r', '\n'},
-													ignoreCase: false,
-													inverted:   true,
-												},
-											},
-											&ruleRefExpr{
-												pos:  position{line: 2005, col: 22, offset: 73094},
-												name: "EOL",
-											},
-										},
+								&oneOrMoreExpr{
+									pos: position{line: 2144, col: 11, offset: 80428},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2144, col: 11, offset: 80428},
+										name: "Space",
 									},
 								},
+								&ruleRefExpr{
+									pos:  position{line: 2145, col: 11, offset: 80446},
+									name: "AttributeSubstitution",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2146, col: 11, offset: 80478},
+									name: "ElementPlaceHolder",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2147, col: 11, offset: 80507},
+									name: "AnyChar",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2148, col: 11, offset: 80525},
+									name: "Newline",
+								},
 							},
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2007, col: 10, offset: 73199},
+						pos:  position{line: 2148, col: 21, offset: 80535},
 						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "Table",
-			pos:  position{line: 2012, col: 1, offset: 73392},
-			expr: &actionExpr{
-				pos: position{line: 2012, col: 10, offset: 73401},
-				run: (*parser).callonTable1,
-				expr: &seqExpr{
-					pos: position{line: 2012, col: 10, offset: 73401},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 2012, col: 10, offset: 73401},
-							label: "attrs",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 2012, col: 16, offset: 73407},
-								expr: &zeroOrMoreExpr{
-									pos: position{line: 2012, col: 17, offset: 73408},
+			name: "InlineMacroSubs",
+			pos:  position{line: 2151, col: 1, offset: 80589},
+			expr: &seqExpr{
+				pos: position{line: 2152, col: 5, offset: 80613},
+				exprs: []interface{}{
+					&zeroOrMoreExpr{
+						pos: position{line: 2152, col: 5, offset: 80613},
+						expr: &choiceExpr{
+							pos: position{line: 2152, col: 6, offset: 80614},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2152, col: 6, offset: 80614},
+									name: "InlineWord",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2153, col: 11, offset: 80675},
+									name: "AlphanumsWithPlus",
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 2154, col: 11, offset: 80703},
 									expr: &ruleRefExpr{
-										pos:  position{line: 2012, col: 17, offset: 73408},
-										name: "BlockAttrs",
+										pos:  position{line: 2154, col: 11, offset: 80703},
+										name: "Space",
 									},
 								},
-							},
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2012, col: 31, offset: 73422},
-							name: "TableDelimiter",
-						},
-						&labeledExpr{
-							pos:   position{line: 2013, col: 5, offset: 73441},
-							label: "header",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 2013, col: 12, offset: 73448},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2013, col: 13, offset: 73449},
-									name: "TableLineHeader",
+								&ruleRefExpr{
+									pos:  position{line: 2155, col: 11, offset: 80721},
+									name: "InlineMacros",
 								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 2014, col: 5, offset: 73471},
-							label: "lines",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 2014, col: 11, offset: 73477},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2014, col: 12, offset: 73478},
-									name: "TableLine",
+								&ruleRefExpr{
+									pos:  position{line: 2156, col: 11, offset: 80744},
+									name: "ElementPlaceHolder",
 								},
-							},
-						},
-						&choiceExpr{
-							pos: position{line: 2015, col: 6, offset: 73495},
-							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 2015, col: 6, offset: 73495},
-									name: "TableDelimiter",
+									pos:  position{line: 2157, col: 11, offset: 80773},
+									name: "AnyChar",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2015, col: 23, offset: 73512},
-									name: "EOF",
+									pos:  position{line: 2158, col: 11, offset: 80791},
+									name: "Newline",
 								},
 							},
 						},
 					},
-				},
-			},
-		},
-		{
-			name: "TableCellSeparator",
-			pos:  position{line: 2019, col: 1, offset: 73622},
-			expr: &seqExpr{
-				pos: position{line: 2019, col: 23, offset: 73644},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 2019, col: 23, offset: 73644},
-						val:        "|",
-						ignoreCase: false,
-						want:       "\"|\"",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 2019, col: 27, offset: 73648},
-						expr: &ruleRefExpr{
-							pos:  position{line: 2019, col: 27, offset: 73648},
-							name: "Space",
-						},
-					},
-				},
-			},
-		},
-		{
-			name: "TableDelimiter",
-			pos:  position{line: 2021, col: 1, offset: 73656},
-			expr: &seqExpr{
-				pos: position{line: 2021, col: 19, offset: 73674},
-				exprs: []interface{}{
-					&litMatcher{
-						pos:        position{line: 2021, col: 19, offset: 73674},
-						val:        "|===",
-						ignoreCase: false,
-						want:       "\"|===\"",
-					},
-					&zeroOrMoreExpr{
-						pos: position{line: 2021, col: 26, offset: 73681},
-						expr: &ruleRefExpr{
-							pos:  position{line: 2021, col: 26, offset: 73681},
-							name: "Space",
-						},
-					},
 					&ruleRefExpr{
-						pos:  position{line: 2021, col: 33, offset: 73688},
-						name: "EOL",
+						pos:  position{line: 2158, col: 21, offset: 80801},
+						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "TableLineHeader",
-			pos:  position{line: 2024, col: 1, offset: 73756},
+			name: "MarkdownQuoteMacroSubs",
+			pos:  position{line: 2161, col: 1, offset: 80881},
 			expr: &actionExpr{
-				pos: position{line: 2024, col: 20, offset: 73775},
-				run: (*parser).callonTableLineHeader1,
+				pos: position{line: 2161, col: 27, offset: 80907},
+				run: (*parser).callonMarkdownQuoteMacroSubs1,
 				expr: &seqExpr{
-					pos: position{line: 2024, col: 20, offset: 73775},
+					pos: position{line: 2161, col: 27, offset: 80907},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 2024, col: 20, offset: 73775},
-							expr: &ruleRefExpr{
-								pos:  position{line: 2024, col: 21, offset: 73776},
-								name: "TableDelimiter",
-							},
-						},
 						&labeledExpr{
-							pos:   position{line: 2024, col: 36, offset: 73791},
-							label: "cells",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 2024, col: 42, offset: 73797},
+							pos:   position{line: 2161, col: 27, offset: 80907},
+							label: "lines",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2161, col: 33, offset: 80913},
 								expr: &ruleRefExpr{
-									pos:  position{line: 2024, col: 43, offset: 73798},
-									name: "TableCell",
+									pos:  position{line: 2161, col: 34, offset: 80914},
+									name: "MarkdownQuoteLine",
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2024, col: 55, offset: 73810},
-							name: "EOL",
-						},
-						&ruleRefExpr{
-							pos:  position{line: 2024, col: 59, offset: 73814},
-							name: "BlankLine",
+							pos:  position{line: 2161, col: 54, offset: 80934},
+							name: "EOF",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "TableLine",
-			pos:  position{line: 2028, col: 1, offset: 73882},
+			name: "MarkdownQuoteLine",
+			pos:  position{line: 2165, col: 1, offset: 81001},
 			expr: &actionExpr{
-				pos: position{line: 2028, col: 14, offset: 73895},
-				run: (*parser).callonTableLine1,
+				pos: position{line: 2166, col: 5, offset: 81027},
+				run: (*parser).callonMarkdownQuoteLine1,
 				expr: &seqExpr{
-					pos: position{line: 2028, col: 14, offset: 73895},
+					pos: position{line: 2166, col: 5, offset: 81027},
 					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 2028, col: 14, offset: 73895},
-							expr: &ruleRefExpr{
-								pos:  position{line: 2028, col: 15, offset: 73896},
-								name: "TableDelimiter",
-							},
-						},
 						&labeledExpr{
-							pos:   position{line: 2028, col: 30, offset: 73911},
-							label: "cells",
+							pos:   position{line: 2166, col: 5, offset: 81027},
+							label: "elements",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 2028, col: 36, offset: 73917},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2028, col: 37, offset: 73918},
-									name: "TableCell",
+								pos: position{line: 2166, col: 14, offset: 81036},
+								expr: &choiceExpr{
+									pos: position{line: 2166, col: 15, offset: 81037},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 2166, col: 15, offset: 81037},
+											name: "InlineWord",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2167, col: 11, offset: 81098},
+											name: "AlphanumsWithPlus",
+										},
+										&oneOrMoreExpr{
+											pos: position{line: 2168, col: 11, offset: 81126},
+											expr: &ruleRefExpr{
+												pos:  position{line: 2168, col: 11, offset: 81126},
+												name: "Space",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2169, col: 11, offset: 81144},
+											name: "InlineMacros",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2170, col: 11, offset: 81167},
+											name: "ElementPlaceHolder",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2171, col: 11, offset: 81196},
+											name: "AnyChar",
+										},
+									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2028, col: 49, offset: 73930},
+							pos:  position{line: 2171, col: 21, offset: 81206},
 							name: "EOL",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 2028, col: 53, offset: 73934},
-							expr: &ruleRefExpr{
-								pos:  position{line: 2028, col: 53, offset: 73934},
-								name: "BlankLine",
-							},
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "TableCell",
-			pos:  position{line: 2032, col: 1, offset: 74003},
+			name: "MarkdownQuoteAttribution",
+			pos:  position{line: 2175, col: 1, offset: 81277},
 			expr: &actionExpr{
-				pos: position{line: 2032, col: 14, offset: 74016},
-				run: (*parser).callonTableCell1,
+				pos: position{line: 2175, col: 29, offset: 81305},
+				run: (*parser).callonMarkdownQuoteAttribution1,
 				expr: &seqExpr{
-					pos: position{line: 2032, col: 14, offset: 74016},
+					pos: position{line: 2175, col: 29, offset: 81305},
 					exprs: []interface{}{
-						&ruleRefExpr{
-							pos:  position{line: 2032, col: 14, offset: 74016},
-							name: "TableCellSeparator",
+						&litMatcher{
+							pos:        position{line: 2175, col: 29, offset: 81305},
+							val:        "-- ",
+							ignoreCase: false,
+							want:       "\"-- \"",
 						},
 						&labeledExpr{
-							pos:   position{line: 2032, col: 33, offset: 74035},
-							label: "elements",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 2032, col: 42, offset: 74044},
-								expr: &seqExpr{
-									pos: position{line: 2032, col: 43, offset: 74045},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 2032, col: 43, offset: 74045},
-											expr: &ruleRefExpr{
-												pos:  position{line: 2032, col: 44, offset: 74046},
-												name: "TableCellSeparator",
-											},
-										},
-										&notExpr{
-											pos: position{line: 2032, col: 63, offset: 74065},
-											expr: &ruleRefExpr{
-												pos:  position{line: 2032, col: 64, offset: 74066},
-												name: "EOL",
-											},
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 2032, col: 68, offset: 74070},
-											expr: &ruleRefExpr{
-												pos:  position{line: 2032, col: 68, offset: 74070},
-												name: "Space",
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 2032, col: 75, offset: 74077},
-											name: "InlineElement",
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 2032, col: 89, offset: 74091},
-											expr: &ruleRefExpr{
-												pos:  position{line: 2032, col: 89, offset: 74091},
-												name: "Space",
-											},
-										},
+							pos:   position{line: 2175, col: 35, offset: 81311},
+							label: "author",
+							expr: &actionExpr{
+								pos: position{line: 2175, col: 43, offset: 81319},
+								run: (*parser).callonMarkdownQuoteAttribution5,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 2175, col: 44, offset: 81320},
+									expr: &charClassMatcher{
+										pos:        position{line: 2175, col: 44, offset: 81320},
+										val:        "[^\\r\\n]",
+										chars:      []rune{'\r', '\n'},
+										ignoreCase: false,
+										inverted:   true,
 									},
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 2177, col: 8, offset: 81370},
+							name: "EOL",
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "LiteralBlock",
-			pos:  position{line: 2039, col: 1, offset: 74417},
-			expr: &choiceExpr{
-				pos: position{line: 2039, col: 17, offset: 74433},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 2039, col: 17, offset: 74433},
-						name: "ParagraphWithLiteralAttribute",
+			name: "ReplacementSubs",
+			pos:  position{line: 2182, col: 1, offset: 81457},
+			expr: &seqExpr{
+				pos: position{line: 2182, col: 20, offset: 81476},
+				exprs: []interface{}{
+					&zeroOrOneExpr{
+						pos: position{line: 2182, col: 20, offset: 81476},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2182, col: 20, offset: 81476},
+							name: "LeadingEmDash",
+						},
 					},
-					&ruleRefExpr{
-						pos:  position{line: 2039, col: 49, offset: 74465},
-						name: "ParagraphWithHeadingSpaces",
+					&zeroOrMoreExpr{
+						pos: position{line: 2183, col: 5, offset: 81495},
+						expr: &choiceExpr{
+							pos: position{line: 2183, col: 6, offset: 81496},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2183, col: 6, offset: 81496},
+									name: "InlineWord",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2184, col: 11, offset: 81557},
+									name: "ReplacementExclusion",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2185, col: 11, offset: 81618},
+									name: "Replacement",
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 2186, col: 11, offset: 81665},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2186, col: 11, offset: 81665},
+										name: "Space",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2187, col: 11, offset: 81683},
+									name: "ElementPlaceHolder",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2188, col: 11, offset: 81712},
+									name: "AnyChar",
+								},
+								&seqExpr{
+									pos: position{line: 2189, col: 11, offset: 81730},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 2189, col: 11, offset: 81730},
+											name: "Newline",
+										},
+										&zeroOrOneExpr{
+											pos: position{line: 2189, col: 19, offset: 81738},
+											expr: &ruleRefExpr{
+												pos:  position{line: 2189, col: 19, offset: 81738},
+												name: "LeadingEmDash",
+											},
+										},
+									},
+								},
+							},
+						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2039, col: 78, offset: 74494},
-						name: "ParagraphWithLiteralBlockDelimiter",
+						pos:  position{line: 2189, col: 36, offset: 81755},
+						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "LiteralBlockDelimiter",
-			pos:  position{line: 2041, col: 1, offset: 74530},
-			expr: &litMatcher{
-				pos:        position{line: 2041, col: 26, offset: 74555},
-				val:        "....",
-				ignoreCase: false,
-				want:       "\"....\"",
-			},
-		},
-		{
-			name: "ParagraphWithHeadingSpaces",
-			pos:  position{line: 2044, col: 1, offset: 74627},
-			expr: &actionExpr{
-				pos: position{line: 2044, col: 31, offset: 74657},
-				run: (*parser).callonParagraphWithHeadingSpaces1,
-				expr: &seqExpr{
-					pos: position{line: 2044, col: 31, offset: 74657},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 2044, col: 31, offset: 74657},
-							label: "attributes",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 2044, col: 42, offset: 74668},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2044, col: 43, offset: 74669},
-									name: "Attributes",
+			name: "PostReplacementSubs",
+			pos:  position{line: 2193, col: 1, offset: 81903},
+			expr: &seqExpr{
+				pos: position{line: 2193, col: 24, offset: 81926},
+				exprs: []interface{}{
+					&zeroOrMoreExpr{
+						pos: position{line: 2193, col: 24, offset: 81926},
+						expr: &choiceExpr{
+							pos: position{line: 2194, col: 5, offset: 81932},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2194, col: 5, offset: 81932},
+									name: "InlineWord",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2195, col: 7, offset: 81989},
+									name: "ElementPlaceHolder",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2196, col: 7, offset: 82014},
+									name: "LineBreak",
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 2197, col: 7, offset: 82057},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2197, col: 7, offset: 82057},
+										name: "Space",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2198, col: 7, offset: 82071},
+									name: "AnyChar",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2199, col: 7, offset: 82085},
+									name: "Newline",
 								},
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 2044, col: 56, offset: 74682},
-							label: "lines",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2044, col: 63, offset: 74689},
-								name: "ParagraphWithHeadingSpacesLines",
 							},
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 2199, col: 17, offset: 82095},
+						name: "EOF",
+					},
 				},
 			},
 		},
 		{
-			name: "ParagraphWithHeadingSpacesLines",
-			pos:  position{line: 2049, col: 1, offset: 74919},
-			expr: &actionExpr{
-				pos: position{line: 2050, col: 5, offset: 74959},
-				run: (*parser).callonParagraphWithHeadingSpacesLines1,
-				expr: &seqExpr{
-					pos: position{line: 2050, col: 5, offset: 74959},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 2050, col: 5, offset: 74959},
-							label: "firstLine",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2050, col: 16, offset: 74970},
-								name: "ParagraphWithHeadingSpacesLine",
-							},
-						},
-						&labeledExpr{
-							pos:   position{line: 2051, col: 5, offset: 75006},
-							label: "otherLines",
-							expr: &zeroOrMoreExpr{
-								pos: position{line: 2051, col: 16, offset: 75017},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2051, col: 17, offset: 75018},
-									name: "LiteralParagraphLine",
+			name: "CalloutSubs",
+			pos:  position{line: 2202, col: 1, offset: 82152},
+			expr: &seqExpr{
+				pos: position{line: 2203, col: 5, offset: 82172},
+				exprs: []interface{}{
+					&zeroOrMoreExpr{
+						pos: position{line: 2203, col: 5, offset: 82172},
+						expr: &choiceExpr{
+							pos: position{line: 2203, col: 6, offset: 82173},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2203, col: 6, offset: 82173},
+									name: "Callout",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2204, col: 11, offset: 82257},
+									name: "InlineWord",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2205, col: 11, offset: 82318},
+									name: "ElementPlaceHolder",
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 2206, col: 11, offset: 82347},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2206, col: 11, offset: 82347},
+										name: "Space",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2207, col: 11, offset: 82364},
+									name: "AnyChar",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2208, col: 11, offset: 82382},
+									name: "Newline",
 								},
 							},
 						},
 					},
+					&ruleRefExpr{
+						pos:  position{line: 2208, col: 21, offset: 82392},
+						name: "EOF",
+					},
 				},
 			},
 		},
 		{
-			name: "ParagraphWithHeadingSpacesLine",
-			pos:  position{line: 2055, col: 1, offset: 75127},
-			expr: &actionExpr{
-				pos: position{line: 2055, col: 35, offset: 75161},
-				run: (*parser).callonParagraphWithHeadingSpacesLine1,
-				expr: &seqExpr{
-					pos: position{line: 2055, col: 35, offset: 75161},
-					exprs: []interface{}{
-						&labeledExpr{
-							pos:   position{line: 2055, col: 35, offset: 75161},
-							label: "line",
-							expr: &actionExpr{
-								pos: position{line: 2055, col: 41, offset: 75167},
-								run: (*parser).callonParagraphWithHeadingSpacesLine4,
-								expr: &seqExpr{
-									pos: position{line: 2055, col: 41, offset: 75167},
-									exprs: []interface{}{
-										&oneOrMoreExpr{
-											pos: position{line: 2055, col: 41, offset: 75167},
-											expr: &ruleRefExpr{
-												pos:  position{line: 2055, col: 41, offset: 75167},
-												name: "Space",
+			name: "NoneSubs",
+			pos:  position{line: 2211, col: 1, offset: 82444},
+			expr: &seqExpr{
+				pos: position{line: 2211, col: 13, offset: 82456},
+				exprs: []interface{}{
+					&zeroOrMoreExpr{
+						pos: position{line: 2211, col: 13, offset: 82456},
+						expr: &choiceExpr{
+							pos: position{line: 2212, col: 5, offset: 82462},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2212, col: 5, offset: 82462},
+									name: "ElementPlaceHolder",
+								},
+								&actionExpr{
+									pos: position{line: 2213, col: 8, offset: 82489},
+									run: (*parser).callonNoneSubs5,
+									expr: &seqExpr{
+										pos: position{line: 2213, col: 8, offset: 82489},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 2213, col: 8, offset: 82489},
+												expr: &ruleRefExpr{
+													pos:  position{line: 2213, col: 9, offset: 82490},
+													name: "EOF",
+												},
 											},
-										},
-										&oneOrMoreExpr{
-											pos: position{line: 2055, col: 48, offset: 75174},
-											expr: &charClassMatcher{
-												pos:        position{line: 2055, col: 48, offset: 75174},
-												val:        "[^\\r\\n]",
-												chars:      []rune{'\r', '\n'},
-												ignoreCase: false,
-												inverted:   true,
+											&zeroOrMoreExpr{
+												pos: position{line: 2213, col: 13, offset: 82494},
+												expr: &charClassMatcher{
+													pos:        position{line: 2213, col: 13, offset: 82494},
+													val:        "[^\\r\\n]",
+													chars:      []rune{'\r', '\n'},
+													ignoreCase: false,
+													inverted:   true,
+												},
+											},
+											&ruleRefExpr{
+												pos:  position{line: 2213, col: 22, offset: 82503},
+												name: "EOL",
 											},
 										},
 									},
 								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 2057, col: 8, offset: 75240},
-							name: "EOL",
-						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2215, col: 10, offset: 82608},
+						name: "EOF",
 					},
 				},
 			},
 		},
 		{
-			name: "ParagraphWithLiteralBlockDelimiter",
-			pos:  position{line: 2062, col: 1, offset: 75380},
+			name: "Table",
+			pos:  position{line: 2220, col: 1, offset: 82801},
+			expr: &choiceExpr{
+				pos: position{line: 2220, col: 10, offset: 82810},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 2220, col: 10, offset: 82810},
+						name: "CSVTable",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2220, col: 21, offset: 82821},
+						name: "DSVTable",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2220, col: 32, offset: 82832},
+						name: "DataTable",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2220, col: 44, offset: 82844},
+						name: "PSVTable",
+					},
+				},
+			},
+		},
+		{
+			name: "PSVTable",
+			pos:  position{line: 2223, col: 1, offset: 82939},
 			expr: &actionExpr{
-				pos: position{line: 2062, col: 39, offset: 75418},
-				run: (*parser).callonParagraphWithLiteralBlockDelimiter1,
+				pos: position{line: 2223, col: 13, offset: 82951},
+				run: (*parser).callonPSVTable1,
 				expr: &seqExpr{
-					pos: position{line: 2062, col: 39, offset: 75418},
+					pos: position{line: 2223, col: 13, offset: 82951},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 2062, col: 39, offset: 75418},
-							label: "attributes",
+							pos:   position{line: 2223, col: 13, offset: 82951},
+							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 2062, col: 50, offset: 75429},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2062, col: 51, offset: 75430},
-									name: "Attributes",
+								pos: position{line: 2223, col: 19, offset: 82957},
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 2223, col: 20, offset: 82958},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2223, col: 20, offset: 82958},
+										name: "BlockAttrs",
+									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2063, col: 9, offset: 75451},
-							name: "LiteralBlockDelimiter",
+							pos:  position{line: 2223, col: 34, offset: 82972},
+							name: "PSVTableStartDelimiter",
 						},
-						&zeroOrMoreExpr{
-							pos: position{line: 2063, col: 31, offset: 75473},
-							expr: &ruleRefExpr{
-								pos:  position{line: 2063, col: 31, offset: 75473},
-								name: "Space",
+						&labeledExpr{
+							pos:   position{line: 2224, col: 5, offset: 82999},
+							label: "header",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2224, col: 12, offset: 83006},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2224, col: 13, offset: 83007},
+									name: "TableLineHeader",
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 2063, col: 38, offset: 75480},
-							name: "Newline",
-						},
 						&labeledExpr{
-							pos:   position{line: 2063, col: 46, offset: 75488},
+							pos:   position{line: 2225, col: 5, offset: 83029},
 							label: "lines",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2063, col: 53, offset: 75495},
-								name: "ParagraphWithLiteralBlockDelimiterLines",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2225, col: 11, offset: 83035},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2225, col: 12, offset: 83036},
+									name: "TableLine",
+								},
 							},
 						},
 						&choiceExpr{
-							pos: position{line: 2063, col: 95, offset: 75537},
+							pos: position{line: 2226, col: 6, offset: 83053},
 							alternatives: []interface{}{
-								&seqExpr{
-									pos: position{line: 2063, col: 96, offset: 75538},
-									exprs: []interface{}{
-										&ruleRefExpr{
-											pos:  position{line: 2063, col: 96, offset: 75538},
-											name: "LiteralBlockDelimiter",
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 2063, col: 118, offset: 75560},
-											expr: &ruleRefExpr{
-												pos:  position{line: 2063, col: 118, offset: 75560},
-												name: "Space",
-											},
-										},
-										&ruleRefExpr{
-											pos:  position{line: 2063, col: 125, offset: 75567},
-											name: "EOL",
-										},
-									},
+								&ruleRefExpr{
+									pos:  position{line: 2226, col: 6, offset: 83053},
+									name: "PSVTableEndDelimiter",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2063, col: 132, offset: 75574},
+									pos:  position{line: 2226, col: 29, offset: 83076},
 									name: "EOF",
 								},
 							},
@@ -15070,103 +16466,167 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "ParagraphWithLiteralBlockDelimiterLines",
-			pos:  position{line: 2068, col: 1, offset: 75733},
+			name: "CSVTable",
+			pos:  position{line: 2231, col: 1, offset: 83258},
 			expr: &actionExpr{
-				pos: position{line: 2068, col: 44, offset: 75776},
-				run: (*parser).callonParagraphWithLiteralBlockDelimiterLines1,
-				expr: &labeledExpr{
-					pos:   position{line: 2068, col: 44, offset: 75776},
-					label: "lines",
-					expr: &zeroOrMoreExpr{
-						pos: position{line: 2068, col: 50, offset: 75782},
-						expr: &ruleRefExpr{
-							pos:  position{line: 2068, col: 51, offset: 75783},
-							name: "ParagraphWithLiteralBlockDelimiterLine",
+				pos: position{line: 2231, col: 13, offset: 83270},
+				run: (*parser).callonCSVTable1,
+				expr: &seqExpr{
+					pos: position{line: 2231, col: 13, offset: 83270},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2231, col: 13, offset: 83270},
+							label: "attrs",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2231, col: 19, offset: 83276},
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 2231, col: 20, offset: 83277},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2231, col: 20, offset: 83277},
+										name: "BlockAttrs",
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2231, col: 34, offset: 83291},
+							name: "CSVTableDelimiter",
+						},
+						&labeledExpr{
+							pos:   position{line: 2232, col: 5, offset: 83314},
+							label: "lines",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2232, col: 11, offset: 83320},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2232, col: 12, offset: 83321},
+									name: "DataTableLine",
+								},
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 2233, col: 6, offset: 83342},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2233, col: 6, offset: 83342},
+									name: "CSVTableDelimiter",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2233, col: 26, offset: 83362},
+									name: "EOF",
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ParagraphWithLiteralBlockDelimiterLine",
-			pos:  position{line: 2072, col: 1, offset: 75867},
+			name: "DSVTable",
+			pos:  position{line: 2238, col: 1, offset: 83524},
 			expr: &actionExpr{
-				pos: position{line: 2073, col: 5, offset: 75922},
-				run: (*parser).callonParagraphWithLiteralBlockDelimiterLine1,
+				pos: position{line: 2238, col: 13, offset: 83536},
+				run: (*parser).callonDSVTable1,
 				expr: &seqExpr{
-					pos: position{line: 2073, col: 5, offset: 75922},
+					pos: position{line: 2238, col: 13, offset: 83536},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 2073, col: 5, offset: 75922},
-							label: "line",
-							expr: &actionExpr{
-								pos: position{line: 2073, col: 11, offset: 75928},
-								run: (*parser).callonParagraphWithLiteralBlockDelimiterLine4,
-								expr: &seqExpr{
-									pos: position{line: 2073, col: 11, offset: 75928},
-									exprs: []interface{}{
-										&notExpr{
-											pos: position{line: 2073, col: 11, offset: 75928},
-											expr: &ruleRefExpr{
-												pos:  position{line: 2073, col: 12, offset: 75929},
-												name: "LiteralBlockDelimiter",
-											},
-										},
-										&zeroOrMoreExpr{
-											pos: position{line: 2073, col: 34, offset: 75951},
-											expr: &charClassMatcher{
-												pos:        position{line: 2073, col: 34, offset: 75951},
-												val:        "[^\\r\\n]",
-												chars:      []rune{'\r', '\n'},
-												ignoreCase: false,
-												inverted:   true,
-											},
-										},
+							pos:   position{line: 2238, col: 13, offset: 83536},
+							label: "attrs",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2238, col: 19, offset: 83542},
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 2238, col: 20, offset: 83543},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2238, col: 20, offset: 83543},
+										name: "BlockAttrs",
 									},
 								},
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2075, col: 8, offset: 76017},
-							name: "EOL",
+							pos:  position{line: 2238, col: 34, offset: 83557},
+							name: "DSVTableDelimiter",
+						},
+						&labeledExpr{
+							pos:   position{line: 2239, col: 5, offset: 83580},
+							label: "lines",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2239, col: 11, offset: 83586},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2239, col: 12, offset: 83587},
+									name: "DataTableLine",
+								},
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 2240, col: 6, offset: 83608},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2240, col: 6, offset: 83608},
+									name: "DSVTableDelimiter",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2240, col: 26, offset: 83628},
+									name: "EOF",
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "ParagraphWithLiteralAttribute",
-			pos:  position{line: 2080, col: 1, offset: 76143},
+			name: "DataTable",
+			pos:  position{line: 2245, col: 1, offset: 83834},
 			expr: &actionExpr{
-				pos: position{line: 2081, col: 5, offset: 76181},
-				run: (*parser).callonParagraphWithLiteralAttribute1,
+				pos: position{line: 2245, col: 14, offset: 83847},
+				run: (*parser).callonDataTable1,
 				expr: &seqExpr{
-					pos: position{line: 2081, col: 5, offset: 76181},
+					pos: position{line: 2245, col: 14, offset: 83847},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 2081, col: 5, offset: 76181},
-							label: "attributes",
+							pos:   position{line: 2245, col: 14, offset: 83847},
+							label: "attrs",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 2081, col: 16, offset: 76192},
-								expr: &ruleRefExpr{
-									pos:  position{line: 2081, col: 17, offset: 76193},
-									name: "Attributes",
+								pos: position{line: 2245, col: 20, offset: 83853},
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 2245, col: 21, offset: 83854},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2245, col: 21, offset: 83854},
+										name: "BlockAttrs",
+									},
 								},
 							},
 						},
 						&andCodeExpr{
-							pos: position{line: 2082, col: 5, offset: 76210},
-							run: (*parser).callonParagraphWithLiteralAttribute6,
+							pos: position{line: 2246, col: 5, offset: 83873},
+							run: (*parser).callonDataTable7,
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2249, col: 5, offset: 83920},
+							name: "TableDelimiter",
 						},
 						&labeledExpr{
-							pos:   position{line: 2089, col: 5, offset: 76422},
+							pos:   position{line: 2250, col: 5, offset: 83940},
 							label: "lines",
-							expr: &oneOrMoreExpr{
-								pos: position{line: 2089, col: 11, offset: 76428},
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2250, col: 11, offset: 83946},
 								expr: &ruleRefExpr{
-									pos:  position{line: 2089, col: 12, offset: 76429},
-									name: "LiteralParagraphLine",
+									pos:  position{line: 2250, col: 12, offset: 83947},
+									name: "DataTableLine",
+								},
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 2251, col: 6, offset: 83968},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2251, col: 6, offset: 83968},
+									name: "TableDelimiter",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2251, col: 23, offset: 83985},
+									name: "EOF",
 								},
 							},
 						},
@@ -15175,45 +16635,253 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "LiteralKind",
-			pos:  position{line: 2093, col: 1, offset: 76566},
-			expr: &actionExpr{
-				pos: position{line: 2093, col: 16, offset: 76581},
-				run: (*parser).callonLiteralKind1,
-				expr: &litMatcher{
-					pos:        position{line: 2093, col: 16, offset: 76581},
-					val:        "literal",
-					ignoreCase: false,
-					want:       "\"literal\"",
+			name: "PSVTableStartDelimiter",
+			pos:  position{line: 2256, col: 1, offset: 84186},
+			expr: &seqExpr{
+				pos: position{line: 2256, col: 27, offset: 84212},
+				exprs: []interface{}{
+					&labeledExpr{
+						pos:   position{line: 2256, col: 27, offset: 84212},
+						label: "separator",
+						expr: &actionExpr{
+							pos: position{line: 2256, col: 38, offset: 84223},
+							run: (*parser).callonPSVTableStartDelimiter3,
+							expr: &charClassMatcher{
+								pos:        position{line: 2256, col: 38, offset: 84223},
+								val:        "[|!]",
+								chars:      []rune{'|', '!'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+					&litMatcher{
+						pos:        position{line: 2256, col: 75, offset: 84260},
+						val:        "===",
+						ignoreCase: false,
+						want:       "\"===\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 2256, col: 81, offset: 84266},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2256, col: 81, offset: 84266},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2256, col: 88, offset: 84273},
+						name: "EOL",
+					},
+					&andCodeExpr{
+						pos: position{line: 2256, col: 92, offset: 84277},
+						run: (*parser).callonPSVTableStartDelimiter9,
+					},
 				},
 			},
 		},
 		{
-			name: "LiteralParagraphLine",
-			pos:  position{line: 2097, col: 1, offset: 76627},
+			name: "PSVTableEndDelimiter",
+			pos:  position{line: 2260, col: 1, offset: 84338},
+			expr: &seqExpr{
+				pos: position{line: 2260, col: 25, offset: 84362},
+				exprs: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 2260, col: 25, offset: 84362},
+						name: "TableCellSeparatorChar",
+					},
+					&litMatcher{
+						pos:        position{line: 2260, col: 48, offset: 84385},
+						val:        "===",
+						ignoreCase: false,
+						want:       "\"===\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 2260, col: 54, offset: 84391},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2260, col: 54, offset: 84391},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2260, col: 61, offset: 84398},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "TableCellSeparatorChar",
+			pos:  position{line: 2262, col: 1, offset: 84403},
+			expr: &seqExpr{
+				pos: position{line: 2262, col: 27, offset: 84429},
+				exprs: []interface{}{
+					&labeledExpr{
+						pos:   position{line: 2262, col: 27, offset: 84429},
+						label: "separator",
+						expr: &actionExpr{
+							pos: position{line: 2262, col: 38, offset: 84440},
+							run: (*parser).callonTableCellSeparatorChar3,
+							expr: &charClassMatcher{
+								pos:        position{line: 2262, col: 38, offset: 84440},
+								val:        "[|!]",
+								chars:      []rune{'|', '!'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+					&andCodeExpr{
+						pos: position{line: 2262, col: 75, offset: 84477},
+						run: (*parser).callonTableCellSeparatorChar5,
+					},
+				},
+			},
+		},
+		{
+			name: "TableCellSeparator",
+			pos:  position{line: 2266, col: 1, offset: 84537},
+			expr: &seqExpr{
+				pos: position{line: 2266, col: 23, offset: 84559},
+				exprs: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 2266, col: 23, offset: 84559},
+						name: "TableCellSeparatorChar",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 2266, col: 46, offset: 84582},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2266, col: 46, offset: 84582},
+							name: "Space",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "TableDelimiter",
+			pos:  position{line: 2268, col: 1, offset: 84590},
+			expr: &seqExpr{
+				pos: position{line: 2268, col: 19, offset: 84608},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 2268, col: 19, offset: 84608},
+						val:        "|===",
+						ignoreCase: false,
+						want:       "\"|===\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 2268, col: 26, offset: 84615},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2268, col: 26, offset: 84615},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2268, col: 33, offset: 84622},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "CSVTableDelimiter",
+			pos:  position{line: 2270, col: 1, offset: 84627},
+			expr: &seqExpr{
+				pos: position{line: 2270, col: 22, offset: 84648},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 2270, col: 22, offset: 84648},
+						val:        ",===",
+						ignoreCase: false,
+						want:       "\",===\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 2270, col: 29, offset: 84655},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2270, col: 29, offset: 84655},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2270, col: 36, offset: 84662},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "DSVTableDelimiter",
+			pos:  position{line: 2272, col: 1, offset: 84667},
+			expr: &seqExpr{
+				pos: position{line: 2272, col: 22, offset: 84688},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 2272, col: 22, offset: 84688},
+						val:        ":===",
+						ignoreCase: false,
+						want:       "\":===\"",
+					},
+					&zeroOrMoreExpr{
+						pos: position{line: 2272, col: 29, offset: 84695},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2272, col: 29, offset: 84695},
+							name: "Space",
+						},
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2272, col: 36, offset: 84702},
+						name: "EOL",
+					},
+				},
+			},
+		},
+		{
+			name: "DataTableLine",
+			pos:  position{line: 2274, col: 1, offset: 84707},
 			expr: &actionExpr{
-				pos: position{line: 2097, col: 25, offset: 76651},
-				run: (*parser).callonLiteralParagraphLine1,
+				pos: position{line: 2274, col: 18, offset: 84724},
+				run: (*parser).callonDataTableLine1,
 				expr: &seqExpr{
-					pos: position{line: 2097, col: 25, offset: 76651},
+					pos: position{line: 2274, col: 18, offset: 84724},
 					exprs: []interface{}{
 						&notExpr{
-							pos: position{line: 2097, col: 25, offset: 76651},
+							pos: position{line: 2274, col: 18, offset: 84724},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2097, col: 26, offset: 76652},
-								name: "BlankLine",
+								pos:  position{line: 2274, col: 19, offset: 84725},
+								name: "TableDelimiter",
+							},
+						},
+						&notExpr{
+							pos: position{line: 2274, col: 34, offset: 84740},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2274, col: 35, offset: 84741},
+								name: "CSVTableDelimiter",
+							},
+						},
+						&notExpr{
+							pos: position{line: 2274, col: 53, offset: 84759},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2274, col: 54, offset: 84760},
+								name: "DSVTableDelimiter",
+							},
+						},
+						&notExpr{
+							pos: position{line: 2274, col: 72, offset: 84778},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2274, col: 73, offset: 84779},
+								name: "EOF",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2097, col: 36, offset: 76662},
+							pos:   position{line: 2274, col: 77, offset: 84783},
 							label: "content",
 							expr: &actionExpr{
-								pos: position{line: 2097, col: 45, offset: 76671},
-								run: (*parser).callonLiteralParagraphLine6,
-								expr: &oneOrMoreExpr{
-									pos: position{line: 2097, col: 45, offset: 76671},
+								pos: position{line: 2274, col: 86, offset: 84792},
+								run: (*parser).callonDataTableLine12,
+								expr: &zeroOrMoreExpr{
+									pos: position{line: 2274, col: 86, offset: 84792},
 									expr: &charClassMatcher{
-										pos:        position{line: 2097, col: 45, offset: 76671},
+										pos:        position{line: 2274, col: 86, offset: 84792},
 										val:        "[^\\r\\n]",
 										chars:      []rune{'\r', '\n'},
 										ignoreCase: false,
@@ -15223,7 +16891,7 @@ var g = &grammar{
 							},
 						},
 						&ruleRefExpr{
-							pos:  position{line: 2099, col: 4, offset: 76729},
+							pos:  position{line: 2276, col: 8, offset: 84847},
 							name: "EOL",
 						},
 					},
@@ -15231,207 +16899,183 @@ var g = &grammar{
 			},
 		},
 		{
-			name: "IndexTerm",
-			pos:  position{line: 2106, col: 1, offset: 76906},
+			name: "TableLineHeader",
+			pos:  position{line: 2281, col: 1, offset: 84944},
 			expr: &actionExpr{
-				pos: position{line: 2106, col: 14, offset: 76919},
-				run: (*parser).callonIndexTerm1,
+				pos: position{line: 2281, col: 20, offset: 84963},
+				run: (*parser).callonTableLineHeader1,
 				expr: &seqExpr{
-					pos: position{line: 2106, col: 14, offset: 76919},
+					pos: position{line: 2281, col: 20, offset: 84963},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 2106, col: 14, offset: 76919},
-							val:        "((",
-							ignoreCase: false,
-							want:       "\"((\"",
+						&notExpr{
+							pos: position{line: 2281, col: 20, offset: 84963},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2281, col: 21, offset: 84964},
+								name: "PSVTableEndDelimiter",
+							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2106, col: 19, offset: 76924},
-							label: "term",
-							expr: &ruleRefExpr{
-								pos:  position{line: 2106, col: 25, offset: 76930},
-								name: "IndexTermContent",
+							pos:   position{line: 2281, col: 42, offset: 84985},
+							label: "cells",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 2281, col: 48, offset: 84991},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2281, col: 49, offset: 84992},
+									name: "TableHeaderCell",
+								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 2106, col: 43, offset: 76948},
-							val:        "))",
-							ignoreCase: false,
-							want:       "\"))\"",
+						&ruleRefExpr{
+							pos:  position{line: 2281, col: 67, offset: 85010},
+							name: "EOL",
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2281, col: 71, offset: 85014},
+							name: "BlankLine",
 						},
 					},
 				},
 			},
 		},
 		{
-			name: "IndexTermContent",
-			pos:  position{line: 2110, col: 1, offset: 77013},
+			name: "TableLine",
+			pos:  position{line: 2285, col: 1, offset: 85082},
 			expr: &actionExpr{
-				pos: position{line: 2110, col: 21, offset: 77033},
-				run: (*parser).callonIndexTermContent1,
-				expr: &labeledExpr{
-					pos:   position{line: 2110, col: 21, offset: 77033},
-					label: "elements",
-					expr: &oneOrMoreExpr{
-						pos: position{line: 2110, col: 30, offset: 77042},
-						expr: &choiceExpr{
-							pos: position{line: 2110, col: 31, offset: 77043},
-							alternatives: []interface{}{
-								&ruleRefExpr{
-									pos:  position{line: 2110, col: 31, offset: 77043},
-									name: "Word",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 2110, col: 38, offset: 77050},
-									name: "QuotedString",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 2110, col: 53, offset: 77065},
-									name: "QuotedText",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 2110, col: 66, offset: 77078},
-									name: "Space",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 2110, col: 74, offset: 77086},
-									name: "SpecialCharacter",
-								},
-								&ruleRefExpr{
-									pos:  position{line: 2110, col: 93, offset: 77105},
-									name: "ElementPlaceHolder",
-								},
-								&actionExpr{
-									pos: position{line: 2110, col: 114, offset: 77126},
-									run: (*parser).callonIndexTermContent11,
-									expr: &seqExpr{
-										pos: position{line: 2110, col: 115, offset: 77127},
-										exprs: []interface{}{
-											&notExpr{
-												pos: position{line: 2110, col: 115, offset: 77127},
-												expr: &litMatcher{
-													pos:        position{line: 2110, col: 116, offset: 77128},
-													val:        "))",
-													ignoreCase: false,
-													want:       "\"))\"",
-												},
-											},
-											&anyMatcher{
-												line: 2110, col: 121, offset: 77133,
-											},
-										},
-									},
+				pos: position{line: 2285, col: 14, offset: 85095},
+				run: (*parser).callonTableLine1,
+				expr: &seqExpr{
+					pos: position{line: 2285, col: 14, offset: 85095},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 2285, col: 14, offset: 85095},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2285, col: 15, offset: 85096},
+								name: "PSVTableEndDelimiter",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2285, col: 36, offset: 85117},
+							label: "cells",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 2285, col: 42, offset: 85123},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2285, col: 43, offset: 85124},
+									name: "TableCell",
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 2285, col: 55, offset: 85136},
+							name: "EOL",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 2285, col: 59, offset: 85140},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2285, col: 59, offset: 85140},
+								name: "BlankLine",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "ConcealedIndexTerm",
-			pos:  position{line: 2116, col: 1, offset: 77239},
+			name: "TableCell",
+			pos:  position{line: 2291, col: 1, offset: 85363},
 			expr: &actionExpr{
-				pos: position{line: 2116, col: 23, offset: 77261},
-				run: (*parser).callonConcealedIndexTerm1,
+				pos: position{line: 2291, col: 14, offset: 85376},
+				run: (*parser).callonTableCell1,
 				expr: &seqExpr{
-					pos: position{line: 2116, col: 23, offset: 77261},
+					pos: position{line: 2291, col: 14, offset: 85376},
 					exprs: []interface{}{
-						&litMatcher{
-							pos:        position{line: 2116, col: 23, offset: 77261},
-							val:        "(((",
-							ignoreCase: false,
-							want:       "\"(((\"",
-						},
-						&labeledExpr{
-							pos:   position{line: 2116, col: 29, offset: 77267},
-							label: "term1",
+						&zeroOrMoreExpr{
+							pos: position{line: 2291, col: 14, offset: 85376},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2116, col: 36, offset: 77274},
-								name: "ConcealedIndexTermContent",
+								pos:  position{line: 2291, col: 14, offset: 85376},
+								name: "Space",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2117, col: 5, offset: 77306},
-							label: "term2",
+							pos:   position{line: 2291, col: 21, offset: 85383},
+							label: "specifier",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 2117, col: 11, offset: 77312},
-								expr: &actionExpr{
-									pos: position{line: 2117, col: 12, offset: 77313},
-									run: (*parser).callonConcealedIndexTerm8,
-									expr: &seqExpr{
-										pos: position{line: 2117, col: 12, offset: 77313},
-										exprs: []interface{}{
-											&zeroOrMoreExpr{
-												pos: position{line: 2117, col: 12, offset: 77313},
-												expr: &ruleRefExpr{
-													pos:  position{line: 2117, col: 12, offset: 77313},
-													name: "Space",
-												},
-											},
-											&litMatcher{
-												pos:        position{line: 2117, col: 19, offset: 77320},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 2117, col: 23, offset: 77324},
-												expr: &ruleRefExpr{
-													pos:  position{line: 2117, col: 23, offset: 77324},
-													name: "Space",
-												},
-											},
-											&labeledExpr{
-												pos:   position{line: 2117, col: 30, offset: 77331},
-												label: "content",
-												expr: &ruleRefExpr{
-													pos:  position{line: 2117, col: 39, offset: 77340},
-													name: "ConcealedIndexTermContent",
-												},
-											},
-										},
-									},
+								pos: position{line: 2291, col: 31, offset: 85393},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2291, col: 32, offset: 85394},
+									name: "TableCellSpecifier",
 								},
 							},
 						},
+						&ruleRefExpr{
+							pos:  position{line: 2291, col: 53, offset: 85415},
+							name: "TableCellSeparator",
+						},
 						&labeledExpr{
-							pos:   position{line: 2118, col: 5, offset: 77398},
-							label: "term3",
-							expr: &zeroOrOneExpr{
-								pos: position{line: 2118, col: 11, offset: 77404},
-								expr: &actionExpr{
-									pos: position{line: 2118, col: 12, offset: 77405},
-									run: (*parser).callonConcealedIndexTerm19,
-									expr: &seqExpr{
-										pos: position{line: 2118, col: 12, offset: 77405},
-										exprs: []interface{}{
-											&zeroOrMoreExpr{
-												pos: position{line: 2118, col: 12, offset: 77405},
-												expr: &ruleRefExpr{
-													pos:  position{line: 2118, col: 12, offset: 77405},
-													name: "Space",
-												},
-											},
-											&litMatcher{
-												pos:        position{line: 2118, col: 19, offset: 77412},
-												val:        ",",
-												ignoreCase: false,
-												want:       "\",\"",
-											},
-											&zeroOrMoreExpr{
-												pos: position{line: 2118, col: 23, offset: 77416},
-												expr: &ruleRefExpr{
-													pos:  position{line: 2118, col: 23, offset: 77416},
-													name: "Space",
-												},
-											},
-											&labeledExpr{
-												pos:   position{line: 2118, col: 30, offset: 77423},
-												label: "content",
-												expr: &ruleRefExpr{
-													pos:  position{line: 2118, col: 39, offset: 77432},
-													name: "ConcealedIndexTermContent",
+							pos:   position{line: 2292, col: 5, offset: 85439},
+							label: "content",
+							expr: &actionExpr{
+								pos: position{line: 2292, col: 14, offset: 85448},
+								run: (*parser).callonTableCell10,
+								expr: &seqExpr{
+									pos: position{line: 2292, col: 14, offset: 85448},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 2292, col: 14, offset: 85448},
+											name: "TableCellContent",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 2292, col: 31, offset: 85465},
+											expr: &seqExpr{
+												pos: position{line: 2292, col: 32, offset: 85466},
+												exprs: []interface{}{
+													&ruleRefExpr{
+														pos:  position{line: 2292, col: 32, offset: 85466},
+														name: "EOL",
+													},
+													&notExpr{
+														pos: position{line: 2292, col: 36, offset: 85470},
+														expr: &ruleRefExpr{
+															pos:  position{line: 2292, col: 37, offset: 85471},
+															name: "PSVTableEndDelimiter",
+														},
+													},
+													&notExpr{
+														pos: position{line: 2292, col: 58, offset: 85492},
+														expr: &ruleRefExpr{
+															pos:  position{line: 2292, col: 59, offset: 85493},
+															name: "EOF",
+														},
+													},
+													&notExpr{
+														pos: position{line: 2292, col: 63, offset: 85497},
+														expr: &seqExpr{
+															pos: position{line: 2292, col: 65, offset: 85499},
+															exprs: []interface{}{
+																&zeroOrMoreExpr{
+																	pos: position{line: 2292, col: 65, offset: 85499},
+																	expr: &ruleRefExpr{
+																		pos:  position{line: 2292, col: 65, offset: 85499},
+																		name: "Space",
+																	},
+																},
+																&zeroOrOneExpr{
+																	pos: position{line: 2292, col: 72, offset: 85506},
+																	expr: &ruleRefExpr{
+																		pos:  position{line: 2292, col: 72, offset: 85506},
+																		name: "TableCellSpecifier",
+																	},
+																},
+																&ruleRefExpr{
+																	pos:  position{line: 2292, col: 92, offset: 85526},
+																	name: "TableCellSeparator",
+																},
+															},
+														},
+													},
+													&ruleRefExpr{
+														pos:  position{line: 2292, col: 112, offset: 85546},
+														name: "TableCellContent",
+													},
 												},
 											},
 										},
@@ -15439,201 +17083,2186 @@ var g = &grammar{
 								},
 							},
 						},
-						&litMatcher{
-							pos:        position{line: 2119, col: 5, offset: 77490},
-							val:        ")))",
-							ignoreCase: false,
-							want:       "\")))\"",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "ConcealedIndexTermContent",
-			pos:  position{line: 2123, col: 1, offset: 77569},
+			name: "TableHeaderCell",
+			pos:  position{line: 2299, col: 1, offset: 85777},
 			expr: &actionExpr{
-				pos: position{line: 2123, col: 30, offset: 77598},
-				run: (*parser).callonConcealedIndexTermContent1,
-				expr: &oneOrMoreExpr{
-					pos: position{line: 2123, col: 30, offset: 77598},
-					expr: &choiceExpr{
-						pos: position{line: 2123, col: 31, offset: 77599},
-						alternatives: []interface{}{
-							&ruleRefExpr{
-								pos:  position{line: 2123, col: 31, offset: 77599},
-								name: "Alphanum",
-							},
-							&ruleRefExpr{
-								pos:  position{line: 2123, col: 42, offset: 77610},
+				pos: position{line: 2299, col: 20, offset: 85796},
+				run: (*parser).callonTableHeaderCell1,
+				expr: &seqExpr{
+					pos: position{line: 2299, col: 20, offset: 85796},
+					exprs: []interface{}{
+						&zeroOrMoreExpr{
+							pos: position{line: 2299, col: 20, offset: 85796},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2299, col: 20, offset: 85796},
 								name: "Space",
 							},
 						},
+						&labeledExpr{
+							pos:   position{line: 2299, col: 27, offset: 85803},
+							label: "specifier",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2299, col: 37, offset: 85813},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2299, col: 38, offset: 85814},
+									name: "TableCellSpecifier",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2299, col: 59, offset: 85835},
+							name: "TableCellSeparator",
+						},
+						&labeledExpr{
+							pos:   position{line: 2299, col: 78, offset: 85854},
+							label: "content",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2299, col: 87, offset: 85863},
+								name: "TableCellContent",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "BlankLine",
-			pos:  position{line: 2130, col: 1, offset: 77759},
+			name: "TableCellContent",
+			pos:  position{line: 2305, col: 1, offset: 86190},
 			expr: &actionExpr{
-				pos: position{line: 2130, col: 14, offset: 77772},
-				run: (*parser).callonBlankLine1,
-				expr: &seqExpr{
-					pos: position{line: 2130, col: 14, offset: 77772},
-					exprs: []interface{}{
-						&notExpr{
-							pos: position{line: 2130, col: 14, offset: 77772},
-							expr: &ruleRefExpr{
-								pos:  position{line: 2130, col: 15, offset: 77773},
-								name: "EOF",
+				pos: position{line: 2305, col: 21, offset: 86210},
+				run: (*parser).callonTableCellContent1,
+				expr: &zeroOrMoreExpr{
+					pos: position{line: 2305, col: 21, offset: 86210},
+					expr: &choiceExpr{
+						pos: position{line: 2305, col: 22, offset: 86211},
+						alternatives: []interface{}{
+							&seqExpr{
+								pos: position{line: 2305, col: 22, offset: 86211},
+								exprs: []interface{}{
+									&litMatcher{
+										pos:        position{line: 2305, col: 22, offset: 86211},
+										val:        "\\",
+										ignoreCase: false,
+										want:       "\"\\\\\"",
+									},
+									&ruleRefExpr{
+										pos:  position{line: 2305, col: 27, offset: 86216},
+										name: "TableCellSeparatorChar",
+									},
+								},
 							},
-						},
-						&zeroOrMoreExpr{
-							pos: position{line: 2130, col: 19, offset: 77777},
-							expr: &ruleRefExpr{
-								pos:  position{line: 2130, col: 19, offset: 77777},
-								name: "Space",
+							&seqExpr{
+								pos: position{line: 2305, col: 52, offset: 86241},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 2305, col: 52, offset: 86241},
+										expr: &ruleRefExpr{
+											pos:  position{line: 2305, col: 53, offset: 86242},
+											name: "TableCellSeparator",
+										},
+									},
+									&notExpr{
+										pos: position{line: 2305, col: 72, offset: 86261},
+										expr: &ruleRefExpr{
+											pos:  position{line: 2305, col: 73, offset: 86262},
+											name: "EOL",
+										},
+									},
+									&notExpr{
+										pos: position{line: 2305, col: 77, offset: 86266},
+										expr: &seqExpr{
+											pos: position{line: 2305, col: 79, offset: 86268},
+											exprs: []interface{}{
+												&oneOrMoreExpr{
+													pos: position{line: 2305, col: 79, offset: 86268},
+													expr: &ruleRefExpr{
+														pos:  position{line: 2305, col: 79, offset: 86268},
+														name: "Space",
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 2305, col: 86, offset: 86275},
+													name: "TableCellSpecifier",
+												},
+												&ruleRefExpr{
+													pos:  position{line: 2305, col: 105, offset: 86294},
+													name: "TableCellSeparator",
+												},
+											},
+										},
+									},
+									&anyMatcher{
+										line: 2305, col: 125, offset: 86314,
+									},
+								},
 							},
 						},
-						&ruleRefExpr{
-							pos:  position{line: 2130, col: 26, offset: 77784},
-							name: "EOL",
-						},
 					},
 				},
 			},
 		},
 		{
-			name: "Symbol",
-			pos:  position{line: 2138, col: 1, offset: 77929},
-			expr: &choiceExpr{
-				pos: position{line: 2138, col: 11, offset: 77939},
-				alternatives: []interface{}{
-					&ruleRefExpr{
-						pos:  position{line: 2138, col: 11, offset: 77939},
-						name: "Apostrophe",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 2138, col: 24, offset: 77952},
-						name: "Copyright",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 2138, col: 36, offset: 77964},
-						name: "Trademark",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 2138, col: 48, offset: 77976},
-						name: "Registered",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 2138, col: 61, offset: 77989},
-						name: "Ellipsis",
-					},
-					&ruleRefExpr{
-						pos:  position{line: 2138, col: 72, offset: 78000},
-						name: "ImpliedApostrophe",
+			name: "TableCellSpecifier",
+			pos:  position{line: 2309, col: 1, offset: 86354},
+			expr: &actionExpr{
+				pos: position{line: 2309, col: 23, offset: 86376},
+				run: (*parser).callonTableCellSpecifier1,
+				expr: &seqExpr{
+					pos: position{line: 2309, col: 23, offset: 86376},
+					exprs: []interface{}{
+						&andExpr{
+							pos: position{line: 2309, col: 23, offset: 86376},
+							expr: &choiceExpr{
+								pos: position{line: 2309, col: 25, offset: 86378},
+								alternatives: []interface{}{
+									&charClassMatcher{
+										pos:        position{line: 2309, col: 25, offset: 86378},
+										val:        "[0-9.<>^]",
+										chars:      []rune{'.', '<', '>', '^'},
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+									&charClassMatcher{
+										pos:        position{line: 2309, col: 37, offset: 86390},
+										val:        "[adehlmsv]",
+										chars:      []rune{'a', 'd', 'e', 'h', 'l', 'm', 's', 'v'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2310, col: 5, offset: 86407},
+							label: "factor",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2310, col: 12, offset: 86414},
+								expr: &choiceExpr{
+									pos: position{line: 2310, col: 13, offset: 86415},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 2310, col: 13, offset: 86415},
+											name: "TableCellSpan",
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2310, col: 29, offset: 86431},
+											name: "TableCellDuplication",
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2311, col: 5, offset: 86458},
+							label: "halign",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2311, col: 12, offset: 86465},
+								expr: &actionExpr{
+									pos: position{line: 2311, col: 13, offset: 86466},
+									run: (*parser).callonTableCellSpecifier14,
+									expr: &charClassMatcher{
+										pos:        position{line: 2311, col: 13, offset: 86466},
+										val:        "[<>^]",
+										chars:      []rune{'<', '>', '^'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2312, col: 5, offset: 86509},
+							label: "valign",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2312, col: 12, offset: 86516},
+								expr: &actionExpr{
+									pos: position{line: 2312, col: 13, offset: 86517},
+									run: (*parser).callonTableCellSpecifier18,
+									expr: &seqExpr{
+										pos: position{line: 2312, col: 13, offset: 86517},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 2312, col: 13, offset: 86517},
+												val:        ".",
+												ignoreCase: false,
+												want:       "\".\"",
+											},
+											&labeledExpr{
+												pos:   position{line: 2312, col: 17, offset: 86521},
+												label: "align",
+												expr: &actionExpr{
+													pos: position{line: 2312, col: 24, offset: 86528},
+													run: (*parser).callonTableCellSpecifier22,
+													expr: &charClassMatcher{
+														pos:        position{line: 2312, col: 24, offset: 86528},
+														val:        "[<>^]",
+														chars:      []rune{'<', '>', '^'},
+														ignoreCase: false,
+														inverted:   false,
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2313, col: 5, offset: 86594},
+							label: "style",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2313, col: 11, offset: 86600},
+								expr: &actionExpr{
+									pos: position{line: 2313, col: 12, offset: 86601},
+									run: (*parser).callonTableCellSpecifier26,
+									expr: &charClassMatcher{
+										pos:        position{line: 2313, col: 12, offset: 86601},
+										val:        "[adehlmsv]",
+										chars:      []rune{'a', 'd', 'e', 'h', 'l', 'm', 's', 'v'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
+						},
+						&andExpr{
+							pos: position{line: 2314, col: 5, offset: 86649},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2314, col: 6, offset: 86650},
+								name: "TableCellSeparator",
+							},
+						},
 					},
 				},
 			},
 		},
 		{
-			name: "Apostrophe",
-			pos:  position{line: 2140, col: 1, offset: 78019},
+			name: "TableCellSpan",
+			pos:  position{line: 2319, col: 1, offset: 86773},
 			expr: &actionExpr{
-				pos: position{line: 2140, col: 15, offset: 78033},
-				run: (*parser).callonApostrophe1,
-				expr: &litMatcher{
-					pos:        position{line: 2140, col: 15, offset: 78033},
-					val:        "`'",
-					ignoreCase: false,
-					want:       "\"`'\"",
+				pos: position{line: 2319, col: 18, offset: 86790},
+				run: (*parser).callonTableCellSpan1,
+				expr: &seqExpr{
+					pos: position{line: 2319, col: 18, offset: 86790},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2319, col: 18, offset: 86790},
+							label: "colspan",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2319, col: 26, offset: 86798},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2319, col: 27, offset: 86799},
+									name: "TableCellFactor",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2319, col: 45, offset: 86817},
+							label: "rowspan",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2319, col: 53, offset: 86825},
+								expr: &actionExpr{
+									pos: position{line: 2319, col: 54, offset: 86826},
+									run: (*parser).callonTableCellSpan8,
+									expr: &seqExpr{
+										pos: position{line: 2319, col: 54, offset: 86826},
+										exprs: []interface{}{
+											&litMatcher{
+												pos:        position{line: 2319, col: 54, offset: 86826},
+												val:        ".",
+												ignoreCase: false,
+												want:       "\".\"",
+											},
+											&labeledExpr{
+												pos:   position{line: 2319, col: 58, offset: 86830},
+												label: "rowspan",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2319, col: 67, offset: 86839},
+													name: "TableCellFactor",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 2319, col: 110, offset: 86882},
+							val:        "+",
+							ignoreCase: false,
+							want:       "\"+\"",
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "Copyright",
-			pos:  position{line: 2143, col: 1, offset: 78086},
+			name: "TableCellDuplication",
+			pos:  position{line: 2324, col: 1, offset: 86979},
 			expr: &actionExpr{
-				pos: position{line: 2143, col: 14, offset: 78099},
-				run: (*parser).callonCopyright1,
-				expr: &litMatcher{
-					pos:        position{line: 2143, col: 14, offset: 78099},
-					val:        "(C)",
-					ignoreCase: false,
-					want:       "\"(C)\"",
+				pos: position{line: 2324, col: 25, offset: 87003},
+				run: (*parser).callonTableCellDuplication1,
+				expr: &seqExpr{
+					pos: position{line: 2324, col: 25, offset: 87003},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2324, col: 25, offset: 87003},
+							label: "factor",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2324, col: 33, offset: 87011},
+								name: "TableCellFactor",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 2324, col: 50, offset: 87028},
+							val:        "*",
+							ignoreCase: false,
+							want:       "\"*\"",
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "Trademark",
-			pos:  position{line: 2146, col: 1, offset: 78153},
+			name: "TableCellFactor",
+			pos:  position{line: 2328, col: 1, offset: 87116},
 			expr: &actionExpr{
-				pos: position{line: 2146, col: 14, offset: 78166},
-				run: (*parser).callonTrademark1,
-				expr: &litMatcher{
-					pos:        position{line: 2146, col: 14, offset: 78166},
-					val:        "(TM)",
-					ignoreCase: false,
-					want:       "\"(TM)\"",
+				pos: position{line: 2328, col: 20, offset: 87135},
+				run: (*parser).callonTableCellFactor1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 2328, col: 20, offset: 87135},
+					expr: &ruleRefExpr{
+						pos:  position{line: 2328, col: 20, offset: 87135},
+						name: "DIGIT",
+					},
 				},
 			},
 		},
 		{
-			name: "Registered",
-			pos:  position{line: 2149, col: 1, offset: 78221},
+			name: "LiteralBlock",
+			pos:  position{line: 2335, col: 1, offset: 87454},
+			expr: &choiceExpr{
+				pos: position{line: 2335, col: 17, offset: 87470},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 2335, col: 17, offset: 87470},
+						name: "ParagraphWithLiteralAttribute",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2335, col: 49, offset: 87502},
+						name: "ParagraphWithHeadingSpaces",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2335, col: 78, offset: 87531},
+						name: "ParagraphWithLiteralBlockDelimiter",
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralBlockDelimiter",
+			pos:  position{line: 2337, col: 1, offset: 87567},
+			expr: &litMatcher{
+				pos:        position{line: 2337, col: 26, offset: 87592},
+				val:        "....",
+				ignoreCase: false,
+				want:       "\"....\"",
+			},
+		},
+		{
+			name: "ParagraphWithHeadingSpaces",
+			pos:  position{line: 2340, col: 1, offset: 87664},
 			expr: &actionExpr{
-				pos: position{line: 2149, col: 15, offset: 78235},
-				run: (*parser).callonRegistered1,
-				expr: &litMatcher{
-					pos:        position{line: 2149, col: 15, offset: 78235},
-					val:        "(R)",
-					ignoreCase: false,
-					want:       "\"(R)\"",
+				pos: position{line: 2340, col: 31, offset: 87694},
+				run: (*parser).callonParagraphWithHeadingSpaces1,
+				expr: &seqExpr{
+					pos: position{line: 2340, col: 31, offset: 87694},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2340, col: 31, offset: 87694},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2340, col: 42, offset: 87705},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2340, col: 43, offset: 87706},
+									name: "Attributes",
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2340, col: 56, offset: 87719},
+							label: "lines",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2340, col: 63, offset: 87726},
+								name: "ParagraphWithHeadingSpacesLines",
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "Ellipsis",
-			pos:  position{line: 2152, col: 1, offset: 78289},
+			name: "ParagraphWithHeadingSpacesLines",
+			pos:  position{line: 2345, col: 1, offset: 87956},
 			expr: &actionExpr{
-				pos: position{line: 2152, col: 13, offset: 78301},
-				run: (*parser).callonEllipsis1,
-				expr: &litMatcher{
-					pos:        position{line: 2152, col: 13, offset: 78301},
-					val:        "...",
-					ignoreCase: false,
-					want:       "\"...\"",
+				pos: position{line: 2346, col: 5, offset: 87996},
+				run: (*parser).callonParagraphWithHeadingSpacesLines1,
+				expr: &seqExpr{
+					pos: position{line: 2346, col: 5, offset: 87996},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2346, col: 5, offset: 87996},
+							label: "firstLine",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2346, col: 16, offset: 88007},
+								name: "ParagraphWithHeadingSpacesLine",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2347, col: 5, offset: 88043},
+							label: "otherLines",
+							expr: &zeroOrMoreExpr{
+								pos: position{line: 2347, col: 16, offset: 88054},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2347, col: 17, offset: 88055},
+									name: "LiteralParagraphLine",
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		{
-			name: "ImpliedApostrophe",
-			pos:  position{line: 2160, col: 1, offset: 78578},
+			name: "ParagraphWithHeadingSpacesLine",
+			pos:  position{line: 2351, col: 1, offset: 88164},
 			expr: &actionExpr{
-				pos: position{line: 2160, col: 22, offset: 78599},
-				run: (*parser).callonImpliedApostrophe1,
+				pos: position{line: 2351, col: 35, offset: 88198},
+				run: (*parser).callonParagraphWithHeadingSpacesLine1,
 				expr: &seqExpr{
-					pos: position{line: 2160, col: 22, offset: 78599},
+					pos: position{line: 2351, col: 35, offset: 88198},
 					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2351, col: 35, offset: 88198},
+							label: "line",
+							expr: &actionExpr{
+								pos: position{line: 2351, col: 41, offset: 88204},
+								run: (*parser).callonParagraphWithHeadingSpacesLine4,
+								expr: &seqExpr{
+									pos: position{line: 2351, col: 41, offset: 88204},
+									exprs: []interface{}{
+										&oneOrMoreExpr{
+											pos: position{line: 2351, col: 41, offset: 88204},
+											expr: &ruleRefExpr{
+												pos:  position{line: 2351, col: 41, offset: 88204},
+												name: "Space",
+											},
+										},
+										&oneOrMoreExpr{
+											pos: position{line: 2351, col: 48, offset: 88211},
+											expr: &charClassMatcher{
+												pos:        position{line: 2351, col: 48, offset: 88211},
+												val:        "[^\\r\\n]",
+												chars:      []rune{'\r', '\n'},
+												ignoreCase: false,
+												inverted:   true,
+											},
+										},
+									},
+								},
+							},
+						},
 						&ruleRefExpr{
-							pos:  position{line: 2160, col: 22, offset: 78599},
-							name: "Alphanum",
+							pos:  position{line: 2353, col: 8, offset: 88277},
+							name: "EOL",
 						},
+					},
+				},
+			},
+		},
+		{
+			name: "ParagraphWithLiteralBlockDelimiter",
+			pos:  position{line: 2358, col: 1, offset: 88417},
+			expr: &actionExpr{
+				pos: position{line: 2358, col: 39, offset: 88455},
+				run: (*parser).callonParagraphWithLiteralBlockDelimiter1,
+				expr: &seqExpr{
+					pos: position{line: 2358, col: 39, offset: 88455},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2358, col: 39, offset: 88455},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2358, col: 50, offset: 88466},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2358, col: 51, offset: 88467},
+									name: "Attributes",
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2359, col: 9, offset: 88488},
+							name: "LiteralBlockDelimiter",
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 2359, col: 31, offset: 88510},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2359, col: 31, offset: 88510},
+								name: "Space",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2359, col: 38, offset: 88517},
+							name: "Newline",
+						},
+						&labeledExpr{
+							pos:   position{line: 2359, col: 46, offset: 88525},
+							label: "lines",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2359, col: 53, offset: 88532},
+								name: "ParagraphWithLiteralBlockDelimiterLines",
+							},
+						},
+						&choiceExpr{
+							pos: position{line: 2359, col: 95, offset: 88574},
+							alternatives: []interface{}{
+								&seqExpr{
+									pos: position{line: 2359, col: 96, offset: 88575},
+									exprs: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 2359, col: 96, offset: 88575},
+											name: "LiteralBlockDelimiter",
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 2359, col: 118, offset: 88597},
+											expr: &ruleRefExpr{
+												pos:  position{line: 2359, col: 118, offset: 88597},
+												name: "Space",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2359, col: 125, offset: 88604},
+											name: "EOL",
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2359, col: 132, offset: 88611},
+									name: "EOF",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ParagraphWithLiteralBlockDelimiterLines",
+			pos:  position{line: 2364, col: 1, offset: 88803},
+			expr: &actionExpr{
+				pos: position{line: 2364, col: 44, offset: 88846},
+				run: (*parser).callonParagraphWithLiteralBlockDelimiterLines1,
+				expr: &labeledExpr{
+					pos:   position{line: 2364, col: 44, offset: 88846},
+					label: "lines",
+					expr: &zeroOrMoreExpr{
+						pos: position{line: 2364, col: 50, offset: 88852},
+						expr: &ruleRefExpr{
+							pos:  position{line: 2364, col: 51, offset: 88853},
+							name: "ParagraphWithLiteralBlockDelimiterLine",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ParagraphWithLiteralBlockDelimiterLine",
+			pos:  position{line: 2368, col: 1, offset: 88937},
+			expr: &actionExpr{
+				pos: position{line: 2369, col: 5, offset: 88992},
+				run: (*parser).callonParagraphWithLiteralBlockDelimiterLine1,
+				expr: &seqExpr{
+					pos: position{line: 2369, col: 5, offset: 88992},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2369, col: 5, offset: 88992},
+							label: "line",
+							expr: &actionExpr{
+								pos: position{line: 2369, col: 11, offset: 88998},
+								run: (*parser).callonParagraphWithLiteralBlockDelimiterLine4,
+								expr: &seqExpr{
+									pos: position{line: 2369, col: 11, offset: 88998},
+									exprs: []interface{}{
+										&notExpr{
+											pos: position{line: 2369, col: 11, offset: 88998},
+											expr: &ruleRefExpr{
+												pos:  position{line: 2369, col: 12, offset: 88999},
+												name: "LiteralBlockDelimiter",
+											},
+										},
+										&zeroOrMoreExpr{
+											pos: position{line: 2369, col: 34, offset: 89021},
+											expr: &charClassMatcher{
+												pos:        position{line: 2369, col: 34, offset: 89021},
+												val:        "[^\\r\\n]",
+												chars:      []rune{'\r', '\n'},
+												ignoreCase: false,
+												inverted:   true,
+											},
+										},
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2371, col: 8, offset: 89087},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ParagraphWithLiteralAttribute",
+			pos:  position{line: 2376, col: 1, offset: 89213},
+			expr: &actionExpr{
+				pos: position{line: 2377, col: 5, offset: 89251},
+				run: (*parser).callonParagraphWithLiteralAttribute1,
+				expr: &seqExpr{
+					pos: position{line: 2377, col: 5, offset: 89251},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2377, col: 5, offset: 89251},
+							label: "attributes",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2377, col: 16, offset: 89262},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2377, col: 17, offset: 89263},
+									name: "Attributes",
+								},
+							},
+						},
+						&andCodeExpr{
+							pos: position{line: 2378, col: 5, offset: 89280},
+							run: (*parser).callonParagraphWithLiteralAttribute6,
+						},
+						&labeledExpr{
+							pos:   position{line: 2385, col: 5, offset: 89492},
+							label: "lines",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 2385, col: 11, offset: 89498},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2385, col: 12, offset: 89499},
+									name: "LiteralParagraphLine",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LiteralKind",
+			pos:  position{line: 2389, col: 1, offset: 89636},
+			expr: &actionExpr{
+				pos: position{line: 2389, col: 16, offset: 89651},
+				run: (*parser).callonLiteralKind1,
+				expr: &litMatcher{
+					pos:        position{line: 2389, col: 16, offset: 89651},
+					val:        "literal",
+					ignoreCase: false,
+					want:       "\"literal\"",
+				},
+			},
+		},
+		{
+			name: "LiteralParagraphLine",
+			pos:  position{line: 2393, col: 1, offset: 89697},
+			expr: &actionExpr{
+				pos: position{line: 2393, col: 25, offset: 89721},
+				run: (*parser).callonLiteralParagraphLine1,
+				expr: &seqExpr{
+					pos: position{line: 2393, col: 25, offset: 89721},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 2393, col: 25, offset: 89721},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2393, col: 26, offset: 89722},
+								name: "BlankLine",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2393, col: 36, offset: 89732},
+							label: "content",
+							expr: &actionExpr{
+								pos: position{line: 2393, col: 45, offset: 89741},
+								run: (*parser).callonLiteralParagraphLine6,
+								expr: &oneOrMoreExpr{
+									pos: position{line: 2393, col: 45, offset: 89741},
+									expr: &charClassMatcher{
+										pos:        position{line: 2393, col: 45, offset: 89741},
+										val:        "[^\\r\\n]",
+										chars:      []rune{'\r', '\n'},
+										ignoreCase: false,
+										inverted:   true,
+									},
+								},
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2395, col: 4, offset: 89799},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IndexTerm",
+			pos:  position{line: 2402, col: 1, offset: 89976},
+			expr: &actionExpr{
+				pos: position{line: 2402, col: 14, offset: 89989},
+				run: (*parser).callonIndexTerm1,
+				expr: &seqExpr{
+					pos: position{line: 2402, col: 14, offset: 89989},
+					exprs: []interface{}{
 						&litMatcher{
-							pos:        position{line: 2160, col: 31, offset: 78608},
-							val:        "'",
+							pos:        position{line: 2402, col: 14, offset: 89989},
+							val:        "((",
 							ignoreCase: false,
-							want:       "\"'\"",
+							want:       "\"((\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 2402, col: 19, offset: 89994},
+							label: "term",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2402, col: 25, offset: 90000},
+								name: "IndexTermContent",
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 2402, col: 43, offset: 90018},
+							val:        "))",
+							ignoreCase: false,
+							want:       "\"))\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "IndexTermContent",
+			pos:  position{line: 2406, col: 1, offset: 90083},
+			expr: &actionExpr{
+				pos: position{line: 2406, col: 21, offset: 90103},
+				run: (*parser).callonIndexTermContent1,
+				expr: &labeledExpr{
+					pos:   position{line: 2406, col: 21, offset: 90103},
+					label: "elements",
+					expr: &oneOrMoreExpr{
+						pos: position{line: 2406, col: 30, offset: 90112},
+						expr: &choiceExpr{
+							pos: position{line: 2406, col: 31, offset: 90113},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2406, col: 31, offset: 90113},
+									name: "Word",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2406, col: 38, offset: 90120},
+									name: "QuotedString",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2406, col: 53, offset: 90135},
+									name: "QuotedText",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2406, col: 66, offset: 90148},
+									name: "Space",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2406, col: 74, offset: 90156},
+									name: "SpecialCharacter",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2406, col: 93, offset: 90175},
+									name: "ElementPlaceHolder",
+								},
+								&actionExpr{
+									pos: position{line: 2406, col: 114, offset: 90196},
+									run: (*parser).callonIndexTermContent11,
+									expr: &seqExpr{
+										pos: position{line: 2406, col: 115, offset: 90197},
+										exprs: []interface{}{
+											&notExpr{
+												pos: position{line: 2406, col: 115, offset: 90197},
+												expr: &litMatcher{
+													pos:        position{line: 2406, col: 116, offset: 90198},
+													val:        "))",
+													ignoreCase: false,
+													want:       "\"))\"",
+												},
+											},
+											&anyMatcher{
+												line: 2406, col: 121, offset: 90203,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConcealedIndexTerm",
+			pos:  position{line: 2412, col: 1, offset: 90309},
+			expr: &actionExpr{
+				pos: position{line: 2412, col: 23, offset: 90331},
+				run: (*parser).callonConcealedIndexTerm1,
+				expr: &seqExpr{
+					pos: position{line: 2412, col: 23, offset: 90331},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 2412, col: 23, offset: 90331},
+							val:        "(((",
+							ignoreCase: false,
+							want:       "\"(((\"",
+						},
+						&labeledExpr{
+							pos:   position{line: 2412, col: 29, offset: 90337},
+							label: "term1",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2412, col: 36, offset: 90344},
+								name: "ConcealedIndexTermContent",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2413, col: 5, offset: 90376},
+							label: "term2",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2413, col: 11, offset: 90382},
+								expr: &actionExpr{
+									pos: position{line: 2413, col: 12, offset: 90383},
+									run: (*parser).callonConcealedIndexTerm8,
+									expr: &seqExpr{
+										pos: position{line: 2413, col: 12, offset: 90383},
+										exprs: []interface{}{
+											&zeroOrMoreExpr{
+												pos: position{line: 2413, col: 12, offset: 90383},
+												expr: &ruleRefExpr{
+													pos:  position{line: 2413, col: 12, offset: 90383},
+													name: "Space",
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 2413, col: 19, offset: 90390},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 2413, col: 23, offset: 90394},
+												expr: &ruleRefExpr{
+													pos:  position{line: 2413, col: 23, offset: 90394},
+													name: "Space",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 2413, col: 30, offset: 90401},
+												label: "content",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2413, col: 39, offset: 90410},
+													name: "ConcealedIndexTermContent",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2414, col: 5, offset: 90468},
+							label: "term3",
+							expr: &zeroOrOneExpr{
+								pos: position{line: 2414, col: 11, offset: 90474},
+								expr: &actionExpr{
+									pos: position{line: 2414, col: 12, offset: 90475},
+									run: (*parser).callonConcealedIndexTerm19,
+									expr: &seqExpr{
+										pos: position{line: 2414, col: 12, offset: 90475},
+										exprs: []interface{}{
+											&zeroOrMoreExpr{
+												pos: position{line: 2414, col: 12, offset: 90475},
+												expr: &ruleRefExpr{
+													pos:  position{line: 2414, col: 12, offset: 90475},
+													name: "Space",
+												},
+											},
+											&litMatcher{
+												pos:        position{line: 2414, col: 19, offset: 90482},
+												val:        ",",
+												ignoreCase: false,
+												want:       "\",\"",
+											},
+											&zeroOrMoreExpr{
+												pos: position{line: 2414, col: 23, offset: 90486},
+												expr: &ruleRefExpr{
+													pos:  position{line: 2414, col: 23, offset: 90486},
+													name: "Space",
+												},
+											},
+											&labeledExpr{
+												pos:   position{line: 2414, col: 30, offset: 90493},
+												label: "content",
+												expr: &ruleRefExpr{
+													pos:  position{line: 2414, col: 39, offset: 90502},
+													name: "ConcealedIndexTermContent",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						&litMatcher{
+							pos:        position{line: 2415, col: 5, offset: 90560},
+							val:        ")))",
+							ignoreCase: false,
+							want:       "\")))\"",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "ConcealedIndexTermContent",
+			pos:  position{line: 2419, col: 1, offset: 90639},
+			expr: &actionExpr{
+				pos: position{line: 2419, col: 30, offset: 90668},
+				run: (*parser).callonConcealedIndexTermContent1,
+				expr: &oneOrMoreExpr{
+					pos: position{line: 2419, col: 30, offset: 90668},
+					expr: &choiceExpr{
+						pos: position{line: 2419, col: 31, offset: 90669},
+						alternatives: []interface{}{
+							&ruleRefExpr{
+								pos:  position{line: 2419, col: 31, offset: 90669},
+								name: "Alphanum",
+							},
+							&ruleRefExpr{
+								pos:  position{line: 2419, col: 42, offset: 90680},
+								name: "Space",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BlankLine",
+			pos:  position{line: 2426, col: 1, offset: 90829},
+			expr: &actionExpr{
+				pos: position{line: 2426, col: 14, offset: 90842},
+				run: (*parser).callonBlankLine1,
+				expr: &seqExpr{
+					pos: position{line: 2426, col: 14, offset: 90842},
+					exprs: []interface{}{
+						&notExpr{
+							pos: position{line: 2426, col: 14, offset: 90842},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2426, col: 15, offset: 90843},
+								name: "EOF",
+							},
+						},
+						&zeroOrMoreExpr{
+							pos: position{line: 2426, col: 19, offset: 90847},
+							expr: &ruleRefExpr{
+								pos:  position{line: 2426, col: 19, offset: 90847},
+								name: "Space",
+							},
+						},
+						&ruleRefExpr{
+							pos:  position{line: 2426, col: 26, offset: 90854},
+							name: "EOL",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Symbol",
+			pos:  position{line: 2434, col: 1, offset: 90999},
+			expr: &choiceExpr{
+				pos: position{line: 2434, col: 11, offset: 91009},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 2434, col: 11, offset: 91009},
+						name: "Apostrophe",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2434, col: 24, offset: 91022},
+						name: "Copyright",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2434, col: 36, offset: 91034},
+						name: "Trademark",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2434, col: 48, offset: 91046},
+						name: "Registered",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2434, col: 61, offset: 91059},
+						name: "Ellipsis",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2434, col: 72, offset: 91070},
+						name: "ImpliedApostrophe",
+					},
+				},
+			},
+		},
+		{
+			name: "Apostrophe",
+			pos:  position{line: 2436, col: 1, offset: 91089},
+			expr: &actionExpr{
+				pos: position{line: 2436, col: 15, offset: 91103},
+				run: (*parser).callonApostrophe1,
+				expr: &litMatcher{
+					pos:        position{line: 2436, col: 15, offset: 91103},
+					val:        "`'",
+					ignoreCase: false,
+					want:       "\"`'\"",
+				},
+			},
+		},
+		{
+			name: "Copyright",
+			pos:  position{line: 2439, col: 1, offset: 91156},
+			expr: &actionExpr{
+				pos: position{line: 2439, col: 14, offset: 91169},
+				run: (*parser).callonCopyright1,
+				expr: &litMatcher{
+					pos:        position{line: 2439, col: 14, offset: 91169},
+					val:        "(C)",
+					ignoreCase: false,
+					want:       "\"(C)\"",
+				},
+			},
+		},
+		{
+			name: "Trademark",
+			pos:  position{line: 2442, col: 1, offset: 91223},
+			expr: &actionExpr{
+				pos: position{line: 2442, col: 14, offset: 91236},
+				run: (*parser).callonTrademark1,
+				expr: &litMatcher{
+					pos:        position{line: 2442, col: 14, offset: 91236},
+					val:        "(TM)",
+					ignoreCase: false,
+					want:       "\"(TM)\"",
+				},
+			},
+		},
+		{
+			name: "Registered",
+			pos:  position{line: 2445, col: 1, offset: 91291},
+			expr: &actionExpr{
+				pos: position{line: 2445, col: 15, offset: 91305},
+				run: (*parser).callonRegistered1,
+				expr: &litMatcher{
+					pos:        position{line: 2445, col: 15, offset: 91305},
+					val:        "(R)",
+					ignoreCase: false,
+					want:       "\"(R)\"",
+				},
+			},
+		},
+		{
+			name: "Ellipsis",
+			pos:  position{line: 2448, col: 1, offset: 91359},
+			expr: &actionExpr{
+				pos: position{line: 2448, col: 13, offset: 91371},
+				run: (*parser).callonEllipsis1,
+				expr: &litMatcher{
+					pos:        position{line: 2448, col: 13, offset: 91371},
+					val:        "...",
+					ignoreCase: false,
+					want:       "\"...\"",
+				},
+			},
+		},
+		{
+			name: "ImpliedApostrophe",
+			pos:  position{line: 2456, col: 1, offset: 91648},
+			expr: &actionExpr{
+				pos: position{line: 2456, col: 22, offset: 91669},
+				run: (*parser).callonImpliedApostrophe1,
+				expr: &seqExpr{
+					pos: position{line: 2456, col: 22, offset: 91669},
+					exprs: []interface{}{
+						&ruleRefExpr{
+							pos:  position{line: 2456, col: 22, offset: 91669},
+							name: "Alphanum",
+						},
+						&litMatcher{
+							pos:        position{line: 2456, col: 31, offset: 91678},
+							val:        "'",
+							ignoreCase: false,
+							want:       "\"'\"",
+						},
+						&andExpr{
+							pos: position{line: 2456, col: 35, offset: 91682},
+							expr: &charClassMatcher{
+								pos:        position{line: 2456, col: 36, offset: 91683},
+								val:        "[\\pL]",
+								classes:    []*unicode.RangeTable{rangeTable("L")},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Replacement",
+			pos:  position{line: 2466, col: 1, offset: 92051},
+			expr: &choiceExpr{
+				pos: position{line: 2466, col: 16, offset: 92066},
+				alternatives: []interface{}{
+					&ruleRefExpr{
+						pos:  position{line: 2466, col: 16, offset: 92066},
+						name: "EscapedSymbol",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2466, col: 32, offset: 92082},
+						name: "Symbol",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2466, col: 41, offset: 92091},
+						name: "EmDash",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2466, col: 50, offset: 92100},
+						name: "Arrow",
+					},
+					&ruleRefExpr{
+						pos:  position{line: 2466, col: 58, offset: 92108},
+						name: "CharacterReference",
+					},
+				},
+			},
+		},
+		{
+			name: "ReplacementExclusion",
+			pos:  position{line: 2470, col: 1, offset: 92324},
+			expr: &choiceExpr{
+				pos: position{line: 2470, col: 25, offset: 92348},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 2470, col: 25, offset: 92348},
+						run: (*parser).callonReplacementExclusion2,
+						expr: &seqExpr{
+							pos: position{line: 2470, col: 25, offset: 92348},
+							exprs: []interface{}{
+								&labeledExpr{
+									pos:   position{line: 2470, col: 25, offset: 92348},
+									label: "prefix",
+									expr: &actionExpr{
+										pos: position{line: 2470, col: 33, offset: 92356},
+										run: (*parser).callonReplacementExclusion5,
+										expr: &choiceExpr{
+											pos: position{line: 2470, col: 34, offset: 92357},
+											alternatives: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 2470, col: 34, offset: 92357},
+													name: "URL_SCHEME",
+												},
+												&litMatcher{
+													pos:        position{line: 2470, col: 47, offset: 92370},
+													val:        "link:",
+													ignoreCase: false,
+													want:       "\"link:\"",
+												},
+												&litMatcher{
+													pos:        position{line: 2470, col: 57, offset: 92380},
+													val:        "xref:",
+													ignoreCase: false,
+													want:       "\"xref:\"",
+												},
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 2471, col: 5, offset: 92445},
+									label: "path",
+									expr: &oneOrMoreExpr{
+										pos: position{line: 2471, col: 10, offset: 92450},
+										expr: &choiceExpr{
+											pos: position{line: 2471, col: 11, offset: 92451},
+											alternatives: []interface{}{
+												&actionExpr{
+													pos: position{line: 2471, col: 12, offset: 92452},
+													run: (*parser).callonReplacementExclusion13,
+													expr: &oneOrMoreExpr{
+														pos: position{line: 2471, col: 12, offset: 92452},
+														expr: &charClassMatcher{
+															pos:        position{line: 2471, col: 12, offset: 92452},
+															val:        "[^\\r\\n[\\]\\uFFFD ]",
+															chars:      []rune{'\r', '\n', '[', ']', '�', ' '},
+															ignoreCase: false,
+															inverted:   true,
+														},
+													},
+												},
+												&ruleRefExpr{
+													pos:  position{line: 2471, col: 84, offset: 92524},
+													name: "ElementPlaceHolder",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2473, col: 5, offset: 92622},
+						run: (*parser).callonReplacementExclusion17,
+						expr: &seqExpr{
+							pos: position{line: 2473, col: 5, offset: 92622},
+							exprs: []interface{}{
+								&choiceExpr{
+									pos: position{line: 2473, col: 6, offset: 92623},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 2473, col: 6, offset: 92623},
+											val:        "<<",
+											ignoreCase: false,
+											want:       "\"<<\"",
+										},
+										&seqExpr{
+											pos: position{line: 2473, col: 13, offset: 92630},
+											exprs: []interface{}{
+												&litMatcher{
+													pos:        position{line: 2473, col: 13, offset: 92630},
+													val:        "[[",
+													ignoreCase: false,
+													want:       "\"[[\"",
+												},
+												&zeroOrOneExpr{
+													pos: position{line: 2473, col: 18, offset: 92635},
+													expr: &litMatcher{
+														pos:        position{line: 2473, col: 18, offset: 92635},
+														val:        "[",
+														ignoreCase: false,
+														want:       "\"[\"",
+													},
+												},
+											},
+										},
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2473, col: 24, offset: 92641},
+									name: "ID",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EscapedSymbol",
+			pos:  position{line: 2477, col: 1, offset: 92699},
+			expr: &choiceExpr{
+				pos: position{line: 2477, col: 18, offset: 92716},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 2477, col: 18, offset: 92716},
+						run: (*parser).callonEscapedSymbol2,
+						expr: &seqExpr{
+							pos: position{line: 2477, col: 18, offset: 92716},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2477, col: 18, offset: 92716},
+									val:        "\\",
+									ignoreCase: false,
+									want:       "\"\\\\\"",
+								},
+								&choiceExpr{
+									pos: position{line: 2477, col: 24, offset: 92722},
+									alternatives: []interface{}{
+										&litMatcher{
+											pos:        position{line: 2477, col: 24, offset: 92722},
+											val:        "`'",
+											ignoreCase: false,
+											want:       "\"`'\"",
+										},
+										&litMatcher{
+											pos:        position{line: 2477, col: 31, offset: 92729},
+											val:        "(C)",
+											ignoreCase: false,
+											want:       "\"(C)\"",
+										},
+										&litMatcher{
+											pos:        position{line: 2477, col: 39, offset: 92737},
+											val:        "(TM)",
+											ignoreCase: false,
+											want:       "\"(TM)\"",
+										},
+										&litMatcher{
+											pos:        position{line: 2477, col: 48, offset: 92746},
+											val:        "(R)",
+											ignoreCase: false,
+											want:       "\"(R)\"",
+										},
+										&litMatcher{
+											pos:        position{line: 2477, col: 56, offset: 92754},
+											val:        "...",
+											ignoreCase: false,
+											want:       "\"...\"",
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2479, col: 5, offset: 92821},
+						run: (*parser).callonEscapedSymbol11,
+						expr: &seqExpr{
+							pos: position{line: 2479, col: 5, offset: 92821},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2479, col: 5, offset: 92821},
+									name: "Alphanum",
+								},
+								&litMatcher{
+									pos:        position{line: 2479, col: 14, offset: 92830},
+									val:        "\\'",
+									ignoreCase: false,
+									want:       "\"\\\\'\"",
+								},
+								&andExpr{
+									pos: position{line: 2479, col: 20, offset: 92836},
+									expr: &charClassMatcher{
+										pos:        position{line: 2479, col: 21, offset: 92837},
+										val:        "[\\pL]",
+										classes:    []*unicode.RangeTable{rangeTable("L")},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "EmDash",
+			pos:  position{line: 2485, col: 1, offset: 93078},
+			expr: &choiceExpr{
+				pos: position{line: 2485, col: 11, offset: 93088},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 2485, col: 11, offset: 93088},
+						run: (*parser).callonEmDash2,
+						expr: &seqExpr{
+							pos: position{line: 2485, col: 11, offset: 93088},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2485, col: 11, offset: 93088},
+									name: "Alphanum",
+								},
+								&litMatcher{
+									pos:        position{line: 2485, col: 20, offset: 93097},
+									val:        "--",
+									ignoreCase: false,
+									want:       "\"--\"",
+								},
+								&andExpr{
+									pos: position{line: 2485, col: 25, offset: 93102},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2485, col: 26, offset: 93103},
+										name: "Alphanum",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2487, col: 5, offset: 93211},
+						run: (*parser).callonEmDash8,
+						expr: &seqExpr{
+							pos: position{line: 2487, col: 5, offset: 93211},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2487, col: 5, offset: 93211},
+									name: "Alphanum",
+								},
+								&litMatcher{
+									pos:        position{line: 2487, col: 14, offset: 93220},
+									val:        "\\--",
+									ignoreCase: false,
+									want:       "\"\\\\--\"",
+								},
+								&andExpr{
+									pos: position{line: 2487, col: 21, offset: 93227},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2487, col: 22, offset: 93228},
+										name: "Alphanum",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2489, col: 5, offset: 93322},
+						run: (*parser).callonEmDash14,
+						expr: &seqExpr{
+							pos: position{line: 2489, col: 5, offset: 93322},
+							exprs: []interface{}{
+								&oneOrMoreExpr{
+									pos: position{line: 2489, col: 5, offset: 93322},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2489, col: 5, offset: 93322},
+										name: "Space",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 2489, col: 12, offset: 93329},
+									val:        "--",
+									ignoreCase: false,
+									want:       "\"--\"",
+								},
+								&choiceExpr{
+									pos: position{line: 2489, col: 18, offset: 93335},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 2489, col: 18, offset: 93335},
+											name: "Space",
+										},
+										&andExpr{
+											pos: position{line: 2489, col: 26, offset: 93343},
+											expr: &ruleRefExpr{
+												pos:  position{line: 2489, col: 27, offset: 93344},
+												name: "Newline",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2489, col: 37, offset: 93354},
+											name: "EOF",
+										},
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2492, col: 5, offset: 93513},
+						run: (*parser).callonEmDash24,
+						expr: &seqExpr{
+							pos: position{line: 2492, col: 5, offset: 93513},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2492, col: 5, offset: 93513},
+									val:        "\\--",
+									ignoreCase: false,
+									want:       "\"\\\\--\"",
+								},
+								&choiceExpr{
+									pos: position{line: 2492, col: 13, offset: 93521},
+									alternatives: []interface{}{
+										&ruleRefExpr{
+											pos:  position{line: 2492, col: 13, offset: 93521},
+											name: "Space",
+										},
+										&andExpr{
+											pos: position{line: 2492, col: 21, offset: 93529},
+											expr: &ruleRefExpr{
+												pos:  position{line: 2492, col: 22, offset: 93530},
+												name: "Newline",
+											},
+										},
+										&ruleRefExpr{
+											pos:  position{line: 2492, col: 32, offset: 93540},
+											name: "EOF",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LeadingEmDash",
+			pos:  position{line: 2497, col: 1, offset: 93660},
+			expr: &actionExpr{
+				pos: position{line: 2497, col: 18, offset: 93677},
+				run: (*parser).callonLeadingEmDash1,
+				expr: &seqExpr{
+					pos: position{line: 2497, col: 18, offset: 93677},
+					exprs: []interface{}{
+						&litMatcher{
+							pos:        position{line: 2497, col: 18, offset: 93677},
+							val:        "--",
+							ignoreCase: false,
+							want:       "\"--\"",
+						},
+						&choiceExpr{
+							pos: position{line: 2497, col: 24, offset: 93683},
+							alternatives: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2497, col: 24, offset: 93683},
+									name: "Space",
+								},
+								&andExpr{
+									pos: position{line: 2497, col: 32, offset: 93691},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2497, col: 33, offset: 93692},
+										name: "Newline",
+									},
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2497, col: 43, offset: 93702},
+									name: "EOF",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Arrow",
+			pos:  position{line: 2503, col: 1, offset: 93927},
+			expr: &choiceExpr{
+				pos: position{line: 2503, col: 10, offset: 93936},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 2503, col: 10, offset: 93936},
+						run: (*parser).callonArrow2,
+						expr: &seqExpr{
+							pos: position{line: 2503, col: 10, offset: 93936},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2503, col: 10, offset: 93936},
+									val:        "-",
+									ignoreCase: false,
+									want:       "\"-\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2503, col: 14, offset: 93940},
+									name: "GreaterThan",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2505, col: 5, offset: 94002},
+						run: (*parser).callonArrow6,
+						expr: &seqExpr{
+							pos: position{line: 2505, col: 5, offset: 94002},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2505, col: 5, offset: 94002},
+									val:        "=",
+									ignoreCase: false,
+									want:       "\"=\"",
+								},
+								&ruleRefExpr{
+									pos:  position{line: 2505, col: 9, offset: 94006},
+									name: "GreaterThan",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2507, col: 5, offset: 94068},
+						run: (*parser).callonArrow10,
+						expr: &seqExpr{
+							pos: position{line: 2507, col: 5, offset: 94068},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2507, col: 5, offset: 94068},
+									name: "LessThan",
+								},
+								&litMatcher{
+									pos:        position{line: 2507, col: 14, offset: 94077},
+									val:        "-",
+									ignoreCase: false,
+									want:       "\"-\"",
+								},
+								&notExpr{
+									pos: position{line: 2507, col: 18, offset: 94081},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2507, col: 19, offset: 94082},
+										name: "GreaterThan",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2509, col: 5, offset: 94144},
+						run: (*parser).callonArrow16,
+						expr: &seqExpr{
+							pos: position{line: 2509, col: 5, offset: 94144},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2509, col: 5, offset: 94144},
+									name: "LessThan",
+								},
+								&litMatcher{
+									pos:        position{line: 2509, col: 14, offset: 94153},
+									val:        "=",
+									ignoreCase: false,
+									want:       "\"=\"",
+								},
+								&notExpr{
+									pos: position{line: 2509, col: 18, offset: 94157},
+									expr: &ruleRefExpr{
+										pos:  position{line: 2509, col: 19, offset: 94158},
+										name: "GreaterThan",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2511, col: 5, offset: 94220},
+						run: (*parser).callonArrow22,
+						expr: &seqExpr{
+							pos: position{line: 2511, col: 5, offset: 94220},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2511, col: 5, offset: 94220},
+									val:        "\\",
+									ignoreCase: false,
+									want:       "\"\\\\\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 2511, col: 10, offset: 94225},
+									label: "prefix",
+									expr: &choiceExpr{
+										pos: position{line: 2511, col: 18, offset: 94233},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 2511, col: 18, offset: 94233},
+												val:        "-",
+												ignoreCase: false,
+												want:       "\"-\"",
+											},
+											&litMatcher{
+												pos:        position{line: 2511, col: 24, offset: 94239},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 2511, col: 29, offset: 94244},
+									label: "gt",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2511, col: 33, offset: 94248},
+										name: "GreaterThan",
+									},
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2513, col: 5, offset: 94356},
+						run: (*parser).callonArrow31,
+						expr: &seqExpr{
+							pos: position{line: 2513, col: 5, offset: 94356},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2513, col: 5, offset: 94356},
+									val:        "\\",
+									ignoreCase: false,
+									want:       "\"\\\\\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 2513, col: 10, offset: 94361},
+									label: "lt",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2513, col: 14, offset: 94365},
+										name: "LessThan",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 2513, col: 24, offset: 94375},
+									label: "suffix",
+									expr: &choiceExpr{
+										pos: position{line: 2513, col: 32, offset: 94383},
+										alternatives: []interface{}{
+											&litMatcher{
+												pos:        position{line: 2513, col: 32, offset: 94383},
+												val:        "-",
+												ignoreCase: false,
+												want:       "\"-\"",
+											},
+											&litMatcher{
+												pos:        position{line: 2513, col: 38, offset: 94389},
+												val:        "=",
+												ignoreCase: false,
+												want:       "\"=\"",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "GreaterThan",
+			pos:  position{line: 2517, col: 1, offset: 94488},
+			expr: &choiceExpr{
+				pos: position{line: 2517, col: 16, offset: 94503},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 2517, col: 16, offset: 94503},
+						run: (*parser).callonGreaterThan2,
+						expr: &litMatcher{
+							pos:        position{line: 2517, col: 16, offset: 94503},
+							val:        ">",
+							ignoreCase: false,
+							want:       "\">\"",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2519, col: 5, offset: 94566},
+						run: (*parser).callonGreaterThan4,
+						expr: &seqExpr{
+							pos: position{line: 2519, col: 5, offset: 94566},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2519, col: 5, offset: 94566},
+									val:        "�",
+									ignoreCase: false,
+									want:       "\"�\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 2519, col: 14, offset: 94575},
+									label: "ref",
+									expr: &actionExpr{
+										pos: position{line: 2519, col: 19, offset: 94580},
+										run: (*parser).callonGreaterThan8,
+										expr: &oneOrMoreExpr{
+											pos: position{line: 2519, col: 19, offset: 94580},
+											expr: &charClassMatcher{
+												pos:        position{line: 2519, col: 19, offset: 94580},
+												val:        "[0-9]",
+												ranges:     []rune{'0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 2519, col: 58, offset: 94619},
+									val:        "�",
+									ignoreCase: false,
+									want:       "\"�\"",
+								},
+								&andCodeExpr{
+									pos: position{line: 2519, col: 67, offset: 94628},
+									run: (*parser).callonGreaterThan12,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "LessThan",
+			pos:  position{line: 2525, col: 1, offset: 94744},
+			expr: &choiceExpr{
+				pos: position{line: 2525, col: 13, offset: 94756},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 2525, col: 13, offset: 94756},
+						run: (*parser).callonLessThan2,
+						expr: &litMatcher{
+							pos:        position{line: 2525, col: 13, offset: 94756},
+							val:        "<",
+							ignoreCase: false,
+							want:       "\"<\"",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2527, col: 5, offset: 94819},
+						run: (*parser).callonLessThan4,
+						expr: &seqExpr{
+							pos: position{line: 2527, col: 5, offset: 94819},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2527, col: 5, offset: 94819},
+									val:        "�",
+									ignoreCase: false,
+									want:       "\"�\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 2527, col: 14, offset: 94828},
+									label: "ref",
+									expr: &actionExpr{
+										pos: position{line: 2527, col: 19, offset: 94833},
+										run: (*parser).callonLessThan8,
+										expr: &oneOrMoreExpr{
+											pos: position{line: 2527, col: 19, offset: 94833},
+											expr: &charClassMatcher{
+												pos:        position{line: 2527, col: 19, offset: 94833},
+												val:        "[0-9]",
+												ranges:     []rune{'0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 2527, col: 58, offset: 94872},
+									val:        "�",
+									ignoreCase: false,
+									want:       "\"�\"",
+								},
+								&andCodeExpr{
+									pos: position{line: 2527, col: 67, offset: 94881},
+									run: (*parser).callonLessThan12,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Ampersand",
+			pos:  position{line: 2533, col: 1, offset: 94997},
+			expr: &choiceExpr{
+				pos: position{line: 2533, col: 14, offset: 95010},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 2533, col: 14, offset: 95010},
+						run: (*parser).callonAmpersand2,
+						expr: &litMatcher{
+							pos:        position{line: 2533, col: 14, offset: 95010},
+							val:        "&",
+							ignoreCase: false,
+							want:       "\"&\"",
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2535, col: 5, offset: 95073},
+						run: (*parser).callonAmpersand4,
+						expr: &seqExpr{
+							pos: position{line: 2535, col: 5, offset: 95073},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2535, col: 5, offset: 95073},
+									val:        "�",
+									ignoreCase: false,
+									want:       "\"�\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 2535, col: 14, offset: 95082},
+									label: "ref",
+									expr: &actionExpr{
+										pos: position{line: 2535, col: 19, offset: 95087},
+										run: (*parser).callonAmpersand8,
+										expr: &oneOrMoreExpr{
+											pos: position{line: 2535, col: 19, offset: 95087},
+											expr: &charClassMatcher{
+												pos:        position{line: 2535, col: 19, offset: 95087},
+												val:        "[0-9]",
+												ranges:     []rune{'0', '9'},
+												ignoreCase: false,
+												inverted:   false,
+											},
+										},
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 2535, col: 58, offset: 95126},
+									val:        "�",
+									ignoreCase: false,
+									want:       "\"�\"",
+								},
+								&andCodeExpr{
+									pos: position{line: 2535, col: 67, offset: 95135},
+									run: (*parser).callonAmpersand12,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CharacterReference",
+			pos:  position{line: 2542, col: 1, offset: 95368},
+			expr: &choiceExpr{
+				pos: position{line: 2542, col: 23, offset: 95390},
+				alternatives: []interface{}{
+					&actionExpr{
+						pos: position{line: 2542, col: 23, offset: 95390},
+						run: (*parser).callonCharacterReference2,
+						expr: &seqExpr{
+							pos: position{line: 2542, col: 23, offset: 95390},
+							exprs: []interface{}{
+								&ruleRefExpr{
+									pos:  position{line: 2542, col: 23, offset: 95390},
+									name: "Ampersand",
+								},
+								&labeledExpr{
+									pos:   position{line: 2542, col: 33, offset: 95400},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2542, col: 39, offset: 95406},
+										name: "CharacterReferenceName",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 2542, col: 63, offset: 95430},
+									val:        ";",
+									ignoreCase: false,
+									want:       "\";\"",
+								},
+							},
+						},
+					},
+					&actionExpr{
+						pos: position{line: 2544, col: 5, offset: 95494},
+						run: (*parser).callonCharacterReference8,
+						expr: &seqExpr{
+							pos: position{line: 2544, col: 5, offset: 95494},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2544, col: 5, offset: 95494},
+									val:        "\\",
+									ignoreCase: false,
+									want:       "\"\\\\\"",
+								},
+								&labeledExpr{
+									pos:   position{line: 2544, col: 10, offset: 95499},
+									label: "amp",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2544, col: 15, offset: 95504},
+										name: "Ampersand",
+									},
+								},
+								&labeledExpr{
+									pos:   position{line: 2544, col: 26, offset: 95515},
+									label: "name",
+									expr: &ruleRefExpr{
+										pos:  position{line: 2544, col: 32, offset: 95521},
+										name: "CharacterReferenceName",
+									},
+								},
+								&litMatcher{
+									pos:        position{line: 2544, col: 56, offset: 95545},
+									val:        ";",
+									ignoreCase: false,
+									want:       "\";\"",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "CharacterReferenceName",
+			pos:  position{line: 2548, col: 1, offset: 95640},
+			expr: &actionExpr{
+				pos: position{line: 2548, col: 27, offset: 95666},
+				run: (*parser).callonCharacterReferenceName1,
+				expr: &choiceExpr{
+					pos: position{line: 2548, col: 28, offset: 95667},
+					alternatives: []interface{}{
+						&seqExpr{
+							pos: position{line: 2548, col: 28, offset: 95667},
+							exprs: []interface{}{
+								&charClassMatcher{
+									pos:        position{line: 2548, col: 28, offset: 95667},
+									val:        "[a-zA-Z]",
+									ranges:     []rune{'a', 'z', 'A', 'Z'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&oneOrMoreExpr{
+									pos: position{line: 2548, col: 37, offset: 95676},
+									expr: &charClassMatcher{
+										pos:        position{line: 2548, col: 37, offset: 95676},
+										val:        "[a-zA-Z]",
+										ranges:     []rune{'a', 'z', 'A', 'Z'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2548, col: 47, offset: 95686},
+									expr: &charClassMatcher{
+										pos:        position{line: 2548, col: 47, offset: 95686},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2548, col: 54, offset: 95693},
+									expr: &charClassMatcher{
+										pos:        position{line: 2548, col: 54, offset: 95693},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
+						},
+						&seqExpr{
+							pos: position{line: 2549, col: 11, offset: 95711},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2549, col: 11, offset: 95711},
+									val:        "#x",
+									ignoreCase: false,
+									want:       "\"#x\"",
+								},
+								&charClassMatcher{
+									pos:        position{line: 2549, col: 16, offset: 95716},
+									val:        "[0-9a-fA-F]",
+									ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 2549, col: 28, offset: 95728},
+									val:        "[0-9a-fA-F]",
+									ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2549, col: 40, offset: 95740},
+									expr: &charClassMatcher{
+										pos:        position{line: 2549, col: 40, offset: 95740},
+										val:        "[0-9a-fA-F]",
+										ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2549, col: 53, offset: 95753},
+									expr: &charClassMatcher{
+										pos:        position{line: 2549, col: 53, offset: 95753},
+										val:        "[0-9a-fA-F]",
+										ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2549, col: 66, offset: 95766},
+									expr: &charClassMatcher{
+										pos:        position{line: 2549, col: 66, offset: 95766},
+										val:        "[0-9a-fA-F]",
+										ranges:     []rune{'0', '9', 'a', 'f', 'A', 'F'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+							},
 						},
-						&andExpr{
-							pos: position{line: 2160, col: 35, offset: 78612},
-							expr: &charClassMatcher{
-								pos:        position{line: 2160, col: 36, offset: 78613},
-								val:        "[\\pL]",
-								classes:    []*unicode.RangeTable{rangeTable("L")},
-								ignoreCase: false,
-								inverted:   false,
+						&seqExpr{
+							pos: position{line: 2550, col: 11, offset: 95790},
+							exprs: []interface{}{
+								&litMatcher{
+									pos:        position{line: 2550, col: 11, offset: 95790},
+									val:        "#",
+									ignoreCase: false,
+									want:       "\"#\"",
+								},
+								&charClassMatcher{
+									pos:        position{line: 2550, col: 15, offset: 95794},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&charClassMatcher{
+									pos:        position{line: 2550, col: 21, offset: 95800},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2550, col: 27, offset: 95806},
+									expr: &charClassMatcher{
+										pos:        position{line: 2550, col: 27, offset: 95806},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2550, col: 34, offset: 95813},
+									expr: &charClassMatcher{
+										pos:        position{line: 2550, col: 34, offset: 95813},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2550, col: 41, offset: 95820},
+									expr: &charClassMatcher{
+										pos:        position{line: 2550, col: 41, offset: 95820},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
+								&zeroOrOneExpr{
+									pos: position{line: 2550, col: 48, offset: 95827},
+									expr: &charClassMatcher{
+										pos:        position{line: 2550, col: 48, offset: 95827},
+										val:        "[0-9]",
+										ranges:     []rune{'0', '9'},
+										ignoreCase: false,
+										inverted:   false,
+									},
+								},
 							},
 						},
 					},
@@ -15642,38 +19271,38 @@ var g = &grammar{
 		},
 		{
 			name: "SpecialCharacter",
-			pos:  position{line: 2169, col: 1, offset: 78975},
+			pos:  position{line: 2559, col: 1, offset: 96159},
 			expr: &choiceExpr{
-				pos: position{line: 2169, col: 21, offset: 78995},
+				pos: position{line: 2559, col: 21, offset: 96179},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 2169, col: 21, offset: 78995},
+						pos: position{line: 2559, col: 21, offset: 96179},
 						run: (*parser).callonSpecialCharacter2,
 						expr: &ruleRefExpr{
-							pos:  position{line: 2169, col: 21, offset: 78995},
+							pos:  position{line: 2559, col: 21, offset: 96179},
 							name: "InternalCrossReference",
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2172, col: 9, offset: 79158},
+						pos: position{line: 2562, col: 9, offset: 96342},
 						run: (*parser).callonSpecialCharacter4,
 						expr: &choiceExpr{
-							pos: position{line: 2172, col: 10, offset: 79159},
+							pos: position{line: 2562, col: 10, offset: 96343},
 							alternatives: []interface{}{
 								&litMatcher{
-									pos:        position{line: 2172, col: 10, offset: 79159},
+									pos:        position{line: 2562, col: 10, offset: 96343},
 									val:        "<",
 									ignoreCase: false,
 									want:       "\"<\"",
 								},
 								&litMatcher{
-									pos:        position{line: 2172, col: 16, offset: 79165},
+									pos:        position{line: 2562, col: 16, offset: 96349},
 									val:        ">",
 									ignoreCase: false,
 									want:       "\">\"",
 								},
 								&litMatcher{
-									pos:        position{line: 2172, col: 22, offset: 79171},
+									pos:        position{line: 2562, col: 22, offset: 96355},
 									val:        "&",
 									ignoreCase: false,
 									want:       "\"&\"",
@@ -15686,9 +19315,9 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanum",
-			pos:  position{line: 2179, col: 1, offset: 79349},
+			pos:  position{line: 2569, col: 1, offset: 96533},
 			expr: &charClassMatcher{
-				pos:        position{line: 2179, col: 13, offset: 79361},
+				pos:        position{line: 2569, col: 13, offset: 96545},
 				val:        "[\\pL0-9]",
 				ranges:     []rune{'0', '9'},
 				classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -15698,42 +19327,42 @@ var g = &grammar{
 		},
 		{
 			name: "Parenthesis",
-			pos:  position{line: 2181, col: 1, offset: 79371},
+			pos:  position{line: 2571, col: 1, offset: 96555},
 			expr: &choiceExpr{
-				pos: position{line: 2181, col: 16, offset: 79386},
+				pos: position{line: 2571, col: 16, offset: 96570},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 2181, col: 16, offset: 79386},
+						pos:        position{line: 2571, col: 16, offset: 96570},
 						val:        "(",
 						ignoreCase: false,
 						want:       "\"(\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2181, col: 22, offset: 79392},
+						pos:        position{line: 2571, col: 22, offset: 96576},
 						val:        ")",
 						ignoreCase: false,
 						want:       "\")\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2181, col: 28, offset: 79398},
+						pos:        position{line: 2571, col: 28, offset: 96582},
 						val:        "[",
 						ignoreCase: false,
 						want:       "\"[\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2181, col: 34, offset: 79404},
+						pos:        position{line: 2571, col: 34, offset: 96588},
 						val:        "]",
 						ignoreCase: false,
 						want:       "\"]\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2181, col: 40, offset: 79410},
+						pos:        position{line: 2571, col: 40, offset: 96594},
 						val:        "{",
 						ignoreCase: false,
 						want:       "\"{\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2181, col: 46, offset: 79416},
+						pos:        position{line: 2571, col: 46, offset: 96600},
 						val:        "}",
 						ignoreCase: false,
 						want:       "\"}\"",
@@ -15743,14 +19372,14 @@ var g = &grammar{
 		},
 		{
 			name: "Alphanums",
-			pos:  position{line: 2183, col: 1, offset: 79422},
+			pos:  position{line: 2573, col: 1, offset: 96606},
 			expr: &actionExpr{
-				pos: position{line: 2183, col: 14, offset: 79435},
+				pos: position{line: 2573, col: 14, offset: 96619},
 				run: (*parser).callonAlphanums1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 2183, col: 14, offset: 79435},
+					pos: position{line: 2573, col: 14, offset: 96619},
 					expr: &charClassMatcher{
-						pos:        position{line: 2183, col: 14, offset: 79435},
+						pos:        position{line: 2573, col: 14, offset: 96619},
 						val:        "[\\pL0-9]",
 						ranges:     []rune{'0', '9'},
 						classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -15762,20 +19391,20 @@ var g = &grammar{
 		},
 		{
 			name: "Word",
-			pos:  position{line: 2187, col: 1, offset: 79481},
+			pos:  position{line: 2577, col: 1, offset: 96665},
 			expr: &choiceExpr{
-				pos: position{line: 2191, col: 5, offset: 79808},
+				pos: position{line: 2581, col: 5, offset: 96992},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 2191, col: 5, offset: 79808},
+						pos: position{line: 2581, col: 5, offset: 96992},
 						run: (*parser).callonWord2,
 						expr: &seqExpr{
-							pos: position{line: 2191, col: 5, offset: 79808},
+							pos: position{line: 2581, col: 5, offset: 96992},
 							exprs: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 2191, col: 5, offset: 79808},
+									pos: position{line: 2581, col: 5, offset: 96992},
 									expr: &charClassMatcher{
-										pos:        position{line: 2191, col: 5, offset: 79808},
+										pos:        position{line: 2581, col: 5, offset: 96992},
 										val:        "[\\pL0-9]",
 										ranges:     []rune{'0', '9'},
 										classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -15784,19 +19413,19 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 2191, col: 15, offset: 79818},
+									pos: position{line: 2581, col: 15, offset: 97002},
 									expr: &choiceExpr{
-										pos: position{line: 2191, col: 17, offset: 79820},
+										pos: position{line: 2581, col: 17, offset: 97004},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 2191, col: 17, offset: 79820},
+												pos:        position{line: 2581, col: 17, offset: 97004},
 												val:        "[\\r\\n ,\\]]",
 												chars:      []rune{'\r', '\n', ' ', ',', ']'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2191, col: 30, offset: 79833},
+												pos:  position{line: 2581, col: 30, offset: 97017},
 												name: "EOF",
 											},
 										},
@@ -15806,15 +19435,15 @@ var g = &grammar{
 						},
 					},
 					&actionExpr{
-						pos: position{line: 2193, col: 9, offset: 79903},
+						pos: position{line: 2583, col: 9, offset: 97087},
 						run: (*parser).callonWord10,
 						expr: &seqExpr{
-							pos: position{line: 2193, col: 9, offset: 79903},
+							pos: position{line: 2583, col: 9, offset: 97087},
 							exprs: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 2193, col: 9, offset: 79903},
+									pos: position{line: 2583, col: 9, offset: 97087},
 									expr: &charClassMatcher{
-										pos:        position{line: 2193, col: 9, offset: 79903},
+										pos:        position{line: 2583, col: 9, offset: 97087},
 										val:        "[\\pL0-9]",
 										ranges:     []rune{'0', '9'},
 										classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -15823,21 +19452,21 @@ var g = &grammar{
 									},
 								},
 								&oneOrMoreExpr{
-									pos: position{line: 2193, col: 19, offset: 79913},
+									pos: position{line: 2583, col: 19, offset: 97097},
 									expr: &seqExpr{
-										pos: position{line: 2193, col: 20, offset: 79914},
+										pos: position{line: 2583, col: 20, offset: 97098},
 										exprs: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 2193, col: 20, offset: 79914},
+												pos:        position{line: 2583, col: 20, offset: 97098},
 												val:        "[=*_`]",
 												chars:      []rune{'=', '*', '_', '`'},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&oneOrMoreExpr{
-												pos: position{line: 2193, col: 27, offset: 79921},
+												pos: position{line: 2583, col: 27, offset: 97105},
 												expr: &charClassMatcher{
-													pos:        position{line: 2193, col: 27, offset: 79921},
+													pos:        position{line: 2583, col: 27, offset: 97105},
 													val:        "[\\pL0-9]",
 													ranges:     []rune{'0', '9'},
 													classes:    []*unicode.RangeTable{rangeTable("L")},
@@ -15856,20 +19485,20 @@ var g = &grammar{
 		},
 		{
 			name: "InlineWord",
-			pos:  position{line: 2197, col: 1, offset: 79997},
+			pos:  position{line: 2587, col: 1, offset: 97181},
 			expr: &choiceExpr{
-				pos: position{line: 2198, col: 5, offset: 80078},
+				pos: position{line: 2588, col: 5, offset: 97262},
 				alternatives: []interface{}{
 					&actionExpr{
-						pos: position{line: 2198, col: 5, offset: 80078},
+						pos: position{line: 2588, col: 5, offset: 97262},
 						run: (*parser).callonInlineWord2,
 						expr: &seqExpr{
-							pos: position{line: 2198, col: 5, offset: 80078},
+							pos: position{line: 2588, col: 5, offset: 97262},
 							exprs: []interface{}{
 								&oneOrMoreExpr{
-									pos: position{line: 2198, col: 5, offset: 80078},
+									pos: position{line: 2588, col: 5, offset: 97262},
 									expr: &charClassMatcher{
-										pos:        position{line: 2198, col: 5, offset: 80078},
+										pos:        position{line: 2588, col: 5, offset: 97262},
 										val:        "[\\pL0-9,?!;]",
 										chars:      []rune{',', '?', '!', ';'},
 										ranges:     []rune{'0', '9'},
@@ -15879,19 +19508,19 @@ var g = &grammar{
 									},
 								},
 								&andExpr{
-									pos: position{line: 2198, col: 19, offset: 80092},
+									pos: position{line: 2588, col: 19, offset: 97276},
 									expr: &choiceExpr{
-										pos: position{line: 2198, col: 21, offset: 80094},
+										pos: position{line: 2588, col: 21, offset: 97278},
 										alternatives: []interface{}{
 											&charClassMatcher{
-												pos:        position{line: 2198, col: 21, offset: 80094},
+												pos:        position{line: 2588, col: 21, offset: 97278},
 												val:        "[\\r\\n ]",
 												chars:      []rune{'\r', '\n', ' '},
 												ignoreCase: false,
 												inverted:   false,
 											},
 											&ruleRefExpr{
-												pos:  position{line: 2198, col: 31, offset: 80104},
+												pos:  position{line: 2588, col: 31, offset: 97288},
 												name: "EOF",
 											},
 										},
@@ -15901,7 +19530,7 @@ var g = &grammar{
 						},
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2200, col: 9, offset: 80173},
+						pos:  position{line: 2590, col: 9, offset: 97357},
 						name: "Word",
 					},
 				},
@@ -15909,12 +19538,12 @@ var g = &grammar{
 		},
 		{
 			name: "AnyChar",
-			pos:  position{line: 2203, col: 1, offset: 80273},
+			pos:  position{line: 2593, col: 1, offset: 97457},
 			expr: &actionExpr{
-				pos: position{line: 2203, col: 12, offset: 80284},
+				pos: position{line: 2593, col: 12, offset: 97468},
 				run: (*parser).callonAnyChar1,
 				expr: &charClassMatcher{
-					pos:        position{line: 2203, col: 12, offset: 80284},
+					pos:        position{line: 2593, col: 12, offset: 97468},
 					val:        "[^\\r\\n]",
 					chars:      []rune{'\r', '\n'},
 					ignoreCase: false,
@@ -15924,24 +19553,24 @@ var g = &grammar{
 		},
 		{
 			name: "FileLocation",
-			pos:  position{line: 2207, col: 1, offset: 80349},
+			pos:  position{line: 2597, col: 1, offset: 97533},
 			expr: &actionExpr{
-				pos: position{line: 2207, col: 17, offset: 80365},
+				pos: position{line: 2597, col: 17, offset: 97549},
 				run: (*parser).callonFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 2207, col: 17, offset: 80365},
+					pos:   position{line: 2597, col: 17, offset: 97549},
 					label: "path",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 2207, col: 22, offset: 80370},
+						pos: position{line: 2597, col: 22, offset: 97554},
 						expr: &choiceExpr{
-							pos: position{line: 2207, col: 23, offset: 80371},
+							pos: position{line: 2597, col: 23, offset: 97555},
 							alternatives: []interface{}{
 								&ruleRefExpr{
-									pos:  position{line: 2207, col: 23, offset: 80371},
+									pos:  position{line: 2597, col: 23, offset: 97555},
 									name: "FILENAME",
 								},
 								&ruleRefExpr{
-									pos:  position{line: 2207, col: 34, offset: 80382},
+									pos:  position{line: 2597, col: 34, offset: 97566},
 									name: "ElementPlaceHolder",
 								},
 							},
@@ -15952,17 +19581,17 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedFileLocation",
-			pos:  position{line: 2211, col: 1, offset: 80463},
+			pos:  position{line: 2601, col: 1, offset: 97647},
 			expr: &actionExpr{
-				pos: position{line: 2211, col: 25, offset: 80487},
+				pos: position{line: 2601, col: 25, offset: 97671},
 				run: (*parser).callonResolvedFileLocation1,
 				expr: &labeledExpr{
-					pos:   position{line: 2211, col: 25, offset: 80487},
+					pos:   position{line: 2601, col: 25, offset: 97671},
 					label: "path",
 					expr: &oneOrMoreExpr{
-						pos: position{line: 2211, col: 30, offset: 80492},
+						pos: position{line: 2601, col: 30, offset: 97676},
 						expr: &charClassMatcher{
-							pos:        position{line: 2211, col: 31, offset: 80493},
+							pos:        position{line: 2601, col: 31, offset: 97677},
 							val:        "[^\\r\\n []",
 							chars:      []rune{'\r', '\n', ' ', '['},
 							ignoreCase: false,
@@ -15974,38 +19603,38 @@ var g = &grammar{
 		},
 		{
 			name: "Location",
-			pos:  position{line: 2215, col: 1, offset: 80565},
+			pos:  position{line: 2605, col: 1, offset: 97749},
 			expr: &actionExpr{
-				pos: position{line: 2215, col: 13, offset: 80577},
+				pos: position{line: 2605, col: 13, offset: 97761},
 				run: (*parser).callonLocation1,
 				expr: &seqExpr{
-					pos: position{line: 2215, col: 13, offset: 80577},
+					pos: position{line: 2605, col: 13, offset: 97761},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 2215, col: 13, offset: 80577},
+							pos:   position{line: 2605, col: 13, offset: 97761},
 							label: "scheme",
 							expr: &zeroOrOneExpr{
-								pos: position{line: 2215, col: 20, offset: 80584},
+								pos: position{line: 2605, col: 20, offset: 97768},
 								expr: &ruleRefExpr{
-									pos:  position{line: 2215, col: 21, offset: 80585},
+									pos:  position{line: 2605, col: 21, offset: 97769},
 									name: "URL_SCHEME",
 								},
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2215, col: 34, offset: 80598},
+							pos:   position{line: 2605, col: 34, offset: 97782},
 							label: "path",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 2215, col: 39, offset: 80603},
+								pos: position{line: 2605, col: 39, offset: 97787},
 								expr: &choiceExpr{
-									pos: position{line: 2215, col: 40, offset: 80604},
+									pos: position{line: 2605, col: 40, offset: 97788},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 2215, col: 40, offset: 80604},
+											pos:  position{line: 2605, col: 40, offset: 97788},
 											name: "FILENAME",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2215, col: 52, offset: 80616},
+											pos:  position{line: 2605, col: 52, offset: 97800},
 											name: "ElementPlaceHolder",
 										},
 									},
@@ -16018,35 +19647,35 @@ var g = &grammar{
 		},
 		{
 			name: "LocationWithScheme",
-			pos:  position{line: 2219, col: 1, offset: 80701},
+			pos:  position{line: 2609, col: 1, offset: 97885},
 			expr: &actionExpr{
-				pos: position{line: 2219, col: 23, offset: 80723},
+				pos: position{line: 2609, col: 23, offset: 97907},
 				run: (*parser).callonLocationWithScheme1,
 				expr: &seqExpr{
-					pos: position{line: 2219, col: 23, offset: 80723},
+					pos: position{line: 2609, col: 23, offset: 97907},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 2219, col: 23, offset: 80723},
+							pos:   position{line: 2609, col: 23, offset: 97907},
 							label: "scheme",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2219, col: 31, offset: 80731},
+								pos:  position{line: 2609, col: 31, offset: 97915},
 								name: "URL_SCHEME",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2219, col: 43, offset: 80743},
+							pos:   position{line: 2609, col: 43, offset: 97927},
 							label: "path",
 							expr: &oneOrMoreExpr{
-								pos: position{line: 2219, col: 48, offset: 80748},
+								pos: position{line: 2609, col: 48, offset: 97932},
 								expr: &choiceExpr{
-									pos: position{line: 2219, col: 49, offset: 80749},
+									pos: position{line: 2609, col: 49, offset: 97933},
 									alternatives: []interface{}{
 										&ruleRefExpr{
-											pos:  position{line: 2219, col: 49, offset: 80749},
+											pos:  position{line: 2609, col: 49, offset: 97933},
 											name: "FILENAME",
 										},
 										&ruleRefExpr{
-											pos:  position{line: 2219, col: 60, offset: 80760},
+											pos:  position{line: 2609, col: 60, offset: 97944},
 											name: "ElementPlaceHolder",
 										},
 									},
@@ -16057,13 +19686,167 @@ var g = &grammar{
 				},
 			},
 		},
+		{
+			name: "BareLocationWithScheme",
+			pos:  position{line: 2616, col: 1, offset: 98402},
+			expr: &actionExpr{
+				pos: position{line: 2616, col: 27, offset: 98428},
+				run: (*parser).callonBareLocationWithScheme1,
+				expr: &seqExpr{
+					pos: position{line: 2616, col: 27, offset: 98428},
+					exprs: []interface{}{
+						&labeledExpr{
+							pos:   position{line: 2616, col: 27, offset: 98428},
+							label: "scheme",
+							expr: &ruleRefExpr{
+								pos:  position{line: 2616, col: 35, offset: 98436},
+								name: "URL_SCHEME",
+							},
+						},
+						&labeledExpr{
+							pos:   position{line: 2616, col: 47, offset: 98448},
+							label: "path",
+							expr: &oneOrMoreExpr{
+								pos: position{line: 2616, col: 52, offset: 98453},
+								expr: &ruleRefExpr{
+									pos:  position{line: 2616, col: 53, offset: 98454},
+									name: "BareLocationElement",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "BareLocationElement",
+			pos:  position{line: 2620, col: 1, offset: 98540},
+			expr: &seqExpr{
+				pos: position{line: 2620, col: 24, offset: 98563},
+				exprs: []interface{}{
+					&zeroOrOneExpr{
+						pos: position{line: 2620, col: 24, offset: 98563},
+						expr: &oneOrMoreExpr{
+							pos: position{line: 2620, col: 25, offset: 98564},
+							expr: &charClassMatcher{
+								pos:        position{line: 2620, col: 25, offset: 98564},
+								val:        "[,.?!)]",
+								chars:      []rune{',', '.', '?', '!', ')'},
+								ignoreCase: false,
+								inverted:   false,
+							},
+						},
+					},
+					&choiceExpr{
+						pos: position{line: 2620, col: 37, offset: 98576},
+						alternatives: []interface{}{
+							&oneOrMoreExpr{
+								pos: position{line: 2620, col: 37, offset: 98576},
+								expr: &charClassMatcher{
+									pos:        position{line: 2620, col: 37, offset: 98576},
+									val:        "[^\\r\\n[\\]\\uFFFD <>,.?!)]",
+									chars:      []rune{'\r', '\n', '[', ']', '�', ' ', '<', '>', ',', '.', '?', '!', ')'},
+									ignoreCase: false,
+									inverted:   true,
+								},
+							},
+							&seqExpr{
+								pos: position{line: 2620, col: 65, offset: 98604},
+								exprs: []interface{}{
+									&notExpr{
+										pos: position{line: 2620, col: 65, offset: 98604},
+										expr: &ruleRefExpr{
+											pos:  position{line: 2620, col: 66, offset: 98605},
+											name: "LessThan",
+										},
+									},
+									&notExpr{
+										pos: position{line: 2620, col: 75, offset: 98614},
+										expr: &seqExpr{
+											pos: position{line: 2620, col: 77, offset: 98616},
+											exprs: []interface{}{
+												&ruleRefExpr{
+													pos:  position{line: 2620, col: 77, offset: 98616},
+													name: "GreaterThan",
+												},
+												&notExpr{
+													pos: position{line: 2620, col: 89, offset: 98628},
+													expr: &ruleRefExpr{
+														pos:  position{line: 2620, col: 90, offset: 98629},
+														name: "Alphanum",
+													},
+												},
+											},
+										},
+									},
+									&notExpr{
+										pos: position{line: 2620, col: 100, offset: 98639},
+										expr: &ruleRefExpr{
+											pos:  position{line: 2620, col: 101, offset: 98640},
+											name: "QuotedTextPlaceHolder",
+										},
+									},
+									&ruleRefExpr{
+										pos:  position{line: 2620, col: 123, offset: 98662},
+										name: "ElementPlaceHolder",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "QuotedTextPlaceHolder",
+			pos:  position{line: 2622, col: 1, offset: 98683},
+			expr: &seqExpr{
+				pos: position{line: 2622, col: 26, offset: 98708},
+				exprs: []interface{}{
+					&litMatcher{
+						pos:        position{line: 2622, col: 26, offset: 98708},
+						val:        "�",
+						ignoreCase: false,
+						want:       "\"�\"",
+					},
+					&labeledExpr{
+						pos:   position{line: 2622, col: 35, offset: 98717},
+						label: "ref",
+						expr: &actionExpr{
+							pos: position{line: 2622, col: 40, offset: 98722},
+							run: (*parser).callonQuotedTextPlaceHolder4,
+							expr: &oneOrMoreExpr{
+								pos: position{line: 2622, col: 40, offset: 98722},
+								expr: &charClassMatcher{
+									pos:        position{line: 2622, col: 40, offset: 98722},
+									val:        "[0-9]",
+									ranges:     []rune{'0', '9'},
+									ignoreCase: false,
+									inverted:   false,
+								},
+							},
+						},
+					},
+					&litMatcher{
+						pos:        position{line: 2622, col: 79, offset: 98761},
+						val:        "�",
+						ignoreCase: false,
+						want:       "\"�\"",
+					},
+					&andCodeExpr{
+						pos: position{line: 2622, col: 88, offset: 98770},
+						run: (*parser).callonQuotedTextPlaceHolder8,
+					},
+				},
+			},
+		},
 		{
 			name: "FILENAME",
-			pos:  position{line: 2223, col: 1, offset: 80845},
+			pos:  position{line: 2626, col: 1, offset: 98816},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 2223, col: 13, offset: 80857},
+				pos: position{line: 2626, col: 13, offset: 98828},
 				expr: &charClassMatcher{
-					pos:        position{line: 2223, col: 14, offset: 80858},
+					pos:        position{line: 2626, col: 14, offset: 98829},
 					val:        "[^\\r\\n[\\]\\uFFFD ]",
 					chars:      []rune{'\r', '\n', '[', ']', '�', ' '},
 					ignoreCase: false,
@@ -16073,26 +19856,26 @@ var g = &grammar{
 		},
 		{
 			name: "ResolvedLocation",
-			pos:  position{line: 2225, col: 1, offset: 80926},
+			pos:  position{line: 2628, col: 1, offset: 98897},
 			expr: &actionExpr{
-				pos: position{line: 2225, col: 21, offset: 80946},
+				pos: position{line: 2628, col: 21, offset: 98917},
 				run: (*parser).callonResolvedLocation1,
 				expr: &seqExpr{
-					pos: position{line: 2225, col: 21, offset: 80946},
+					pos: position{line: 2628, col: 21, offset: 98917},
 					exprs: []interface{}{
 						&labeledExpr{
-							pos:   position{line: 2225, col: 21, offset: 80946},
+							pos:   position{line: 2628, col: 21, offset: 98917},
 							label: "scheme",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2225, col: 29, offset: 80954},
+								pos:  position{line: 2628, col: 29, offset: 98925},
 								name: "URL_SCHEME",
 							},
 						},
 						&labeledExpr{
-							pos:   position{line: 2225, col: 41, offset: 80966},
+							pos:   position{line: 2628, col: 41, offset: 98937},
 							label: "path",
 							expr: &ruleRefExpr{
-								pos:  position{line: 2225, col: 47, offset: 80972},
+								pos:  position{line: 2628, col: 47, offset: 98943},
 								name: "RESOLVED_FILENAME",
 							},
 						},
@@ -16102,11 +19885,11 @@ var g = &grammar{
 		},
 		{
 			name: "RESOLVED_FILENAME",
-			pos:  position{line: 2230, col: 1, offset: 81220},
+			pos:  position{line: 2633, col: 1, offset: 99191},
 			expr: &oneOrMoreExpr{
-				pos: position{line: 2230, col: 22, offset: 81241},
+				pos: position{line: 2633, col: 22, offset: 99212},
 				expr: &charClassMatcher{
-					pos:        position{line: 2230, col: 23, offset: 81242},
+					pos:        position{line: 2633, col: 23, offset: 99213},
 					val:        "[^\\r\\n[\\] ]",
 					chars:      []rune{'\r', '\n', '[', ']', ' '},
 					ignoreCase: false,
@@ -16116,14 +19899,14 @@ var g = &grammar{
 		},
 		{
 			name: "URL",
-			pos:  position{line: 2232, col: 1, offset: 81374},
+			pos:  position{line: 2635, col: 1, offset: 99345},
 			expr: &actionExpr{
-				pos: position{line: 2232, col: 9, offset: 81382},
+				pos: position{line: 2635, col: 9, offset: 99353},
 				run: (*parser).callonURL1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 2232, col: 9, offset: 81382},
+					pos: position{line: 2635, col: 9, offset: 99353},
 					expr: &charClassMatcher{
-						pos:        position{line: 2232, col: 9, offset: 81382},
+						pos:        position{line: 2635, col: 9, offset: 99353},
 						val:        "[^\\r\\n[\\]]",
 						chars:      []rune{'\r', '\n', '[', ']'},
 						ignoreCase: false,
@@ -16134,36 +19917,36 @@ var g = &grammar{
 		},
 		{
 			name: "URL_SCHEME",
-			pos:  position{line: 2236, col: 1, offset: 81430},
+			pos:  position{line: 2639, col: 1, offset: 99401},
 			expr: &choiceExpr{
-				pos: position{line: 2236, col: 15, offset: 81444},
+				pos: position{line: 2639, col: 15, offset: 99415},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 2236, col: 15, offset: 81444},
+						pos:        position{line: 2639, col: 15, offset: 99415},
 						val:        "http://",
 						ignoreCase: false,
 						want:       "\"http://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2236, col: 27, offset: 81456},
+						pos:        position{line: 2639, col: 27, offset: 99427},
 						val:        "https://",
 						ignoreCase: false,
 						want:       "\"https://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2236, col: 40, offset: 81469},
+						pos:        position{line: 2639, col: 40, offset: 99440},
 						val:        "ftp://",
 						ignoreCase: false,
 						want:       "\"ftp://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2236, col: 51, offset: 81480},
+						pos:        position{line: 2639, col: 51, offset: 99451},
 						val:        "irc://",
 						ignoreCase: false,
 						want:       "\"irc://\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2236, col: 62, offset: 81491},
+						pos:        position{line: 2639, col: 62, offset: 99462},
 						val:        "mailto:",
 						ignoreCase: false,
 						want:       "\"mailto:\"",
@@ -16173,14 +19956,14 @@ var g = &grammar{
 		},
 		{
 			name: "ID",
-			pos:  position{line: 2238, col: 1, offset: 81502},
+			pos:  position{line: 2641, col: 1, offset: 99473},
 			expr: &actionExpr{
-				pos: position{line: 2238, col: 7, offset: 81508},
+				pos: position{line: 2641, col: 7, offset: 99479},
 				run: (*parser).callonID1,
 				expr: &oneOrMoreExpr{
-					pos: position{line: 2238, col: 7, offset: 81508},
+					pos: position{line: 2641, col: 7, offset: 99479},
 					expr: &charClassMatcher{
-						pos:        position{line: 2238, col: 7, offset: 81508},
+						pos:        position{line: 2641, col: 7, offset: 99479},
 						val:        "[^[\\]<>,]",
 						chars:      []rune{'[', ']', '<', '>', ','},
 						ignoreCase: false,
@@ -16191,12 +19974,12 @@ var g = &grammar{
 		},
 		{
 			name: "DIGIT",
-			pos:  position{line: 2242, col: 1, offset: 81633},
+			pos:  position{line: 2645, col: 1, offset: 99604},
 			expr: &actionExpr{
-				pos: position{line: 2242, col: 10, offset: 81642},
+				pos: position{line: 2645, col: 10, offset: 99613},
 				run: (*parser).callonDIGIT1,
 				expr: &charClassMatcher{
-					pos:        position{line: 2242, col: 10, offset: 81642},
+					pos:        position{line: 2645, col: 10, offset: 99613},
 					val:        "[0-9]",
 					ranges:     []rune{'0', '9'},
 					ignoreCase: false,
@@ -16206,26 +19989,26 @@ var g = &grammar{
 		},
 		{
 			name: "NUMBER",
-			pos:  position{line: 2246, col: 1, offset: 81684},
+			pos:  position{line: 2649, col: 1, offset: 99655},
 			expr: &actionExpr{
-				pos: position{line: 2246, col: 11, offset: 81694},
+				pos: position{line: 2649, col: 11, offset: 99665},
 				run: (*parser).callonNUMBER1,
 				expr: &seqExpr{
-					pos: position{line: 2246, col: 11, offset: 81694},
+					pos: position{line: 2649, col: 11, offset: 99665},
 					exprs: []interface{}{
 						&zeroOrOneExpr{
-							pos: position{line: 2246, col: 11, offset: 81694},
+							pos: position{line: 2649, col: 11, offset: 99665},
 							expr: &litMatcher{
-								pos:        position{line: 2246, col: 11, offset: 81694},
+								pos:        position{line: 2649, col: 11, offset: 99665},
 								val:        "-",
 								ignoreCase: false,
 								want:       "\"-\"",
 							},
 						},
 						&oneOrMoreExpr{
-							pos: position{line: 2246, col: 16, offset: 81699},
+							pos: position{line: 2649, col: 16, offset: 99670},
 							expr: &ruleRefExpr{
-								pos:  position{line: 2246, col: 16, offset: 81699},
+								pos:  position{line: 2649, col: 16, offset: 99670},
 								name: "DIGIT",
 							},
 						},
@@ -16235,21 +20018,21 @@ var g = &grammar{
 		},
 		{
 			name: "Space",
-			pos:  position{line: 2250, col: 1, offset: 81751},
+			pos:  position{line: 2653, col: 1, offset: 99722},
 			expr: &choiceExpr{
-				pos: position{line: 2250, col: 10, offset: 81760},
+				pos: position{line: 2653, col: 10, offset: 99731},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 2250, col: 10, offset: 81760},
+						pos:        position{line: 2653, col: 10, offset: 99731},
 						val:        " ",
 						ignoreCase: false,
 						want:       "\" \"",
 					},
 					&actionExpr{
-						pos: position{line: 2250, col: 16, offset: 81766},
+						pos: position{line: 2653, col: 16, offset: 99737},
 						run: (*parser).callonSpace3,
 						expr: &litMatcher{
-							pos:        position{line: 2250, col: 16, offset: 81766},
+							pos:        position{line: 2653, col: 16, offset: 99737},
 							val:        "\t",
 							ignoreCase: false,
 							want:       "\"\\t\"",
@@ -16260,24 +20043,24 @@ var g = &grammar{
 		},
 		{
 			name: "Newline",
-			pos:  position{line: 2254, col: 1, offset: 81807},
+			pos:  position{line: 2657, col: 1, offset: 99778},
 			expr: &choiceExpr{
-				pos: position{line: 2254, col: 12, offset: 81818},
+				pos: position{line: 2657, col: 12, offset: 99789},
 				alternatives: []interface{}{
 					&litMatcher{
-						pos:        position{line: 2254, col: 12, offset: 81818},
+						pos:        position{line: 2657, col: 12, offset: 99789},
 						val:        "\r\n",
 						ignoreCase: false,
 						want:       "\"\\r\\n\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2254, col: 21, offset: 81827},
+						pos:        position{line: 2657, col: 21, offset: 99798},
 						val:        "\r",
 						ignoreCase: false,
 						want:       "\"\\r\"",
 					},
 					&litMatcher{
-						pos:        position{line: 2254, col: 28, offset: 81834},
+						pos:        position{line: 2657, col: 28, offset: 99805},
 						val:        "\n",
 						ignoreCase: false,
 						want:       "\"\\n\"",
@@ -16287,31 +20070,45 @@ var g = &grammar{
 		},
 		{
 			name: "EOF",
-			pos:  position{line: 2256, col: 1, offset: 81840},
+			pos:  position{line: 2659, col: 1, offset: 99811},
 			expr: &notExpr{
-				pos: position{line: 2256, col: 8, offset: 81847},
+				pos: position{line: 2659, col: 8, offset: 99818},
 				expr: &anyMatcher{
-					line: 2256, col: 9, offset: 81848,
+					line: 2659, col: 9, offset: 99819,
 				},
 			},
 		},
 		{
 			name: "EOL",
-			pos:  position{line: 2258, col: 1, offset: 81851},
+			pos:  position{line: 2661, col: 1, offset: 99822},
 			expr: &choiceExpr{
-				pos: position{line: 2258, col: 8, offset: 81858},
+				pos: position{line: 2661, col: 8, offset: 99829},
 				alternatives: []interface{}{
 					&ruleRefExpr{
-						pos:  position{line: 2258, col: 8, offset: 81858},
+						pos:  position{line: 2661, col: 8, offset: 99829},
 						name: "Newline",
 					},
 					&ruleRefExpr{
-						pos:  position{line: 2258, col: 18, offset: 81868},
+						pos:  position{line: 2661, col: 18, offset: 99839},
 						name: "EOF",
 					},
 				},
 			},
 		},
+		{
+			name: "SourcePosition",
+			pos:  position{line: 2664, col: 1, offset: 99927},
+			expr: &actionExpr{
+				pos: position{line: 2664, col: 19, offset: 99945},
+				run: (*parser).callonSourcePosition1,
+				expr: &litMatcher{
+					pos:        position{line: 2664, col: 19, offset: 99945},
+					val:        "",
+					ignoreCase: false,
+					want:       "\"\"",
+				},
+			},
+		},
 	},
 }
 
@@ -16406,6 +20203,29 @@ func (p *parser) callonRawDocument1() (interface{}, error) {
 	return p.cur.onRawDocument1(stack["frontmatter"], stack["blocks"])
 }
 
+func (c *current) onRawBlocks12() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonRawBlocks12() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRawBlocks12()
+}
+
+func (c *current) onRawBlocks10(block interface{}) (interface{}, error) {
+
+	c.recordBlock()
+	return block, nil
+
+}
+
+func (p *parser) callonRawBlocks10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onRawBlocks10(stack["block"])
+}
+
 func (c *current) onRawBlocks1(header, blocks interface{}) (interface{}, error) {
 	if header != nil && blocks != nil {
 		return append([]interface{}{header}, blocks.([]interface{})...), nil
@@ -16441,14 +20261,14 @@ func (p *parser) callonYamlFrontMatterContent1() (interface{}, error) {
 	return p.cur.onYamlFrontMatterContent1()
 }
 
-func (c *current) onDocumentHeader1(title, id, authors, revision interface{}) (interface{}, error) {
-	return types.NewDocumentHeader(title.([]interface{}), authors, revision)
+func (c *current) onDocumentHeader1(pos, title, id, authors, revision interface{}) (interface{}, error) {
+	return types.NewDocumentHeader(title.([]interface{}), authors, revision, pos.(types.Position))
 }
 
 func (p *parser) callonDocumentHeader1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onDocumentHeader1(stack["title"], stack["id"], stack["authors"], stack["revision"])
+	return p.cur.onDocumentHeader1(stack["pos"], stack["title"], stack["id"], stack["authors"], stack["revision"])
 }
 
 func (c *current) onDocumentAuthorsInlineForm1(authors interface{}) (interface{}, error) {
@@ -16646,7 +20466,7 @@ func (p *parser) callonAttributeReset11() (interface{}, error) {
 }
 
 func (c *current) onAttrSub1(name interface{}) (interface{}, error) {
-	return types.NewAttributeSubstitution(name.(string))
+	return types.NewAttributeSubstitution(name.(string), c.position())
 }
 
 func (p *parser) callonAttrSub1() (interface{}, error) {
@@ -16776,6 +20596,26 @@ func (p *parser) callonInlineElementID1() (interface{}, error) {
 	return p.cur.onInlineElementID1(stack["id"])
 }
 
+func (c *current) onBibliographyAnchor8(label interface{}) (interface{}, error) {
+	return label, nil
+}
+
+func (p *parser) callonBibliographyAnchor8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBibliographyAnchor8(stack["label"])
+}
+
+func (c *current) onBibliographyAnchor1(id, label interface{}) (interface{}, error) {
+	return types.NewBibliographyAnchor(id.(string), label)
+}
+
+func (p *parser) callonBibliographyAnchor1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBibliographyAnchor1(stack["id"], stack["label"])
+}
+
 func (c *current) onElementTitle1(title interface{}) (interface{}, error) {
 	return types.NewElementTitle(title.(string))
 }
@@ -16893,14 +20733,24 @@ func (p *parser) callonListingBlockAttribute1() (interface{}, error) {
 	return p.cur.onListingBlockAttribute1()
 }
 
-func (c *current) onAdmonitionMarkerAttribute1(k interface{}) (interface{}, error) {
-	return types.NewAdmonitionAttribute(k.(types.AdmonitionKind))
+func (c *current) onAdmonitionMarkerAttribute8(attrs interface{}) (interface{}, error) {
+	return attrs, nil
+}
+
+func (p *parser) callonAdmonitionMarkerAttribute8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionMarkerAttribute8(stack["attrs"])
+}
+
+func (c *current) onAdmonitionMarkerAttribute1(k, others interface{}) (interface{}, error) {
+	return types.NewAdmonitionAttribute(k.(types.AdmonitionKind), others)
 }
 
 func (p *parser) callonAdmonitionMarkerAttribute1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionMarkerAttribute1(stack["k"])
+	return p.cur.onAdmonitionMarkerAttribute1(stack["k"], stack["others"])
 }
 
 func (c *current) onSourceAttributes6(attr interface{}) (interface{}, error) {
@@ -17327,40 +21177,85 @@ func (p *parser) callonAttrValueNone1() (interface{}, error) {
 	return p.cur.onAttrValueNone1()
 }
 
-func (c *current) onSection7() (interface{}, error) {
+func (c *current) onSection9() (interface{}, error) {
 
 	// `=` is level 0, etc.
 	return (len(c.text) - 1), nil
 
 }
 
-func (p *parser) callonSection7() (interface{}, error) {
+func (p *parser) callonSection9() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection7()
+	return p.cur.onSection9()
 }
 
-func (c *current) onSection10(attributes, level interface{}) (bool, error) {
+func (c *current) onSection12(attributes, pos, level interface{}) (bool, error) {
 
 	// use a predicate to make sure that only `=` (level 0) to `======` (level 5) are allowed
 	return level.(int) <= 5, nil
 
 }
 
-func (p *parser) callonSection10() (bool, error) {
+func (p *parser) callonSection12() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection10(stack["attributes"], stack["level"])
+	return p.cur.onSection12(stack["attributes"], stack["pos"], stack["level"])
 }
 
-func (c *current) onSection1(attributes, level, title, id interface{}) (interface{}, error) {
-	return types.NewSection(level.(int), title.([]interface{}), id.([]interface{}), attributes)
+func (c *current) onSection1(attributes, pos, level, title, id interface{}) (interface{}, error) {
+	return types.NewSection(level.(int), title.([]interface{}), id.([]interface{}), attributes, pos.(types.Position))
 }
 
 func (p *parser) callonSection1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onSection1(stack["attributes"], stack["level"], stack["title"], stack["id"])
+	return p.cur.onSection1(stack["attributes"], stack["pos"], stack["level"], stack["title"], stack["id"])
+}
+
+func (c *current) onDiscreteHeading6(attributes interface{}) (bool, error) {
+	return isDiscreteHeading(attributes)
+
+}
+
+func (p *parser) callonDiscreteHeading6() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading6(stack["attributes"])
+}
+
+func (c *current) onDiscreteHeading8() (interface{}, error) {
+
+	return (len(c.text) - 1), nil
+
+}
+
+func (p *parser) callonDiscreteHeading8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading8()
+}
+
+func (c *current) onDiscreteHeading11(attributes, level interface{}) (bool, error) {
+
+	return level.(int) <= 5, nil
+
+}
+
+func (p *parser) callonDiscreteHeading11() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading11(stack["attributes"], stack["level"])
+}
+
+func (c *current) onDiscreteHeading1(attributes, level, title, id interface{}) (interface{}, error) {
+	return types.NewDiscreteHeading(level.(int), title.([]interface{}), id.([]interface{}), attributes)
+}
+
+func (p *parser) callonDiscreteHeading1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDiscreteHeading1(stack["attributes"], stack["level"], stack["title"], stack["id"])
 }
 
 func (c *current) onTitleElements1(elements interface{}) (interface{}, error) {
@@ -18174,54 +22069,84 @@ func (p *parser) callonLabeledListItemDescription1() (interface{}, error) {
 	return p.cur.onLabeledListItemDescription1(stack["elements"])
 }
 
-func (c *current) onAdmonitionKind2() (interface{}, error) {
-	return types.Tip, nil
+func (c *current) onAdmonitionKind5() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonAdmonitionKind5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind5()
+}
+
+func (c *current) onAdmonitionKind10(name interface{}) (bool, error) {
+	return c.isCustomAdmonition(name.(string))
+}
+
+func (p *parser) callonAdmonitionKind10() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind10(stack["name"])
+}
+
+func (c *current) onAdmonitionKind2(name interface{}) (interface{}, error) {
+	return types.AdmonitionKind(strings.ToLower(name.(string))), nil
 }
 
 func (p *parser) callonAdmonitionKind2() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind2()
+	return p.cur.onAdmonitionKind2(stack["name"])
 }
 
-func (c *current) onAdmonitionKind4() (interface{}, error) {
+func (c *current) onAdmonitionKind11() (interface{}, error) {
+	return types.Tip, nil
+}
+
+func (p *parser) callonAdmonitionKind11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAdmonitionKind11()
+}
+
+func (c *current) onAdmonitionKind13() (interface{}, error) {
 	return types.Note, nil
 }
 
-func (p *parser) callonAdmonitionKind4() (interface{}, error) {
+func (p *parser) callonAdmonitionKind13() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind4()
+	return p.cur.onAdmonitionKind13()
 }
 
-func (c *current) onAdmonitionKind6() (interface{}, error) {
+func (c *current) onAdmonitionKind15() (interface{}, error) {
 	return types.Important, nil
 }
 
-func (p *parser) callonAdmonitionKind6() (interface{}, error) {
+func (p *parser) callonAdmonitionKind15() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind6()
+	return p.cur.onAdmonitionKind15()
 }
 
-func (c *current) onAdmonitionKind8() (interface{}, error) {
+func (c *current) onAdmonitionKind17() (interface{}, error) {
 	return types.Warning, nil
 }
 
-func (p *parser) callonAdmonitionKind8() (interface{}, error) {
+func (p *parser) callonAdmonitionKind17() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind8()
+	return p.cur.onAdmonitionKind17()
 }
 
-func (c *current) onAdmonitionKind10() (interface{}, error) {
+func (c *current) onAdmonitionKind19() (interface{}, error) {
 	return types.Caution, nil
 }
 
-func (p *parser) callonAdmonitionKind10() (interface{}, error) {
+func (p *parser) callonAdmonitionKind19() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onAdmonitionKind10()
+	return p.cur.onAdmonitionKind19()
 }
 
 func (c *current) onRawParagraph2(attributes, t, lines interface{}) (interface{}, error) {
@@ -18461,24 +22386,55 @@ func (p *parser) callonInlineElements1() (interface{}, error) {
 	return p.cur.onInlineElements1(stack["elements"])
 }
 
-func (c *current) onInlineElement1(element interface{}) (interface{}, error) {
-	return element, nil
+func (c *current) onInlineElement3() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonInlineElement3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElement3()
+}
+
+func (c *current) onInlineElement1(element interface{}) (interface{}, error) {
+	return element, nil
+}
+
+func (p *parser) callonInlineElement1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineElement1(stack["element"])
+}
+
+func (c *current) onLineBreak1() (interface{}, error) {
+	return types.NewLineBreak()
+}
+
+func (p *parser) callonLineBreak1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLineBreak1()
+}
+
+func (c *current) onQuotedText3() (bool, error) {
+	return c.checkContext()
 }
 
-func (p *parser) callonInlineElement1() (interface{}, error) {
+func (p *parser) callonQuotedText3() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInlineElement1(stack["element"])
+	return p.cur.onQuotedText3()
 }
 
-func (c *current) onLineBreak1() (interface{}, error) {
-	return types.NewLineBreak()
+func (c *current) onQuotedText1(text interface{}) (interface{}, error) {
+	// quoted texts may be deeply nested
+	return text, nil
 }
 
-func (p *parser) callonLineBreak1() (interface{}, error) {
+func (p *parser) callonQuotedText1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onLineBreak1()
+	return p.cur.onQuotedText1(stack["text"])
 }
 
 func (c *current) onConstrainedQuotedText1(text interface{}) (interface{}, error) {
@@ -18533,6 +22489,16 @@ func (p *parser) callonDoubleQuoteBoldText1() (interface{}, error) {
 	return p.cur.onDoubleQuoteBoldText1(stack["attrs"], stack["elements"])
 }
 
+func (c *current) onDoubleQuoteBoldTextElements2() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonDoubleQuoteBoldTextElements2() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteBoldTextElements2()
+}
+
 func (c *current) onDoubleQuoteBoldTextElement1(element interface{}) (interface{}, error) {
 	return element, nil
 }
@@ -18576,6 +22542,16 @@ func (p *parser) callonSingleQuoteBoldText17() (interface{}, error) {
 	return p.cur.onSingleQuoteBoldText17(stack["attrs"], stack["elements"])
 }
 
+func (c *current) onSingleQuoteBoldTextElements2() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonSingleQuoteBoldTextElements2() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteBoldTextElements2()
+}
+
 func (c *current) onSingleQuoteBoldTextFallbackCharacter3() (interface{}, error) {
 	// or a bold delimiter when immediately followed by an alphanum (ie, in the middle of some text)
 	return types.NewStringElement(string(c.text))
@@ -18634,6 +22610,16 @@ func (p *parser) callonDoubleQuoteItalicText1() (interface{}, error) {
 	return p.cur.onDoubleQuoteItalicText1(stack["attrs"], stack["elements"])
 }
 
+func (c *current) onDoubleQuoteItalicTextElements2() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonDoubleQuoteItalicTextElements2() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteItalicTextElements2()
+}
+
 func (c *current) onDoubleQuoteItalicTextElement1(element interface{}) (interface{}, error) {
 	return element, nil
 }
@@ -18677,6 +22663,16 @@ func (p *parser) callonSingleQuoteItalicText14() (interface{}, error) {
 	return p.cur.onSingleQuoteItalicText14(stack["attrs"], stack["elements"])
 }
 
+func (c *current) onSingleQuoteItalicTextElements2() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonSingleQuoteItalicTextElements2() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteItalicTextElements2()
+}
+
 func (c *current) onSingleQuoteItalicTextFallbackCharacter3() (interface{}, error) {
 	// or an italic delimiter when immediately followed by an alphanum (ie, in the middle of some text)
 	return types.NewStringElement(string(c.text))
@@ -18735,6 +22731,16 @@ func (p *parser) callonDoubleQuoteMonospaceText1() (interface{}, error) {
 	return p.cur.onDoubleQuoteMonospaceText1(stack["attrs"], stack["elements"])
 }
 
+func (c *current) onDoubleQuoteMonospaceTextElements2() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonDoubleQuoteMonospaceTextElements2() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteMonospaceTextElements2()
+}
+
 func (c *current) onDoubleQuoteMonospaceTextElement14() (interface{}, error) {
 	// must be before SingleQuoteMonospaceText
 	// do not convert to apostrophe (yet)
@@ -18791,6 +22797,16 @@ func (p *parser) callonSingleQuoteMonospaceText14() (interface{}, error) {
 	return p.cur.onSingleQuoteMonospaceText14(stack["attrs"], stack["elements"])
 }
 
+func (c *current) onSingleQuoteMonospaceTextElements2() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonSingleQuoteMonospaceTextElements2() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteMonospaceTextElements2()
+}
+
 func (c *current) onSingleQuoteMonospaceTextElement22() (interface{}, error) {
 	// do not convert to apostrophe (yet)
 	return types.NewStringElement(string(c.text))
@@ -18941,6 +22957,16 @@ func (p *parser) callonDoubleQuoteMarkedText1() (interface{}, error) {
 	return p.cur.onDoubleQuoteMarkedText1(stack["attrs"], stack["elements"])
 }
 
+func (c *current) onDoubleQuoteMarkedTextElements2() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonDoubleQuoteMarkedTextElements2() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDoubleQuoteMarkedTextElements2()
+}
+
 func (c *current) onDoubleQuoteMarkedTextFallbackCharacter3() (interface{}, error) {
 	// or a marked delimiter when immediately followed by an alphanum (ie, in the middle of some text)
 	return types.NewStringElement(string(c.text))
@@ -18974,6 +23000,16 @@ func (p *parser) callonSingleQuoteMarkedText14() (interface{}, error) {
 	return p.cur.onSingleQuoteMarkedText14(stack["attrs"], stack["elements"])
 }
 
+func (c *current) onSingleQuoteMarkedTextElements2() (bool, error) {
+	return c.checkContext()
+}
+
+func (p *parser) callonSingleQuoteMarkedTextElements2() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSingleQuoteMarkedTextElements2()
+}
+
 func (c *current) onSingleQuoteMarkedTextFallbackCharacter3() (interface{}, error) {
 	// or a mark delimiter when immediately followed by an alphanum (ie, in the middle of some text)
 	return types.NewStringElement(string(c.text))
@@ -19119,6 +23155,16 @@ func (p *parser) callonSinglePlusPassthroughContent24() (interface{}, error) {
 	return p.cur.onSinglePlusPassthroughContent24()
 }
 
+func (c *current) onAlphanumsWithPlus1() (interface{}, error) {
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonAlphanumsWithPlus1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAlphanumsWithPlus1()
+}
+
 func (c *current) onTriplePlusPassthrough1(content interface{}) (interface{}, error) {
 	return types.NewInlinePassthrough(types.TriplePlusPassthrough, []interface{}{content})
 }
@@ -19171,6 +23217,36 @@ func (p *parser) callonPassthroughMacro9() (interface{}, error) {
 	return p.cur.onPassthroughMacro9(stack["content"])
 }
 
+func (c *current) onInlineStem4() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonInlineStem4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineStem4()
+}
+
+func (c *current) onInlineStem1(kind, content interface{}) (interface{}, error) {
+	return types.NewInlineStem(kind.(string), content.(string))
+}
+
+func (p *parser) callonInlineStem1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineStem1(stack["kind"], stack["content"])
+}
+
+func (c *current) onInlineStemContent1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonInlineStemContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineStemContent1()
+}
+
 func (c *current) onPassthroughMacroCharacter1() (interface{}, error) {
 	return types.NewStringElement(string(c.text))
 }
@@ -19182,7 +23258,7 @@ func (p *parser) callonPassthroughMacroCharacter1() (interface{}, error) {
 }
 
 func (c *current) onInternalCrossReference2(id, label interface{}) (interface{}, error) {
-	return types.NewInternalCrossReference(id.(string), label.(string))
+	return types.NewInternalCrossReference(id.(string), label.(string), c.position())
 }
 
 func (p *parser) callonInternalCrossReference2() (interface{}, error) {
@@ -19192,7 +23268,7 @@ func (p *parser) callonInternalCrossReference2() (interface{}, error) {
 }
 
 func (c *current) onInternalCrossReference13(id interface{}) (interface{}, error) {
-	return types.NewInternalCrossReference(id.(string), nil)
+	return types.NewInternalCrossReference(id.(string), nil, c.position())
 }
 
 func (p *parser) callonInternalCrossReference13() (interface{}, error) {
@@ -19221,14 +23297,77 @@ func (p *parser) callonRelativeLink1() (interface{}, error) {
 	return p.cur.onRelativeLink1(stack["url"], stack["inlineAttributes"])
 }
 
-func (c *current) onExternalLink1(url, inlineAttributes interface{}) (interface{}, error) {
-	return types.NewInlineLink(url.(types.Location), inlineAttributes)
+func (c *current) onExternalLink2(url, inlineAttributes interface{}) (interface{}, error) {
+	return types.NewInlineLink(url.(types.Location), inlineAttributes.(types.Attributes))
+}
+
+func (p *parser) callonExternalLink2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExternalLink2(stack["url"], stack["inlineAttributes"])
+}
+
+func (c *current) onExternalLink8(url interface{}) (interface{}, error) {
+	return types.NewInlineLink(url.(types.Location), nil)
+}
+
+func (p *parser) callonExternalLink8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExternalLink8(stack["url"])
+}
+
+func (c *current) onExternalLink11(url interface{}) (interface{}, error) {
+	// eg: `<https://example.com>`
+	return types.NewInlineLink(url.(types.Location), nil)
+}
+
+func (p *parser) callonExternalLink11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onExternalLink11(stack["url"])
+}
+
+func (c *current) onInlineEmail2(address interface{}) (interface{}, error) {
+
+	return types.NewStringElement(address.(string))
+}
+
+func (p *parser) callonInlineEmail2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineEmail2(stack["address"])
+}
+
+func (c *current) onInlineEmail7() (interface{}, error) {
+	// not an email address when preceded by `:` or `/`
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonInlineEmail7() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineEmail7()
+}
+
+func (c *current) onInlineEmail11(address interface{}) (interface{}, error) {
+	return types.NewEmailLink(address.(string))
+}
+
+func (p *parser) callonInlineEmail11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineEmail11(stack["address"])
+}
+
+func (c *current) onEmailAddress1() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonExternalLink1() (interface{}, error) {
+func (p *parser) callonEmailAddress1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onExternalLink1(stack["url"], stack["inlineAttributes"])
+	return p.cur.onEmailAddress1()
 }
 
 func (c *current) onLinkAttributes1(firstAttr, otherattrs interface{}) (interface{}, error) {
@@ -19326,19 +23465,19 @@ func (p *parser) callonResolvedExternalLink1() (interface{}, error) {
 	return p.cur.onResolvedExternalLink1(stack["url"], stack["inlineAttributes"])
 }
 
-func (c *current) onImageBlock1(attributes, path, inlineAttrs interface{}) (interface{}, error) {
+func (c *current) onImageBlock1(attributes, pos, path, inlineAttrs interface{}) (interface{}, error) {
 	// 'imagesdir' attribute is added after applying the attribute substitutions on the image location
-	return types.NewImageBlock(path.(types.Location), inlineAttrs.(types.Attributes), attributes)
+	return types.NewImageBlock(path.(types.Location), inlineAttrs.(types.Attributes), attributes, pos.(types.Position))
 }
 
 func (p *parser) callonImageBlock1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onImageBlock1(stack["attributes"], stack["path"], stack["inlineAttrs"])
+	return p.cur.onImageBlock1(stack["attributes"], stack["pos"], stack["path"], stack["inlineAttrs"])
 }
 
 func (c *current) onInlineImage1(path, inlineAttrs interface{}) (interface{}, error) {
-	return types.NewInlineImage(path.(types.Location), inlineAttrs.(types.Attributes), c.globalStore["imagesdir"])
+	return types.NewInlineImage(path.(types.Location), inlineAttrs.(types.Attributes), c.globalStore["imagesdir"], c.position())
 }
 
 func (p *parser) callonInlineImage1() (interface{}, error) {
@@ -19381,96 +23520,248 @@ func (c *current) onImageWidth1(value interface{}) (interface{}, error) {
 	return types.NewInlineAttribute(types.AttrWidth, value)
 }
 
-func (p *parser) callonImageWidth1() (interface{}, error) {
+func (p *parser) callonImageWidth1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onImageWidth1(stack["value"])
+}
+
+func (c *current) onImageHeight1(value interface{}) (interface{}, error) {
+	return types.NewInlineAttribute(types.AttrImageHeight, value)
+}
+
+func (p *parser) callonImageHeight1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onImageHeight1(stack["value"])
+}
+
+func (c *current) onImageAltAttr1(value interface{}) (interface{}, error) {
+	return types.NewElementNamedAttr(types.AttrImageAlt, value)
+}
+
+func (p *parser) callonImageAltAttr1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onImageAltAttr1(stack["value"])
+}
+
+func (c *current) onImageWidthAttr1(value interface{}) (interface{}, error) {
+	if value != nil {
+		return types.NewElementNamedAttr(types.AttrWidth, value)
+	}
+	return nil, nil
+}
+
+func (p *parser) callonImageWidthAttr1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onImageWidthAttr1(stack["value"])
+}
+
+func (c *current) onImageHeightAttr1(value interface{}) (interface{}, error) {
+	if value != nil {
+		return types.NewElementNamedAttr(types.AttrImageHeight, value)
+	}
+	return nil, nil
+}
+
+func (p *parser) callonImageHeightAttr1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onImageHeightAttr1(stack["value"])
+}
+
+func (c *current) onVideoBlock1(attributes, path, inlineAttrs interface{}) (interface{}, error) {
+	// 'imagesdir' attribute is added after applying the attribute substitutions on the video location
+	return types.NewVideoBlock(path.(types.Location), inlineAttrs.(types.Attributes), attributes)
+}
+
+func (p *parser) callonVideoBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVideoBlock1(stack["attributes"], stack["path"], stack["inlineAttrs"])
+}
+
+func (c *current) onVideoAttrs1(poster, w, h, nv interface{}) (interface{}, error) {
+	return types.NewElementAttributes(poster, w, h, nv)
+}
+
+func (p *parser) callonVideoAttrs1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVideoAttrs1(stack["poster"], stack["w"], stack["h"], stack["nv"])
+}
+
+func (c *current) onVideoPoster1(value interface{}) (interface{}, error) {
+	return types.NewInlineAttribute(types.AttrPoster, value)
+}
+
+func (p *parser) callonVideoPoster1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onVideoPoster1(stack["value"])
+}
+
+func (c *current) onAudioBlock1(attributes, path, inlineAttrs interface{}) (interface{}, error) {
+	// 'imagesdir' attribute is added after applying the attribute substitutions on the audio location
+	return types.NewAudioBlock(path.(types.Location), inlineAttrs.(types.Attributes), attributes)
+}
+
+func (p *parser) callonAudioBlock1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAudioBlock1(stack["attributes"], stack["path"], stack["inlineAttrs"])
+}
+
+func (c *current) onAudioAttrs1(nv interface{}) (interface{}, error) {
+	return types.NewElementAttributes(nv)
+}
+
+func (p *parser) callonAudioAttrs1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAudioAttrs1(stack["nv"])
+}
+
+func (c *current) onInlineIcon5() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonInlineIcon5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineIcon5()
+}
+
+func (c *current) onInlineIcon1(icon, attrs interface{}) (interface{}, error) {
+	return types.NewIcon(icon.(string), attrs.(types.Attributes))
+}
+
+func (p *parser) callonInlineIcon1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineIcon1(stack["icon"], stack["attrs"])
+}
+
+func (c *current) onIconAttrs1(size, nv interface{}) (interface{}, error) {
+	return types.NewElementAttributes(size, nv)
+}
+
+func (p *parser) callonIconAttrs1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIconAttrs1(stack["size"], stack["nv"])
+}
+
+func (c *current) onIconSize1(value interface{}) (interface{}, error) {
+	return types.NewInlineAttribute(types.AttrIconSize, value)
+}
+
+func (p *parser) callonIconSize1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onIconSize1(stack["value"])
+}
+
+func (c *current) onInlineUIMacro3() (bool, error) {
+	return c.isExperimental()
+}
+
+func (p *parser) callonInlineUIMacro3() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onInlineUIMacro3()
+}
+
+func (c *current) onInlineUIMacro1(element interface{}) (interface{}, error) {
+	return element, nil
+}
+
+func (p *parser) callonInlineUIMacro1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onImageWidth1(stack["value"])
+	return p.cur.onInlineUIMacro1(stack["element"])
 }
 
-func (c *current) onImageHeight1(value interface{}) (interface{}, error) {
-	return types.NewInlineAttribute(types.AttrImageHeight, value)
+func (c *current) onInlineKeyboard1(content interface{}) (interface{}, error) {
+	return c.newInlineKeyboard(content.(string))
 }
 
-func (p *parser) callonImageHeight1() (interface{}, error) {
+func (p *parser) callonInlineKeyboard1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onImageHeight1(stack["value"])
+	return p.cur.onInlineKeyboard1(stack["content"])
 }
 
-func (c *current) onImageAltAttr1(value interface{}) (interface{}, error) {
-	return types.NewElementNamedAttr(types.AttrImageAlt, value)
+func (c *current) onInlineButton1(content interface{}) (interface{}, error) {
+	return c.newInlineButton(content.(string))
 }
 
-func (p *parser) callonImageAltAttr1() (interface{}, error) {
+func (p *parser) callonInlineButton1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onImageAltAttr1(stack["value"])
+	return p.cur.onInlineButton1(stack["content"])
 }
 
-func (c *current) onImageWidthAttr1(value interface{}) (interface{}, error) {
-	if value != nil {
-		return types.NewElementNamedAttr(types.AttrWidth, value)
-	}
-	return nil, nil
+func (c *current) onInlineMenu5() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonImageWidthAttr1() (interface{}, error) {
+func (p *parser) callonInlineMenu5() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onImageWidthAttr1(stack["value"])
+	return p.cur.onInlineMenu5()
 }
 
-func (c *current) onImageHeightAttr1(value interface{}) (interface{}, error) {
-	if value != nil {
-		return types.NewElementNamedAttr(types.AttrImageHeight, value)
-	}
-	return nil, nil
+func (c *current) onInlineMenu1(menu, content interface{}) (interface{}, error) {
+	return c.newInlineMenu(menu.(string), content.(string))
 }
 
-func (p *parser) callonImageHeightAttr1() (interface{}, error) {
+func (p *parser) callonInlineMenu1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onImageHeightAttr1(stack["value"])
+	return p.cur.onInlineMenu1(stack["menu"], stack["content"])
 }
 
-func (c *current) onInlineIcon5() (interface{}, error) {
+func (c *current) onUIMacroContent1() (interface{}, error) {
 	return string(c.text), nil
 }
 
-func (p *parser) callonInlineIcon5() (interface{}, error) {
+func (p *parser) callonUIMacroContent1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInlineIcon5()
+	return p.cur.onUIMacroContent1()
 }
 
-func (c *current) onInlineIcon1(icon, attrs interface{}) (interface{}, error) {
-	return types.NewIcon(icon.(string), attrs.(types.Attributes))
+func (c *current) onInlineMenuShorthand5() (interface{}, error) {
+	return string(c.text), nil
 }
 
-func (p *parser) callonInlineIcon1() (interface{}, error) {
+func (p *parser) callonInlineMenuShorthand5() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onInlineIcon1(stack["icon"], stack["attrs"])
+	return p.cur.onInlineMenuShorthand5()
 }
 
-func (c *current) onIconAttrs1(size, nv interface{}) (interface{}, error) {
-	return types.NewElementAttributes(size, nv)
+func (c *current) onInlineMenuShorthand11(content interface{}) (bool, error) {
+	return c.isMenuShorthand(content.(string))
 }
 
-func (p *parser) callonIconAttrs1() (interface{}, error) {
+func (p *parser) callonInlineMenuShorthand11() (bool, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onIconAttrs1(stack["size"], stack["nv"])
+	return p.cur.onInlineMenuShorthand11(stack["content"])
 }
 
-func (c *current) onIconSize1(value interface{}) (interface{}, error) {
-	return types.NewInlineAttribute(types.AttrIconSize, value)
+func (c *current) onInlineMenuShorthand1(content interface{}) (interface{}, error) {
+	return c.newInlineMenuShorthand(content.(string))
 }
 
-func (p *parser) callonIconSize1() (interface{}, error) {
+func (p *parser) callonInlineMenuShorthand1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onIconSize1(stack["value"])
+	return p.cur.onInlineMenuShorthand1(stack["content"])
 }
 
 func (c *current) onInlineFootnote2(content interface{}) (interface{}, error) {
@@ -19504,24 +23795,44 @@ func (p *parser) callonFootnoteContent1() (interface{}, error) {
 	return p.cur.onFootnoteContent1(stack["elements"])
 }
 
-func (c *current) onCallout5() (interface{}, error) {
+func (c *current) onCallout11() (interface{}, error) {
+	return strconv.Atoi(string(c.text))
+}
+
+func (p *parser) callonCallout11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCallout11()
+}
+
+func (c *current) onCallout2(ref interface{}) (interface{}, error) {
+	return types.NewCallout(ref.(int))
+}
+
+func (p *parser) callonCallout2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCallout2(stack["ref"])
+}
+
+func (c *current) onCallout25() (interface{}, error) {
 	return strconv.Atoi(string(c.text))
 }
 
-func (p *parser) callonCallout5() (interface{}, error) {
+func (p *parser) callonCallout25() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onCallout5()
+	return p.cur.onCallout25()
 }
 
-func (c *current) onCallout1(ref interface{}) (interface{}, error) {
+func (c *current) onCallout21(ref interface{}) (interface{}, error) {
 	return types.NewCallout(ref.(int))
 }
 
-func (p *parser) callonCallout1() (interface{}, error) {
+func (p *parser) callonCallout21() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onCallout1(stack["ref"])
+	return p.cur.onCallout21(stack["ref"])
 }
 
 func (c *current) onCalloutListItem1(ref, description interface{}) (interface{}, error) {
@@ -19575,7 +23886,7 @@ func (p *parser) callonDelimitedBlock1() (interface{}, error) {
 }
 
 func (c *current) onExampleBlock1(attributes, blocks interface{}) (interface{}, error) {
-	return types.NewExampleBlock(blocks.([]interface{}), attributes)
+	return newCompoundBlock(types.Example, blocks.([]interface{}), attributes) // may be another kind of block, depending on its style
 }
 
 func (p *parser) callonExampleBlock1() (interface{}, error) {
@@ -19657,6 +23968,62 @@ func (p *parser) callonSidebarBlockRawContent2() (interface{}, error) {
 	return p.cur.onSidebarBlockRawContent2(stack["element"])
 }
 
+func (c *current) onOpenBlock7(attributes interface{}) (bool, error) {
+	// content is a set of raw lines if the block masquerades as a verbatim block (eg: `[source]`)
+	return isVerbatimBlockStyle(attributes)
+
+}
+
+func (p *parser) callonOpenBlock7() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlock7(stack["attributes"])
+}
+
+func (c *current) onOpenBlock2(attributes, content interface{}) (interface{}, error) {
+	return newVerbatimBlock(types.Open, content.([]interface{}), attributes)
+
+}
+
+func (p *parser) callonOpenBlock2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlock2(stack["attributes"], stack["content"])
+}
+
+func (c *current) onOpenBlock12(attributes, content interface{}) (interface{}, error) {
+	return newCompoundBlock(types.Open, content.([]interface{}), attributes) // may be another kind of block, depending on its style
+
+}
+
+func (p *parser) callonOpenBlock12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlock12(stack["attributes"], stack["content"])
+}
+
+func (c *current) onOpenBlockRawLines2(line interface{}) (interface{}, error) {
+
+	return line, nil
+}
+
+func (p *parser) callonOpenBlockRawLines2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockRawLines2(stack["line"])
+}
+
+func (c *current) onOpenBlockRawContent2(element interface{}) (interface{}, error) {
+	return element, nil
+
+}
+
+func (p *parser) callonOpenBlockRawContent2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onOpenBlockRawContent2(stack["element"])
+}
+
 func (c *current) onFencedBlock1(attributes, content interface{}) (interface{}, error) {
 	return types.NewFencedBlock(content.([]interface{}), attributes)
 }
@@ -19679,7 +24046,7 @@ func (p *parser) callonFencedBlockRawContent2() (interface{}, error) {
 }
 
 func (c *current) onListingBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewListingBlock(content.([]interface{}), attributes)
+	return newVerbatimBlock(types.Listing, content.([]interface{}), attributes) // may be another kind of block, depending on its style
 }
 
 func (p *parser) callonListingBlock1() (interface{}, error) {
@@ -19737,7 +24104,7 @@ func (p *parser) callonVerseBlockRawContent2() (interface{}, error) {
 }
 
 func (c *current) onPassthroughBlock1(attributes, content interface{}) (interface{}, error) {
-	return types.NewPassthroughBlock(content.([]interface{}), attributes)
+	return newVerbatimBlock(types.Passthrough, content.([]interface{}), attributes)
 }
 
 func (p *parser) callonPassthroughBlock1() (interface{}, error) {
@@ -19853,63 +24220,289 @@ func (c *current) onMarkdownQuoteAttribution1(author interface{}) (interface{},
 	return author, nil
 }
 
-func (p *parser) callonMarkdownQuoteAttribution1() (interface{}, error) {
+func (p *parser) callonMarkdownQuoteAttribution1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onMarkdownQuoteAttribution1(stack["author"])
+}
+
+func (c *current) onNoneSubs5() (interface{}, error) {
+	// just text, including empty lines
+	return types.NewStringElement(string(c.text))
+
+}
+
+func (p *parser) callonNoneSubs5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onNoneSubs5()
+}
+
+func (c *current) onPSVTable1(attrs, header, lines interface{}) (interface{}, error) {
+	// end delimiter or end of file
+	return types.NewTable(header, lines.([]interface{}), attrs, c.diagnostics())
+}
+
+func (p *parser) callonPSVTable1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPSVTable1(stack["attrs"], stack["header"], stack["lines"])
+}
+
+func (c *current) onCSVTable1(attrs, lines interface{}) (interface{}, error) {
+	return newDataTable(types.CSVTableFormat, lines.([]interface{}), attrs, c.diagnostics())
+}
+
+func (p *parser) callonCSVTable1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCSVTable1(stack["attrs"], stack["lines"])
+}
+
+func (c *current) onDSVTable1(attrs, lines interface{}) (interface{}, error) {
+	return newDataTable(types.DSVTableFormat, lines.([]interface{}), attrs, c.diagnostics())
+}
+
+func (p *parser) callonDSVTable1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDSVTable1(stack["attrs"], stack["lines"])
+}
+
+func (c *current) onDataTable7(attrs interface{}) (bool, error) {
+	return isDataTable(attrs)
+
+}
+
+func (p *parser) callonDataTable7() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDataTable7(stack["attrs"])
+}
+
+func (c *current) onDataTable1(attrs, lines interface{}) (interface{}, error) {
+	return newDataTable(types.PSVTableFormat, lines.([]interface{}), attrs, c.diagnostics())
+}
+
+func (p *parser) callonDataTable1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDataTable1(stack["attrs"], stack["lines"])
+}
+
+func (c *current) onPSVTableStartDelimiter3() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonPSVTableStartDelimiter3() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPSVTableStartDelimiter3()
+}
+
+func (c *current) onPSVTableStartDelimiter9(separator interface{}) (bool, error) {
+	return c.setTableCellSeparator(separator.(string))
+}
+
+func (p *parser) callonPSVTableStartDelimiter9() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onPSVTableStartDelimiter9(stack["separator"])
+}
+
+func (c *current) onTableCellSeparatorChar3() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonTableCellSeparatorChar3() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableCellSeparatorChar3()
+}
+
+func (c *current) onTableCellSeparatorChar5(separator interface{}) (bool, error) {
+	return c.isTableCellSeparator(separator.(string))
+}
+
+func (p *parser) callonTableCellSeparatorChar5() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableCellSeparatorChar5(stack["separator"])
+}
+
+func (c *current) onDataTableLine12() (interface{}, error) {
+
+	return string(c.text), nil
+
+}
+
+func (p *parser) callonDataTableLine12() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDataTableLine12()
+}
+
+func (c *current) onDataTableLine1(content interface{}) (interface{}, error) {
+
+	return content, nil
+}
+
+func (p *parser) callonDataTableLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onDataTableLine1(stack["content"])
+}
+
+func (c *current) onTableLineHeader1(cells interface{}) (interface{}, error) {
+	return types.NewTableLine(cells.([]interface{}))
+}
+
+func (p *parser) callonTableLineHeader1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableLineHeader1(stack["cells"])
+}
+
+func (c *current) onTableLine1(cells interface{}) (interface{}, error) {
+	return types.NewTableLine(cells.([]interface{}))
+}
+
+func (p *parser) callonTableLine1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableLine1(stack["cells"])
+}
+
+func (c *current) onTableCell10() (interface{}, error) {
+
+	return c.unescapeTableCellSeparator(string(c.text)), nil
+
+}
+
+func (p *parser) callonTableCell10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableCell10()
+}
+
+func (c *current) onTableCell1(specifier, content interface{}) (interface{}, error) {
+	return types.NewTableCells(specifier, content.(string))
+}
+
+func (p *parser) callonTableCell1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableCell1(stack["specifier"], stack["content"])
+}
+
+func (c *current) onTableHeaderCell1(specifier, content interface{}) (interface{}, error) {
+	return types.NewTableCells(specifier, c.unescapeTableCellSeparator(content.(string)))
+}
+
+func (p *parser) callonTableHeaderCell1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableHeaderCell1(stack["specifier"], stack["content"])
+}
+
+func (c *current) onTableCellContent1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonTableCellContent1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableCellContent1()
+}
+
+func (c *current) onTableCellSpecifier14() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonTableCellSpecifier14() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableCellSpecifier14()
+}
+
+func (c *current) onTableCellSpecifier22() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonTableCellSpecifier22() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableCellSpecifier22()
+}
+
+func (c *current) onTableCellSpecifier18(align interface{}) (interface{}, error) {
+	return align, nil
+}
+
+func (p *parser) callonTableCellSpecifier18() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onTableCellSpecifier18(stack["align"])
+}
+
+func (c *current) onTableCellSpecifier26() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonTableCellSpecifier26() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onMarkdownQuoteAttribution1(stack["author"])
+	return p.cur.onTableCellSpecifier26()
 }
 
-func (c *current) onNoneSubs5() (interface{}, error) {
-	// just text, including empty lines
-	return types.NewStringElement(string(c.text))
-
+func (c *current) onTableCellSpecifier1(factor, halign, valign, style interface{}) (interface{}, error) {
+	return types.NewTableCellSpecifier(factor, halign, valign, style)
 }
 
-func (p *parser) callonNoneSubs5() (interface{}, error) {
+func (p *parser) callonTableCellSpecifier1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onNoneSubs5()
+	return p.cur.onTableCellSpecifier1(stack["factor"], stack["halign"], stack["valign"], stack["style"])
 }
 
-func (c *current) onTable1(attrs, header, lines interface{}) (interface{}, error) {
-	// end delimiter or end of file
-	return types.NewTable(header, lines.([]interface{}), attrs)
+func (c *current) onTableCellSpan8(rowspan interface{}) (interface{}, error) {
+	return rowspan, nil
 }
 
-func (p *parser) callonTable1() (interface{}, error) {
+func (p *parser) callonTableCellSpan8() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTable1(stack["attrs"], stack["header"], stack["lines"])
+	return p.cur.onTableCellSpan8(stack["rowspan"])
 }
 
-func (c *current) onTableLineHeader1(cells interface{}) (interface{}, error) {
-	return types.NewTableLine(cells.([]interface{}))
+func (c *current) onTableCellSpan1(colspan, rowspan interface{}) (interface{}, error) {
+	return types.NewTableCellSpan(colspan, rowspan, c.maxTableCellFactor())
 }
 
-func (p *parser) callonTableLineHeader1() (interface{}, error) {
+func (p *parser) callonTableCellSpan1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTableLineHeader1(stack["cells"])
+	return p.cur.onTableCellSpan1(stack["colspan"], stack["rowspan"])
 }
 
-func (c *current) onTableLine1(cells interface{}) (interface{}, error) {
-	return types.NewTableLine(cells.([]interface{}))
+func (c *current) onTableCellDuplication1(factor interface{}) (interface{}, error) {
+	return types.NewTableCellDuplication(factor.(int), c.maxTableCellFactor())
 }
 
-func (p *parser) callonTableLine1() (interface{}, error) {
+func (p *parser) callonTableCellDuplication1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTableLine1(stack["cells"])
+	return p.cur.onTableCellDuplication1(stack["factor"])
 }
 
-func (c *current) onTableCell1(elements interface{}) (interface{}, error) {
-	return types.NewInlineElements(elements)
+func (c *current) onTableCellFactor1() (interface{}, error) {
+	return strconv.Atoi(string(c.text))
 }
 
-func (p *parser) callonTableCell1() (interface{}, error) {
+func (p *parser) callonTableCellFactor1() (interface{}, error) {
 	stack := p.vstack[len(p.vstack)-1]
 	_ = stack
-	return p.cur.onTableCell1(stack["elements"])
+	return p.cur.onTableCellFactor1()
 }
 
 func (c *current) onParagraphWithHeadingSpaces1(attributes, lines interface{}) (interface{}, error) {
@@ -19956,7 +24549,7 @@ func (p *parser) callonParagraphWithHeadingSpacesLine1() (interface{}, error) {
 }
 
 func (c *current) onParagraphWithLiteralBlockDelimiter1(attributes, lines interface{}) (interface{}, error) {
-	return types.NewLiteralBlock(types.LiteralBlockWithDelimiter, lines.([]interface{}), attributes)
+	return newVerbatimBlock(types.Literal, lines.([]interface{}), attributes) // may be another kind of block, depending on its style
 }
 
 func (p *parser) callonParagraphWithLiteralBlockDelimiter1() (interface{}, error) {
@@ -20194,6 +24787,330 @@ func (p *parser) callonImpliedApostrophe1() (interface{}, error) {
 	return p.cur.onImpliedApostrophe1()
 }
 
+func (c *current) onReplacementExclusion5() (interface{}, error) {
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonReplacementExclusion5() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onReplacementExclusion5()
+}
+
+func (c *current) onReplacementExclusion13() (interface{}, error) {
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonReplacementExclusion13() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onReplacementExclusion13()
+}
+
+func (c *current) onReplacementExclusion2(prefix, path interface{}) (interface{}, error) {
+	return append([]interface{}{prefix}, path.([]interface{})...), nil
+}
+
+func (p *parser) callonReplacementExclusion2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onReplacementExclusion2(stack["prefix"], stack["path"])
+}
+
+func (c *current) onReplacementExclusion17() (interface{}, error) {
+	return types.NewStringElement(string(c.text))
+}
+
+func (p *parser) callonReplacementExclusion17() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onReplacementExclusion17()
+}
+
+func (c *current) onEscapedSymbol2() (interface{}, error) {
+	return types.NewStringElement(string(c.text[1:]))
+}
+
+func (p *parser) callonEscapedSymbol2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapedSymbol2()
+}
+
+func (c *current) onEscapedSymbol11() (interface{}, error) {
+	return types.NewStringElement(strings.Replace(string(c.text), `\`, "", 1))
+}
+
+func (p *parser) callonEscapedSymbol11() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEscapedSymbol11()
+}
+
+func (c *current) onEmDash2() (interface{}, error) {
+	return types.NewStringElement(strings.TrimSuffix(string(c.text), "--") + "\u2014\u200b")
+}
+
+func (p *parser) callonEmDash2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEmDash2()
+}
+
+func (c *current) onEmDash8() (interface{}, error) {
+	return types.NewStringElement(strings.Replace(string(c.text), `\`, "", 1))
+}
+
+func (p *parser) callonEmDash8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEmDash8()
+}
+
+func (c *current) onEmDash14() (interface{}, error) {
+	spaces := string(c.text[:strings.Index(string(c.text), "--")])
+	return types.NewStringElement(spaces[:len(spaces)-1] + "\u2009\u2014\u2009")
+}
+
+func (p *parser) callonEmDash14() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEmDash14()
+}
+
+func (c *current) onEmDash24() (interface{}, error) {
+	return types.NewStringElement(string(c.text[1:]))
+}
+
+func (p *parser) callonEmDash24() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onEmDash24()
+}
+
+func (c *current) onLeadingEmDash1() (interface{}, error) {
+	return types.NewStringElement("\u2009\u2014\u2009")
+}
+
+func (p *parser) callonLeadingEmDash1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLeadingEmDash1()
+}
+
+func (c *current) onArrow2() (interface{}, error) {
+	return types.NewStringElement("\u2192")
+}
+
+func (p *parser) callonArrow2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrow2()
+}
+
+func (c *current) onArrow6() (interface{}, error) {
+	return types.NewStringElement("\u21d2")
+}
+
+func (p *parser) callonArrow6() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrow6()
+}
+
+func (c *current) onArrow10() (interface{}, error) {
+	return types.NewStringElement("\u2190")
+}
+
+func (p *parser) callonArrow10() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrow10()
+}
+
+func (c *current) onArrow16() (interface{}, error) {
+	return types.NewStringElement("\u21d0")
+}
+
+func (p *parser) callonArrow16() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrow16()
+}
+
+func (c *current) onArrow22(prefix, gt interface{}) (interface{}, error) {
+	return []interface{}{types.StringElement{Content: string(prefix.([]byte))}, gt}, nil
+}
+
+func (p *parser) callonArrow22() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrow22(stack["prefix"], stack["gt"])
+}
+
+func (c *current) onArrow31(lt, suffix interface{}) (interface{}, error) {
+	return []interface{}{lt, types.StringElement{Content: string(suffix.([]byte))}}, nil
+}
+
+func (p *parser) callonArrow31() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onArrow31(stack["lt"], stack["suffix"])
+}
+
+func (c *current) onGreaterThan2() (interface{}, error) {
+	return types.NewSpecialCharacter(string(c.text))
+}
+
+func (p *parser) callonGreaterThan2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGreaterThan2()
+}
+
+func (c *current) onGreaterThan8() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonGreaterThan8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGreaterThan8()
+}
+
+func (c *current) onGreaterThan12(ref interface{}) (bool, error) {
+
+	return c.isSpecialCharacter(ref.(string), ">")
+}
+
+func (p *parser) callonGreaterThan12() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGreaterThan12(stack["ref"])
+}
+
+func (c *current) onGreaterThan4(ref interface{}) (interface{}, error) {
+	return types.NewElementPlaceHolder(ref.(string))
+}
+
+func (p *parser) callonGreaterThan4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onGreaterThan4(stack["ref"])
+}
+
+func (c *current) onLessThan2() (interface{}, error) {
+	return types.NewSpecialCharacter(string(c.text))
+}
+
+func (p *parser) callonLessThan2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLessThan2()
+}
+
+func (c *current) onLessThan8() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonLessThan8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLessThan8()
+}
+
+func (c *current) onLessThan12(ref interface{}) (bool, error) {
+
+	return c.isSpecialCharacter(ref.(string), "<")
+}
+
+func (p *parser) callonLessThan12() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLessThan12(stack["ref"])
+}
+
+func (c *current) onLessThan4(ref interface{}) (interface{}, error) {
+	return types.NewElementPlaceHolder(ref.(string))
+}
+
+func (p *parser) callonLessThan4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onLessThan4(stack["ref"])
+}
+
+func (c *current) onAmpersand2() (interface{}, error) {
+	return types.NewSpecialCharacter(string(c.text))
+}
+
+func (p *parser) callonAmpersand2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAmpersand2()
+}
+
+func (c *current) onAmpersand8() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonAmpersand8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAmpersand8()
+}
+
+func (c *current) onAmpersand12(ref interface{}) (bool, error) {
+
+	return c.isSpecialCharacter(ref.(string), "&")
+}
+
+func (p *parser) callonAmpersand12() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAmpersand12(stack["ref"])
+}
+
+func (c *current) onAmpersand4(ref interface{}) (interface{}, error) {
+	return types.NewElementPlaceHolder(ref.(string))
+}
+
+func (p *parser) callonAmpersand4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onAmpersand4(stack["ref"])
+}
+
+func (c *current) onCharacterReference2(name interface{}) (interface{}, error) {
+	return types.NewCharacterReference(name.(string))
+}
+
+func (p *parser) callonCharacterReference2() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCharacterReference2(stack["name"])
+}
+
+func (c *current) onCharacterReference8(amp, name interface{}) (interface{}, error) {
+	return []interface{}{amp, types.StringElement{Content: name.(string) + ";"}}, nil
+}
+
+func (p *parser) callonCharacterReference8() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCharacterReference8(stack["amp"], stack["name"])
+}
+
+func (c *current) onCharacterReferenceName1() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonCharacterReferenceName1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onCharacterReferenceName1()
+}
+
 func (c *current) onSpecialCharacter2() (interface{}, error) {
 	// if we have a InternalCrossReference we just return a StringElement.
 	return types.NewStringElement(string(c.text))
@@ -20313,6 +25230,36 @@ func (p *parser) callonLocationWithScheme1() (interface{}, error) {
 	return p.cur.onLocationWithScheme1(stack["scheme"], stack["path"])
 }
 
+func (c *current) onBareLocationWithScheme1(scheme, path interface{}) (interface{}, error) {
+	return types.NewLocation(scheme, path.([]interface{}))
+}
+
+func (p *parser) callonBareLocationWithScheme1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onBareLocationWithScheme1(stack["scheme"], stack["path"])
+}
+
+func (c *current) onQuotedTextPlaceHolder4() (interface{}, error) {
+	return string(c.text), nil
+}
+
+func (p *parser) callonQuotedTextPlaceHolder4() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedTextPlaceHolder4()
+}
+
+func (c *current) onQuotedTextPlaceHolder8(ref interface{}) (bool, error) {
+	return c.isQuotedText(ref.(string))
+}
+
+func (p *parser) callonQuotedTextPlaceHolder8() (bool, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onQuotedTextPlaceHolder8(stack["ref"])
+}
+
 func (c *current) onResolvedLocation1(scheme, path interface{}) (interface{}, error) {
 	return types.NewLocation(scheme, path.([]interface{}))
 }
@@ -20374,6 +25321,16 @@ func (p *parser) callonSpace3() (interface{}, error) {
 	return p.cur.onSpace3()
 }
 
+func (c *current) onSourcePosition1() (interface{}, error) {
+	return c.position(), nil
+}
+
+func (p *parser) callonSourcePosition1() (interface{}, error) {
+	stack := p.vstack[len(p.vstack)-1]
+	_ = stack
+	return p.cur.onSourcePosition1()
+}
+
 var (
 	// errNoRule is returned when the grammar to parse has no rule.
 	errNoRule = errors.New("grammar has no rule")
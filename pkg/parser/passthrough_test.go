@@ -412,6 +412,26 @@ var _ = Describe("passthroughs", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(result).To(MatchDocument(expected))
 				})
+
+				It("invalid singleplus passthrough after alphanumeric", func() {
+					source := `zoom in with (Ctrl+=) and out with (Ctrl+-)`
+					expected := types.Document{
+						Elements: []interface{}{
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: "zoom in with (Ctrl+=) and out with (Ctrl+-)",
+										},
+									},
+								},
+							},
+						},
+					}
+					result, err := ParseDocument(source)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(MatchDocument(expected))
+				})
 			})
 
 		})
@@ -0,0 +1,29 @@
+package parser
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// isSpecialCharacter returns `true` if the placeholder with the given ref stands for the given special character,
+// since the special characters were already substituted when the replacements are applied (eg: `-&gt;`)
+func (c *current) isSpecialCharacter(ref, name string) (bool, error) {
+	e, ok := c.placeholder(ref).(types.SpecialCharacter)
+	return ok && e.Name == name, nil
+}
+
+// isQuotedText returns `true` if the placeholder with the given ref stands for a quoted text,
+// since the quoted texts were already substituted when the inline macros are parsed (eg: `https://example.com/a_b_, c _d_`)
+func (c *current) isQuotedText(ref string) (bool, error) {
+	_, ok := c.placeholder(ref).(types.QuotedText)
+	return ok, nil
+}
+
+// placeholder returns the element of the placeholder with the given ref in the content being substituted,
+// or `nil` if there is no such placeholder
+func (c *current) placeholder(ref string) interface{} {
+	p, ok := c.globalStore[placeholdersKey].(*placeholders)
+	if !ok {
+		return nil
+	}
+	return p.elements[ref]
+}
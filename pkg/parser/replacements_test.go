@@ -0,0 +1,220 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("replacements", func() {
+
+	Context("draft documents", func() {
+
+		It("em-dashes", func() {
+			source := `foo--bar and foo -- bar`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "foo—​bar and foo — bar",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("arrows", func() {
+			source := `a -> b => c <- d <= e`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "a → b ⇒ c ← d ⇐ e",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("escaped arrow and symbol", func() {
+			source := `a \-> b \(C)`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "a -",
+								},
+								types.SpecialCharacter{
+									Name: ">",
+								},
+								types.StringElement{
+									Content: " b (C)",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("character references", func() {
+			source := `&loz; &#169; &#x2022; \&amp;`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.CharacterReference{
+									Name: "loz",
+								},
+								types.StringElement{
+									Content: " ",
+								},
+								types.CharacterReference{
+									Name: "#169",
+								},
+								types.StringElement{
+									Content: " ",
+								},
+								types.CharacterReference{
+									Name: "#x2022",
+								},
+								types.StringElement{
+									Content: " ",
+								},
+								types.SpecialCharacter{
+									Name: "&",
+								},
+								types.StringElement{
+									Content: "amp;",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("list item with arrow and character reference", func() {
+			source := `* a -> b &loz;`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.UnorderedListItem{
+						Level:       1,
+						BulletStyle: types.OneAsterisk,
+						CheckStyle:  types.NoCheck,
+						Elements: []interface{}{
+							types.Paragraph{
+								Lines: [][]interface{}{
+									{
+										types.StringElement{
+											Content: "a → b ",
+										},
+										types.CharacterReference{
+											Name: "loz",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+	})
+
+	Context("final documents", func() {
+
+		It("double hyphens and arrow in link location", func() {
+			source := `https://foo.bar/a--b->c[a--b]`
+			expected := types.Document{
+				Elements: []interface{}{
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.InlineLink{
+									Attributes: types.Attributes{
+										types.AttrInlineLinkText: []interface{}{
+											types.StringElement{
+												Content: "a—​b",
+											},
+										},
+									},
+									Location: types.Location{
+										Scheme: "https://",
+										Path: []interface{}{
+											types.StringElement{
+												Content: "foo.bar/a--b-",
+											},
+											types.SpecialCharacter{
+												Name: ">",
+											},
+											types.StringElement{
+												Content: "c",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("double hyphens in cross reference id", func() {
+			source := `[[a--b]]
+a paragraph
+
+see <<a--b>>`
+			expected := types.Document{
+				Elements: []interface{}{
+					types.Paragraph{
+						Attributes: types.Attributes{
+							types.AttrID:       "a--b",
+							types.AttrCustomID: true,
+						},
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "a paragraph",
+								},
+							},
+						},
+					},
+					types.Paragraph{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "see ",
+								},
+								types.InternalCrossReference{
+									ID: "a--b",
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+	})
+})
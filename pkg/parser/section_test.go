@@ -763,6 +763,51 @@ a short preamble
 
 		})
 
+		Context("discrete headings", func() {
+
+			It("discrete heading alone", func() {
+				source := `[discrete]
+== a discrete heading`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.DiscreteHeading{
+							Attributes: types.Attributes{
+								types.AttrStyle: types.DiscreteStyle,
+								types.AttrID:    "_a_discrete_heading",
+							},
+							Level: 1,
+							Title: []interface{}{
+								types.StringElement{Content: "a discrete heading"},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("floating heading with custom ID and role", func() {
+				source := `[float#custom.role]
+=== a floating heading`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.DiscreteHeading{
+							Attributes: types.Attributes{
+								types.AttrStyle:    types.FloatStyle,
+								types.AttrID:       "custom",
+								types.AttrCustomID: true,
+								types.AttrRole:     types.ElementRole{"role"},
+							},
+							Level: 2,
+							Title: []interface{}{
+								types.StringElement{Content: "a floating heading"},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+		})
+
 		Context("invalid sections", func() {
 			It("header invalid - missing space", func() {
 				source := "=a header"
@@ -862,8 +907,15 @@ Doc Writer <thedoc@asciidoctor.org>`
 									types.SpecialCharacter{
 										Name: "<",
 									},
-									types.StringElement{
-										Content: "thedoc@asciidoctor.org",
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "mailto:",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "thedoc@asciidoctor.org",
+												},
+											},
+										},
 									},
 									types.SpecialCharacter{
 										Name: ">",
@@ -1902,6 +1954,107 @@ a short preamble
 			})
 		})
 
+		Context("discrete headings", func() {
+
+			It("discrete heading within section", func() {
+				source := `== section 1
+
+[discrete]
+=== a discrete heading
+
+content
+
+== section 2`
+				section1Title := []interface{}{
+					types.StringElement{Content: "section 1"},
+				}
+				headingTitle := []interface{}{
+					types.StringElement{Content: "a discrete heading"},
+				}
+				section2Title := []interface{}{
+					types.StringElement{Content: "section 2"},
+				}
+				expected := types.Document{
+					ElementReferences: types.ElementReferences{
+						"_section_1":          section1Title,
+						"_a_discrete_heading": headingTitle,
+						"_section_2":          section2Title,
+					},
+					Elements: []interface{}{
+						types.Section{
+							Attributes: types.Attributes{
+								types.AttrID: "_section_1",
+							},
+							Level: 1,
+							Title: section1Title,
+							Elements: []interface{}{
+								types.DiscreteHeading{
+									Attributes: types.Attributes{
+										types.AttrStyle: types.DiscreteStyle,
+										types.AttrID:    "_a_discrete_heading",
+									},
+									Level: 2,
+									Title: headingTitle,
+								},
+								types.Paragraph{
+									Lines: [][]interface{}{
+										{
+											types.StringElement{Content: "content"},
+										},
+									},
+								},
+							},
+						},
+						types.Section{
+							Attributes: types.Attributes{
+								types.AttrID: "_section_2",
+							},
+							Level:    1,
+							Title:    section2Title,
+							Elements: []interface{}{},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+
+			It("discrete heading with same title as section", func() {
+				source := `== a title
+
+[float]
+== a title`
+				title := []interface{}{
+					types.StringElement{Content: "a title"},
+				}
+				expected := types.Document{
+					ElementReferences: types.ElementReferences{
+						"_a_title":   title,
+						"_a_title_2": title,
+					},
+					Elements: []interface{}{
+						types.Section{
+							Attributes: types.Attributes{
+								types.AttrID: "_a_title",
+							},
+							Level: 1,
+							Title: title,
+							Elements: []interface{}{
+								types.DiscreteHeading{
+									Attributes: types.Attributes{
+										types.AttrStyle: types.FloatStyle,
+										types.AttrID:    "_a_title_2",
+									},
+									Level: 1,
+									Title: title,
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDocument(source)).To(MatchDocument(expected))
+			})
+		})
+
 		Context("invalid sections", func() {
 
 			It("header invalid - too many spaces", func() {
@@ -2024,8 +2177,15 @@ Doc Writer <thedoc@asciidoctor.org>`
 									types.SpecialCharacter{
 										Name: "<",
 									},
-									types.StringElement{
-										Content: "thedoc@asciidoctor.org",
+									types.InlineLink{
+										Location: types.Location{
+											Scheme: "mailto:",
+											Path: []interface{}{
+												types.StringElement{
+													Content: "thedoc@asciidoctor.org",
+												},
+											},
+										},
 									},
 									types.SpecialCharacter{
 										Name: ">",
@@ -0,0 +1,20 @@
+package parser
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// isDiscreteHeading returns `true` if the given attributes have the `discrete` (or `float`) style,
+// ie, if the heading is not part of the hierarchy of sections
+func isDiscreteHeading(attributes interface{}) (bool, error) {
+	attrs, err := types.NewAttributes(attributes)
+	if err != nil {
+		return false, nil // will be reported when parsing the heading as a section
+	}
+	switch attrs.GetAsStringWithDefault(types.AttrStyle, "") {
+	case types.DiscreteStyle, types.FloatStyle:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
@@ -41,17 +41,14 @@ var _ = Describe("special characters", func() {
 							types.StringElement{
 								Content: " ",
 							},
-							types.SpecialCharacter{
-								Name: "&",
+							types.CharacterReference{
+								Name: "apos",
 							},
 							types.StringElement{
-								Content: "apos; ",
-							},
-							types.SpecialCharacter{
-								Name: "&",
+								Content: " ",
 							},
-							types.StringElement{
-								Content: "amp;",
+							types.CharacterReference{
+								Name: "amp",
 							},
 						},
 					},
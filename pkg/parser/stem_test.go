@@ -0,0 +1,153 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("stem", func() {
+
+	Context("draft documents", func() {
+
+		Context("inline macros", func() {
+
+			It("with stem, latexmath and asciimath macros", func() {
+				source := `stem:[sqrt(4) = 2], latexmath:[\frac{a}{b}] and asciimath:[x^2]`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineStem{
+										Kind:    types.Stem,
+										Content: "sqrt(4) = 2",
+									},
+									types.StringElement{
+										Content: ", ",
+									},
+									types.InlineStem{
+										Kind:    types.LatexMath,
+										Content: `\frac{a}{b}`,
+									},
+									types.StringElement{
+										Content: " and ",
+									},
+									types.InlineStem{
+										Kind:    types.AsciiMath,
+										Content: "x^2",
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("with escaped bracket and quoted text", func() {
+				source := `asciimath:[[a, *b*\]]`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineStem{
+										Kind:    types.AsciiMath,
+										Content: "[a, *b*]",
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+		})
+
+		Context("delimited blocks", func() {
+
+			It("with stem style", func() {
+				source := `[stem]
+++++
+x < y
+++++`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.StemBlock{
+							Attributes: types.Attributes{
+								"stem": nil,
+							},
+							Lines: [][]interface{}{
+								{
+									types.StringElement{
+										Content: "x ",
+									},
+									types.SpecialCharacter{
+										Name: "<",
+									},
+									types.StringElement{
+										Content: " y",
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("with latexmath style and title", func() {
+				source := `.a title
+[latexmath]
+++++
+\frac{*a*}{b}
+++++`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.StemBlock{
+							Attributes: types.Attributes{
+								types.AttrTitle: "a title",
+								"latexmath":     nil,
+							},
+							Lines: [][]interface{}{
+								{
+									types.StringElement{
+										Content: `\frac{*a*}{b}`,
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("open block with asciimath style", func() {
+				source := `[asciimath]
+--
+x^2
+--`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.StemBlock{
+							Attributes: types.Attributes{
+								"asciimath": nil,
+							},
+							Lines: [][]interface{}{
+								{
+									types.StringElement{
+										Content: "x^2",
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package parser_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
@@ -24,31 +25,35 @@ var _ = Describe("tables", func() {
 					},
 					Lines: []types.TableLine{
 						{
-							Cells: [][]interface{}{
+							Cells: []types.TableCell{
 								{
-									types.QuotedText{
-										Kind: types.Bold,
-										Elements: []interface{}{
-											types.StringElement{
-												Content: "foo",
+									Elements: []interface{}{
+										types.QuotedText{
+											Kind: types.Bold,
+											Elements: []interface{}{
+												types.StringElement{
+													Content: "foo",
+												},
 											},
 										},
-									},
-									types.StringElement{
-										Content: " foo  ",
+										types.StringElement{
+											Content: " foo  ",
+										},
 									},
 								},
 								{
-									types.QuotedText{
-										Kind: types.Italic,
-										Elements: []interface{}{
-											types.StringElement{
-												Content: "bar",
+									Elements: []interface{}{
+										types.QuotedText{
+											Kind: types.Italic,
+											Elements: []interface{}{
+												types.StringElement{
+													Content: "bar",
+												},
 											},
 										},
-									},
-									types.StringElement{
-										Content: "  ",
+										types.StringElement{
+											Content: "  ",
+										},
 									},
 								},
 							},
@@ -74,36 +79,42 @@ var _ = Describe("tables", func() {
 					},
 					Lines: []types.TableLine{
 						{
-							Cells: [][]interface{}{
+							Cells: []types.TableCell{
 								{
-									types.QuotedText{
-										Kind: types.Bold,
-										Elements: []interface{}{
-											types.StringElement{
-												Content: "foo",
+									Elements: []interface{}{
+										types.QuotedText{
+											Kind: types.Bold,
+											Elements: []interface{}{
+												types.StringElement{
+													Content: "foo",
+												},
 											},
 										},
-									},
-									types.StringElement{
-										Content: " foo  ",
+										types.StringElement{
+											Content: " foo  ",
+										},
 									},
 								},
 								{
-									types.QuotedText{
-										Kind: types.Italic,
-										Elements: []interface{}{
-											types.StringElement{
-												Content: "bar",
+									Elements: []interface{}{
+										types.QuotedText{
+											Kind: types.Italic,
+											Elements: []interface{}{
+												types.StringElement{
+													Content: "bar",
+												},
 											},
 										},
-									},
-									types.StringElement{
-										Content: "  ",
+										types.StringElement{
+											Content: "  ",
+										},
 									},
 								},
 								{
-									types.StringElement{
-										Content: "baz",
+									Elements: []interface{}{
+										types.StringElement{
+											Content: "baz",
+										},
 									},
 								},
 							},
@@ -138,15 +149,19 @@ var _ = Describe("tables", func() {
 					},
 
 					Header: types.TableLine{
-						Cells: [][]interface{}{
+						Cells: []types.TableCell{
 							{
-								types.StringElement{
-									Content: "heading 1 ",
+								Elements: []interface{}{
+									types.StringElement{
+										Content: "heading 1 ",
+									},
 								},
 							},
 							{
-								types.StringElement{
-									Content: "heading 2",
+								Elements: []interface{}{
+									types.StringElement{
+										Content: "heading 2",
+									},
 								},
 							},
 						},
@@ -154,29 +169,37 @@ var _ = Describe("tables", func() {
 
 					Lines: []types.TableLine{
 						{
-							Cells: [][]interface{}{
+							Cells: []types.TableCell{
 								{
-									types.StringElement{
-										Content: "row 1, column 1",
+									Elements: []interface{}{
+										types.StringElement{
+											Content: "row 1, column 1",
+										},
 									},
 								},
 								{
-									types.StringElement{
-										Content: "row 1, column 2",
+									Elements: []interface{}{
+										types.StringElement{
+											Content: "row 1, column 2",
+										},
 									},
 								},
 							},
 						},
 						{
-							Cells: [][]interface{}{
+							Cells: []types.TableCell{
 								{
-									types.StringElement{
-										Content: "row 2, column 1",
+									Elements: []interface{}{
+										types.StringElement{
+											Content: "row 2, column 1",
+										},
 									},
 								},
 								{
-									types.StringElement{
-										Content: "row 2, column 2",
+									Elements: []interface{}{
+										types.StringElement{
+											Content: "row 2, column 2",
+										},
 									},
 								},
 							},
@@ -211,15 +234,19 @@ var _ = Describe("tables", func() {
 						types.AttrCustomID: true,
 					},
 					Header: types.TableLine{
-						Cells: [][]interface{}{
+						Cells: []types.TableCell{
 							{
-								types.StringElement{
-									Content: "heading 1 ",
+								Elements: []interface{}{
+									types.StringElement{
+										Content: "heading 1 ",
+									},
 								},
 							},
 							{
-								types.StringElement{
-									Content: "heading 2",
+								Elements: []interface{}{
+									types.StringElement{
+										Content: "heading 2",
+									},
 								},
 							},
 						},
@@ -231,29 +258,37 @@ var _ = Describe("tables", func() {
 					},
 					Lines: []types.TableLine{
 						{
-							Cells: [][]interface{}{
+							Cells: []types.TableCell{
 								{
-									types.StringElement{
-										Content: "row 1, column 1",
+									Elements: []interface{}{
+										types.StringElement{
+											Content: "row 1, column 1",
+										},
 									},
 								},
 								{
-									types.StringElement{
-										Content: "row 1, column 2",
+									Elements: []interface{}{
+										types.StringElement{
+											Content: "row 1, column 2",
+										},
 									},
 								},
 							},
 						},
 						{
-							Cells: [][]interface{}{
+							Cells: []types.TableCell{
 								{
-									types.StringElement{
-										Content: "row 2, column 1",
+									Elements: []interface{}{
+										types.StringElement{
+											Content: "row 2, column 1",
+										},
 									},
 								},
 								{
-									types.StringElement{
-										Content: "row 2, column 2",
+									Elements: []interface{}{
+										types.StringElement{
+											Content: "row 2, column 2",
+										},
 									},
 								},
 							},
@@ -403,4 +438,1164 @@ var _ = Describe("tables", func() {
 		}
 		Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
 	})
+
+	Context("header and footer", func() {
+
+		It("header and footer options", func() {
+			source := `[cols="2*",options="header,footer"]
+|===
+|h1
+|h2
+|a |b
+|f1 |f2
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrCols:    "2*",
+							types.AttrOptions: map[string]bool{"header": true, "footer": true},
+						},
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Header: types.TableLine{
+							Cells: []types.TableCell{
+								{
+									Elements: []interface{}{
+										types.StringElement{Content: "h1"},
+									},
+								},
+								{
+									Elements: []interface{}{
+										types.StringElement{Content: "h2"},
+									},
+								},
+							},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b"},
+										},
+									},
+								},
+							},
+						},
+						Footer: types.TableLine{
+							Cells: []types.TableCell{
+								{
+									Elements: []interface{}{
+										types.StringElement{Content: "f1 "},
+									},
+								},
+								{
+									Elements: []interface{}{
+										types.StringElement{Content: "f2"},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("implicit header disabled with the noheader option", func() {
+			source := `[%noheader]
+|===
+|a |b
+
+|c |d
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrOptions: map[string]bool{"noheader": true},
+						},
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b"},
+										},
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "c "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "d"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("autowidth columns with total widths exceeding 100%", func() {
+			source := "[cols=\"~,80,40\"]\n|===\n|==="
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrCols: "~,80,40",
+						},
+						Columns: []types.TableColumn{
+							{Width: "", HAlign: "left", VAlign: "top"},
+							{Width: "66.6667", HAlign: "left", VAlign: "top"},
+							{Width: "33.3333", HAlign: "left", VAlign: "top"},
+						},
+						Lines: []types.TableLine{},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+	})
+
+	Context("cell specifiers", func() {
+
+		It("cells with column and row spans", func() {
+			source := `|===
+2+|a |b
+.2+|c |d |e
+2.2+|f
+|g
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Columns: []types.TableColumn{
+							{Width: "33.3333", VAlign: "top", HAlign: "left"},
+							{Width: "33.3333", VAlign: "top", HAlign: "left"},
+							{Width: "33.3334", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a "},
+										},
+										ColSpan: 2,
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b"},
+										},
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "c "},
+										},
+										RowSpan: 2,
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "d "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "e"},
+										},
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "f"},
+										},
+										ColSpan: 2,
+										RowSpan: 2,
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "g"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("duplicated cell", func() {
+			source := `|===
+|a |b |c
+3*|d
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Columns: []types.TableColumn{
+							{Width: "33.3333", VAlign: "top", HAlign: "left"},
+							{Width: "33.3333", VAlign: "top", HAlign: "left"},
+							{Width: "33.3334", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "c"},
+										},
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "d"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "d"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "d"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("cells with alignments and styles", func() {
+			source := `|===
+^.>|a >s|b
+a|c .^m|d
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a"},
+										},
+										HAlign: "center",
+										VAlign: "bottom",
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b"},
+										},
+										HAlign: "right",
+										Style:  "s",
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.Paragraph{
+												Lines: [][]interface{}{
+													{
+														types.StringElement{Content: "c"},
+													},
+												},
+											},
+										},
+										Style: "a",
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "d"},
+										},
+										VAlign: "middle",
+										Style:  "m",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("specifier-like content not preceded by a space", func() {
+			source := `|===
+|a2+|b
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a2+"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("ragged rows with spans", func() {
+			source := `[cols="3*"]
+|===
+|a
+2+|b
+|c |d
+.2+|e |f |g
+|h |i
+|j
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrCols: "3*",
+						},
+						Columns: []types.TableColumn{
+							{Width: "33.3333", VAlign: "top", HAlign: "left"},
+							{Width: "33.3333", VAlign: "top", HAlign: "left"},
+							{Width: "33.3334", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b"},
+										},
+										ColSpan: 2,
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "c "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "d"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "e "},
+										},
+										RowSpan: 2,
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "f "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "g"},
+										},
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "h "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "i"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "j"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+	})
+
+	Context("cell styles", func() {
+
+		It("asciidoc cells with their own attributes", func() {
+			source := `:foo: outer
+
+[cols="1,a"]
+|===
+|{foo}
+|:foo: inner
+
+* item {foo}
+|===`
+			expected := types.Document{
+				Attributes: types.Attributes{
+					"foo": "outer",
+				},
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrCols: "1,a",
+						},
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left", Style: "a"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "outer"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.UnorderedList{
+												Items: []types.UnorderedListItem{
+													{
+														Level:       1,
+														BulletStyle: types.OneAsterisk,
+														CheckStyle:  types.NoCheck,
+														Elements: []interface{}{
+															types.Paragraph{
+																Lines: [][]interface{}{
+																	{
+																		types.StringElement{Content: "item inner"},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDocument(source)).To(MatchDocument(expected))
+		})
+
+		It("asciidoc cell with a custom admonition paragraph", func() {
+			source := `|===
+a|DANGER: in cell
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Columns: []types.TableColumn{
+							{Width: "100", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.Paragraph{
+												Attributes: types.Attributes{
+													types.AttrAdmonitionKind: types.AdmonitionKind("danger"),
+												},
+												Lines: [][]interface{}{
+													{
+														types.StringElement{Content: "in cell"},
+													},
+												},
+											},
+										},
+										Style: "a",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source, configuration.WithCustomAdmonition(configuration.CustomAdmonition{
+				Name: "DANGER",
+			}))).To(MatchDraftDocument(expected))
+		})
+
+		It("asciidoc cell with a nested table", func() {
+			source := `|===
+a|!===
+!x !y
+!===
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Columns: []types.TableColumn{
+							{Width: "100", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.Table{
+												Columns: []types.TableColumn{
+													{Width: "50", VAlign: "top", HAlign: "left"},
+													{Width: "50", VAlign: "top", HAlign: "left"},
+												},
+												Lines: []types.TableLine{
+													{
+														Cells: []types.TableCell{
+															{
+																Elements: []interface{}{
+																	types.StringElement{Content: "x "},
+																},
+															},
+															{
+																Elements: []interface{}{
+																	types.StringElement{Content: "y"},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+										Style: "a",
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("cells on multiple lines, with literal content and escaped separator", func() {
+			source := `[cols="2*"]
+|===
+|first
+*line* |a \| b
+l|  *literal*
+  content
+|c
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrCols: "2*",
+						},
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "first\n"},
+											types.QuotedText{
+												Kind: types.Bold,
+												Elements: []interface{}{
+													types.StringElement{Content: "line"},
+												},
+											},
+											types.StringElement{Content: " "},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a | b"},
+										},
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "*literal*\n  content"},
+										},
+										Style: "l",
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "c"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+	})
+
+	Context("data formats", func() {
+
+		It("csv table with header and quoted values", func() {
+			source := `[format=csv]
+|===
+Name, "Description, with comma"
+
+foo, "multi
+line *value*"
+"a ""quoted"" value",
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrFormat: "csv",
+						},
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Header: types.TableLine{
+							Cells: []types.TableCell{
+								{
+									Elements: []interface{}{
+										types.StringElement{Content: "Name"},
+									},
+								},
+								{
+									Elements: []interface{}{
+										types.StringElement{Content: "Description, with comma"},
+									},
+								},
+							},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "foo"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "multi\nline "},
+											types.QuotedText{
+												Kind: types.Bold,
+												Elements: []interface{}{
+													types.StringElement{Content: "value"},
+												},
+											},
+										},
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a \"quoted\" value"},
+										},
+									},
+									{
+										Elements: []interface{}{},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("csv table with shorthand delimiter and custom separator", func() {
+			source := `[separator=;]
+,===
+a;b
+c;d
+,===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrFormat:    "csv",
+							types.AttrSeparator: ";",
+						},
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b"},
+										},
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "c"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "d"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("csv table with invalid separator", func() {
+			source := `[format=csv,separator=;;]
+|===
+a,b
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrFormat:    "csv",
+							types.AttrSeparator: ";;",
+						},
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("tsv table", func() {
+			source := "[format=tsv]\n|===\na\t\tc\n|==="
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrFormat: "tsv",
+						},
+						Columns: []types.TableColumn{
+							{Width: "33.3333", VAlign: "top", HAlign: "left"},
+							{Width: "33.3333", VAlign: "top", HAlign: "left"},
+							{Width: "33.3334", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a"},
+										},
+									},
+									{
+										Elements: []interface{}{},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "c"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("dsv table with shorthand delimiter and escaped separator", func() {
+			source := `:===
+a:b\:c
+:===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrFormat: "dsv",
+						},
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "a"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "b:c"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("csv table with included data", func() {
+			source := `,===
+include::../../test/includes/data.csv[]
+,===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Attributes: types.Attributes{
+							types.AttrFormat: "csv",
+						},
+						Columns: []types.TableColumn{
+							{Width: "50", VAlign: "top", HAlign: "left"},
+							{Width: "50", VAlign: "top", HAlign: "left"},
+						},
+						Header: types.TableLine{
+							Cells: []types.TableCell{
+								{
+									Elements: []interface{}{
+										types.StringElement{Content: "Name"},
+									},
+								},
+								{
+									Elements: []interface{}{
+										types.StringElement{Content: "Value"},
+									},
+								},
+							},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "foo"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "1,5"},
+										},
+									},
+								},
+							},
+							{
+								Cells: []types.TableCell{
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "bar"},
+										},
+									},
+									{
+										Elements: []interface{}{
+											types.StringElement{Content: "multi\nline"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source, configuration.WithFilename("test.adoc"))).To(MatchDraftDocument(expected))
+		})
+	})
+
+	Context("diagnostics", func() {
+
+		parse := func(source string) []types.Diagnostic {
+			reported := []types.Diagnostic{}
+			_, err := ParseDraftDocument(source,
+				configuration.WithFilename("test.adoc"),
+				configuration.WithDiagnosticSink(func(d types.Diagnostic) {
+					reported = append(reported, d)
+				}))
+			Expect(err).NotTo(HaveOccurred())
+			return reported
+		}
+
+		It("cell spanning past the last column", func() {
+			source := `a paragraph
+
+[cols="2*"]
+|===
+|a 3+|b
+|===`
+			Expect(parse(source)).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.InvalidTableCellsCode,
+					Message:  "cell in table line at offset 1 spans over 3 column(s), exceeding the number of columns",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "Table",
+				},
+			}))
+		})
+
+		It("cells of an incomplete last line", func() {
+			source := `a paragraph
+
+|===
+|a |b
+|c
+|===`
+			Expect(parse(source)).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.InvalidTableCellsCode,
+					Message:  "dropping 1 cell(s) from incomplete table line",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "Table",
+				},
+			}))
+		})
+
+		It("csv table with invalid separator", func() {
+			source := `a paragraph
+
+[format=csv,separator=;;]
+|===
+a,b
+|===`
+			Expect(parse(source)).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.InvalidTableSeparatorCode,
+					Message:  "invalid separator ';;' in table in the 'csv' format, using the default separator instead",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "Table",
+				},
+			}))
+		})
+
+		It("cell with a huge duplication factor", func() {
+			source := `a paragraph
+
+|===
+2000000000*|x
+|===`
+			Expect(parse(source)).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.InvalidTableCellsCode,
+					Message:  "table cell duplication factor of 2000000000 exceeds the maximum of 1000",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "Table",
+				},
+			}))
+		})
+
+		It("cell with a huge column span", func() {
+			source := `a paragraph
+
+|===
+2000000000+|x
+|===`
+			Expect(parse(source)).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.InvalidTableCellsCode,
+					Message:  "table cell column span of 2000000000 exceeds the maximum of 1000",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "Table",
+				},
+			}))
+		})
+
+		It("cell with a duplication factor exceeding the configured maximum", func() {
+			source := `|===
+|a 5*|b
+|===`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.Table{
+						Columns: []types.TableColumn{
+							{Width: "25", HAlign: "left", VAlign: "top"},
+							{Width: "25", HAlign: "left", VAlign: "top"},
+							{Width: "25", HAlign: "left", VAlign: "top"},
+							{Width: "25", HAlign: "left", VAlign: "top"},
+						},
+						Lines: []types.TableLine{
+							{
+								Cells: []types.TableCell{
+									{Elements: []interface{}{types.StringElement{Content: "a"}}},
+									{Elements: []interface{}{types.StringElement{Content: "b"}}},
+									{Elements: []interface{}{types.StringElement{Content: "b"}}},
+									{Elements: []interface{}{types.StringElement{Content: "b"}}},
+								},
+							},
+						},
+					},
+				},
+			}
+			reported := []types.Diagnostic{}
+			Expect(ParseDraftDocument(source,
+				configuration.WithMaxTableCellFactor(3),
+				configuration.WithDiagnosticSink(func(d types.Diagnostic) {
+					reported = append(reported, d)
+				}))).To(MatchDraftDocument(expected))
+			Expect(reported).To(HaveLen(1))
+			Expect(reported[0].Message).To(Equal("table cell duplication factor of 5 exceeds the maximum of 3"))
+		})
+
+		It("autowidth columns with total widths exceeding 100%", func() {
+			source := `a paragraph
+
+[cols="~,80,40"]
+|===
+|===`
+			Expect(parse(source)).To(Equal([]types.Diagnostic{
+				{
+					Severity: types.SeverityWarning,
+					Code:     types.InvalidTableColumnWidthsCode,
+					Message:  "total column widths (120) cannot exceed 100% when using autowidth columns",
+					Filename: "test.adoc",
+					Line:     3,
+					Kind:     "Table",
+				},
+			}))
+		})
+	})
 })
@@ -0,0 +1,30 @@
+package parser
+
+import "strings"
+
+// tableCellSeparatorKey the key to the separator of the cells of the current table in the parser's global store
+const tableCellSeparatorKey = "table_cell_separator"
+
+// setTableCellSeparator sets the separator of the cells of the table being parsed
+func (c *current) setTableCellSeparator(separator string) (bool, error) {
+	c.globalStore[tableCellSeparatorKey] = separator
+	return true, nil
+}
+
+// isTableCellSeparator returns `true` if the given separator is the one of the table being parsed (`|` by default)
+func (c *current) isTableCellSeparator(separator string) (bool, error) {
+	return separator == c.tableCellSeparator(), nil
+}
+
+// unescapeTableCellSeparator replaces the escaped separators (eg: `\|`) with the separator of the table being parsed
+func (c *current) unescapeTableCellSeparator(content string) string {
+	separator := c.tableCellSeparator()
+	return strings.ReplaceAll(content, `\`+separator, separator)
+}
+
+func (c *current) tableCellSeparator() string {
+	if separator, ok := c.globalStore[tableCellSeparatorKey].(string); ok {
+		return separator
+	}
+	return "|"
+}
@@ -0,0 +1,129 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// experimentalKey the key to the flag in the parser's global store which enables the UI macros (keyboard, button and menu)
+const experimentalKey = "experimental"
+
+// placeholdersKey the key to the placeholders of the content being substituted in the parser's global store
+const placeholdersKey = "placeholders"
+
+// isExperimental returns `true` if the document has the `experimental` attribute
+func (c *current) isExperimental() (bool, error) {
+	experimental, _ := c.globalStore[experimentalKey].(bool)
+	return experimental, nil
+}
+
+var placeholderRegexp = regexp.MustCompile("\uFFFD([0-9]+)\uFFFD")
+
+// newInlineKeyboard returns a new InlineKeyboard with the keys of the given `kbd:[]` macro content, which are separated by
+// the first `+` or `,` character (eg: `Ctrl+Shift+T`, `Ctrl,T` or `Ctrl++`)
+func (c *current) newInlineKeyboard(content string) (types.InlineKeyboard, error) {
+	keys := splitKeys(unescapeUIMacroContent(content))
+	result := make([][]interface{}, len(keys))
+	for i, key := range keys {
+		result[i] = uiMacroElements(key)
+	}
+	return types.NewInlineKeyboard(result)
+}
+
+func splitKeys(keys string) []string {
+	keys = strings.TrimSpace(keys)
+	if len(keys) < 2 {
+		return []string{keys}
+	}
+	i := strings.IndexAny(keys[1:], ",+")
+	if i == -1 {
+		return []string{keys}
+	}
+	delim := keys[i+1 : i+2]
+	// the last key may be the delimiter itself (eg: `Ctrl++`)
+	lastIsDelim := strings.HasSuffix(keys, delim)
+	result := strings.Split(strings.TrimSuffix(keys, delim), delim)
+	for i, key := range result {
+		result[i] = strings.TrimSpace(key)
+	}
+	if lastIsDelim {
+		result[len(result)-1] += delim
+	}
+	return result
+}
+
+// newInlineButton returns a new InlineButton with the label of the given `btn:[]` macro content
+func (c *current) newInlineButton(content string) (types.InlineButton, error) {
+	return types.NewInlineButton(uiMacroElements(strings.TrimSpace(unescapeUIMacroContent(content))))
+}
+
+// newInlineMenu returns a new InlineMenu with the given menu, followed by the submenus and menu item of the given
+// `menu:[]` macro content, which are separated by `>` or `,` characters (eg: `menu:View[Zoom > Reset]`)
+func (c *current) newInlineMenu(menu, content string) (types.InlineMenu, error) {
+	path := []string{menu}
+	if items := strings.TrimSpace(c.resolveMenuSeparators(unescapeUIMacroContent(content))); items != "" {
+		switch {
+		case strings.Contains(items, ">"):
+			path = append(path, strings.Split(items, ">")...)
+		case strings.Contains(items, ","):
+			path = append(path, strings.Split(items, ",")...)
+		default:
+			path = append(path, items)
+		}
+	}
+	return types.NewInlineMenu(menuPath(path))
+}
+
+// isMenuShorthand returns `true` if the given content of a double-quoted string is a menu selection,
+// ie, it contains a `>` character surrounded by spaces (eg: `"File > Save"`)
+func (c *current) isMenuShorthand(content string) (bool, error) {
+	return strings.Contains(c.resolveMenuSeparators(content), " > "), nil
+}
+
+// newInlineMenuShorthand returns a new InlineMenu from the given content of a double-quoted string (eg: `"File > Save"`)
+func (c *current) newInlineMenuShorthand(content string) (types.InlineMenu, error) {
+	return types.NewInlineMenu(menuPath(strings.Split(c.resolveMenuSeparators(content), ">")))
+}
+
+func menuPath(path []string) [][]interface{} {
+	result := make([][]interface{}, len(path))
+	for i, p := range path {
+		result[i] = uiMacroElements(strings.TrimSpace(p))
+	}
+	return result
+}
+
+// resolveMenuSeparators replaces the placeholders of the `>` special characters with the character itself,
+// since the special characters were already substituted when the UI macros are parsed
+func (c *current) resolveMenuSeparators(content string) string {
+	return placeholderRegexp.ReplaceAllStringFunc(content, func(s string) string {
+		if ok, _ := c.isSpecialCharacter(placeholderRegexp.FindStringSubmatch(s)[1], ">"); ok {
+			return ">"
+		}
+		return s
+	})
+}
+
+func unescapeUIMacroContent(content string) string {
+	return strings.ReplaceAll(content, `\]`, "]")
+}
+
+// uiMacroElements returns the elements of the given content, in which the placeholders are kept as such
+// until the elements they refer to are restored
+func uiMacroElements(content string) []interface{} {
+	result := []interface{}{}
+	start := 0
+	for _, m := range placeholderRegexp.FindAllStringSubmatchIndex(content, -1) {
+		if m[0] > start {
+			result = append(result, types.StringElement{Content: content[start:m[0]]})
+		}
+		result = append(result, types.ElementPlaceHolder{Ref: content[m[2]:m[3]]})
+		start = m[1]
+	}
+	if start < len(content) {
+		result = append(result, types.StringElement{Content: content[start:]})
+	}
+	return result
+}
@@ -0,0 +1,335 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("ui macros", func() {
+
+	Context("draft documents", func() {
+
+		Context("with experimental attribute", func() {
+
+			It("keyboard with single key", func() {
+				source := `:experimental:
+
+kbd:[F11]`
+				expected := types.DraftDocument{
+					Attributes: types.Attributes{
+						types.AttrExperimental: "",
+					},
+					Elements: []interface{}{
+						types.AttributeDeclaration{
+							Name:  types.AttrExperimental,
+							Value: "",
+						},
+						types.BlankLine{},
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineKeyboard{
+										Keys: [][]interface{}{
+											{
+												types.StringElement{Content: "F11"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("keyboard with key combinations", func() {
+				source := `:experimental:
+
+press kbd:[Ctrl+Shift+T], kbd:[Ctrl , T] or kbd:[Ctrl++]`
+				expected := types.DraftDocument{
+					Attributes: types.Attributes{
+						types.AttrExperimental: "",
+					},
+					Elements: []interface{}{
+						types.AttributeDeclaration{
+							Name:  types.AttrExperimental,
+							Value: "",
+						},
+						types.BlankLine{},
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "press "},
+									types.InlineKeyboard{
+										Keys: [][]interface{}{
+											{
+												types.StringElement{Content: "Ctrl"},
+											},
+											{
+												types.StringElement{Content: "Shift"},
+											},
+											{
+												types.StringElement{Content: "T"},
+											},
+										},
+									},
+									types.StringElement{Content: ", "},
+									types.InlineKeyboard{
+										Keys: [][]interface{}{
+											{
+												types.StringElement{Content: "Ctrl"},
+											},
+											{
+												types.StringElement{Content: "T"},
+											},
+										},
+									},
+									types.StringElement{Content: " or "},
+									types.InlineKeyboard{
+										Keys: [][]interface{}{
+											{
+												types.StringElement{Content: "Ctrl"},
+											},
+											{
+												types.StringElement{Content: "+"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("keyboard with escaped bracket and special character", func() {
+				source := `:experimental:
+
+kbd:[Ctrl+\]] and kbd:[Ctrl+<]`
+				expected := types.DraftDocument{
+					Attributes: types.Attributes{
+						types.AttrExperimental: "",
+					},
+					Elements: []interface{}{
+						types.AttributeDeclaration{
+							Name:  types.AttrExperimental,
+							Value: "",
+						},
+						types.BlankLine{},
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineKeyboard{
+										Keys: [][]interface{}{
+											{
+												types.StringElement{Content: "Ctrl"},
+											},
+											{
+												types.StringElement{Content: "]"},
+											},
+										},
+									},
+									types.StringElement{Content: " and "},
+									types.InlineKeyboard{
+										Keys: [][]interface{}{
+											{
+												types.StringElement{Content: "Ctrl"},
+											},
+											{
+												types.SpecialCharacter{Name: "<"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("button", func() {
+				source := `:experimental:
+
+click btn:[Save]`
+				expected := types.DraftDocument{
+					Attributes: types.Attributes{
+						types.AttrExperimental: "",
+					},
+					Elements: []interface{}{
+						types.AttributeDeclaration{
+							Name:  types.AttrExperimental,
+							Value: "",
+						},
+						types.BlankLine{},
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "click "},
+									types.InlineButton{
+										Label: []interface{}{
+											types.StringElement{Content: "Save"},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("menu with submenu and item", func() {
+				source := `:experimental:
+
+menu:View[Zoom > Reset]`
+				expected := types.DraftDocument{
+					Attributes: types.Attributes{
+						types.AttrExperimental: "",
+					},
+					Elements: []interface{}{
+						types.AttributeDeclaration{
+							Name:  types.AttrExperimental,
+							Value: "",
+						},
+						types.BlankLine{},
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineMenu{
+										Path: [][]interface{}{
+											{
+												types.StringElement{Content: "View"},
+											},
+											{
+												types.StringElement{Content: "Zoom"},
+											},
+											{
+												types.StringElement{Content: "Reset"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("menu without item", func() {
+				source := `:experimental:
+
+menu:File[]`
+				expected := types.DraftDocument{
+					Attributes: types.Attributes{
+						types.AttrExperimental: "",
+					},
+					Elements: []interface{}{
+						types.AttributeDeclaration{
+							Name:  types.AttrExperimental,
+							Value: "",
+						},
+						types.BlankLine{},
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.InlineMenu{
+										Path: [][]interface{}{
+											{
+												types.StringElement{Content: "File"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+
+			It("menu shorthand", func() {
+				source := `:experimental:
+
+select "File > Save" but not "a>b"`
+				expected := types.DraftDocument{
+					Attributes: types.Attributes{
+						types.AttrExperimental: "",
+					},
+					Elements: []interface{}{
+						types.AttributeDeclaration{
+							Name:  types.AttrExperimental,
+							Value: "",
+						},
+						types.BlankLine{},
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.StringElement{Content: "select "},
+									types.InlineMenu{
+										Path: [][]interface{}{
+											{
+												types.StringElement{Content: "File"},
+											},
+											{
+												types.StringElement{Content: "Save"},
+											},
+										},
+									},
+									types.StringElement{Content: ` but not "a`},
+									types.SpecialCharacter{Name: ">"},
+									types.StringElement{Content: `b"`},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+		})
+
+		Context("without experimental attribute", func() {
+
+			It("keyboard, button and menu as plain text", func() {
+				source := `kbd:[F11], btn:[Save] and "File > Save"`
+				expected := types.DraftDocument{
+					Elements: []interface{}{
+						types.Paragraph{
+							Lines: [][]interface{}{
+								{
+									types.UserMacro{
+										Kind: types.InlineMacro,
+										Name: "kbd",
+										Attributes: types.Attributes{
+											"F11": nil,
+										},
+										RawText: "kbd:[F11]",
+									},
+									types.StringElement{Content: ", "},
+									types.UserMacro{
+										Kind: types.InlineMacro,
+										Name: "btn",
+										Attributes: types.Attributes{
+											"Save": nil,
+										},
+										RawText: "btn:[Save]",
+									},
+									types.StringElement{Content: ` and "File `},
+									types.SpecialCharacter{Name: ">"},
+									types.StringElement{Content: ` Save"`},
+								},
+							},
+						},
+					},
+				}
+				Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+			})
+		})
+	})
+})
@@ -0,0 +1,153 @@
+package parser_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("video blocks", func() {
+
+	Context("draft documents", func() {
+
+		It("with empty attributes", func() {
+			source := "video::videos/foo.mp4[]"
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.VideoBlock{
+						Location: types.Location{
+							Path: []interface{}{
+								types.StringElement{Content: "videos/foo.mp4"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("with poster, dimensions, times and options", func() {
+			source := `video::videos/foo.mp4[foo.png, 640, 480, start=10, end=20, options="autoplay,loop"]`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.VideoBlock{
+						Attributes: types.Attributes{
+							types.AttrPoster:      "foo.png",
+							types.AttrWidth:       "640",
+							types.AttrImageHeight: "480",
+							types.AttrStart:       "10",
+							types.AttrEnd:         "20",
+							types.AttrOptions: map[string]bool{
+								"autoplay": true,
+								"loop":     true,
+							},
+						},
+						Location: types.Location{
+							Path: []interface{}{
+								types.StringElement{Content: "videos/foo.mp4"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("with id, title and poster in imagesdir", func() {
+			source := `:imagesdir: media
+
+[#vid]
+.A title
+video::foo.mp4[foo.png]`
+			expected := types.DraftDocument{
+				Attributes: types.Attributes{
+					"imagesdir": "media",
+				},
+				Elements: []interface{}{
+					types.AttributeDeclaration{
+						Name:  "imagesdir",
+						Value: "media",
+					},
+					types.BlankLine{},
+					types.VideoBlock{
+						Attributes: types.Attributes{
+							types.AttrID:       "vid",
+							types.AttrCustomID: true,
+							types.AttrTitle:    "A title",
+							types.AttrPoster:   "media/foo.png",
+						},
+						Location: types.Location{
+							Path: []interface{}{
+								types.StringElement{Content: "media"},
+								types.StringElement{Content: "foo.mp4"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+
+		It("hosted on youtube", func() {
+			source := `:imagesdir: media
+
+video::rPQoq7ThGAU[youtube]`
+			expected := types.DraftDocument{
+				Attributes: types.Attributes{
+					"imagesdir": "media",
+				},
+				Elements: []interface{}{
+					types.AttributeDeclaration{
+						Name:  "imagesdir",
+						Value: "media",
+					},
+					types.BlankLine{},
+					types.VideoBlock{
+						Attributes: types.Attributes{
+							types.AttrPoster: types.YouTubeVideo,
+						},
+						Location: types.Location{
+							Path: []interface{}{
+								types.StringElement{Content: "rPQoq7ThGAU"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+	})
+})
+
+var _ = Describe("audio blocks", func() {
+
+	Context("draft documents", func() {
+
+		It("with title and options", func() {
+			source := `.A title
+audio::sounds/foo.ogg[start=60, opts="autoplay,nocontrols"]`
+			expected := types.DraftDocument{
+				Elements: []interface{}{
+					types.AudioBlock{
+						Attributes: types.Attributes{
+							types.AttrTitle: "A title",
+							types.AttrStart: "60",
+							types.AttrOptions: map[string]bool{
+								"autoplay":   true,
+								"nocontrols": true,
+							},
+						},
+						Location: types.Location{
+							Path: []interface{}{
+								types.StringElement{Content: "sounds/foo.ogg"},
+							},
+						},
+					},
+				},
+			}
+			Expect(ParseDraftDocument(source)).To(MatchDraftDocument(expected))
+		})
+	})
+})
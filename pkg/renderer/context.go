@@ -1,13 +1,16 @@
 package renderer
 
 import (
+	"context"
+
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
 )
 
-// Context is a custom implementation of the standard golang context.Context interface,
-// which carries the types.Document which is being processed
+// Context the rendering context, which carries the configuration and the state of the types.Document which is being rendered.
+// It also embeds the `context.Context` of the conversion, so that the rendering stops as soon as the latter is done
 type Context struct {
+	context.Context
 	Config configuration.Configuration
 	// TableOfContents exists even if the document did not specify the `:toc:` attribute.
 	// It will take into account the configured `:toclevels:` attribute value.
@@ -16,18 +19,21 @@ type Context struct {
 	WithinDelimitedBlock bool
 	EncodeSpecialChars   bool
 	WithinList           int
+	InteractiveChecklist bool // `true` when rendering the items of an unordered list with the `interactive` option
 	counters             map[string]int
 	Attributes           types.Attributes
 	Footnotes            []types.Footnote
 	ElementReferences    types.ElementReferences
 	HasHeader            bool
 	UseUnicode           bool
+	Generator            string // the name (and version) of the library, rendered in the `generator` meta element
 }
 
 // NewContext returns a new rendering context for the given document.
 func NewContext(doc types.Document, config configuration.Configuration) *Context {
 	_, hasHeader := doc.Header()
 	return &Context{
+		Context:            context.Background(),
 		Config:             config,
 		counters:           make(map[string]int),
 		Attributes:         doc.Attributes,
@@ -35,6 +41,7 @@ func NewContext(doc types.Document, config configuration.Configuration) *Context
 		Footnotes:          doc.Footnotes,
 		HasHeader:          hasHeader,
 		EncodeSpecialChars: true,
+		Generator:          "libasciidoc",
 	}
 }
 
@@ -59,6 +66,24 @@ func (ctx *Context) GetAndIncrementExampleBlockCounter() int {
 	return ctx.getAndIncrementCounter(exampleBlockCounter)
 }
 
+const checkedItemCounter = "checkedItemCounter"
+
+const uncheckedItemCounter = "uncheckedItemCounter"
+
+// IncrementChecklistItemCounter increments the counter of checked or unchecked checklist items.
+func (ctx *Context) IncrementChecklistItemCounter(checked bool) {
+	if checked {
+		ctx.getAndIncrementCounter(checkedItemCounter)
+		return
+	}
+	ctx.getAndIncrementCounter(uncheckedItemCounter)
+}
+
+// ChecklistItemCounters returns the number of checked and unchecked checklist items which were rendered so far.
+func (ctx *Context) ChecklistItemCounters() (int, int) {
+	return ctx.counters[checkedItemCounter], ctx.counters[uncheckedItemCounter]
+}
+
 // getAndIncrementCounter returns the current value for the  counter after internally incrementing it.
 func (ctx *Context) getAndIncrementCounter(name string) int {
 	if _, found := ctx.counters[name]; !found {
@@ -0,0 +1,39 @@
+package sgml
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func (r *sgmlRenderer) renderAudioBlock(ctx *Context, a types.AudioBlock) (string, error) {
+	result := &strings.Builder{}
+	roles, err := r.renderElementRoles(ctx, a.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render audio roles")
+	}
+	err = r.audioBlock.Execute(result, struct {
+		ID       string
+		Title    string
+		Roles    string
+		Src      string
+		Autoplay bool
+		Loop     bool
+		Muted    bool
+		Controls bool
+	}{
+		ID:       r.renderElementID(a.Attributes),
+		Title:    r.renderElementTitle(a.Attributes),
+		Roles:    roles,
+		Src:      a.Location.Stringify() + mediaTimeAnchor(a.Attributes),
+		Autoplay: a.Attributes.HasOption("autoplay"),
+		Loop:     a.Attributes.HasOption("loop"),
+		Muted:    a.Attributes.HasOption("muted"),
+		Controls: !a.Attributes.HasOption("nocontrols"),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render audio block")
+	}
+	return result.String(), nil
+}
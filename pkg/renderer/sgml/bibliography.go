@@ -0,0 +1,23 @@
+package sgml
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func (r *sgmlRenderer) renderBibliographyAnchor(a types.BibliographyAnchor) (string, error) {
+	result := &strings.Builder{}
+	err := r.bibliographyAnchor.Execute(result, struct {
+		ID    string
+		Label string
+	}{
+		ID:    a.ID,
+		Label: a.Label,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render bibliography anchor")
+	}
+	return result.String(), nil
+}
@@ -2,6 +2,7 @@ package sgml
 
 import (
 	"io"
+	"strconv"
 	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
@@ -29,6 +30,7 @@ func (r *sgmlRenderer) renderCalloutList(ctx *renderer.Context, l types.CalloutL
 		ID      string
 		Title   string
 		Roles   string
+		Icons   string
 		Content string
 		Items   []types.CalloutListItem
 	}{
@@ -36,6 +38,7 @@ func (r *sgmlRenderer) renderCalloutList(ctx *renderer.Context, l types.CalloutL
 		ID:      r.renderElementID(l.Attributes),
 		Title:   r.renderElementTitle(l.Attributes),
 		Roles:   roles,
+		Icons:   calloutIcons(ctx),
 		Content: string(content.String()),
 		Items:   l.Items,
 	})
@@ -46,18 +49,28 @@ func (r *sgmlRenderer) renderCalloutList(ctx *renderer.Context, l types.CalloutL
 }
 
 func (r *sgmlRenderer) renderCalloutListItem(ctx *renderer.Context, w io.Writer, item types.CalloutListItem) error {
-
-	content, err := r.renderListElements(ctx, item.Elements)
+	icons := calloutIcons(ctx)
+	var content string
+	var err error
+	if icons != "" {
+		content, err = r.renderCalloutListItemText(ctx, item.Elements)
+	} else {
+		content, err = r.renderListElements(ctx, item.Elements)
+	}
 	if err != nil {
 		return errors.Wrap(err, "unable to render callout list item content")
 	}
 	err = r.calloutListItem.Execute(w, struct {
 		Context *renderer.Context
 		Ref     int
+		Icons   string
+		Path    string
 		Content string
 	}{
 		Context: ctx,
 		Ref:     item.Ref,
+		Icons:   icons,
+		Path:    calloutIconPath(ctx, item.Ref),
 		Content: string(content),
 	})
 	if err != nil {
@@ -65,3 +78,53 @@ func (r *sgmlRenderer) renderCalloutListItem(ctx *renderer.Context, w io.Writer,
 	}
 	return nil
 }
+
+// renderCalloutListItemText renders the content of a callout list item in a table cell, in which
+// the first paragraph is not wrapped in a `<p>` element (but the subsequent blocks are rendered as-is)
+func (r *sgmlRenderer) renderCalloutListItemText(ctx *renderer.Context, elements []interface{}) (string, error) {
+	if len(elements) == 0 {
+		return "", nil
+	}
+	p, ok := elements[0].(types.Paragraph)
+	if !ok {
+		return r.renderListElements(ctx, elements)
+	}
+	result := &strings.Builder{}
+	text, err := r.renderLines(ctx, p.Lines)
+	if err != nil {
+		return "", err
+	}
+	result.WriteString(text)
+	if len(elements) > 1 {
+		blocks, err := r.renderListElements(ctx, elements[1:])
+		if err != nil {
+			return "", err
+		}
+		result.WriteString("\n")
+		result.WriteString(blocks)
+	}
+	return result.String(), nil
+}
+
+// calloutIcons returns the kind of icons used to render the callouts (`font` or `image`),
+// or an empty string if the callouts are rendered as text (ie, when the `icons` document attribute is not set)
+func calloutIcons(ctx *renderer.Context) string {
+	icons, found := ctx.Attributes.GetAsString("icons")
+	switch {
+	case !found || icons == "text":
+		return ""
+	case icons == "font":
+		return "font"
+	default:
+		return "image"
+	}
+}
+
+// calloutIconPath returns the path to the image of the callout with the given ref (eg: `images/icons/callouts/1.png`),
+// or an empty string if the callouts are not rendered with images
+func calloutIconPath(ctx *renderer.Context, ref int) string {
+	if calloutIcons(ctx) != "image" {
+		return ""
+	}
+	return imageSource(ctx, renderIconPath(ctx, "callouts/"+strconv.Itoa(ref)), types.Position{})
+}
@@ -1,6 +1,7 @@
 package sgml
 
 import (
+	"fmt"
 	"path/filepath"
 	"strings"
 
@@ -27,6 +28,14 @@ func (r *sgmlRenderer) renderInternalCrossReference(ctx *renderer.Context, xref
 			return "", errors.Errorf("unable to process internal cross reference to element of type %T", target)
 		}
 	} else {
+		ctx.Config.Report(types.Diagnostic{
+			Severity: types.SeverityWarning,
+			Code:     types.BrokenCrossReferenceCode,
+			Message:  fmt.Sprintf("cross reference to unknown element '%s'", xref.ID),
+			Filename: xref.Position.Filename,
+			Line:     xref.Position.Line,
+			Kind:     "InternalCrossReference",
+		})
 		label = "[" + xref.ID + "]"
 	}
 	err := r.internalCrossReference.Execute(result, struct {
@@ -36,7 +36,7 @@ func (r *sgmlRenderer) renderAdmonitionBlock(ctx *renderer.Context, b types.Exam
 	}{
 		Context: ctx,
 		ID:      r.renderElementID(b.Attributes),
-		Kind:    kind,
+		Kind:    types.AdmonitionKind(admonitionClass(ctx, string(kind))),
 		Roles:   roles,
 		Title:   r.renderElementTitle(b.Attributes),
 		Icon:    icon,
@@ -77,7 +77,7 @@ func (r *sgmlRenderer) renderAdmonitionParagraph(ctx *renderer.Context, p types.
 		Context: ctx,
 		ID:      r.renderElementID(p.Attributes),
 		Title:   r.renderElementTitle(p.Attributes),
-		Kind:    string(k),
+		Kind:    admonitionClass(ctx, string(k)),
 		Roles:   roles,
 		Icon:    icon,
 		Content: string(content),
@@ -27,6 +27,26 @@ func (r *sgmlRenderer) renderExampleBlock(ctx *renderer.Context, b types.Example
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render fenced block content")
 	}
+	// collapsible blocks have no caption, and fall back to regular example blocks
+	// if the backend does not support them
+	if b.Attributes.HasOption("collapsible") && r.collapsibleExampleBlock != nil {
+		err = r.collapsibleExampleBlock.Execute(result, struct {
+			Context *renderer.Context
+			ID      string
+			Title   string
+			Roles   string
+			Open    bool
+			Content string
+		}{
+			Context: ctx,
+			ID:      r.renderElementID(b.Attributes),
+			Title:   r.renderElementTitle(b.Attributes),
+			Roles:   roles,
+			Open:    b.Attributes.HasOption("open"),
+			Content: content,
+		})
+		return result.String(), err
+	}
 	c, ok := b.Attributes.GetAsString(types.AttrCaption)
 	if !ok {
 		c = ctx.Attributes.GetAsStringWithDefault(types.AttrExampleCaption, "Example")
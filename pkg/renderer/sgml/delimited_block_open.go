@@ -0,0 +1,60 @@
+package sgml
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func (r *sgmlRenderer) renderOpenBlock(ctx *renderer.Context, b types.OpenBlock) (string, error) {
+	result := &strings.Builder{}
+	blocks := discardBlankLines(b.Elements)
+	content, err := r.renderElements(ctx, blocks)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render open block content")
+	}
+	roles, err := r.renderElementRoles(ctx, b.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render open block roles")
+	}
+	style, _ := b.Attributes.BlockStyle()
+	if style == types.Abstract {
+		// an abstract is rendered as a quote block, with the `abstract` role
+		err = r.quoteBlock.Execute(result, struct {
+			Context     *renderer.Context
+			ID          string
+			Title       string
+			Roles       string
+			Attribution Attribution
+			Content     string
+		}{
+			Context: ctx,
+			ID:      r.renderElementID(b.Attributes),
+			Title:   r.renderElementTitle(b.Attributes),
+			Roles:   strings.TrimSpace(string(types.Abstract) + " " + roles),
+			Content: content,
+		})
+		return result.String(), err
+	}
+	if style == types.Open {
+		style = "" // no extra class for the `[open]` style
+	}
+	err = r.openBlock.Execute(result, struct {
+		Context *renderer.Context
+		ID      string
+		Title   string
+		Style   string
+		Roles   string
+		Content string
+	}{
+		Context: ctx,
+		ID:      r.renderElementID(b.Attributes),
+		Title:   r.renderElementTitle(b.Attributes),
+		Style:   string(style),
+		Roles:   roles,
+		Content: content,
+	})
+	return result.String(), err
+}
@@ -117,7 +117,7 @@ func (r *sgmlRenderer) renderSourceLines(ctx *renderer.Context, b types.ListingB
 			result.WriteString(highlightedLineBuf.String())
 			// append callouts at the end of the highlighted line
 			for _, callout := range callouts {
-				renderedCallout, err := r.renderCalloutRef(callout)
+				renderedCallout, err := r.renderCalloutRef(ctx, callout)
 				if err != nil {
 					return "", "", "", err
 				}
@@ -167,9 +167,17 @@ func (r *sgmlRenderer) renderSourceLine(ctx *renderer.Context, line interface{})
 	return result.String(), callouts, nil
 }
 
-func (r *sgmlRenderer) renderCalloutRef(co types.Callout) (string, error) {
+func (r *sgmlRenderer) renderCalloutRef(ctx *renderer.Context, co types.Callout) (string, error) {
 	result := &strings.Builder{}
-	err := r.calloutRef.Execute(result, co)
+	err := r.calloutRef.Execute(result, struct {
+		Ref   int
+		Icons string
+		Path  string
+	}{
+		Ref:   co.Ref,
+		Icons: calloutIcons(ctx),
+		Path:  calloutIconPath(ctx, co.Ref),
+	})
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render callout number")
 	}
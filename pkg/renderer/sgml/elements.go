@@ -64,6 +64,9 @@ func (r *sgmlRenderer) renderListElements(ctx *renderer.Context, elements []inte
 // nolint: gocyclo
 func (r *sgmlRenderer) renderElement(ctx *renderer.Context, element interface{}) (string, error) {
 	log.Debugf("rendering element of type `%T`", element)
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	switch e := element.(type) {
 	case []interface{}:
 		return r.renderElements(ctx, e)
@@ -71,6 +74,8 @@ func (r *sgmlRenderer) renderElement(ctx *renderer.Context, element interface{})
 		return r.renderTableOfContents(ctx, ctx.TableOfContents)
 	case types.Section:
 		return r.renderSection(ctx, e)
+	case types.DiscreteHeading:
+		return r.renderDiscreteHeading(ctx, e)
 	case types.Preamble:
 		return r.renderPreamble(ctx, e)
 	case types.BlankLine:
@@ -84,21 +89,35 @@ func (r *sgmlRenderer) renderElement(ctx *renderer.Context, element interface{})
 	case types.CalloutList:
 		return r.renderCalloutList(ctx, e)
 	case types.Callout:
-		return r.renderCalloutRef(e)
+		return r.renderCalloutRef(ctx, e)
 	case types.Paragraph:
 		return r.renderParagraph(ctx, e)
 	case types.InternalCrossReference:
 		return r.renderInternalCrossReference(ctx, e)
 	case types.ExternalCrossReference:
 		return r.renderExternalCrossReference(ctx, e)
+	case types.BibliographyAnchor:
+		return r.renderBibliographyAnchor(e)
 	case types.QuotedText:
 		return r.renderQuotedText(ctx, e)
 	case types.InlinePassthrough:
 		return r.renderInlinePassthrough(ctx, e)
+	case types.InlineStem:
+		return r.renderInlineStem(ctx, e)
 	case types.ImageBlock:
 		return r.renderImageBlock(ctx, e)
+	case types.VideoBlock:
+		return r.renderVideoBlock(ctx, e)
+	case types.AudioBlock:
+		return r.renderAudioBlock(ctx, e)
 	case types.Icon:
 		return r.renderInlineIcon(ctx, e)
+	case types.InlineKeyboard:
+		return r.renderInlineKeyboard(ctx, e)
+	case types.InlineButton:
+		return r.renderInlineButton(ctx, e)
+	case types.InlineMenu:
+		return r.renderInlineMenu(ctx, e)
 	case types.InlineImage:
 		return r.renderInlineImage(ctx, e)
 	case types.ExampleBlock:
@@ -107,12 +126,16 @@ func (r *sgmlRenderer) renderElement(ctx *renderer.Context, element interface{})
 		return r.renderQuoteBlock(ctx, e)
 	case types.SidebarBlock:
 		return r.renderSidebarBlock(ctx, e)
+	case types.OpenBlock:
+		return r.renderOpenBlock(ctx, e)
 	case types.FencedBlock:
 		return r.renderFencedBlock(ctx, e)
 	case types.ListingBlock:
 		return r.renderListingBlock(ctx, e)
 	case types.PassthroughBlock:
 		return r.renderPassthroughBlock(ctx, e)
+	case types.StemBlock:
+		return r.renderStemBlock(ctx, e)
 	case types.MarkdownQuoteBlock:
 		return r.renderMarkdownQuoteBlock(ctx, e)
 	case types.VerseBlock:
@@ -143,6 +166,8 @@ func (r *sgmlRenderer) renderElement(ctx *renderer.Context, element interface{})
 		return r.renderThematicBreak()
 	case types.SpecialCharacter:
 		return r.renderSpecialCharacter(ctx, e)
+	case types.CharacterReference:
+		return r.renderCharacterReference(e)
 	case types.PredefinedAttribute:
 		return r.renderPredefinedAttribute(e)
 	default:
@@ -164,6 +189,12 @@ func (r *sgmlRenderer) renderPlainText(ctx *renderer.Context, element interface{
 		return element.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""), nil
 	case types.InlineImage:
 		return element.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""), nil
+	case types.InlineKeyboard:
+		return r.renderUIMacroPlainText(ctx, element.Keys, "+")
+	case types.InlineButton:
+		return r.renderPlainText(ctx, element.Label)
+	case types.InlineMenu:
+		return r.renderUIMacroPlainText(ctx, element.Path, " > ")
 	case types.InlineLink:
 		if alt, ok := element.Attributes[types.AttrInlineLinkText].([]interface{}); ok {
 			return r.renderPlainText(ctx, alt)
@@ -173,6 +204,10 @@ func (r *sgmlRenderer) renderPlainText(ctx *renderer.Context, element interface{
 		return "\n\n", nil
 	case types.StringElement:
 		return element.Content, nil
+	case types.SpecialCharacter:
+		return r.renderSpecialCharacter(ctx, element)
+	case types.CharacterReference:
+		return r.renderCharacterReference(element)
 	case types.QuotedString:
 		return r.renderQuotedStringPlain(ctx, element)
 	case types.Paragraph:
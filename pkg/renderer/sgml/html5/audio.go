@@ -0,0 +1,14 @@
+package html5
+
+const (
+	audioBlockTmpl = `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="audioblock{{ if .Roles }} {{ .Roles }}{{ end }}">
+{{ if .Title }}<div class="title">{{ .Title }}</div>
+{{ end }}<div class="content">
+<audio src="{{ escape .Src }}"{{ if .Autoplay }} autoplay{{ end }}{{ if .Muted }} muted{{ end }}` +
+		`{{ if .Controls }} controls{{ end }}{{ if .Loop }} loop{{ end }}>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>
+`
+)
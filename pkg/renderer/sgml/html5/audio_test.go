@@ -0,0 +1,40 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("audio blocks", func() {
+
+	It("with empty attributes", func() {
+		source := "audio::sounds/foo.ogg[]"
+		expected := `<div class="audioblock">
+<div class="content">
+<audio src="sounds/foo.ogg" controls>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with id, title, times and options", func() {
+		source := `[#clip]
+.A title
+audio::sounds/foo.ogg[start=5, end=10, options="autoplay,loop"]`
+		expected := `<div id="clip" class="audioblock">
+<div class="title">A title</div>
+<div class="content">
+<audio src="sounds/foo.ogg#t=5,10" autoplay controls loop>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+})
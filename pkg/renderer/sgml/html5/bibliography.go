@@ -0,0 +1,5 @@
+package html5
+
+const (
+	bibliographyAnchorTmpl = `<a id="{{ escape .ID }}"></a>[{{ escape .Label }}]`
+)
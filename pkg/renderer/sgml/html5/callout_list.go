@@ -2,17 +2,24 @@ package html5
 
 // initializes the sgml
 const (
+	// callout lists are rendered in a table when the callouts are rendered with icons
 	calloutListTmpl = `<div` +
 		`{{ if .ID }} id="{{ .ID }}"{{ end }} ` +
 		"class=\"colist arabic{{ if .Roles }} {{ .Roles }}{{ end}}\">\n" +
 		"{{ if .Title }}<div class=\"title\">{{ .Title }}</div>\n{{ end }}" +
-		"<ol>\n" +
-		"{{ .Content }}" +
-		"</ol>\n</div>\n"
+		"{{ if .Icons }}<table>\n{{ .Content }}</table>\n" +
+		"{{ else }}<ol>\n{{ .Content }}</ol>\n{{ end }}" +
+		"</div>\n"
 
 	// NB: The items are numbered sequentially.
-	calloutListItemTmpl = "<li>\n{{ .Content }}</li>\n"
+	calloutListItemTmpl = "{{ if .Icons }}<tr>\n<td>" +
+		`{{ if eq .Icons "font" }}<i class="conum" data-value="{{ .Ref }}"></i><b>{{ .Ref }}</b>` +
+		`{{ else }}<img src="{{ .Path }}" alt="{{ .Ref }}">{{ end }}` +
+		"</td>\n<td>{{ .Content }}</td>\n</tr>\n" +
+		"{{ else }}<li>\n{{ .Content }}</li>\n{{ end }}"
 
 	// This should probably have been a <span>, but for compatibility we use <b>
-	calloutRefTmpl = "<b class=\"conum\">({{ .Ref }})</b>"
+	calloutRefTmpl = `{{ if eq .Icons "font" }}<i class="conum" data-value="{{ .Ref }}"></i><b>({{ .Ref }})</b>` +
+		`{{ else if eq .Icons "image" }}<img src="{{ .Path }}" alt="{{ .Ref }}">` +
+		`{{ else }}<b class="conum">({{ .Ref }})</b>{{ end }}`
 )
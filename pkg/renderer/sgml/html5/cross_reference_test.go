@@ -46,6 +46,23 @@ with some content linked to <<thetitle,a label to the title>>!`
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
 
+		It("cross references with double hyphens in id", func() {
+			source := `[[a--b]]
+== a title
+
+see <<a--b>>, <<a--b,the a--b section>> and xref:other--doc.adoc[the other--doc]`
+			expected := `<div class="sect1">
+<h2 id="a--b">a title</h2>
+<div class="sectionbody">
+<div class="paragraph">
+<p>see <a href="#a--b">a title</a>, <a href="#a--b">the a—​b section</a> and <a href="other--doc.html">the other&#8212;&#8203;doc</a></p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
 		It("invalid section reference", func() {
 
 			source := `[[thetitle]]
@@ -1,6 +1,7 @@
 package html5_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo" //nolint golint
@@ -122,6 +123,28 @@ with <strong>bold content</strong></p>
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
 
+		It("admonition block with icon embedded as a data URI", func() {
+			source := `:icons:
+:iconsdir: ../../../../test/images/icons
+:data-uri:
+
+NOTE: some note`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<img src="data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAIAAACQd1PeAAAADElEQVR4nGNgYPgPAAEDAQAIicLsAAAAAElFTkSuQmCC" alt="Note">
+</td>
+<td class="content">
+some note
+</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
 		It("admonition block with ID, title and SVG icon", func() {
 			source := `:icons:
 :icontype: svg
@@ -254,5 +277,165 @@ an admonition text on 1 line.
 `
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
+
+		It("admonition paragraph with unicode caption", func() {
+			source := `:tip-caption: 💡
+
+TIP: an admonition text.`
+			expected := `<div class="admonitionblock tip">
+<table>
+<tr>
+<td class="icon">
+<div class="title">💡</div>
+</td>
+<td class="content">
+an admonition text.
+</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("admonition block with caption and custom icon", func() {
+			source := `:icons: font
+
+[TIP,caption=Hint,icon=bulb]
+====
+an admonition text.
+====`
+			expected := `<div class="admonitionblock tip">
+<table>
+<tr>
+<td class="icon">
+<img src="images/icons/bulb.png" alt="Hint">
+</td>
+<td class="content">
+<div class="paragraph">
+<p>an admonition text.</p>
+</div>
+</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("admonition paragraph with custom icon with extension", func() {
+			source := `:icons: image
+:iconsdir: icons
+
+[NOTE,icon=info.svg]
+an admonition text.`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<img src="icons/info.svg" alt="Note">
+</td>
+<td class="content">
+an admonition text.
+</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("admonition paragraph with custom icon without icons", func() {
+			source := `[NOTE,icon=info]
+an admonition text.`
+			expected := `<div class="admonitionblock note">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Note</div>
+</td>
+<td class="content">
+an admonition text.
+</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("custom admonitions", func() {
+
+		danger := configuration.WithCustomAdmonition(configuration.CustomAdmonition{
+			Name:  "DANGER",
+			Icon:  "fire",
+			Class: "danger",
+		})
+
+		It("custom admonition paragraph", func() {
+			source := `DANGER: an admonition text.`
+			expected := `<div class="admonitionblock danger">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Danger</div>
+</td>
+<td class="content">
+an admonition text.
+</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderHTML(source, danger)).To(MatchHTML(expected))
+		})
+
+		It("custom admonition block with font icon and caption attribute", func() {
+			source := `:icons: font
+:danger-caption: Beware
+
+[DANGER]
+====
+an admonition text.
+====`
+			expected := `<div class="admonitionblock danger">
+<table>
+<tr>
+<td class="icon">
+<i class="fa icon-fire" title="Beware"></i>
+</td>
+<td class="content">
+<div class="paragraph">
+<p>an admonition text.</p>
+</div>
+</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderHTML(source, danger)).To(MatchHTML(expected))
+		})
+
+		It("custom admonition paragraph with image icon", func() {
+			source := `:icons: image
+
+[DANGER,caption=Careful]
+an admonition text.`
+			expected := `<div class="admonitionblock danger">
+<table>
+<tr>
+<td class="icon">
+<img src="images/icons/fire.png" alt="Careful">
+</td>
+<td class="content">
+an admonition text.
+</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderHTML(source, danger)).To(MatchHTML(expected))
+		})
 	})
 })
@@ -3,6 +3,11 @@ package html5_test
 import (
 	"strings"
 
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml"
+	"github.com/bytesparadise/libasciidoc/pkg/renderer/sgml/html5"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo" //nolint golint
@@ -159,6 +164,119 @@ foo
 		})
 	})
 
+	Context("collapsible blocks", func() {
+
+		It("collapsible example block with title", func() {
+			source := `.Show the logs
+[%collapsible]
+====
+some *logs*
+====`
+			expected := `<details>
+<summary class="title">Show the logs</summary>
+<div class="content">
+<div class="paragraph">
+<p>some <strong>logs</strong></p>
+</div>
+</div>
+</details>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("collapsible example block without title", func() {
+			source := `[%collapsible]
+====
+some logs
+====`
+			expected := `<details>
+<summary class="title">Details</summary>
+<div class="content">
+<div class="paragraph">
+<p>some logs</p>
+</div>
+</div>
+</details>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("open collapsible example block with ID and role", func() {
+			source := `[#logs.verbose%collapsible%open]
+.Show the logs
+====
+some logs
+====`
+			expected := `<details id="logs" class="verbose" open>
+<summary class="title">Show the logs</summary>
+<div class="content">
+<div class="paragraph">
+<p>some logs</p>
+</div>
+</div>
+</details>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("collapsible example block does not increment the example number", func() {
+			source := `.Show the logs
+[%collapsible]
+====
+some logs
+====
+
+.Regular example
+====
+foo
+====`
+			expected := `<details>
+<summary class="title">Show the logs</summary>
+<div class="content">
+<div class="paragraph">
+<p>some logs</p>
+</div>
+</div>
+</details>
+<div class="exampleblock">
+<div class="title">Example 1. Regular example</div>
+<div class="content">
+<div class="paragraph">
+<p>foo</p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("collapsible example block without template in the backend", func() {
+			source := `.Show the logs
+[%collapsible]
+====
+some logs
+====`
+			expected := `<div class="exampleblock">
+<div class="title">Example 1. Show the logs</div>
+<div class="content">
+<div class="paragraph">
+<p>some logs</p>
+</div>
+</div>
+</div>
+`
+			tmpls := html5.Templates()
+			tmpls.CollapsibleExampleBlock = "" // backend without support for interactive elements
+			config := configuration.NewConfiguration()
+			doc, err := parser.ParseDocument(strings.NewReader(source), config)
+			Expect(err).NotTo(HaveOccurred())
+			output := &strings.Builder{}
+			_, err = sgml.NewRenderer(tmpls).Render(renderer.NewContext(doc, config), doc, output)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.String()).To(MatchHTML(expected))
+		})
+	})
+
 	Context("paragraph blocks", func() {
 
 		It("with single plaintext line", func() {
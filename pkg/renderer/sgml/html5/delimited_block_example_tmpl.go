@@ -8,4 +8,11 @@ const (
 		"{{ .Content }}" +
 		"</div>\n" +
 		"</div>\n"
+
+	collapsibleExampleBlockTmpl = "<details{{ if .ID }} id=\"{{ .ID }}\"{{ end }}{{ if .Roles }} class=\"{{ .Roles }}\"{{ end }}{{ if .Open }} open{{ end }}>\n" +
+		"<summary class=\"title\">{{ if .Title }}{{ .Title }}{{ else }}Details{{ end }}</summary>\n" +
+		"<div class=\"content\">\n" +
+		"{{ .Content }}" +
+		"</div>\n" +
+		"</details>\n"
 )
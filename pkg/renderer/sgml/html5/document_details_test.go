@@ -246,4 +246,76 @@ a paragraph`
 		})
 
 	})
+
+	Context("head metadata", func() {
+
+		It("with description, keywords, language, title, copyright, application name and favicon", func() {
+			source := `= Document Title
+:description: A document about Go & AsciiDoc
+:keywords: go, asciidoc
+:lang: fr
+:title: Custom Title
+:copyright: Copyright (C) the authors
+:app-name: Libasciidoc
+:favicon: images/icon.png
+:noheader:
+:nofooter:
+
+a paragraph`
+			expected := `<!DOCTYPE html>
+<html lang="fr">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<meta name="application-name" content="Libasciidoc">
+<meta name="description" content="A document about Go &amp; AsciiDoc">
+<meta name="keywords" content="go, asciidoc">
+<meta name="copyright" content="Copyright (C) the authors">
+<link rel="icon" type="image/png" href="images/icon.png">
+<title>Custom Title</title>
+</head>
+<body class="article">
+<div id="content">
+<div class="paragraph">
+<p>a paragraph</p>
+</div>
+</div>
+</body>
+</html>
+`
+			Expect(RenderHTML(source, configuration.WithHeaderFooter(true))).To(MatchHTML(expected))
+		})
+
+		It("without language and with default favicon", func() {
+			source := `= Document Title
+:nolang:
+:favicon:
+:noheader:
+:nofooter:
+
+a paragraph`
+			expected := `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<link rel="icon" type="image/x-icon" href="favicon.ico">
+<title>Document Title</title>
+</head>
+<body class="article">
+<div id="content">
+<div class="paragraph">
+<p>a paragraph</p>
+</div>
+</div>
+</body>
+</html>
+`
+			Expect(RenderHTML(source, configuration.WithHeaderFooter(true))).To(MatchHTML(expected))
+		})
+	})
 })
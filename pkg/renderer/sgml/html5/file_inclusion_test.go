@@ -71,6 +71,31 @@ var _ = Describe("file inclusions", func() {
 		Expect(logs).ToNot(ContainAnyMessageWithLevels(log.ErrorLevel, log.WarnLevel))
 	})
 
+	It("should resolve imagesdir relatively to the included file", func() {
+		source := `:imagesdir: images
+
+image::cover.png[Cover]
+
+include::../../../../test/includes/chapter-b/chapter-b.adoc[]`
+		expected := `<div class="imageblock">
+<div class="content">
+<img src="images/cover.png" alt="Cover">
+</div>
+</div>
+<div class="sect1">
+<h2 id="_chapter_b">Chapter B</h2>
+<div class="sectionbody">
+<div class="imageblock">
+<div class="content">
+<img src="../../../../test/includes/chapter-b/images/diagram.png" alt="Diagram">
+</div>
+</div>
+</div>
+</div>
+`
+		Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+	})
+
 	It("should include grandchild content with relative offset", func() {
 		source := `include::../../../../test/includes/grandchild-include.adoc[leveloffset=+1]`
 		expected := `<div class="sect2">
@@ -2,13 +2,18 @@ package html5
 
 const (
 	articleTmpl = "<!DOCTYPE html>\n" +
-		"<html lang=\"en\">\n" +
+		"<html{{ if .Lang }} lang=\"{{ .Lang }}\"{{ end }}>\n" +
 		"<head>\n" +
 		"<meta charset=\"UTF-8\">\n" +
 		"<meta http-equiv=\"X-UA-Compatible\" content=\"IE=edge\">\n" +
 		"<meta name=\"viewport\" content=\"width=device-width, initial-scale=1.0\">\n" +
 		"{{ if .Generator }}<meta name=\"generator\" content=\"{{ .Generator }}\">\n{{ end }}" +
+		"{{ if .AppName }}<meta name=\"application-name\" content=\"{{ .AppName }}\">\n{{ end }}" +
+		"{{ if .Description }}<meta name=\"description\" content=\"{{ .Description }}\">\n{{ end }}" +
+		"{{ if .Keywords }}<meta name=\"keywords\" content=\"{{ .Keywords }}\">\n{{ end }}" +
 		"{{ if .Authors }}<meta name=\"author\" content=\"{{ .Authors }}\">\n{{ end }}" +
+		"{{ if .Copyright }}<meta name=\"copyright\" content=\"{{ .Copyright }}\">\n{{ end }}" +
+		"{{ if .Favicon }}<link rel=\"icon\" type=\"{{ .FaviconType }}\" href=\"{{ .Favicon }}\">\n{{ end }}" +
 		"{{ if .CSS}}<link type=\"text/css\" rel=\"stylesheet\" href=\"{{ .CSS }}\">\n{{ end }}" +
 		"<title>{{ .Title }}</title>\n" +
 		"</head>\n" +
@@ -25,6 +30,7 @@ const (
 		"Last updated {{ .LastUpdated }}\n" +
 		"</div>\n" +
 		"</div>\n{{ end }}" +
+		"{{ .MathJax }}" +
 		"</body>\n" +
 		"</html>\n"
 
@@ -3,11 +3,19 @@ package html5
 const (
 	blockImageTmpl = `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="imageblock{{ if .Roles }} {{ .Roles }}{{ end }}">
 <div class="content">
-{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}<img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>{{ if ne .Href "" }}</a>{{ end }}
+{{ if ne .Href "" }}<a class="image" href="{{ .Href }}"{{ if .Window }} target="{{ .Window }}"{{ end }}{{ if .Rel }} rel="{{ .Rel }}"{{ end }}>{{ end }}` + svgImageTmpl +
+		`<img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .ScaledWidth }} style="width: {{ .ScaledWidth }}"{{ end }}>{{ end }}{{ if ne .Href "" }}</a>{{ end }}
 </div>{{ if .Title }}
 <div class="title">{{ .Caption }}{{ .Title }}</div>
 {{ else }}
 {{ end }}</div>
 `
-	inlineImageTmpl = `<span class="image{{ if .Roles }} {{ .Roles }}{{ end }}">{{ if ne .Href "" }}<a class="image" href="{{ .Href }}">{{ end }}<img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .Title }} title="{{ .Title }}"{{ end }}>{{ if ne .Href "" }}</a>{{ end }}</span>`
+	inlineImageTmpl = `<span class="image{{ if .Roles }} {{ .Roles }}{{ end }}">{{ if ne .Href "" }}<a class="image" href="{{ .Href }}"{{ if .Window }} target="{{ .Window }}"{{ end }}{{ if .Rel }} rel="{{ .Rel }}"{{ end }}>{{ end }}` + svgImageTmpl +
+		`<img src="{{ .Path }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}{{ if .ScaledWidth }} style="width: {{ .ScaledWidth }}"{{ end }}{{ if .Title }} title="{{ .Title }}"{{ end }}>{{ end }}{{ if ne .Href "" }}</a>{{ end }}</span>`
+
+	// embedded or interactive SVG image, otherwise falls back to the `<img>` element which follows (and ends with `{{ end }}`)
+	svgImageTmpl = `{{ if .SVG.Inline }}{{ if .SVG.Content }}{{ .SVG.Content }}{{ else }}<span class="alt">{{ .Alt }}</span>{{ end }}` +
+		`{{ else if .SVG.Interactive }}<object type="image/svg+xml" data="{{ .Path }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>` +
+		`<img src="{{ .SVG.Fallback }}" alt="{{ .Alt }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}></object>` +
+		`{{ else }}`
 )
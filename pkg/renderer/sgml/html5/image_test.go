@@ -1,6 +1,8 @@
 package html5_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo" //nolint golint
@@ -59,6 +61,90 @@ var _ = Describe("images", func() {
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
 
+		It("block image with link to itself", func() {
+
+			source := "image::foo.png[foo image,link=self]"
+			expected := `<div class="imageblock">
+<div class="content">
+<a class="image" href="foo.png"><img src="foo.png" alt="foo image"></a>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("block image with link in blank window", func() {
+
+			source := "image::foo.png[foo image,link=https://example.com,window=^]"
+			expected := `<div class="imageblock">
+<div class="content">
+<a class="image" href="https://example.com" target="_blank" rel="noopener"><img src="foo.png" alt="foo image"></a>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("block image with link in named window and nofollow option", func() {
+
+			source := "image::foo.png[foo image,link=https://example.com,window=docs,opts=nofollow]"
+			expected := `<div class="imageblock">
+<div class="content">
+<a class="image" href="https://example.com" target="docs" rel="nofollow"><img src="foo.png" alt="foo image"></a>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("block image with scaledwidth", func() {
+
+			source := "image::foo.png[foo image,scaledwidth=50%]"
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo image" style="width: 50%">
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("block image with pdfwidth", func() {
+
+			source := "image::foo.png[foo image,pdfwidth=75vw]"
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo image" style="width: 75vw">
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("block image with width and scaledwidth", func() {
+
+			source := "image::foo.png[foo image,300,scaledwidth=50%]"
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo image" width="300">
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("block image with scaledwidth without unit", func() {
+
+			source := "image::foo.png[foo image,scaledwidth=50]"
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo image">
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
 		It("block image with custom caption", func() {
 
 			source := ".Image Title\nimage::foo.png[foo image, 600, 400,caption=\"Bar A. \"]"
@@ -100,6 +186,19 @@ var _ = Describe("images", func() {
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
 
+		It("block image with caption suppressed by attribute override", func() {
+
+			source := ".Image Title\nimage::foo.png[foo image, 600, 400]"
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="foo.png" alt="foo image" width="600" height="400">
+</div>
+<div class="title">Image Title</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithAttribute("!"+types.AttrFigureCaption, ""))).To(MatchHTML(expected))
+		})
+
 		It("block image with alt and dimensions and multiple roles", func() {
 
 			source := `[.role1.role2]
@@ -187,6 +286,24 @@ image::appa.png[]`
 				Expect(RenderHTML(source)).To(MatchHTML(expected))
 			})
 
+			It("inline image with float, link and window", func() {
+				source := "image:foo.png[foo,float=right,link=https://example.com,window=_blank,opts=nofollow]"
+				expected := `<div class="paragraph">
+<p><span class="image right"><a class="image" href="https://example.com" target="_blank" rel="nofollow noopener"><img src="foo.png" alt="foo"></a></span></p>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
+			It("inline image with link to itself and scaledwidth", func() {
+				source := "image:foo.png[foo,link=self,scaledwidth=25%]"
+				expected := `<div class="paragraph">
+<p><span class="image"><a class="image" href="foo.png"><img src="foo.png" alt="foo" style="width: 25%"></a></span></p>
+</div>
+`
+				Expect(RenderHTML(source)).To(MatchHTML(expected))
+			})
+
 			It("inline image with id, title and role", func() {
 				source := "image:foo.png[id=myid, title=mytitle, role=myrole]"
 				expected := `<div class="paragraph">
@@ -321,4 +438,248 @@ image::file:///bar/foo.png[]`
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
 	})
+
+	Context("svg images", func() {
+
+		It("block image with inline option", func() {
+			source := `image::../../../../test/images/circle.svg[Circle,opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg xmlns="http://www.w3.org/2000/svg" width="100" height="100" style="border: 1px">
+<circle cx="50" cy="50" r="40" fill="red"/>
+</svg>
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("block image with inline option and dimensions", func() {
+			source := `[%inline]
+image::../../../../test/images/circle.svg[Circle,200,300]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg xmlns="http://www.w3.org/2000/svg" width="200" height="300">
+<circle cx="50" cy="50" r="40" fill="red"/>
+</svg>
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("block image with inline option and missing file", func() {
+			source := `image::unknown.svg[Unknown,opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<span class="alt">Unknown</span>
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("block image with interactive option", func() {
+			source := `image::circle.svg[Circle,200,opts=interactive]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="circle.svg" width="200"><img src="circle.svg" alt="Circle" width="200"></object>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("block image with interactive option and fallback in imagesdir", func() {
+			source := `:imagesdir: ./assets
+
+image::circle.svg[Circle,opts=interactive,fallback=circle.png]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="./assets/circle.svg"><img src="./assets/circle.png" alt="Circle"></object>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("inline image with inline option", func() {
+			source := `a image:../../../../test/images/circle.svg[Circle,50,opts=inline] circle`
+			expected := `<div class="paragraph">
+<p>a <span class="image"><svg xmlns="http://www.w3.org/2000/svg" width="50">
+<circle cx="50" cy="50" r="40" fill="red"/>
+</svg></span> circle</p>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("inline image with interactive option", func() {
+			source := `a image:circle.svg[Circle,opts=interactive] circle`
+			expected := `<div class="paragraph">
+<p>a <span class="image"><object type="image/svg+xml" data="circle.svg"><img src="circle.svg" alt="Circle"></object></span> circle</p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("data-uri", func() {
+
+		It("block image embedded as a data URI", func() {
+			source := `:data-uri:
+
+image::../../../../test/images/dot.png[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAIAAACQd1PeAAAADElEQVR4nGP4z8AAAAMBAQDJ/pLvAAAAAElFTkSuQmCC" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("block image in imagesdir embedded as a data URI", func() {
+			source := `:data-uri:
+:imagesdir: ../../../../test/images
+
+image::dot.png[Dot,link=https://example.com]`
+			expected := `<div class="imageblock">
+<div class="content">
+<a class="image" href="https://example.com"><img src="data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAIAAACQd1PeAAAADElEQVR4nGP4z8AAAAMBAQDJ/pLvAAAAAElFTkSuQmCC" alt="Dot"></a>
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("inline SVG image embedded as a data URI", func() {
+			source := `a image:../../../../test/images/circle.svg[Circle] circle`
+			expected := `<div class="paragraph">
+<p>a <span class="image"><img src="data:image/svg+xml;base64,PD94bWwgdmVyc2lvbj0iMS4wIiBlbmNvZGluZz0iVVRGLTgiIHN0YW5kYWxvbmU9Im5vIj8+CjwhRE9DVFlQRSBzdmcgUFVCTElDICItLy9XM0MvL0RURCBTVkcgMS4xLy9FTiIgImh0dHA6Ly93d3cudzMub3JnL0dyYXBoaWNzL1NWRy8xLjEvRFREL3N2ZzExLmR0ZCI+CjxzdmcgeG1sbnM9Imh0dHA6Ly93d3cudzMub3JnLzIwMDAvc3ZnIiB3aWR0aD0iMTAwIiBoZWlnaHQ9IjEwMCIgc3R5bGU9ImJvcmRlcjogMXB4Ij4KPGNpcmNsZSBjeD0iNTAiIGN5PSI1MCIgcj0iNDAiIGZpbGw9InJlZCIvPgo8L3N2Zz4K" alt="Circle"></span> circle</p>
+</div>
+`
+			Expect(RenderHTML(source,
+				configuration.WithFilename("test.adoc"),
+				configuration.WithAttribute(types.AttrDataURI, ""),
+			)).To(MatchHTML(expected))
+		})
+
+		It("image not embedded after data-uri attribute reset", func() {
+			source := `:data-uri:
+:data-uri!:
+
+image::../../../../test/images/dot.png[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="../../../../test/images/dot.png" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("remote image not embedded", func() {
+			source := `:data-uri:
+
+image::https://example.com/dot.png[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="https://example.com/dot.png" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("missing image not embedded", func() {
+			source := `:data-uri:
+
+image::unknown.png[Unknown]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="unknown.png" alt="Unknown">
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("image with unsupported content not embedded", func() {
+			source := `:data-uri:
+
+image::../../../../test/images/fake.png[Fake]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="../../../../test/images/fake.png" alt="Fake">
+</div>
+</div>
+`
+			Expect(RenderHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("image exceeding the size limit not embedded", func() {
+			source := `:data-uri:
+
+image::../../../../test/images/dot.png[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="../../../../test/images/dot.png" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source,
+				configuration.WithFilename("test.adoc"),
+				configuration.WithMaxDataURISize(10),
+			)).To(MatchHTML(expected))
+		})
+
+		It("image outside of the document directory not embedded in safe mode", func() {
+			source := `:data-uri:
+
+image::../../../../test/images/dot.png[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="../../../../test/images/dot.png" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source,
+				configuration.WithFilename("test.adoc"),
+				configuration.WithSafeMode(configuration.Safe),
+			)).To(MatchHTML(expected))
+		})
+
+		It("image within the document directory embedded in safe mode", func() {
+			source := `:data-uri:
+
+image::images/dot.png[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAIAAACQd1PeAAAADElEQVR4nGP4z8AAAAMBAQDJ/pLvAAAAAElFTkSuQmCC" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source,
+				configuration.WithFilename("../../../../test/test.adoc"),
+				configuration.WithSafeMode(configuration.Safe),
+			)).To(MatchHTML(expected))
+		})
+
+		It("image not embedded in secure mode", func() {
+			source := `:data-uri:
+
+image::images/dot.png[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="images/dot.png" alt="Dot">
+</div>
+</div>
+`
+			Expect(RenderHTML(source,
+				configuration.WithFilename("../../../../test/test.adoc"),
+				configuration.WithSafeMode(configuration.Secure),
+			)).To(MatchHTML(expected))
+		})
+	})
 })
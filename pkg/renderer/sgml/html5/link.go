@@ -1,5 +1,5 @@
 package html5
 
 const (
-	linkTmpl = `<a href="{{ .URL }}"{{if .Class}} class="{{ .Class }}"{{ end }}>{{ .Text }}</a>`
+	linkTmpl = `<a href="{{ .URL }}"{{if .Class}} class="{{ .Class }}"{{ end }}{{ if .Window }} target="{{ .Window }}"{{ end }}{{ if .Rel }} rel="{{ .Rel }}"{{ end }}>{{ .Text }}</a>`
 )
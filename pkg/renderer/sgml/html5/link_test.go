@@ -39,13 +39,14 @@ var _ = Describe("links", func() {
 			Expect(RenderHTML(source)).To(MatchHTML(expected))
 		})
 
-		// 		It("email link with unquoted text having comma", func() {
-		// 			source := "mailto:foo@example.com[A, B, and C]"
-		// 			expected := `<div class="paragraph">
-		// <p><a href="mailto:foo@example.com?subject=B&amp;body=and+C">A</a></p>
-		// </div>`
-		// 			Expect(RenderHTML(source)).To(MatchHTML(expected))
-		// 		})
+		It("email link with unquoted text having comma", func() {
+			source := "mailto:foo@example.com[A, B, and C]"
+			expected := `<div class="paragraph">
+<p><a href="mailto:foo@example.com?subject=B&amp;body=and%20C">A</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
 
 		It("email link with quoted text having comma", func() {
 			source := `mailto:foo@example.com["A, B, and C"]`
@@ -221,4 +222,213 @@ a link to {scheme}:{path}[] and https://foo.baz`
 			})
 		})
 	})
+
+	Context("bare links", func() {
+
+		It("external links followed by punctuation", func() {
+			source := `see https://foo.bar, https://foo.bar/baz. Or (https://foo.bar)!`
+			expected := `<div class="paragraph">
+<p>see <a href="https://foo.bar" class="bare">https://foo.bar</a>, <a href="https://foo.bar/baz" class="bare">https://foo.bar/baz</a>. Or (<a href="https://foo.bar" class="bare">https://foo.bar</a>)!</p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external link with query string", func() {
+			source := `https://foo.bar?a=b&c=d`
+			expected := `<div class="paragraph">
+<p><a href="https://foo.bar?a=b&amp;c=d" class="bare">https://foo.bar?a=b&amp;c=d</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external link within angle brackets", func() {
+			source := `see <https://foo.bar>`
+			expected := `<div class="paragraph">
+<p>see <a href="https://foo.bar" class="bare">https://foo.bar</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external links ending with quoted text markers", func() {
+			source := `a link to *https://foo.bar/_*
+
+a link to _https://foo.bar/*_`
+			expected := `<div class="paragraph">
+<p>a link to <strong><a href="https://foo.bar/_" class="bare">https://foo.bar/_</a></strong></p>
+</div>
+<div class="paragraph">
+<p>a link to <em><a href="https://foo.bar/*" class="bare">https://foo.bar/*</a></em></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external links followed by quoted text", func() {
+			source := `Or https://example.com/a_b_, too. _x_`
+			// the location ends before the (unbalanced) italic text which starts with its trailing `_`
+			expected := `<div class="paragraph">
+<p>Or <a href="https://example.com/a_b" class="bare">https://example.com/a_b</a><em>, too. _x</em></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external links with hidden URI scheme", func() {
+			source := `:hide-uri-scheme:
+
+https://foo.bar and https://foo.bar[] and https://foo.bar[foo]`
+			expected := `<div class="paragraph">
+<p><a href="https://foo.bar" class="bare">foo.bar</a> and <a href="https://foo.bar" class="bare">foo.bar</a> and <a href="https://foo.bar">foo</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external link in a list item", func() {
+			source := `* see <https://foo.bar>, or https://foo.bar.`
+			expected := `<div class="ulist">
+<ul>
+<li>
+<p>see <a href="https://foo.bar" class="bare">https://foo.bar</a>, or <a href="https://foo.bar" class="bare">https://foo.bar</a>.</p>
+</li>
+</ul>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("locations with replacement characters", func() {
+
+		It("external link with double hyphens and arrow", func() {
+			source := `see http://example.com/a--b[link] and https://foo.bar/p->q`
+			expected := `<div class="paragraph">
+<p>see <a href="http://example.com/a--b">link</a> and <a href="https://foo.bar/p-&gt;q" class="bare">https://foo.bar/p-&gt;q</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external link within angle brackets with arrow", func() {
+			source := `see <https://foo.bar/p->q>`
+			expected := `<div class="paragraph">
+<p>see <a href="https://foo.bar/p-&gt;q" class="bare">https://foo.bar/p-&gt;q</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("relative link with double hyphens", func() {
+			source := `see link:docs/a--b.html[] -- or not`
+			expected := `<div class="paragraph">
+<p>see <a href="docs/a--b.html" class="bare">docs/a--b.html</a>&#8201;&#8212;&#8201;or not</p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("link windows and options", func() {
+
+		It("external link with caret window shorthand", func() {
+			source := `https://foo.bar[foo^] and https://foo.bar[^]`
+			expected := `<div class="paragraph">
+<p><a href="https://foo.bar" target="_blank" rel="noopener">foo</a> and <a href="https://foo.bar" class="bare" target="_blank" rel="noopener">https://foo.bar</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("relative link with caret window shorthand", func() {
+			source := `link:foo.adoc[*foo*^]`
+			expected := `<div class="paragraph">
+<p><a href="foo.adoc" target="_blank" rel="noopener"><strong>foo</strong></a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external link with blank window", func() {
+			source := `https://foo.bar[foo,window=_blank]`
+			expected := `<div class="paragraph">
+<p><a href="https://foo.bar" target="_blank" rel="noopener">foo</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external link with named window and options", func() {
+			source := `https://foo.bar[foo,window=read-later,opts=nofollow]`
+			expected := `<div class="paragraph">
+<p><a href="https://foo.bar" target="read-later" rel="nofollow">foo</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external link with nofollow option and blank window", func() {
+			source := `https://foo.bar[foo^,opts=nofollow]`
+			expected := `<div class="paragraph">
+<p><a href="https://foo.bar" target="_blank" rel="nofollow noopener">foo</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("email links", func() {
+
+		It("email link without text", func() {
+			source := `mailto:foo@example.com[]`
+			expected := `<div class="paragraph">
+<p><a href="mailto:foo@example.com">foo@example.com</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("email link with subject and body", func() {
+			source := `mailto:foo@example.com[Subscribe,Subscribe me,I want to participate.]`
+			expected := `<div class="paragraph">
+<p><a href="mailto:foo@example.com?subject=Subscribe%20me&amp;body=I%20want%20to%20participate.">Subscribe</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("email link with subject only", func() {
+			source := `mailto:foo@example.com[Subscribe,"Hello & welcome"]`
+			expected := `<div class="paragraph">
+<p><a href="mailto:foo@example.com?subject=Hello%20%26%20welcome">Subscribe</a></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("bare email addresses", func() {
+			source := `write to foo@example.com, \bar@example.com or _baz@example.org_.`
+			expected := `<div class="paragraph">
+<p>write to <a href="mailto:foo@example.com">foo@example.com</a>, bar@example.com or <em><a href="mailto:baz@example.org">baz@example.org</a></em>.</p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("email address in a labeled list term", func() {
+			source := `foo@example.com:: the email address`
+			expected := `<div class="dlist">
+<dl>
+<dt class="hdlist1"><a href="mailto:foo@example.com">foo@example.com</a></dt>
+<dd>
+<p>the email address</p>
+</dd>
+</dl>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
 })
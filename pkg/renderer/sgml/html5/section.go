@@ -15,4 +15,6 @@ const (
 		"</div>\n"
 
 	sectionHeaderTmpl = "<h{{ .LevelPlusOne }} id=\"{{ .ID }}\">{{ .Content }}</h{{ .LevelPlusOne }}>\n"
+
+	discreteHeadingTmpl = "<h{{ .LevelPlusOne }} id=\"{{ .ID }}\" class=\"discrete{{ if .Roles }} {{ .Roles }}{{ end }}\">{{ .Content }}</h{{ .LevelPlusOne }}>\n"
 )
@@ -289,6 +289,158 @@ Listing block content is commonly used to preserve code input.</pre>
 		})
 	})
 
+	Context("discrete headings", func() {
+
+		It("discrete heading within section", func() {
+			source := `== section 1
+
+[discrete]
+=== a discrete *heading*
+
+content
+
+[float#custom.role]
+== a floating heading`
+			expected := `<div class="sect1">
+<h2 id="_section_1">section 1</h2>
+<div class="sectionbody">
+<h3 id="_a_discrete_heading" class="discrete">a discrete <strong>heading</strong></h3>
+<div class="paragraph">
+<p>content</p>
+</div>
+<h2 id="custom" class="discrete role">a floating heading</h2>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("discrete heading in example block", func() {
+			source := `====
+[discrete]
+=== an example *heading*
+
+content
+====`
+			expected := `<div class="exampleblock">
+<div class="content">
+<h3 id="_an_example_heading" class="discrete">an example <strong>heading</strong></h3>
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("discrete heading in quote block", func() {
+			source := `____
+[discrete]
+== a quote heading
+
+content
+____`
+			expected := `<div class="quoteblock">
+<blockquote>
+<h2 id="_a_quote_heading" class="discrete">a quote heading</h2>
+<div class="paragraph">
+<p>content</p>
+</div>
+</blockquote>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("discrete heading in sidebar block", func() {
+			source := `****
+[float]
+== a sidebar heading
+
+content
+****`
+			expected := `<div class="sidebarblock">
+<div class="content">
+<h2 id="_a_sidebar_heading" class="discrete">a sidebar heading</h2>
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("discrete heading in open block", func() {
+			source := `--
+[discrete#custom]
+== an open heading
+
+content
+--`
+			expected := `<div class="openblock">
+<div class="content">
+<h2 id="custom" class="discrete">an open heading</h2>
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("discrete heading in list item continuation", func() {
+			source := `* item
++
+[discrete]
+== a list item heading`
+			expected := `<div class="ulist">
+<ul>
+<li>
+<p>item</p>
+<h2 id="_a_list_item_heading" class="discrete">a list item heading</h2>
+</li>
+</ul>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("discrete heading not in table of contents", func() {
+			source := `= A title
+:toc:
+
+== section 1
+
+[discrete]
+=== a discrete heading
+
+== section 2`
+			expected := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_1">section 1</a></li>
+<li><a href="#_section_2">section 2</a></li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_section_1">section 1</h2>
+<div class="sectionbody">
+<h3 id="_a_discrete_heading" class="discrete">a discrete heading</h3>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_2">section 2</h2>
+<div class="sectionbody">
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
 	Context("preambles", func() {
 
 		It("should include preamble wrapper", func() {
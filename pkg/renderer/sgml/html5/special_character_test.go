@@ -12,7 +12,7 @@ var _ = Describe("special characters", func() {
 	It("should parse in paragraph", func() {
 		source := "<b>*</b> &apos; &amp;"
 		expected := `<div class="paragraph">
-<p>&lt;b&gt;*&lt;/b&gt; &amp;apos; &amp;amp;</p>
+<p>&lt;b&gt;*&lt;/b&gt; &apos; &amp;</p>
 </div>
 `
 		Expect(RenderHTML(source)).To(MatchHTML(expected))
@@ -37,7 +37,7 @@ var _ = Describe("special characters", func() {
 			"<b>*</b> &apos; &amp;" + "\n" +
 			"```"
 		expected := `<div class="paragraph">
-<p>&lt;b&gt;*&lt;/b&gt; &amp;apos; &amp;amp;</p>
+<p>&lt;b&gt;*&lt;/b&gt; &apos; &amp;</p>
 </div>
 <div class="listingblock">
 <div class="content">
@@ -0,0 +1,30 @@
+package html5
+
+const (
+	inlineStemTmpl = `{{ if eq .Notation "latexmath" }}\({{ escape .Content }}\){{ else }}\${{ escape .Content }}\${{ end }}`
+
+	stemBlockTmpl = "<div {{ if .ID }}id=\"{{ .ID }}\" {{ end }}class=\"stemblock{{ if .Roles }} {{ .Roles }}{{ end }}\">\n" +
+		"{{ if .Title }}<div class=\"title\">{{ .Title }}</div>\n{{ end }}" +
+		"<div class=\"content\">\n" +
+		`{{ if eq .Notation "latexmath" }}\[{{ escape .Content }}\]{{ else }}\${{ escape .Content }}\${{ end }}` + "\n" +
+		"</div>\n" +
+		"</div>\n"
+
+	// the MathJax configuration and script, included in the document when the `stem` attribute is set
+	mathJaxTmpl = "<script type=\"text/x-mathjax-config\">\n" +
+		"MathJax.Hub.Config({\n" +
+		"  messageStyle: \"none\",\n" +
+		"  tex2jax: {\n" +
+		"    inlineMath: [[\"\\\\(\", \"\\\\)\"]],\n" +
+		"    displayMath: [[\"\\\\[\", \"\\\\]\"]],\n" +
+		"    ignoreClass: \"nostem|nolatexmath\"\n" +
+		"  },\n" +
+		"  asciimath2jax: {\n" +
+		"    delimiters: [[\"\\\\$\", \"\\\\$\"]],\n" +
+		"    ignoreClass: \"nostem|noasciimath\"\n" +
+		"  },\n" +
+		"  TeX: { equationNumbers: { autoNumber: \"none\" } }\n" +
+		"})\n" +
+		"</script>\n" +
+		"<script src=\"https://cdnjs.cloudflare.com/ajax/libs/mathjax/2.7.9/MathJax.js?config=TeX-MML-AM_HTMLorMML\"></script>\n"
+)
@@ -0,0 +1,154 @@
+package html5_test
+
+import (
+	"time"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("stem", func() {
+
+	Context("inline macros", func() {
+
+		It("with default notation", func() {
+			source := `:stem:
+
+see stem:[sqrt(4) = 2] and asciimath:[x < y]`
+			expected := `<div class="paragraph">
+<p>see \$sqrt(4) = 2\$ and \$x &lt; y\$</p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with latexmath notation", func() {
+			source := `:stem: latexmath
+
+see stem:[\sqrt{4} = 2] and latexmath:[C = \alpha + \beta Y^{\gamma}]`
+			expected := `<div class="paragraph">
+<p>see \(\sqrt{4} = 2\) and \(C = \alpha + \beta Y^{\gamma}\)</p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("delimited blocks", func() {
+
+		It("with default notation", func() {
+			source := `:stem:
+
+[stem]
+++++
+sqrt(4) < 3
+++++`
+			expected := `<div class="stemblock">
+<div class="content">
+\$sqrt(4) &lt; 3\$
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with latexmath notation, ID and title", func() {
+			source := `[[equation]]
+.an equation
+[latexmath]
+++++
+\frac{a}{b}
+++++`
+			expected := `<div id="equation" class="stemblock">
+<div class="title">an equation</div>
+<div class="content">
+\[\frac{a}{b}\]
+</div>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("documents", func() {
+
+		It("should include MathJax when stem attribute is set", func() {
+			source := `:stem:
+
+stem:[x^2]`
+			expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title></title>
+</head>
+<body class="article">
+<div id="content">
+<div class="paragraph">
+<p>\$x^2\$</p>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+<script type="text/x-mathjax-config">
+MathJax.Hub.Config({
+  messageStyle: "none",
+  tex2jax: {
+    inlineMath: [["\\(", "\\)"]],
+    displayMath: [["\\[", "\\]"]],
+    ignoreClass: "nostem|nolatexmath"
+  },
+  asciimath2jax: {
+    delimiters: [["\\$", "\\$"]],
+    ignoreClass: "nostem|noasciimath"
+  },
+  TeX: { equationNumbers: { autoNumber: "none" } }
+})
+</script>
+<script src="https://cdnjs.cloudflare.com/ajax/libs/mathjax/2.7.9/MathJax.js?config=TeX-MML-AM_HTMLorMML"></script>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source, configuration.WithHeaderFooter(true), configuration.WithLastUpdated(now))).To(MatchHTMLTemplate(expected, now))
+		})
+
+		It("should not include MathJax when stem attribute is not set", func() {
+			source := `latexmath:[x^2]`
+			expected := `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="UTF-8">
+<meta http-equiv="X-UA-Compatible" content="IE=edge">
+<meta name="viewport" content="width=device-width, initial-scale=1.0">
+<meta name="generator" content="libasciidoc">
+<title></title>
+</head>
+<body class="article">
+<div id="content">
+<div class="paragraph">
+<p>\(x^2\)</p>
+</div>
+</div>
+<div id="footer">
+<div id="footer-text">
+Last updated {{.LastUpdated}}
+</div>
+</div>
+</body>
+</html>
+`
+			now := time.Now()
+			Expect(RenderHTML(source, configuration.WithHeaderFooter(true), configuration.WithLastUpdated(now))).To(MatchHTMLTemplate(expected, now))
+		})
+	})
+})
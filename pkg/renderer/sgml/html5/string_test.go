@@ -134,4 +134,142 @@ var _ = Describe("strings", func() {
 			"</div>\n"
 		Expect(RenderHTML(source)).To(MatchHTML(expected))
 	})
+
+	It("text with em-dashes", func() {
+		source := `foo--bar, foo -- bar and foo--
+-- bar`
+		expected := `<div class="paragraph">
+<p>foo&#8212;&#8203;bar, foo&#8201;&#8212;&#8201;bar and foo--
+&#8201;&#8212;&#8201;bar</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with arrows", func() {
+		source := `a -> b => c <- d <= e`
+		expected := `<div class="paragraph">
+<p>a &#8594; b &#8658; c &#8592; d &#8656; e</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with arrows (unicode)", func() {
+		source := ":unicode:\n\na -> b => c <- d <= e"
+		expected := "<div class=\"paragraph\">\n" +
+			"<p>a \u2192 b \u21d2 c \u2190 d \u21d0 e</p>\n" +
+			"</div>\n"
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with arrows in bold, italic and monospace text", func() {
+		source := "*a -> b* _c => d_ `e <- f` and *g _h <= i_ \\-> j*"
+		expected := `<div class="paragraph">
+<p><strong>a &#8594; b</strong> <em>c &#8658; d</em> <code>e &#8592; f</code> and <strong>g <em>h &#8656; i</em> -&gt; j</strong></p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with em-dashes and symbols in nested quoted text", func() {
+		source := `*a _b -- c (C)_ d*`
+		expected := `<div class="paragraph">
+<p><strong>a <em>b&#8201;&#8212;&#8201;c &#169;</em> d</strong></p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with double-sided arrows", func() {
+		source := `<-> and <=>`
+		expected := `<div class="paragraph">
+<p>&lt;&#8594; and &lt;&#8658;</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with escaped replacements", func() {
+		source := `\(C) \(R) \(TM) \... \-> \=> \<- \<= foo\--bar a \-- b don\'t`
+		expected := `<div class="paragraph">
+<p>(C) (R) (TM) ... -&gt; =&gt; &lt;- &lt;= foo--bar a -- b don't</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with character references", func() {
+		source := `&loz; &#169; &#x2022; &amp;lt; and \&copy;`
+		expected := `<div class="paragraph">
+<p>&loz; &#169; &#x2022; &amp;lt; and &amp;copy;</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with character references (unicode)", func() {
+		source := ":unicode:\n\n&loz; and &#169;"
+		expected := `<div class="paragraph">
+<p>&loz; and &#169;</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with invalid character references", func() {
+		source := `&a; &#1; &#x1; & foo;`
+		expected := `<div class="paragraph">
+<p>&amp;a; &amp;#1; &amp;#x1; &amp; foo;</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text without replacements", func() {
+		source := `[subs="-replacements"]
+a -> b (C) &loz; foo--bar`
+		expected := `<div class="paragraph">
+<p>a -&gt; b (C) &amp;loz; foo--bar</p>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("list item with replacements", func() {
+		source := `* a -> b &loz; foo--bar \(C)`
+		expected := `<div class="ulist">
+<ul>
+<li>
+<p>a &#8594; b &loz; foo&#8212;&#8203;bar (C)</p>
+</li>
+</ul>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("labeled list item with replacements", func() {
+		source := `term -> x:: desc <= y`
+		expected := `<div class="dlist">
+<dl>
+<dt class="hdlist1">term &#8594; x</dt>
+<dd>
+<p>desc &#8656; y</p>
+</dd>
+</dl>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("title with arrow and character reference", func() {
+		source := "== Input -> Output &amp; more"
+		expected := "<div class=\"sect1\">\n" +
+			"<h2 id=\"_input_output_more\">Input &#8594; Output &amp; more</h2>\n" +
+			"<div class=\"sectionbody\">\n" +
+			"</div>\n" +
+			"</div>\n"
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
 })
@@ -18,16 +18,35 @@ const (
 		"</colgroup>\n" +
 		"{{ .Header }}" +
 		"{{ .Body }}" +
+		"{{ .Footer }}" +
 		"{{ end }}" +
 		"</table>\n"
 
 	tableBodyTmpl = "{{ if .Content }}<tbody>\n{{ .Content }}</tbody>\n{{ end }}"
 
+	tableFooterTmpl = "{{ if .Content }}<tfoot>\n{{ .Content }}</tfoot>\n{{ end }}"
+
 	tableHeaderTmpl = "{{ if .Content }}<thead>\n<tr>\n{{ .Content }}</tr>\n</thead>\n{{ end }}"
 
 	tableRowTmpl = "<tr>\n{{ .Content }}</tr>\n"
 
-	tableHeaderCellTmpl = "<th class=\"tableblock halign-{{ .HAlign }} valign-{{ .VAlign }}\">{{ .Content }}</th>\n"
+	tableHeaderCellTmpl = "<th class=\"tableblock halign-{{ .HAlign }} valign-{{ .VAlign }}\"" +
+		"{{ if gt .ColSpan 1 }} colspan=\"{{ .ColSpan }}\"{{ end }}" +
+		"{{ if gt .RowSpan 1 }} rowspan=\"{{ .RowSpan }}\"{{ end }}" +
+		">{{ .Content }}</th>\n"
 
-	tableCellTmpl = "<td class=\"tableblock halign-{{ .HAlign }} valign-{{ .VAlign }}\"><p class=\"tableblock\">{{ .Content }}</p></td>\n"
+	tableCellTmpl = "<{{ if eq .Style \"h\" }}th{{ else }}td{{ end }}" +
+		" class=\"tableblock halign-{{ .HAlign }} valign-{{ .VAlign }}\"" +
+		"{{ if gt .ColSpan 1 }} colspan=\"{{ .ColSpan }}\"{{ end }}" +
+		"{{ if gt .RowSpan 1 }} rowspan=\"{{ .RowSpan }}\"{{ end }}" +
+		">" +
+		"{{ if eq .Style \"a\" }}<div class=\"content\">{{ .Content }}</div>" +
+		"{{ else if eq .Style \"l\" }}<div class=\"literal\"><pre>{{ .Content }}</pre></div>" +
+		"{{ else }}<p class=\"tableblock\">" +
+		"{{ if eq .Style \"e\" }}<em>{{ .Content }}</em>" +
+		"{{ else if eq .Style \"s\" }}<strong>{{ .Content }}</strong>" +
+		"{{ else if eq .Style \"m\" }}<code>{{ .Content }}</code>" +
+		"{{ else }}{{ .Content }}{{ end }}" +
+		"</p>{{ end }}" +
+		"</{{ if eq .Style \"h\" }}th{{ else }}td{{ end }}>\n"
 )
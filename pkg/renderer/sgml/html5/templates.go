@@ -13,13 +13,18 @@ var templates = sgml.Templates{
 	AdmonitionParagraph:       admonitionParagraphTmpl,
 	Article:                   articleTmpl,
 	ArticleHeader:             articleHeaderTmpl,
+	AudioBlock:                audioBlockTmpl,
+	BibliographyAnchor:        bibliographyAnchorTmpl,
 	BlankLine:                 blankLineTmpl,
 	BlockImage:                blockImageTmpl,
 	BoldText:                  boldTextTmpl,
 	CalloutList:               calloutListTmpl,
 	CalloutListItem:           calloutListItemTmpl,
 	CalloutRef:                calloutRefTmpl,
+	ChecklistMarker:           checklistMarkerTmpl,
+	CollapsibleExampleBlock:   collapsibleExampleBlockTmpl,
 	DelimitedBlockParagraph:   delimitedBlockParagraphTmpl,
+	DiscreteHeading:           discreteHeadingTmpl,
 	DocumentDetails:           documentDetailsTmpl,
 	DocumentAuthorDetails:     documentAuthorDetailsTmpl,
 	ExternalCrossReference:    externalCrossReferenceTmpl,
@@ -33,8 +38,12 @@ var templates = sgml.Templates{
 	IconFont:                  iconFontTmpl,
 	IconImage:                 iconImageTmpl,
 	IconText:                  iconTextTmpl,
+	InlineButton:              inlineButtonTmpl,
 	InlineIcon:                inlineIconTmpl,
 	InlineImage:               inlineImageTmpl,
+	InlineKeyboard:            inlineKeyboardTmpl,
+	InlineMenu:                inlineMenuTmpl,
+	InlineStem:                inlineStemTmpl,
 	InternalCrossReference:    internalCrossReferenceTmpl,
 	InvalidFootnote:           invalidFootnoteTmpl,
 	ItalicText:                italicTextTmpl,
@@ -50,7 +59,9 @@ var templates = sgml.Templates{
 	ManpageNameParagraph:      manpageNameParagraphTmpl,
 	MarkdownQuoteBlock:        markdownQuoteBlockTmpl,
 	MarkedText:                markedTextTmpl,
+	MathJax:                   mathJaxTmpl,
 	MonospaceText:             monospaceTextTmpl,
+	OpenBlock:                 openBlockTmpl,
 	OrderedList:               orderedListTmpl,
 	OrderedListItem:           orderedListItemTmpl,
 	PassthroughBlock:          passthroughBlock,
@@ -66,12 +77,14 @@ var templates = sgml.Templates{
 	SidebarBlock:              sidebarBlockTmpl,
 	SourceBlock:               sourceBlockTmpl,
 	SpecialCharacter:          specialCharacterTmpl,
+	StemBlock:                 stemBlockTmpl,
 	StringElement:             stringTmpl,
 	SubscriptText:             subscriptTextTmpl,
 	SuperscriptText:           superscriptTextTmpl,
 	Table:                     tableTmpl,
 	TableBody:                 tableBodyTmpl,
 	TableCell:                 tableCellTmpl,
+	TableFooter:               tableFooterTmpl,
 	TableHeader:               tableHeaderTmpl,
 	TableHeaderCell:           tableHeaderCellTmpl,
 	TableRow:                  tableRowTmpl,
@@ -84,6 +97,7 @@ var templates = sgml.Templates{
 	VerbatimLine:              verbatimLineTmpl,
 	VerseBlock:                verseBlockTmpl,
 	VerseParagraph:            verseParagraphTmpl,
+	VideoBlock:                videoBlockTmpl,
 }
 
 var defaultRenderer sgml.Renderer
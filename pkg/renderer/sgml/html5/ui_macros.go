@@ -0,0 +1,15 @@
+package html5
+
+const (
+	inlineKeyboardTmpl = `{{ if eq (len .Keys) 1 }}<kbd>{{ index .Keys 0 }}</kbd>` +
+		`{{ else }}<span class="keyseq">{{ range $i, $key := .Keys }}{{ if $i }}+{{ end }}<kbd>{{ $key }}</kbd>{{ end }}</span>{{ end }}`
+
+	inlineButtonTmpl = `<b class="button">{{ .Label }}</b>`
+
+	inlineMenuTmpl = `{{ if .MenuItem }}<span class="menuseq"><b class="menu">{{ .Menu }}</b>` +
+		`{{ range .Submenus }}` + menuCaretTmpl + `<b class="submenu">{{ . }}</b>{{ end }}` +
+		menuCaretTmpl + `<b class="menuitem">{{ .MenuItem }}</b></span>` +
+		`{{ else }}<b class="menuref">{{ .Menu }}</b>{{ end }}`
+
+	menuCaretTmpl = `{{ if $.FontIcons }}&#160;<i class="fa fa-angle-right caret"></i> {{ else }}&#160;<b class="caret">&#8250;</b> {{ end }}`
+)
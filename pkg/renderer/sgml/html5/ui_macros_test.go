@@ -0,0 +1,143 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("ui macros", func() {
+
+	Context("keyboard macros", func() {
+
+		It("single key", func() {
+			source := `:experimental:
+
+press kbd:[F11] to toggle fullscreen`
+			expected := `<div class="paragraph">
+<p>press <kbd>F11</kbd> to toggle fullscreen</p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("key combinations", func() {
+			source := `:experimental:
+
+kbd:[Ctrl+Shift+T], kbd:[Ctrl,T], kbd:[Ctrl++] and kbd:[Ctrl+\]]`
+			expected := `<div class="paragraph">
+<p><span class="keyseq"><kbd>Ctrl</kbd>+<kbd>Shift</kbd>+<kbd>T</kbd></span>, <span class="keyseq"><kbd>Ctrl</kbd>+<kbd>T</kbd></span>, <span class="keyseq"><kbd>Ctrl</kbd>+<kbd>+</kbd></span> and <span class="keyseq"><kbd>Ctrl</kbd>+<kbd>]</kbd></span></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("key with special character", func() {
+			source := `:experimental:
+
+kbd:[Ctrl+<]`
+			expected := `<div class="paragraph">
+<p><span class="keyseq"><kbd>Ctrl</kbd>+<kbd>&lt;</kbd></span></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("key within quoted text", func() {
+			source := `:experimental:
+
+*kbd:[Esc]*`
+			expected := `<div class="paragraph">
+<p><strong><kbd>Esc</kbd></strong></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("button macros", func() {
+
+		It("button", func() {
+			source := `:experimental:
+
+click btn:[Save] or btn:[Cancel]`
+			expected := `<div class="paragraph">
+<p>click <b class="button">Save</b> or <b class="button">Cancel</b></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("menu macros", func() {
+
+		It("menu with item", func() {
+			source := `:experimental:
+
+menu:File[Save]`
+			expected := `<div class="paragraph">
+<p><span class="menuseq"><b class="menu">File</b>&#160;<b class="caret">&#8250;</b> <b class="menuitem">Save</b></span></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("menu with submenu and item", func() {
+			source := `:experimental:
+
+menu:View[Zoom > Reset]`
+			expected := `<div class="paragraph">
+<p><span class="menuseq"><b class="menu">View</b>&#160;<b class="caret">&#8250;</b> <b class="submenu">Zoom</b>&#160;<b class="caret">&#8250;</b> <b class="menuitem">Reset</b></span></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("menu without item", func() {
+			source := `:experimental:
+
+menu:File[]`
+			expected := `<div class="paragraph">
+<p><b class="menuref">File</b></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("menu with font icons", func() {
+			source := `:experimental:
+:icons: font
+
+menu:View[Zoom > Reset]`
+			expected := `<div class="paragraph">
+<p><span class="menuseq"><b class="menu">View</b>&#160;<i class="fa fa-angle-right caret"></i> <b class="submenu">Zoom</b>&#160;<i class="fa fa-angle-right caret"></i> <b class="menuitem">Reset</b></span></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("menu shorthand", func() {
+			source := `:experimental:
+
+select "File > Save" then "View > Zoom > Reset"`
+			expected := `<div class="paragraph">
+<p>select <span class="menuseq"><b class="menu">File</b>&#160;<b class="caret">&#8250;</b> <b class="menuitem">Save</b></span> then <span class="menuseq"><b class="menu">View</b>&#160;<b class="caret">&#8250;</b> <b class="submenu">Zoom</b>&#160;<b class="caret">&#8250;</b> <b class="menuitem">Reset</b></span></p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("without experimental attribute", func() {
+
+		It("ui macros as plain text", func() {
+			source := `kbd:[F11], btn:[Save], menu:File[Save] and "File > Save"`
+			expected := `<div class="paragraph">
+<p>kbd:[F11], btn:[Save], menu:File[Save] and "File &gt; Save"</p>
+</div>
+`
+			Expect(RenderHTML(source)).To(MatchHTML(expected))
+		})
+	})
+})
@@ -11,4 +11,8 @@ const (
 		"{{ .Content }}</ul>\n</div>\n"
 
 	unorderedListItemTmpl = "<li>\n{{ .Content }}</li>\n"
+
+	checklistMarkerTmpl = `{{ if .Interactive }}<input type="checkbox" data-item-complete="{{ if .Checked }}1{{ else }}0{{ end }}"{{ if .Checked }} checked{{ end }}>` +
+		`{{ else if eq .Icons "font" }}<i class="fa fa-{{ if .Checked }}check-square-o{{ else }}square-o{{ end }}"></i>` +
+		`{{ else if .Checked }}&#10003;{{ else }}&#10063;{{ end }} `
 )
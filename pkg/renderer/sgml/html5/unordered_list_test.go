@@ -326,6 +326,69 @@ var _ = Describe("checklists", func() {
 		Expect(RenderHTML(source)).To(MatchHTML(expected))
 	})
 
+	It("interactive checklist", func() {
+		source := `[%interactive]
+* [*] checked
+* [ ] not checked
+*     normal list item`
+		expected := `<div class="ulist checklist">
+<ul class="checklist">
+<li>
+<p><input type="checkbox" data-item-complete="1" checked> checked</p>
+</li>
+<li>
+<p><input type="checkbox" data-item-complete="0"> not checked</p>
+</li>
+<li>
+<p>normal list item</p>
+</li>
+</ul>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("interactive checklist with nested non-interactive checklist", func() {
+		source := `[%interactive]
+* [ ] parent not checked
+** [x] checked`
+		expected := `<div class="ulist checklist">
+<ul class="checklist">
+<li>
+<p><input type="checkbox" data-item-complete="0"> parent not checked</p>
+<div class="ulist checklist">
+<ul class="checklist">
+<li>
+<p>&#10003; checked</p>
+</li>
+</ul>
+</div>
+</li>
+</ul>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("checklist with font icons", func() {
+		source := `:icons: font
+
+* [x] checked
+* [ ] not checked`
+		expected := `<div class="ulist checklist">
+<ul class="checklist">
+<li>
+<p><i class="fa fa-check-square-o"></i> checked</p>
+</li>
+<li>
+<p><i class="fa fa-square-o"></i> not checked</p>
+</li>
+</ul>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
 	It("parent checklist with title and nested checklist", func() {
 		source := `.Checklist
 * [ ] parent not checked
@@ -0,0 +1,66 @@
+package html5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("video blocks", func() {
+
+	It("with empty attributes", func() {
+		source := "video::videos/foo.mp4[]"
+		expected := `<div class="videoblock">
+<div class="content">
+<video src="videos/foo.mp4" controls>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("with id, title, role, poster, dimensions, times and options", func() {
+		source := `:imagesdir: media
+
+[#vid.role1]
+.A title
+video::foo.mp4[foo.png, 640, 480, start=10, end=20, options="autoplay,loop,muted,nocontrols"]`
+		expected := `<div id="vid" class="videoblock role1">
+<div class="title">A title</div>
+<div class="content">
+<video src="media/foo.mp4#t=10,20" width="640" height="480" poster="media/foo.png" autoplay muted loop>
+Your browser does not support the video tag.
+</video>
+</div>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("hosted on youtube", func() {
+		source := `video::rPQoq7ThGAU[youtube, 640, 360, start=5, end=30, options="autoplay,loop,muted"]`
+		expected := `<div class="videoblock">
+<div class="content">
+<iframe width="640" height="360" src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0&amp;start=5&amp;end=30&amp;autoplay=1&amp;loop=1&amp;playlist=rPQoq7ThGAU&amp;mute=1" frameborder="0" allowfullscreen></iframe>
+</div>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("hosted on vimeo", func() {
+		source := `.A title
+video::67480300[vimeo, start=60, options="autoplay,nofullscreen"]`
+		expected := `<div class="videoblock">
+<div class="title">A title</div>
+<div class="content">
+<iframe src="https://player.vimeo.com/video/67480300?autoplay=1#at=60" frameborder="0"></iframe>
+</div>
+</div>
+`
+		Expect(RenderHTML(source)).To(MatchHTML(expected))
+	})
+})
@@ -67,6 +67,8 @@ func (r *sgmlRenderer) renderIcon(ctx *renderer.Context, icon types.Icon, admoni
 	}
 	title := ""
 	alt := icon.Class
+	class := icon.Class
+	iconPath := renderIconPath(ctx, icon.Class)
 
 	// TODO: This is rather inconsistent, and done for CSS compatibility.  We should
 	// expand the templates, and eliminate this code in the future.
@@ -74,8 +76,20 @@ func (r *sgmlRenderer) renderIcon(ctx *renderer.Context, icon types.Icon, admoni
 		// Admonition uses title on block instead of the icon, and the alt text is
 		// taken from the caption.  However, in admonitions using the font, the alt
 		// is used as the title element instead.  Go figure.
-		alt = ctx.Attributes.GetAsStringWithDefault(icon.Class+"-caption", defaultIconClasses[icon.Class+"-caption"])
+		caption := defaultIconClasses[icon.Class+"-caption"]
+		if a, found := ctx.Config.CustomAdmonition(icon.Class); found {
+			caption = a.CaptionOrDefault()
+			class = a.IconOrDefault()
+			iconPath = renderIconPath(ctx, class)
+		}
+		alt = ctx.Attributes.GetAsStringWithDefault(icon.Class+"-caption", caption)
 		alt = icon.Attributes.GetAsStringWithDefault(types.AttrCaption, alt)
+		// a custom icon is always rendered as an image, unless icons are disabled
+		if name, found := icon.Attributes.GetAsString(types.AttrIcon); found && icons != "text" {
+			font = false
+			template = r.iconImage
+			iconPath = renderCustomIconPath(ctx, name)
+		}
 		if font {
 			title = alt
 			alt = ""
@@ -101,7 +115,7 @@ func (r *sgmlRenderer) renderIcon(ctx *renderer.Context, icon types.Icon, admoni
 		Path       string
 		Admonition bool
 	}{
-		Class:      icon.Class,
+		Class:      class,
 		Alt:        alt,
 		Title:      title,
 		Width:      icon.Attributes.GetAsStringWithDefault(types.AttrWidth, ""),
@@ -111,18 +125,42 @@ func (r *sgmlRenderer) renderIcon(ctx *renderer.Context, icon types.Icon, admoni
 		Flip:       icon.Attributes.GetAsStringWithDefault(types.AttrIconFlip, ""),
 		Link:       icon.Attributes.GetAsStringWithDefault(types.AttrInlineLink, ""),
 		Window:     icon.Attributes.GetAsStringWithDefault(types.AttrImageWindow, ""),
-		Path:       renderIconPath(ctx, icon.Class),
+		Path:       imageSource(ctx, iconPath, types.Position{}),
 		Admonition: admonition,
 	})
 	return string(s.String()), err
 }
 
 func renderIconPath(ctx *renderer.Context, name string) string {
-	// Icon files by default are in {imagesdir}/icons, where {imagesdir} defaults to "./images"
-	dir := ctx.Attributes.GetAsStringWithDefault("iconsdir",
-		path.Join(ctx.Attributes.GetAsStringWithDefault("imagesdir", "./images"), "icons"))
 	// TODO: perform attribute substitutions here!
 	ext := ctx.Attributes.GetAsStringWithDefault("icontype", "png")
 
-	return path.Join(dir, name+"."+ext)
+	return path.Join(iconsDir(ctx), name+"."+ext)
+}
+
+// renderCustomIconPath returns the path to the icon set with the `icon` attribute of an admonition.
+// The icon is located in the icons directory, unless it is a URL or an absolute path, and
+// the `icontype` extension is appended if the icon has no extension.
+func renderCustomIconPath(ctx *renderer.Context, name string) string {
+	if strings.Contains(name, "://") || path.IsAbs(name) {
+		return name
+	}
+	if path.Ext(name) == "" {
+		return renderIconPath(ctx, name)
+	}
+	return path.Join(iconsDir(ctx), name)
+}
+
+func iconsDir(ctx *renderer.Context) string {
+	// Icon files by default are in {imagesdir}/icons, where {imagesdir} defaults to "./images"
+	return ctx.Attributes.GetAsStringWithDefault("iconsdir",
+		path.Join(ctx.Attributes.GetAsStringWithDefault("imagesdir", "./images"), "icons"))
+}
+
+// admonitionClass returns the CSS class of the admonition of the given kind
+func admonitionClass(ctx *renderer.Context, kind string) string {
+	if a, found := ctx.Config.CustomAdmonition(kind); found {
+		return a.ClassOrDefault()
+	}
+	return kind
 }
@@ -1,6 +1,7 @@
 package sgml
 
 import (
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -39,6 +40,12 @@ func (r *sgmlRenderer) renderImageBlock(ctx *renderer.Context, img types.ImageBl
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render image roles")
 	}
+	svg := r.renderSVG(ctx, img.Location, img.Attributes, img.Position)
+	path := img.Location.Stringify()
+	if !svg.Inline {
+		path = imageSource(ctx, path, img.Position)
+	}
+	link := newImageLink(img.Attributes, path)
 	err = r.blockImage.Execute(result, struct {
 		ID          string
 		Title       string
@@ -46,21 +53,29 @@ func (r *sgmlRenderer) renderImageBlock(ctx *renderer.Context, img types.ImageBl
 		Caption     string
 		Roles       string
 		Href        string
+		Window      string
+		Rel         string
 		Alt         string
 		Width       string
 		Height      string
+		ScaledWidth string
 		Path        string
+		SVG         svgImage
 	}{
 		ID:          r.renderElementID(img.Attributes),
 		Title:       title,
 		ImageNumber: number,
 		Caption:     caption.String(),
 		Roles:       roles,
-		Href:        img.Attributes.GetAsStringWithDefault(types.AttrInlineLink, ""),
+		Href:        link.Href,
+		Window:      link.Window,
+		Rel:         link.Rel,
 		Alt:         img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
 		Width:       img.Attributes.GetAsStringWithDefault(types.AttrWidth, ""),
 		Height:      img.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
-		Path:        img.Location.Stringify(),
+		ScaledWidth: imageScaledWidth(img.Attributes),
+		Path:        path,
+		SVG:         svg,
 	})
 
 	if err != nil {
@@ -76,22 +91,36 @@ func (r *sgmlRenderer) renderInlineImage(ctx *Context, img types.InlineImage) (s
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render image roles")
 	}
+	svg := r.renderSVG(ctx, img.Location, img.Attributes, img.Position)
+	path := img.Location.Stringify()
+	if !svg.Inline {
+		path = imageSource(ctx, path, img.Position)
+	}
+	link := newImageLink(img.Attributes, path)
 	err = r.inlineImage.Execute(result, struct {
-		Roles  string
-		Title  string
-		Href   string
-		Alt    string
-		Width  string
-		Height string
-		Path   string
+		Roles       string
+		Title       string
+		Href        string
+		Window      string
+		Rel         string
+		Alt         string
+		Width       string
+		Height      string
+		ScaledWidth string
+		Path        string
+		SVG         svgImage
 	}{
-		Title:  r.renderElementTitle(img.Attributes),
-		Roles:  roles,
-		Href:   img.Attributes.GetAsStringWithDefault(types.AttrInlineLink, ""),
-		Alt:    img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
-		Width:  img.Attributes.GetAsStringWithDefault(types.AttrWidth, ""),
-		Height: img.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
-		Path:   img.Location.Stringify(),
+		Title:       r.renderElementTitle(img.Attributes),
+		Roles:       roles,
+		Href:        link.Href,
+		Window:      link.Window,
+		Rel:         link.Rel,
+		Alt:         img.Attributes.GetAsStringWithDefault(types.AttrImageAlt, ""),
+		Width:       img.Attributes.GetAsStringWithDefault(types.AttrWidth, ""),
+		Height:      img.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
+		ScaledWidth: imageScaledWidth(img.Attributes),
+		Path:        path,
+		SVG:         svg,
 	})
 
 	if err != nil {
@@ -100,3 +129,45 @@ func (r *sgmlRenderer) renderInlineImage(ctx *Context, img types.InlineImage) (s
 	// log.Debugf("rendered inline image: %s", result.Bytes())
 	return result.String(), nil
 }
+
+// imageLink the link around an image
+type imageLink struct {
+	Href   string
+	Window string
+	Rel    string
+}
+
+// newImageLink returns the link around the image at the given path, if the image has a `link` attribute.
+// A `self` link refers to the image itself.
+func newImageLink(attrs types.Attributes, path string) imageLink {
+	href, found := attrs.GetAsString(types.AttrInlineLink)
+	if !found || href == "" {
+		return imageLink{}
+	}
+	if href == "self" {
+		href = path
+	}
+	window, rel := linkWindowAndRel(attrs)
+	return imageLink{
+		Href:   href,
+		Window: window,
+		Rel:    rel,
+	}
+}
+
+// the units of the `scaledwidth` and `pdfwidth` attributes which are also valid CSS units
+var scaledWidthRegexp = regexp.MustCompile(`^\d+(?:\.\d+)?(?:%|px|em|rem|vw|vh|in|cm|mm|pt|pc)$`)
+
+// imageScaledWidth returns the value of the `scaledwidth` (or `pdfwidth`) attribute, which is used as the CSS width
+// of the image unless its `width` is set. Values without a unit are ignored
+func imageScaledWidth(attrs types.Attributes) string {
+	if _, found := attrs.GetAsString(types.AttrWidth); found {
+		return ""
+	}
+	for _, key := range []string{types.AttrImageScaledWidth, types.AttrImagePDFWidth} {
+		if w, found := attrs.GetAsString(key); found && scaledWidthRegexp.MatchString(w) {
+			return w
+		}
+	}
+	return ""
+}
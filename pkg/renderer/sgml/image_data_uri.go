@@ -0,0 +1,124 @@
+package sgml
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// the MIME types of the images which can be embedded as data URIs, as detected from their content
+var dataURIImageTypes = map[string]bool{
+	"image/gif":  true,
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/webp": true,
+}
+
+// imageSource returns the `src` of the image at the given path, ie, the path itself, or a `data:` URI
+// with the base64-encoded content of the image file if the document has the `data-uri` attribute.
+// The path is returned as-is if the image is remote, or if the conversion is in `Secure` mode. A warning is reported
+// if the image file cannot be embedded (missing file, unsupported type, etc.), at the given position of the image in the source
+func imageSource(ctx *Context, path string, position types.Position) string {
+	if _, enabled := ctx.Attributes.GetAsString(types.AttrDataURI); !enabled || ctx.Config.SafeMode >= configuration.Secure || isRemoteImage(path) {
+		return path
+	}
+	uri, err := imageDataURI(ctx, path)
+	if err != nil {
+		log.WithError(err).Debugf("unable to embed image '%s'", path)
+		code := types.ImageDataURICode
+		if os.IsNotExist(err) {
+			code = types.MissingImageCode
+		}
+		ctx.Config.Report(types.Diagnostic{
+			Severity: types.SeverityWarning,
+			Code:     code,
+			Message:  fmt.Sprintf("unable to embed image '%s' as a data URI: %s", path, err.Error()),
+			Filename: position.Filename,
+			Line:     position.Line,
+			Kind:     "Image",
+		})
+		return path
+	}
+	return uri
+}
+
+func imageDataURI(ctx *Context, path string) (string, error) {
+	filename, err := resolveImageFile(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	info, err := os.Stat(filename)
+	if err != nil {
+		return "", err
+	}
+	if max := ctx.Config.MaxDataURISize; max > 0 && info.Size() > max {
+		return "", fmt.Errorf("image size (%d bytes) exceeds the limit of %d bytes", info.Size(), max)
+	}
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", err
+	}
+	mimeType, err := imageMIMEType(filename, data)
+	if err != nil {
+		return "", err
+	}
+	return "data:" + mimeType + ";base64," + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// imageMIMEType returns the MIME type of the image, based on its content. SVG images, which cannot be detected
+// from their content alone, are recognized by the extension of the file, as long as their content has an `<svg` element.
+func imageMIMEType(filename string, data []byte) (string, error) {
+	if strings.ToLower(filepath.Ext(filename)) == ".svg" {
+		if bytes.Contains(data, []byte("<svg")) {
+			return "image/svg+xml", nil
+		}
+		return "", fmt.Errorf("unsupported image type: not a valid SVG image")
+	}
+	mimeType := http.DetectContentType(data)
+	if !dataURIImageTypes[mimeType] {
+		return "", fmt.Errorf("unsupported image type: '%s'", mimeType)
+	}
+	return mimeType, nil
+}
+
+func isRemoteImage(path string) bool {
+	return (strings.Contains(path, "://") && !strings.HasPrefix(path, "file://")) || strings.HasPrefix(path, "data:")
+}
+
+// resolveImageFile returns the path of the local image file at the given location, which is resolved like
+// the files to include (ie, relatively to the directory of the document).
+// Returns an error if the image is remote, or if the conversion is in `Safe` mode (or above)
+// and the image is outside of the directory of the document
+func resolveImageFile(ctx *Context, location string) (string, error) {
+	if isRemoteImage(location) {
+		return "", fmt.Errorf("remote images cannot be embedded")
+	}
+	path := strings.TrimPrefix(location, "file://")
+	dir := filepath.Dir(ctx.Config.Filename)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(dir, path)
+	}
+	if ctx.Config.SafeMode >= configuration.Safe {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return "", err
+		}
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return "", err
+		}
+		if rel, err := filepath.Rel(absDir, absPath); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return "", fmt.Errorf("access to files outside of the directory of the document is not allowed in '%s' mode", ctx.Config.SafeMode)
+		}
+	}
+	return path, nil
+}
@@ -0,0 +1,106 @@
+package sgml
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// svgImage the data to render an SVG image with the `inline` or `interactive` option
+type svgImage struct {
+	Inline      bool
+	Content     string // the content of the SVG file to embed in the document, or empty if it could not be read
+	Interactive bool
+	Fallback    string // the image to display when the SVG image cannot be rendered in an `<object>` element
+}
+
+func isSVG(location types.Location, attrs types.Attributes) bool {
+	return attrs.GetAsStringWithDefault(types.AttrFormat, "") == "svg" ||
+		strings.HasSuffix(strings.ToLower(location.Stringify()), ".svg")
+}
+
+// renderSVG returns the data to embed the SVG image at the given location if it has the `inline` option,
+// or to render it in an `<object>` element if it has the `interactive` option (unless the conversion is in `Secure` mode)
+func (r *sgmlRenderer) renderSVG(ctx *Context, location types.Location, attrs types.Attributes, position types.Position) svgImage {
+	if !isSVG(location, attrs) || ctx.Config.SafeMode >= configuration.Secure {
+		return svgImage{}
+	}
+	switch {
+	case attrs.HasOption("inline"):
+		content, err := readSVG(ctx, location, attrs)
+		if err != nil {
+			log.WithError(err).Debugf("unable to embed SVG image '%s'", location.Stringify())
+			ctx.Config.Report(types.Diagnostic{
+				Severity: types.SeverityWarning,
+				Code:     types.MissingImageCode,
+				Message:  fmt.Sprintf("unable to embed SVG image '%s': %s", location.Stringify(), err.Error()),
+				Filename: position.Filename,
+				Line:     position.Line,
+				Kind:     "Image",
+			})
+		}
+		return svgImage{
+			Inline:  true,
+			Content: content,
+		}
+	case attrs.HasOption("interactive"):
+		return svgImage{
+			Interactive: true,
+			Fallback:    imageSource(ctx, attrs.GetAsStringWithDefault(types.AttrImageFallback, location.Stringify()), position),
+		}
+	default:
+		return svgImage{}
+	}
+}
+
+var (
+	// all the content before the `<svg>` start tag (XML prolog, DOCTYPE, comments, etc.)
+	svgPreambleRegexp = regexp.MustCompile(`(?s)\A.*?(<svg[\s>])`)
+	svgStartTagRegexp = regexp.MustCompile(`\A<svg[^>]*>`)
+	// the attributes which are removed from the `<svg>` start tag when the width or the height of the image is set
+	svgDimensionAttributeRegexp = regexp.MustCompile(`\s(?:width|height|style)=(?:"[^"]*"|'[^']*')`)
+)
+
+// readSVG reads the SVG file at the given location, which is resolved relatively to the directory of the document
+// (see `resolveImageFile`), and returns its `<svg>` element, with the width and height set in the given attributes
+func readSVG(ctx *Context, location types.Location, attrs types.Attributes) (string, error) {
+	path, err := resolveImageFile(ctx, location.Stringify())
+	if err != nil {
+		return "", err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	svg := strings.TrimSpace(string(data))
+	if !strings.HasPrefix(svg, "<svg") {
+		m := svgPreambleRegexp.FindStringSubmatchIndex(svg)
+		if m == nil {
+			return "", fmt.Errorf("no <svg> element found")
+		}
+		svg = svg[m[2]:]
+	}
+	width, hasWidth := attrs.GetAsString(types.AttrWidth)
+	height, hasHeight := attrs.GetAsString(types.AttrImageHeight)
+	if !hasWidth && !hasHeight {
+		return svg, nil
+	}
+	startTag := svgStartTagRegexp.FindString(svg)
+	if startTag == "" {
+		return svg, nil
+	}
+	newStartTag := svgDimensionAttributeRegexp.ReplaceAllString(startTag, "")
+	newStartTag = strings.TrimSuffix(newStartTag, ">")
+	if hasWidth {
+		newStartTag += ` width="` + width + `"`
+	}
+	if hasHeight {
+		newStartTag += ` height="` + height + `"`
+	}
+	return newStartTag + ">" + svg[len(startTag):], nil
+}
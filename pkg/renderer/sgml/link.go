@@ -1,6 +1,8 @@
 package sgml
 
 import (
+	"html"
+	"net/url"
 	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
@@ -15,8 +17,14 @@ func (r *sgmlRenderer) renderLink(ctx *renderer.Context, l types.InlineLink) (st
 	text := ""
 	class := ""
 	var err error
-	// TODO; support `mailto:` positional attributes
 	positionals := l.Attributes.Positionals()
+	if l.Location.Scheme == "mailto:" && len(positionals) > 1 {
+		// the optional 2nd and 3rd positional attributes of an email link are the subject and the body of the email
+		if location, err = r.withMailtoParams(ctx, location, positionals[1:]); err != nil {
+			return "", errors.Wrap(err, "unable to render external link")
+		}
+		positionals = positionals[:1]
+	}
 	if len(positionals) > 0 {
 		buf := &strings.Builder{}
 		for i, arg := range positionals {
@@ -30,18 +38,29 @@ func (r *sgmlRenderer) renderLink(ctx *renderer.Context, l types.InlineLink) (st
 			}
 		}
 		text = buf.String()
+	} else if l.Location.Scheme == "mailto:" {
+		// email address only
+		text = EscapeString(strings.TrimPrefix(location, l.Location.Scheme))
 	} else {
 		class = "bare"
-		text = location
+		text = EscapeString(location)
+		if ctx.Attributes.Has(types.AttrHideURIScheme) {
+			text = EscapeString(strings.TrimPrefix(location, l.Location.Scheme))
+		}
 	}
+	window, rel := linkWindowAndRel(l.Attributes)
 	err = r.link.Execute(result, struct {
-		URL   string
-		Text  string
-		Class string
+		URL    string
+		Text   string
+		Class  string
+		Window string
+		Rel    string
 	}{
-		URL:   location,
-		Text:  text,
-		Class: class,
+		URL:    EscapeString(location),
+		Text:   text,
+		Class:  class,
+		Window: window,
+		Rel:    rel,
 	})
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render external link")
@@ -49,3 +68,48 @@ func (r *sgmlRenderer) renderLink(ctx *renderer.Context, l types.InlineLink) (st
 	log.Debugf("rendered external link: %s", result.String())
 	return result.String(), nil
 }
+
+// withMailtoParams appends the `subject` and the `body` query parameters to the given `mailto:` location
+func (r *sgmlRenderer) withMailtoParams(ctx *renderer.Context, location string, params [][]interface{}) (string, error) {
+	result := &strings.Builder{}
+	result.WriteString(location)
+	for i, name := range []string{"subject", "body"} {
+		if i >= len(params) {
+			break
+		}
+		value, err := r.renderPlainText(ctx, params[i])
+		if err != nil {
+			return "", err
+		}
+		if i == 0 {
+			result.WriteString("?")
+		} else {
+			result.WriteString("&")
+		}
+		result.WriteString(name)
+		result.WriteString("=")
+		// the value is encoded from its unescaped form (eg: `&` instead of `&amp;`),
+		// and spaces are encoded as `%20` rather than `+` in the `mailto:` URLs (see RFC 6068)
+		value = html.UnescapeString(strings.TrimSpace(value))
+		result.WriteString(strings.ReplaceAll(url.QueryEscape(value), "+", "%20"))
+	}
+	return result.String(), nil
+}
+
+// linkWindowAndRel returns the target window and the `rel` attribute of a link.
+// A `^` window is a shorthand for `_blank`. Links which open in a new window have the `noopener` rel,
+// and the `nofollow` option adds the `nofollow` rel
+func linkWindowAndRel(attrs types.Attributes) (string, string) {
+	window := attrs.GetAsStringWithDefault(types.AttrImageWindow, "")
+	if window == "^" {
+		window = "_blank"
+	}
+	var rel []string
+	if attrs.HasOption("nofollow") {
+		rel = append(rel, "nofollow")
+	}
+	if window == "_blank" || attrs.HasOption("noopener") {
+		rel = append(rel, "noopener")
+	}
+	return window, strings.Join(rel, " ")
+}
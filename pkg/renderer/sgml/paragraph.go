@@ -90,6 +90,10 @@ func (r *sgmlRenderer) renderParagraphWithinDelimitedBlock(ctx *renderer.Context
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render delimited block paragraph content")
 	}
+	checkStyle, err := r.renderCheckStyle(ctx, p.Attributes[types.AttrCheckStyle])
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render delimited block paragraph content")
+	}
 	err = r.delimitedBlockParagraph.Execute(result, struct {
 		Context    *renderer.Context
 		ID         string
@@ -101,22 +105,35 @@ func (r *sgmlRenderer) renderParagraphWithinDelimitedBlock(ctx *renderer.Context
 		Context:    ctx,
 		ID:         r.renderElementID(p.Attributes),
 		Title:      r.renderElementTitle(p.Attributes),
-		CheckStyle: renderCheckStyle(p.Attributes[types.AttrCheckStyle]),
+		CheckStyle: checkStyle,
 		Content:    string(content),
 		Lines:      p.Lines,
 	})
 	return result.String(), err
 }
 
-func renderCheckStyle(style interface{}) string {
-	switch style {
-	case types.Unchecked:
-		return "&#10063; "
-	case types.Checked:
-		return "&#10003; "
-	default:
-		return ""
+// renderCheckStyle renders the checkbox of an interactive checklist item,
+// or the glyph of a (non-interactive) checklist item, depending on the `icons` attribute
+func (r *sgmlRenderer) renderCheckStyle(ctx *renderer.Context, style interface{}) (string, error) {
+	if style != types.Checked && style != types.Unchecked {
+		return "", nil
 	}
+	result := &strings.Builder{}
+	err := r.checklistMarker.Execute(result, struct {
+		Context     *renderer.Context
+		Checked     bool
+		Interactive bool
+		Icons       string
+	}{
+		Context:     ctx,
+		Checked:     style == types.Checked,
+		Interactive: ctx.InteractiveChecklist,
+		Icons:       ctx.Attributes.GetAsStringWithDefault("icons", "text"),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render checklist marker")
+	}
+	return result.String(), nil
 }
 
 func (r *sgmlRenderer) renderElementTitle(attrs types.Attributes) string {
@@ -3,9 +3,11 @@ package sgml
 import (
 	"fmt"
 	"io"
+	"path"
 	"strings"
 	texttemplate "text/template"
 
+	"github.com/bytesparadise/libasciidoc/pkg/asciimath"
 	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	"github.com/bytesparadise/libasciidoc/pkg/renderer"
 	"github.com/bytesparadise/libasciidoc/pkg/types"
@@ -42,6 +44,7 @@ func NewRenderer(t Templates) Renderer {
 		"trim":                trimBoth,
 		"specialCharacter":    specialCharacter,
 		"predefinedAttribute": predefinedAttribute,
+		"asciimath":           asciimath.ToMathML,
 	}
 
 	return r
@@ -128,12 +131,19 @@ func (r *sgmlRenderer) newTemplate(name string, tmpl string, err error) (*textTe
 	t := texttemplate.New(name)
 	t.Funcs(r.functions)
 	if t, err = t.Parse(tmpl); err != nil {
-		log.Errorf("failed to initialize the '%s' template: %v", name, err)
-		return nil, err
+		return nil, errors.Wrapf(err, "failed to initialize the '%s' template", name)
 	}
 	return t, nil
 }
 
+// newOptionalTemplate same as `newTemplate`, but returns a `nil` template (and no error) if the given template is empty
+func (r *sgmlRenderer) newOptionalTemplate(name string, tmpl string, err error) (*textTemplate, error) {
+	if err != nil || len(tmpl) == 0 {
+		return nil, err
+	}
+	return r.newTemplate(name, tmpl, err)
+}
+
 // Render renders the given document in HTML and writes the result in the given `writer`
 func (r *sgmlRenderer) Render(ctx *renderer.Context, doc types.Document, output io.Writer) (types.Metadata, error) {
 
@@ -164,10 +174,22 @@ func (r *sgmlRenderer) Render(ctx *renderer.Context, doc types.Document, output
 	}
 	if ctx.Config.IncludeHeaderFooter {
 		log.Debugf("Rendering full document...")
+		mathJax, err := r.renderMathJax(doc)
+		if err != nil {
+			return md, errors.Wrap(err, "unable to render full document")
+		}
+		favicon, faviconType := renderFavicon(doc)
 		err = r.article.Execute(output, struct {
 			Generator     string
 			Doctype       string
+			Lang          string
 			Title         string
+			Description   string
+			Keywords      string
+			Copyright     string
+			AppName       string
+			Favicon       string
+			FaviconType   string
 			Authors       string
 			Header        string
 			Role          string
@@ -179,10 +201,18 @@ func (r *sgmlRenderer) Render(ctx *renderer.Context, doc types.Document, output
 			CSS           string
 			IncludeHeader bool
 			IncludeFooter bool
+			MathJax       string
 		}{
-			Generator:     "libasciidoc", // TODO: externalize this value and include the lib version ?
+			Generator:     ctx.Generator,
 			Doctype:       doc.Attributes.GetAsStringWithDefault(types.AttrDocType, "article"),
-			Title:         renderedTitle,
+			Lang:          renderLang(doc),
+			Title:         renderHeadTitle(doc, renderedTitle),
+			Description:   EscapeString(doc.Attributes.GetAsStringWithDefault(types.AttrDescription, "")),
+			Keywords:      EscapeString(doc.Attributes.GetAsStringWithDefault(types.AttrKeywords, "")),
+			Copyright:     EscapeString(doc.Attributes.GetAsStringWithDefault(types.AttrCopyright, "")),
+			AppName:       EscapeString(doc.Attributes.GetAsStringWithDefault(types.AttrAppName, "")),
+			Favicon:       favicon,
+			FaviconType:   faviconType,
 			Authors:       r.renderAuthors(doc),
 			Header:        renderedHeader,
 			Roles:         roles,
@@ -193,6 +223,7 @@ func (r *sgmlRenderer) Render(ctx *renderer.Context, doc types.Document, output
 			CSS:           ctx.Config.CSS,
 			IncludeHeader: !doc.Attributes.Has(types.AttrNoHeader),
 			IncludeFooter: !doc.Attributes.Has(types.AttrNoFooter),
+			MathJax:       mathJax,
 		})
 		if err != nil {
 			return md, errors.Wrapf(err, "unable to render full document")
@@ -208,6 +239,7 @@ func (r *sgmlRenderer) Render(ctx *renderer.Context, doc types.Document, output
 	// arguably this should be a time.Time for use in Go
 	md.LastUpdated = ctx.Config.LastUpdated.Format(configuration.LastUpdatedFormat)
 	md.TableOfContents = ctx.TableOfContents
+	md.Checklist.Checked, md.Checklist.Unchecked = ctx.ChecklistItemCounters()
 	return md, err
 }
 
@@ -319,6 +351,42 @@ func (r *sgmlRenderer) renderDocumentTitle(ctx *renderer.Context, doc types.Docu
 	return "", nil
 }
 
+// renderHeadTitle returns the title of the document in the `<head>` element, ie, the value of the `title` attribute
+// if it was set, or the rendered title of the document otherwise
+func renderHeadTitle(doc types.Document, renderedTitle string) string {
+	if title, found := doc.Attributes.GetAsString(types.AttrTitle); found {
+		return EscapeString(title)
+	}
+	return renderedTitle
+}
+
+// renderLang returns the language of the document (`en` by default),
+// or an empty string if the `nolang` attribute is set
+func renderLang(doc types.Document) string {
+	if doc.Attributes.Has(types.AttrNoLang) {
+		return ""
+	}
+	return EscapeString(doc.Attributes.GetAsStringWithDefault(types.AttrLang, "en"))
+}
+
+// renderFavicon returns the location and the MIME type of the icon of the document,
+// or empty strings if the `favicon` attribute is not set
+func renderFavicon(doc types.Document) (string, string) {
+	favicon, found := doc.Attributes.GetAsString(types.AttrFavicon)
+	if !found {
+		return "", ""
+	}
+	if favicon == "" {
+		return "favicon.ico", "image/x-icon"
+	}
+	switch ext := path.Ext(favicon); ext {
+	case "", ".ico":
+		return EscapeString(favicon), "image/x-icon"
+	default:
+		return EscapeString(favicon), "image/" + ext[1:]
+	}
+}
+
 func (r *sgmlRenderer) renderArticleHeader(ctx *renderer.Context, header types.Section) (string, error) {
 	renderedHeader, err := r.renderInlineElements(ctx, header.Title)
 	if err != nil {
@@ -421,3 +489,15 @@ func (r *sgmlRenderer) renderDocumentElements(ctx *renderer.Context, source []in
 	buff.WriteString(renderedFootnotes)
 	return buff.String(), nil
 }
+
+// renderMathJax renders the MathJax script, if the STEM support is enabled in the document
+func (r *sgmlRenderer) renderMathJax(doc types.Document) (string, error) {
+	if !doc.Attributes.Has(types.AttrStem) {
+		return "", nil
+	}
+	result := &strings.Builder{}
+	if err := r.mathJax.Execute(result, nil); err != nil {
+		return "", errors.Wrap(err, "unable to render MathJax script")
+	}
+	return result.String(), nil
+}
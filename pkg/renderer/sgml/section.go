@@ -107,3 +107,33 @@ func (r *sgmlRenderer) renderSectionTitle(ctx *renderer.Context, s types.Section
 	// log.Debugf("rendered sectionTitle: %s", result.Bytes())
 	return string(result.String()), nil
 }
+
+func (r *sgmlRenderer) renderDiscreteHeading(ctx *renderer.Context, h types.DiscreteHeading) (string, error) {
+	log.Debugf("rendering discrete heading level %d", h.Level)
+	renderedContent, err := r.renderInlineElements(ctx, h.Title)
+	if err != nil {
+		return "", errors.Wrap(err, "error while rendering discrete heading content")
+	}
+	roles, err := r.renderElementRoles(ctx, h.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render discrete heading roles")
+	}
+	result := &strings.Builder{}
+	err = r.discreteHeading.Execute(result, struct {
+		Level        int
+		LevelPlusOne int
+		ID           string
+		Roles        string
+		Content      string
+	}{
+		Level:        h.Level,
+		LevelPlusOne: h.Level + 1, // Level 1 is <h2>.
+		ID:           r.renderElementID(h.Attributes),
+		Roles:        roles,
+		Content:      strings.TrimSpace(renderedContent),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "error while rendering discrete heading")
+	}
+	return result.String(), nil
+}
@@ -13,13 +13,18 @@ type sgmlRenderer struct {
 	admonitionParagraph       *textTemplate
 	article                   *textTemplate
 	articleHeader             *textTemplate
+	audioBlock                *textTemplate
+	bibliographyAnchor        *textTemplate
 	blankLine                 *textTemplate
 	blockImage                *textTemplate
 	boldText                  *textTemplate
 	calloutList               *textTemplate
 	calloutListItem           *textTemplate
 	calloutRef                *textTemplate
+	checklistMarker           *textTemplate
+	collapsibleExampleBlock   *textTemplate
 	delimitedBlockParagraph   *textTemplate
+	discreteHeading           *textTemplate
 	documentDetails           *textTemplate
 	documentAuthorDetails     *textTemplate
 	externalCrossReference    *textTemplate
@@ -33,8 +38,12 @@ type sgmlRenderer struct {
 	iconFont                  *textTemplate
 	iconImage                 *textTemplate
 	iconText                  *textTemplate
+	inlineButton              *textTemplate
 	inlineIcon                *textTemplate
 	inlineImage               *textTemplate
+	inlineKeyboard            *textTemplate
+	inlineMenu                *textTemplate
+	inlineStem                *textTemplate
 	internalCrossReference    *textTemplate
 	invalidFootnote           *textTemplate
 	italicText                *textTemplate
@@ -50,7 +59,9 @@ type sgmlRenderer struct {
 	manpageNameParagraph      *textTemplate
 	markdownQuoteBlock        *textTemplate
 	markedText                *textTemplate
+	mathJax                   *textTemplate
 	monospaceText             *textTemplate
+	openBlock                 *textTemplate
 	orderedList               *textTemplate
 	orderedListItem           *textTemplate
 	paragraph                 *textTemplate
@@ -66,12 +77,14 @@ type sgmlRenderer struct {
 	sidebarBlock              *textTemplate
 	sourceBlock               *textTemplate
 	specialCharacter          *textTemplate
+	stemBlock                 *textTemplate
 	stringElement             *textTemplate
 	subscriptText             *textTemplate
 	superscriptText           *textTemplate
 	table                     *textTemplate
 	tableBody                 *textTemplate
 	tableCell                 *textTemplate
+	tableFooter               *textTemplate
 	tableHeader               *textTemplate
 	tableHeaderCell           *textTemplate
 	tableRow                  *textTemplate
@@ -84,6 +97,7 @@ type sgmlRenderer struct {
 	verbatimLine              *textTemplate
 	verseBlock                *textTemplate
 	verseParagraph            *textTemplate
+	videoBlock                *textTemplate
 }
 
 func (r *sgmlRenderer) prepareTemplates() error {
@@ -94,13 +108,18 @@ func (r *sgmlRenderer) prepareTemplates() error {
 		r.admonitionParagraph, err = r.newTemplate("admonition-paragraph", tmpls.AdmonitionParagraph, err)
 		r.article, err = r.newTemplate("article", tmpls.Article, err)
 		r.articleHeader, err = r.newTemplate("article-header", tmpls.ArticleHeader, err)
+		r.audioBlock, err = r.newTemplate("audio-block", tmpls.AudioBlock, err)
+		r.bibliographyAnchor, err = r.newTemplate("bibliography-anchor", tmpls.BibliographyAnchor, err)
 		r.blankLine, err = r.newTemplate("blank-line", tmpls.BlankLine, err)
 		r.blockImage, err = r.newTemplate("block-image", tmpls.BlockImage, err)
 		r.boldText, err = r.newTemplate("bold-text", tmpls.BoldText, err)
 		r.calloutList, err = r.newTemplate("callout-list", tmpls.CalloutList, err)
 		r.calloutListItem, err = r.newTemplate("callout-list-item", tmpls.CalloutListItem, err)
 		r.calloutRef, err = r.newTemplate("callout-ref", tmpls.CalloutRef, err)
+		r.checklistMarker, err = r.newTemplate("checklist-marker", tmpls.ChecklistMarker, err)
+		r.collapsibleExampleBlock, err = r.newOptionalTemplate("collapsible-example-block", tmpls.CollapsibleExampleBlock, err)
 		r.delimitedBlockParagraph, err = r.newTemplate("delimited-block-paragraph", tmpls.DelimitedBlockParagraph, err)
+		r.discreteHeading, err = r.newTemplate("discrete-heading", tmpls.DiscreteHeading, err)
 		r.documentDetails, err = r.newTemplate("document-details", tmpls.DocumentDetails, err)
 		r.documentAuthorDetails, err = r.newTemplate("document-author-details", tmpls.DocumentAuthorDetails, err)
 		r.exampleBlock, err = r.newTemplate("example-block", tmpls.ExampleBlock, err)
@@ -114,8 +133,12 @@ func (r *sgmlRenderer) prepareTemplates() error {
 		r.iconFont, err = r.newTemplate("icon-font", tmpls.IconFont, err)
 		r.iconImage, err = r.newTemplate("icon-image", tmpls.IconImage, err)
 		r.iconText, err = r.newTemplate("icon-text", tmpls.IconText, err)
+		r.inlineButton, err = r.newTemplate("inline-button", tmpls.InlineButton, err)
 		r.inlineIcon, err = r.newTemplate("inline-icon", tmpls.InlineIcon, err)
 		r.inlineImage, err = r.newTemplate("inline-image", tmpls.InlineImage, err)
+		r.inlineKeyboard, err = r.newTemplate("inline-keyboard", tmpls.InlineKeyboard, err)
+		r.inlineMenu, err = r.newTemplate("inline-menu", tmpls.InlineMenu, err)
+		r.inlineStem, err = r.newTemplate("inline-stem", tmpls.InlineStem, err)
 		r.internalCrossReference, err = r.newTemplate("internal-xref", tmpls.InternalCrossReference, err)
 		r.invalidFootnote, err = r.newTemplate("invalid-footnote", tmpls.InvalidFootnote, err)
 		r.italicText, err = r.newTemplate("italic-text", tmpls.ItalicText, err)
@@ -131,7 +154,9 @@ func (r *sgmlRenderer) prepareTemplates() error {
 		r.manpageNameParagraph, err = r.newTemplate("manpage-name-paragraph", tmpls.ManpageNameParagraph, err)
 		r.markdownQuoteBlock, err = r.newTemplate("markdown-quote-block", tmpls.MarkdownQuoteBlock, err)
 		r.markedText, err = r.newTemplate("marked-text", tmpls.MarkedText, err)
+		r.mathJax, err = r.newTemplate("mathjax", tmpls.MathJax, err)
 		r.monospaceText, err = r.newTemplate("monospace-text", tmpls.MonospaceText, err)
+		r.openBlock, err = r.newTemplate("open-block", tmpls.OpenBlock, err)
 		r.orderedList, err = r.newTemplate("ordered-list", tmpls.OrderedList, err)
 		r.orderedListItem, err = r.newTemplate("ordered-list-item", tmpls.OrderedListItem, err)
 		r.paragraph, err = r.newTemplate("paragraph", tmpls.Paragraph, err)
@@ -148,11 +173,13 @@ func (r *sgmlRenderer) prepareTemplates() error {
 		r.sidebarBlock, err = r.newTemplate("sidebar-block", tmpls.SidebarBlock, err)
 		r.sourceBlock, err = r.newTemplate("source-block", tmpls.SourceBlock, err)
 		r.specialCharacter, err = r.newTemplate("special-character", tmpls.SpecialCharacter, err)
+		r.stemBlock, err = r.newTemplate("stem-block", tmpls.StemBlock, err)
 		r.subscriptText, err = r.newTemplate("subscript", tmpls.SubscriptText, err)
 		r.superscriptText, err = r.newTemplate("superscript", tmpls.SuperscriptText, err)
 		r.table, err = r.newTemplate("table", tmpls.Table, err)
 		r.tableBody, err = r.newTemplate("table-body", tmpls.TableBody, err)
 		r.tableCell, err = r.newTemplate("table-cell", tmpls.TableCell, err)
+		r.tableFooter, err = r.newTemplate("table-footer", tmpls.TableFooter, err)
 		r.tableHeader, err = r.newTemplate("table-header", tmpls.TableHeader, err)
 		r.tableHeaderCell, err = r.newTemplate("table-header-cell", tmpls.TableHeaderCell, err)
 		r.tableRow, err = r.newTemplate("table-row", tmpls.TableRow, err)
@@ -165,6 +192,7 @@ func (r *sgmlRenderer) prepareTemplates() error {
 		r.verbatimLine, err = r.newTemplate("verbatim-line", tmpls.VerbatimLine, err)
 		r.verseBlock, err = r.newTemplate("verse", tmpls.VerseBlock, err)
 		r.verseParagraph, err = r.newTemplate("verse-paragraph", tmpls.VerseParagraph, err)
+		r.videoBlock, err = r.newTemplate("video-block", tmpls.VideoBlock, err)
 	})
 	return err
 }
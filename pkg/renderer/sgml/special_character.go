@@ -25,3 +25,8 @@ func (r *sgmlRenderer) renderSpecialCharacter(ctx *Context, s types.SpecialChara
 	}
 	return result.String(), nil
 }
+
+func (r *sgmlRenderer) renderCharacterReference(c types.CharacterReference) (string, error) {
+	// character references are rendered as-is, regardless of the `unicode` attribute
+	return "&" + c.Name + ";", nil
+}
@@ -0,0 +1,67 @@
+package sgml
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/renderer"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func (r *sgmlRenderer) renderInlineStem(ctx *renderer.Context, s types.InlineStem) (string, error) {
+	result := &strings.Builder{}
+	err := r.inlineStem.Execute(result, struct {
+		Notation string
+		Content  string
+	}{
+		Notation: string(s.Notation(ctx.Attributes)),
+		Content:  s.Content,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render inline STEM")
+	}
+	return result.String(), nil
+}
+
+func (r *sgmlRenderer) renderStemBlock(ctx *renderer.Context, b types.StemBlock) (string, error) {
+	previousWithinDelimitedBlock := ctx.WithinDelimitedBlock
+	previousIncludeBlankLine := ctx.IncludeBlankLine
+	defer func() {
+		ctx.WithinDelimitedBlock = previousWithinDelimitedBlock
+		ctx.IncludeBlankLine = previousIncludeBlankLine
+		ctx.EncodeSpecialChars = true
+	}()
+	ctx.WithinDelimitedBlock = true
+	ctx.IncludeBlankLine = true
+	// the content is rendered in its raw form, and then escaped or converted by the template
+	ctx.EncodeSpecialChars = false
+	content, err := r.renderLines(ctx, discardEmptyLines(b.Lines))
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render STEM block content")
+	}
+	ctx.EncodeSpecialChars = true
+	roles, err := r.renderElementRoles(ctx, b.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render STEM block roles")
+	}
+	result := &strings.Builder{}
+	err = r.stemBlock.Execute(result, struct {
+		Context  *renderer.Context
+		ID       string
+		Title    string
+		Roles    string
+		Notation string
+		Content  string
+	}{
+		Context:  ctx,
+		ID:       r.renderElementID(b.Attributes),
+		Title:    r.renderElementTitle(b.Attributes),
+		Roles:    roles,
+		Notation: string(b.Notation(ctx.Attributes)),
+		Content:  content,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render STEM block")
+	}
+	return result.String(), nil
+}
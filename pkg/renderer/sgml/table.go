@@ -71,6 +71,10 @@ func (r *sgmlRenderer) renderTable(ctx *renderer.Context, t types.Table) (string
 	if err != nil {
 		return "", errors.Wrap(err, "failed to render table")
 	}
+	footer, err := r.renderTableFooter(ctx, t.Footer, t.Columns)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to render table")
+	}
 	roles, err := r.renderElementRoles(ctx, t.Attributes)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render table roles")
@@ -91,6 +95,7 @@ func (r *sgmlRenderer) renderTable(ctx *renderer.Context, t types.Table) (string
 		Roles       string
 		Header      string
 		Body        string
+		Footer      string
 	}{
 		Context:     ctx,
 		Title:       r.renderElementTitle(t.Attributes),
@@ -106,6 +111,7 @@ func (r *sgmlRenderer) renderTable(ctx *renderer.Context, t types.Table) (string
 		Width:       width,
 		Header:      header,
 		Body:        body,
+		Footer:      footer,
 	})
 	if err != nil {
 		return "", errors.Wrap(err, "failed to render table")
@@ -116,10 +122,9 @@ func (r *sgmlRenderer) renderTable(ctx *renderer.Context, t types.Table) (string
 func (r *sgmlRenderer) renderTableHeader(ctx *renderer.Context, l types.TableLine, cols []types.TableColumn) (string, error) {
 	result := &strings.Builder{}
 	content := &strings.Builder{}
-	col := 0
-	for _, cell := range l.Cells {
-		c, err := r.renderTableHeaderCell(ctx, cell, cols[col%len(cols)])
-		col++
+	offsets := l.CellColumns()
+	for i, cell := range l.Cells {
+		c, err := r.renderTableHeaderCell(ctx, cell, cols[offsets[i]%len(cols)])
 		if err != nil {
 			return "", errors.Wrap(err, "unable to render header")
 		}
@@ -128,7 +133,7 @@ func (r *sgmlRenderer) renderTableHeader(ctx *renderer.Context, l types.TableLin
 	err := r.tableHeader.Execute(result, struct {
 		Context *renderer.Context
 		Content string
-		Cells   [][]interface{}
+		Cells   []types.TableCell
 	}{
 		Context: ctx,
 		Content: content.String(),
@@ -137,24 +142,28 @@ func (r *sgmlRenderer) renderTableHeader(ctx *renderer.Context, l types.TableLin
 	return result.String(), err
 }
 
-func (r *sgmlRenderer) renderTableHeaderCell(ctx *renderer.Context, cell []interface{}, col types.TableColumn) (string, error) {
+func (r *sgmlRenderer) renderTableHeaderCell(ctx *renderer.Context, cell types.TableCell, col types.TableColumn) (string, error) {
 	result := &strings.Builder{}
-	content, err := r.renderInlineElements(ctx, cell)
+	content, err := r.renderInlineElements(ctx, cell.Elements)
 	if err != nil {
 		return "", errors.Wrap(err, "unable to render header cell")
 	}
 	err = r.tableHeaderCell.Execute(result, struct {
 		Context *renderer.Context
 		Content string
-		Cell    []interface{}
+		Cell    types.TableCell
 		VAlign  string
 		HAlign  string
+		ColSpan int
+		RowSpan int
 	}{
 		Context: ctx,
 		Content: content,
 		Cell:    cell,
-		HAlign:  col.HAlign,
-		VAlign:  col.VAlign,
+		HAlign:  alignment(cell.HAlign, col.HAlign),
+		VAlign:  alignment(cell.VAlign, col.VAlign),
+		ColSpan: span(cell.ColSpan),
+		RowSpan: span(cell.RowSpan),
 	})
 	return result.String(), err
 }
@@ -162,8 +171,9 @@ func (r *sgmlRenderer) renderTableHeaderCell(ctx *renderer.Context, cell []inter
 func (r *sgmlRenderer) renderTableBody(ctx *renderer.Context, t types.Table) (string, error) {
 	result := &strings.Builder{}
 	content := &strings.Builder{}
-	for _, row := range t.Lines {
-		c, err := r.renderTableRow(ctx, row, t.Columns)
+	columns := t.CellColumns()
+	for i, row := range t.Lines {
+		c, err := r.renderTableRow(ctx, row, t.Columns, columns[i])
 		if err != nil {
 			return "", errors.Wrap(err, "unable to render header")
 		}
@@ -183,13 +193,33 @@ func (r *sgmlRenderer) renderTableBody(ctx *renderer.Context, t types.Table) (st
 	return result.String(), err
 }
 
-func (r *sgmlRenderer) renderTableRow(ctx *renderer.Context, l types.TableLine, cols []types.TableColumn) (string, error) {
+func (r *sgmlRenderer) renderTableFooter(ctx *renderer.Context, l types.TableLine, cols []types.TableColumn) (string, error) {
+	result := &strings.Builder{}
+	content := ""
+	if len(l.Cells) > 0 {
+		var err error
+		if content, err = r.renderTableRow(ctx, l, cols, l.CellColumns()); err != nil {
+			return "", errors.Wrap(err, "unable to render footer")
+		}
+	}
+	err := r.tableFooter.Execute(result, struct {
+		Context *renderer.Context
+		Content string
+		Cells   []types.TableCell
+	}{
+		Context: ctx,
+		Content: content,
+		Cells:   l.Cells,
+	})
+	return result.String(), err
+}
+
+// renderTableRow renders the given row, in which each cell is in the column at the given offset
+func (r *sgmlRenderer) renderTableRow(ctx *renderer.Context, l types.TableLine, cols []types.TableColumn, offsets []int) (string, error) {
 	result := &strings.Builder{}
 	content := &strings.Builder{}
-	col := 0
-	for _, cell := range l.Cells {
-		c, err := r.renderTableCell(ctx, cell, cols[col%len(cols)])
-		col++
+	for i, cell := range l.Cells {
+		c, err := r.renderTableCell(ctx, cell, cols[offsets[i]%len(cols)])
 		if err != nil {
 			return "", errors.Wrap(err, "unable to render header")
 		}
@@ -198,7 +228,7 @@ func (r *sgmlRenderer) renderTableRow(ctx *renderer.Context, l types.TableLine,
 	err := r.tableRow.Execute(result, struct {
 		Context *renderer.Context
 		Content string
-		Cells   [][]interface{}
+		Cells   []types.TableCell
 	}{
 		Context: ctx,
 		Content: content.String(),
@@ -207,24 +237,55 @@ func (r *sgmlRenderer) renderTableRow(ctx *renderer.Context, l types.TableLine,
 	return result.String(), err
 }
 
-func (r *sgmlRenderer) renderTableCell(ctx *renderer.Context, cell []interface{}, col types.TableColumn) (string, error) {
+func (r *sgmlRenderer) renderTableCell(ctx *renderer.Context, cell types.TableCell, col types.TableColumn) (string, error) {
 	result := &strings.Builder{}
-	content, err := r.renderInlineElements(ctx, cell)
+	style := cell.StyleIn(col)
+	var content string
+	var err error
+	if style == "a" {
+		// AsciiDoc cells contain blocks
+		content, err = r.renderElements(ctx, cell.Elements)
+		content = strings.TrimSuffix(content, "\n")
+	} else {
+		content, err = r.renderInlineElements(ctx, cell.Elements)
+	}
 	if err != nil {
-		return "", errors.Wrap(err, "unable to render header cell")
+		return "", errors.Wrap(err, "unable to render cell")
 	}
 	err = r.tableCell.Execute(result, struct {
 		Context *renderer.Context
 		Content string
-		Cell    []interface{}
+		Cell    types.TableCell
 		HAlign  string
 		VAlign  string
+		ColSpan int
+		RowSpan int
+		Style   string
 	}{
 		Context: ctx,
 		Content: content,
 		Cell:    cell,
-		HAlign:  col.HAlign,
-		VAlign:  col.VAlign,
+		HAlign:  alignment(cell.HAlign, col.HAlign),
+		VAlign:  alignment(cell.VAlign, col.VAlign),
+		ColSpan: span(cell.ColSpan),
+		RowSpan: span(cell.RowSpan),
+		Style:   style,
 	})
 	return result.String(), err
 }
+
+// alignment returns the alignment of the cell if it was specified, or the alignment of its column otherwise
+func alignment(cell, col string) string {
+	if cell != "" {
+		return cell
+	}
+	return col
+}
+
+// span returns the given span, or `1` if it was unspecified
+func span(s int) int {
+	if s > 1 {
+		return s
+	}
+	return 1
+}
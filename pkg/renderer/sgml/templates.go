@@ -6,13 +6,18 @@ type Templates struct {
 	AdmonitionParagraph       string
 	Article                   string
 	ArticleHeader             string
+	AudioBlock                string
+	BibliographyAnchor        string
 	BlankLine                 string
 	BlockImage                string
 	BoldText                  string
 	CalloutList               string
 	CalloutListItem           string
 	CalloutRef                string
+	ChecklistMarker           string
+	CollapsibleExampleBlock   string // optional: collapsible blocks are rendered as regular example blocks if empty
 	DelimitedBlockParagraph   string
+	DiscreteHeading           string
 	DocumentDetails           string
 	DocumentAuthorDetails     string
 	ExampleBlock              string
@@ -26,8 +31,12 @@ type Templates struct {
 	IconFont                  string
 	IconImage                 string
 	IconText                  string
+	InlineButton              string
 	InlineIcon                string
 	InlineImage               string
+	InlineKeyboard            string
+	InlineMenu                string
+	InlineStem                string
 	InternalCrossReference    string
 	InvalidFootnote           string
 	ItalicText                string
@@ -43,7 +52,9 @@ type Templates struct {
 	ManpageNameParagraph      string
 	MarkdownQuoteBlock        string
 	MarkedText                string
+	MathJax                   string
 	MonospaceText             string
+	OpenBlock                 string
 	OrderedList               string
 	OrderedListItem           string
 	Paragraph                 string
@@ -59,12 +70,14 @@ type Templates struct {
 	SidebarBlock              string
 	SourceBlock               string
 	SpecialCharacter          string
+	StemBlock                 string
 	StringElement             string
 	SubscriptText             string
 	SuperscriptText           string
 	Table                     string
 	TableBody                 string
 	TableCell                 string
+	TableFooter               string
 	TableHeader               string
 	TableHeaderCell           string
 	TableRow                  string
@@ -77,4 +90,5 @@ type Templates struct {
 	VerbatimLine              string
 	VerseBlock                string
 	VerseParagraph            string
+	VideoBlock                string
 }
@@ -0,0 +1,98 @@
+package sgml
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func (r *sgmlRenderer) renderInlineKeyboard(ctx *Context, k types.InlineKeyboard) (string, error) {
+	keys, err := r.renderUIMacroElements(ctx, k.Keys)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render keyboard keys")
+	}
+	result := &strings.Builder{}
+	err = r.inlineKeyboard.Execute(result, struct {
+		Keys []string
+	}{
+		Keys: keys,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render keyboard macro")
+	}
+	return result.String(), nil
+}
+
+func (r *sgmlRenderer) renderInlineButton(ctx *Context, b types.InlineButton) (string, error) {
+	label, err := r.renderInlineElements(ctx, b.Label)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render button label")
+	}
+	result := &strings.Builder{}
+	err = r.inlineButton.Execute(result, struct {
+		Label string
+	}{
+		Label: label,
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render button macro")
+	}
+	return result.String(), nil
+}
+
+func (r *sgmlRenderer) renderInlineMenu(ctx *Context, m types.InlineMenu) (string, error) {
+	menu, err := r.renderInlineElements(ctx, m.Menu())
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render menu")
+	}
+	submenus, err := r.renderUIMacroElements(ctx, m.Submenus())
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render submenus")
+	}
+	menuItem, err := r.renderInlineElements(ctx, m.MenuItem())
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render menu item")
+	}
+	result := &strings.Builder{}
+	err = r.inlineMenu.Execute(result, struct {
+		Menu      string
+		Submenus  []string
+		MenuItem  string
+		FontIcons bool
+	}{
+		Menu:      menu,
+		Submenus:  submenus,
+		MenuItem:  menuItem,
+		FontIcons: ctx.Attributes.GetAsStringWithDefault("icons", "text") == "font",
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render menu macro")
+	}
+	return result.String(), nil
+}
+
+func (r *sgmlRenderer) renderUIMacroElements(ctx *Context, elements [][]interface{}) ([]string, error) {
+	result := make([]string, len(elements))
+	for i, e := range elements {
+		s, err := r.renderInlineElements(ctx, e)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = s
+	}
+	return result, nil
+}
+
+// renderUIMacroPlainText returns the given keys (joined with `+`) or menu path (joined with `>`) as plain text
+func (r *sgmlRenderer) renderUIMacroPlainText(ctx *Context, elements [][]interface{}, sep string) (string, error) {
+	result := make([]string, len(elements))
+	for i, e := range elements {
+		s, err := r.renderPlainText(ctx, e)
+		if err != nil {
+			return "", err
+		}
+		result[i] = s
+	}
+	return strings.Join(result, sep), nil
+}
@@ -17,6 +17,12 @@ func (r *sgmlRenderer) renderUnorderedList(ctx *renderer.Context, l types.Unorde
 			checkList = true
 		}
 	}
+	// items of nested lists are interactive only if their own list has the `interactive` option
+	interactive := ctx.InteractiveChecklist
+	ctx.InteractiveChecklist = checkList && l.Attributes.HasOption("interactive")
+	defer func() {
+		ctx.InteractiveChecklist = interactive
+	}()
 	result := &strings.Builder{}
 	content := &strings.Builder{}
 
@@ -55,8 +61,11 @@ func (r *sgmlRenderer) renderUnorderedList(ctx *renderer.Context, l types.Unorde
 	}
 	return result.String(), nil
 }
-func (r *sgmlRenderer) renderUnorderedListItem(ctx *renderer.Context, w io.Writer, item types.UnorderedListItem) error {
 
+func (r *sgmlRenderer) renderUnorderedListItem(ctx *renderer.Context, w io.Writer, item types.UnorderedListItem) error {
+	if item.CheckStyle != types.NoCheck {
+		ctx.IncrementChecklistItemCounter(item.CheckStyle == types.Checked)
+	}
 	content, err := r.renderListElements(ctx, item.Elements)
 	if err != nil {
 		return errors.Wrap(err, "unable to render unordered list item content")
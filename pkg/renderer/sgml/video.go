@@ -0,0 +1,139 @@
+package sgml
+
+import (
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
+)
+
+func (r *sgmlRenderer) renderVideoBlock(ctx *Context, v types.VideoBlock) (string, error) {
+	result := &strings.Builder{}
+	roles, err := r.renderImageRoles(ctx, v.Attributes)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render video roles")
+	}
+	var src, poster string
+	switch v.Provider() {
+	case types.YouTubeVideo:
+		src = youTubeVideoSource(ctx, v)
+	case types.VimeoVideo:
+		src = vimeoVideoSource(ctx, v)
+	default:
+		src = v.Location.Stringify() + mediaTimeAnchor(v.Attributes)
+		poster = v.Attributes.GetAsStringWithDefault(types.AttrPoster, "")
+	}
+	err = r.videoBlock.Execute(result, struct {
+		ID         string
+		Title      string
+		Roles      string
+		Src        string
+		Poster     string
+		Width      string
+		Height     string
+		Embedded   bool
+		Autoplay   bool
+		Loop       bool
+		Muted      bool
+		Controls   bool
+		Fullscreen bool
+	}{
+		ID:         r.renderElementID(v.Attributes),
+		Title:      r.renderElementTitle(v.Attributes),
+		Roles:      roles,
+		Src:        src,
+		Poster:     poster,
+		Width:      v.Attributes.GetAsStringWithDefault(types.AttrWidth, ""),
+		Height:     v.Attributes.GetAsStringWithDefault(types.AttrImageHeight, ""),
+		Embedded:   v.Provider() != "",
+		Autoplay:   v.Attributes.HasOption("autoplay"),
+		Loop:       v.Attributes.HasOption("loop"),
+		Muted:      v.Attributes.HasOption("muted"),
+		Controls:   !v.Attributes.HasOption("nocontrols"),
+		Fullscreen: !v.Attributes.HasOption("nofullscreen"),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "unable to render video block")
+	}
+	return result.String(), nil
+}
+
+// youTubeVideoSource returns the URL of the player of the YouTube video, with its parameters
+// (see https://developers.google.com/youtube/player_parameters#Parameters)
+func youTubeVideoSource(ctx *Context, v types.VideoBlock) string {
+	id := v.Location.Stringify()
+	src := &strings.Builder{}
+	src.WriteString(assetURIScheme(ctx) + "//www.youtube.com/embed/" + id + "?rel=0")
+	if start, found := v.Attributes.GetAsString(types.AttrStart); found {
+		src.WriteString("&start=" + start)
+	}
+	if end, found := v.Attributes.GetAsString(types.AttrEnd); found {
+		src.WriteString("&end=" + end)
+	}
+	if v.Attributes.HasOption("autoplay") {
+		src.WriteString("&autoplay=1")
+	}
+	if v.Attributes.HasOption("loop") {
+		// looping only works when the video is also its own playlist
+		src.WriteString("&loop=1&playlist=" + id)
+	}
+	if v.Attributes.HasOption("muted") {
+		src.WriteString("&mute=1")
+	}
+	if v.Attributes.HasOption("nocontrols") {
+		src.WriteString("&controls=0")
+	}
+	if v.Attributes.HasOption("nofullscreen") {
+		src.WriteString("&fs=0")
+	}
+	return src.String()
+}
+
+// vimeoVideoSource returns the URL of the player of the Vimeo video, with its parameters
+func vimeoVideoSource(ctx *Context, v types.VideoBlock) string {
+	src := &strings.Builder{}
+	src.WriteString(assetURIScheme(ctx) + "//player.vimeo.com/video/" + v.Location.Stringify())
+	params := []string{}
+	if v.Attributes.HasOption("autoplay") {
+		params = append(params, "autoplay=1")
+	}
+	if v.Attributes.HasOption("loop") {
+		params = append(params, "loop=1")
+	}
+	if v.Attributes.HasOption("muted") {
+		params = append(params, "muted=1")
+	}
+	if len(params) > 0 {
+		src.WriteString("?" + strings.Join(params, "&"))
+	}
+	if start, found := v.Attributes.GetAsString(types.AttrStart); found {
+		src.WriteString("#at=" + start)
+	}
+	return src.String()
+}
+
+// assetURIScheme returns the scheme to use in the URL of the remote assets (`https:` unless
+// specified otherwise with the `asset-uri-scheme` document attribute)
+func assetURIScheme(ctx *Context) string {
+	if scheme := ctx.Attributes.GetAsStringWithDefault("asset-uri-scheme", "https"); scheme != "" {
+		return scheme + ":"
+	}
+	return ""
+}
+
+// mediaTimeAnchor returns the "media fragment" to play a video or an audio file
+// between the `start` and `end` times (eg: `#t=10,20`)
+func mediaTimeAnchor(attrs types.Attributes) string {
+	start, hasStart := attrs.GetAsString(types.AttrStart)
+	end, hasEnd := attrs.GetAsString(types.AttrEnd)
+	switch {
+	case hasStart && hasEnd:
+		return "#t=" + start + "," + end
+	case hasStart:
+		return "#t=" + start
+	case hasEnd:
+		return "#t=," + end
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,14 @@
+package xhtml5
+
+const (
+	audioBlockTmpl = `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="audioblock{{ if .Roles }} {{ .Roles }}{{ end }}">
+{{ if .Title }}<div class="title">{{ .Title }}</div>
+{{ end }}<div class="content">
+<audio src="{{ escape .Src }}"{{ if .Autoplay }} autoplay="autoplay"{{ end }}{{ if .Muted }} muted="muted"{{ end }}` +
+		`{{ if .Controls }} controls="controls"{{ end }}{{ if .Loop }} loop="loop"{{ end }}>
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>
+`
+)
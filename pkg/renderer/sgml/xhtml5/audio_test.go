@@ -0,0 +1,24 @@
+package xhtml5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("audio blocks", func() {
+
+	It("with options", func() {
+		source := `audio::sounds/foo.ogg[options="autoplay,loop"]`
+		expected := `<div class="audioblock">
+<div class="content">
+<audio src="sounds/foo.ogg" autoplay="autoplay" controls="controls" loop="loop">
+Your browser does not support the audio tag.
+</audio>
+</div>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+})
@@ -0,0 +1,64 @@
+package xhtml5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("bibliography", func() {
+
+	It("section with references", func() {
+		source := `see <<pp>> and <<gof>>.
+
+[bibliography]
+== References
+
+* [[[pp]]] Andy Hunt & Dave Thomas. The Pragmatic Programmer.
+* [[[gof,gang]]] Erich Gamma et al. Design Patterns.`
+		expected := `<div class="paragraph">
+<p>see <a href="#pp">[pp]</a> and <a href="#gof">[gang]</a>.</p>
+</div>
+<div class="sect1">
+<h2 id="_references">References</h2>
+<div class="sectionbody">
+<div class="ulist bibliography">
+<ul class="bibliography">
+<li>
+<p><a id="pp"></a>[pp] Andy Hunt &amp; Dave Thomas. The Pragmatic Programmer.</p>
+</li>
+<li>
+<p><a id="gof"></a>[gang] Erich Gamma et al. Design Patterns.</p>
+</li>
+</ul>
+</div>
+</div>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("list with bibliography style", func() {
+		source := `[bibliography]
+* [[[pp]]] The Pragmatic Programmer
+* [[[gof,gang]]] Design Patterns
+
+see <<gof>>.`
+		expected := `<div class="ulist bibliography">
+<ul class="bibliography">
+<li>
+<p><a id="pp"></a>[pp] The Pragmatic Programmer</p>
+</li>
+<li>
+<p><a id="gof"></a>[gang] Design Patterns</p>
+</li>
+</ul>
+</div>
+<div class="paragraph">
+<p>see <a href="#gof">[gang]</a>.</p>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+})
@@ -0,0 +1,13 @@
+package xhtml5
+
+const (
+	calloutListItemTmpl = "{{ if .Icons }}<tr>\n<td>" +
+		`{{ if eq .Icons "font" }}<i class="conum" data-value="{{ .Ref }}"></i><b>{{ .Ref }}</b>` +
+		`{{ else }}<img src="{{ .Path }}" alt="{{ .Ref }}"/>{{ end }}` +
+		"</td>\n<td>{{ .Content }}</td>\n</tr>\n" +
+		"{{ else }}<li>\n{{ .Content }}</li>\n{{ end }}"
+
+	calloutRefTmpl = `{{ if eq .Icons "font" }}<i class="conum" data-value="{{ .Ref }}"></i><b>({{ .Ref }})</b>` +
+		`{{ else if eq .Icons "image" }}<img src="{{ .Path }}" alt="{{ .Ref }}"/>` +
+		`{{ else }}<b class="conum">({{ .Ref }})</b>{{ end }}`
+)
@@ -251,6 +251,31 @@ an admonition text on 1 line.
 </tr>
 </table>
 </div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("admonition block with caption and custom icon", func() {
+			source := `:icons: font
+
+[TIP,caption=Hint,icon=bulb]
+====
+an admonition text.
+====`
+			expected := `<div class="admonitionblock tip">
+<table>
+<tr>
+<td class="icon">
+<img src="images/icons/bulb.png" alt="Hint"/>
+</td>
+<td class="content">
+<div class="paragraph">
+<p>an admonition text.</p>
+</div>
+</td>
+</tr>
+</table>
+</div>
 `
 			Expect(RenderXHTML(source)).To(MatchHTML(expected))
 		})
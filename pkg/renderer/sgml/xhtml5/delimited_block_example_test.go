@@ -159,6 +159,93 @@ foo
 		})
 	})
 
+	Context("collapsible blocks", func() {
+
+		It("collapsible example block with title", func() {
+			source := `.Show the logs
+[%collapsible]
+====
+some *logs*
+====`
+			expected := `<details>
+<summary class="title">Show the logs</summary>
+<div class="content">
+<div class="paragraph">
+<p>some <strong>logs</strong></p>
+</div>
+</div>
+</details>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("collapsible example block without title", func() {
+			source := `[%collapsible]
+====
+some logs
+====`
+			expected := `<details>
+<summary class="title">Details</summary>
+<div class="content">
+<div class="paragraph">
+<p>some logs</p>
+</div>
+</div>
+</details>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("open collapsible example block with ID and role", func() {
+			source := `[#logs.verbose%collapsible%open]
+.Show the logs
+====
+some logs
+====`
+			expected := `<details id="logs" class="verbose" open="open">
+<summary class="title">Show the logs</summary>
+<div class="content">
+<div class="paragraph">
+<p>some logs</p>
+</div>
+</div>
+</details>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("collapsible example block does not increment the example number", func() {
+			source := `.Show the logs
+[%collapsible]
+====
+some logs
+====
+
+.Regular example
+====
+foo
+====`
+			expected := `<details>
+<summary class="title">Show the logs</summary>
+<div class="content">
+<div class="paragraph">
+<p>some logs</p>
+</div>
+</div>
+</details>
+<div class="exampleblock">
+<div class="title">Example 1. Regular example</div>
+<div class="content">
+<div class="paragraph">
+<p>foo</p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
 	Context("with custom substitutions", func() {
 
 		// testing custom substitutions on example blocks only, as
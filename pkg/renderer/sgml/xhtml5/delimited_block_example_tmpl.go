@@ -0,0 +1,10 @@
+package xhtml5
+
+const (
+	collapsibleExampleBlockTmpl = "<details{{ if .ID }} id=\"{{ .ID }}\"{{ end }}{{ if .Roles }} class=\"{{ .Roles }}\"{{ end }}{{ if .Open }} open=\"open\"{{ end }}>\n" +
+		"<summary class=\"title\">{{ if .Title }}{{ .Title }}{{ else }}Details{{ end }}</summary>\n" +
+		"<div class=\"content\">\n" +
+		"{{ .Content }}" +
+		"</div>\n" +
+		"</details>\n"
+)
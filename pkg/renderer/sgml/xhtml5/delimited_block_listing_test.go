@@ -165,6 +165,55 @@ func foo() {} <b class="conum">(4)</b></pre>
 			Expect(RenderXHTML(source)).To(MatchHTML(expected))
 		})
 
+		It("with font callouts", func() {
+			source := `:icons: font
+
+----
+import java.util.List; // <1>
+----
+<1> an import`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre>import java.util.List; <i class="conum" data-value="1"></i><b>(1)</b></pre>
+</div>
+</div>
+<div class="colist arabic">
+<table>
+<tr>
+<td><i class="conum" data-value="1"></i><b>1</b></td>
+<td>an import</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with image callouts", func() {
+			source := `:icons: image
+:iconsdir: icons
+
+----
+import java.util.List; # <1>
+----
+<1> an import`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre>import java.util.List; <img src="icons/callouts/1.png" alt="1"/></pre>
+</div>
+</div>
+<div class="colist arabic">
+<table>
+<tr>
+<td><img src="icons/callouts/1.png" alt="1"/></td>
+<td>an import</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
 		It("with invalid callout", func() {
 			source := `----
 import <a>
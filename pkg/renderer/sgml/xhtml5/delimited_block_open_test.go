@@ -0,0 +1,236 @@
+package xhtml5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("open blocks", func() {
+
+	Context("delimited blocks", func() {
+
+		It("open block with paragraph and list", func() {
+			source := `--
+some *bold* content
+
+* an item
+--`
+			expected := `<div class="openblock">
+<div class="content">
+<div class="paragraph">
+<p>some <strong>bold</strong> content</p>
+</div>
+<div class="ulist">
+<ul>
+<li>
+<p>an item</p>
+</li>
+</ul>
+</div>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("open block with id, title and role", func() {
+			source := `[#an-id.a-role]
+.a title
+--
+content
+--`
+			expected := `<div id="an-id" class="openblock a-role">
+<div class="title">a title</div>
+<div class="content">
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("open block attached to a list item", func() {
+			source := `* an item
++
+--
+first paragraph
+
+second paragraph
+--`
+			expected := `<div class="ulist">
+<ul>
+<li>
+<p>an item</p>
+<div class="openblock">
+<div class="content">
+<div class="paragraph">
+<p>first paragraph</p>
+</div>
+<div class="paragraph">
+<p>second paragraph</p>
+</div>
+</div>
+</div>
+</li>
+</ul>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("abstract", func() {
+			source := `[abstract]
+.Abstract
+--
+the abstract
+--`
+			expected := `<div class="quoteblock abstract">
+<div class="title">Abstract</div>
+<blockquote>
+<div class="paragraph">
+<p>the abstract</p>
+</div>
+</blockquote>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("partintro", func() {
+			source := `[partintro]
+--
+the intro
+--`
+			expected := `<div class="openblock partintro">
+<div class="content">
+<div class="paragraph">
+<p>the intro</p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("masquerading", func() {
+
+		It("open block as source block", func() {
+			source := `[source,go]
+--
+package foo
+--`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code class="language-go" data-lang="go">package foo</code></pre>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("open block as sidebar block", func() {
+			source := `[sidebar]
+--
+content
+--`
+			expected := `<div class="sidebarblock">
+<div class="content">
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("open block as verse block", func() {
+			source := `[verse, john doe]
+--
+some *verse*
+  content
+--`
+			expected := `<div class="verseblock">
+<pre class="content">some <strong>verse</strong>
+  content</pre>
+<div class="attribution">
+&#8212; john doe
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("open block as admonition block", func() {
+			source := `[TIP]
+--
+content
+--`
+			expected := `<div class="admonitionblock tip">
+<table>
+<tr>
+<td class="icon">
+<div class="title">Tip</div>
+</td>
+<td class="content">
+<div class="paragraph">
+<p>content</p>
+</div>
+</td>
+</tr>
+</table>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("example block as sidebar block", func() {
+			source := `[sidebar]
+====
+content
+====`
+			expected := `<div class="sidebarblock">
+<div class="content">
+<div class="paragraph">
+<p>content</p>
+</div>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("listing block as literal block", func() {
+			source := `[literal]
+----
+some *content*
+----`
+			expected := `<div class="literalblock">
+<div class="content">
+<pre>some *content*</pre>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("literal block as source block", func() {
+			source := `[source]
+....
+some *content*
+....`
+			expected := `<div class="listingblock">
+<div class="content">
+<pre class="highlight"><code>some *content*</code></pre>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+})
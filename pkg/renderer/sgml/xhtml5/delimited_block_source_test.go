@@ -153,7 +153,7 @@ printf("Hello world!\n"); // <1>
 <div class="content">
 <pre class="chroma highlight"><code data-lang="c"><span class="tok-cp">#include</span> <span class="tok-cpf">&lt;stdio.h&gt;</span>
 
-<span class="tok-n">printf</span><span class="tok-p">(</span><span class="tok-s">&#34;Hello world!</span><span class="tok-se">\n</span><span class="tok-s">&#34;</span><span class="tok-p">);</span> <span class="tok-o">//</span> <b class="conum">(1)</b>
+<span class="tok-n">printf</span><span class="tok-p">(</span><span class="tok-s">&#34;Hello world!</span><span class="tok-se">\n</span><span class="tok-s">&#34;</span><span class="tok-p">);</span> <b class="conum">(1)</b>
 <span class="tok-o">&lt;</span><span class="tok-n">a</span><span class="tok-o">&gt;</span><span class="tok-n">link</span><span class="tok-o">&lt;/</span><span class="tok-n">a</span><span class="tok-o">&gt;</span></code></pre>
 </div>
 </div>
@@ -211,7 +211,7 @@ public class GreetingResourceTest {
 public class GreetingResourceTest {
 
     @InjectMock
-    @RestClient // <b class="conum">(1)</b>
+    @RestClient <b class="conum">(1)</b>
     GreetingService greetingService;
 
     @Test
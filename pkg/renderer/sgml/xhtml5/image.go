@@ -5,20 +5,32 @@ const (
 		"{{ if .ID }} id=\"{{ .ID }}\"{{ end }}" +
 		" class=\"imageblock{{ if .Roles }} {{ .Roles }}{{ end }}\">\n" +
 		"<div class=\"content\">\n" +
-		`{{ if .Href }}<a class="image" href="{{ .Href }}">{{ end }}` +
+		`{{ if .Href }}<a class="image" href="{{ .Href }}"{{ if .Window }} target="{{ .Window }}"{{ end }}{{ if .Rel }} rel="{{ .Rel }}"{{ end }}>{{ end }}` +
+		svgImageTmpl +
 		`<img src="{{ .Path }}" alt="{{ .Alt }}"` +
 		`{{ if .Width }} width="{{ .Width }}"{{ end }}` +
 		`{{ if .Height }} height="{{ .Height }}"{{ end }}` +
-		"/>{{ if .Href }}</a>{{ end }}\n" +
+		`{{ if .ScaledWidth }} style="width: {{ .ScaledWidth }}"{{ end }}` +
+		"/>{{ end }}{{ if .Href }}</a>{{ end }}\n" +
 		"</div>\n" +
 		"{{ if .Title }}<div class=\"title\">{{ .Caption }}{{ .Title }}</div>\n{{ end }}" +
 		"</div>\n"
 
 	inlineImageTmpl = `<span class="image{{ if .Roles }} {{ .Roles }}{{ end }}">` +
-		`{{ if .Href }}<a class="image" href="{{ .Href }}">{{ end }}` +
+		`{{ if .Href }}<a class="image" href="{{ .Href }}"{{ if .Window }} target="{{ .Window }}"{{ end }}{{ if .Rel }} rel="{{ .Rel }}"{{ end }}>{{ end }}` +
+		svgImageTmpl +
 		`<img src="{{ .Path }}" alt="{{ .Alt }}"` +
 		`{{ if .Width }} width="{{ .Width }}"{{ end }}` +
 		`{{ if .Height }} height="{{ .Height }}"{{ end }}` +
+		`{{ if .ScaledWidth }} style="width: {{ .ScaledWidth }}"{{ end }}` +
 		`{{ if .Title }} title="{{ .Title }}"{{ end }}` +
-		`/>{{ if .Href }}</a>{{ end }}</span>`
+		`/>{{ end }}{{ if .Href }}</a>{{ end }}</span>`
+
+	// embedded or interactive SVG image, otherwise falls back to the `<img/>` element which follows (and ends with `{{ end }}`)
+	svgImageTmpl = `{{ if .SVG.Inline }}{{ if .SVG.Content }}{{ .SVG.Content }}{{ else }}<span class="alt">{{ .Alt }}</span>{{ end }}` +
+		`{{ else if .SVG.Interactive }}<object type="image/svg+xml" data="{{ .Path }}"` +
+		`{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}>` +
+		`<img src="{{ .SVG.Fallback }}" alt="{{ .Alt }}"` +
+		`{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}/></object>` +
+		`{{ else }}`
 )
@@ -1,6 +1,7 @@
 package xhtml5_test
 
 import (
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
 	. "github.com/bytesparadise/libasciidoc/testsupport"
 
 	. "github.com/onsi/ginkgo" //nolint golint
@@ -308,4 +309,70 @@ image::file:///bar/foo.png[]`
 			Expect(RenderXHTML(source)).To(MatchHTML(expected))
 		})
 	})
+
+	Context("links and sizes", func() {
+
+		It("block image with link in blank window and scaledwidth", func() {
+			source := "image::foo.png[foo image,link=self,window=^,scaledwidth=50%]"
+			expected := `<div class="imageblock">
+<div class="content">
+<a class="image" href="foo.png" target="_blank" rel="noopener"><img src="foo.png" alt="foo image" style="width: 50%"/></a>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("inline image with link and nofollow option", func() {
+			source := "image:foo.png[foo,link=https://example.com,opts=nofollow,pdfwidth=2in]"
+			expected := `<div class="paragraph">
+<p><span class="image"><a class="image" href="https://example.com" rel="nofollow"><img src="foo.png" alt="foo" style="width: 2in"/></a></span></p>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("svg images", func() {
+
+		It("block image with inline option", func() {
+			source := `image::../../../../test/images/circle.svg[Circle,opts=inline]`
+			expected := `<div class="imageblock">
+<div class="content">
+<svg xmlns="http://www.w3.org/2000/svg" width="100" height="100" style="border: 1px">
+<circle cx="50" cy="50" r="40" fill="red"/>
+</svg>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+
+		It("block image with interactive option", func() {
+			source := `image::circle.svg[Circle,200,opts=interactive]`
+			expected := `<div class="imageblock">
+<div class="content">
+<object type="image/svg+xml" data="circle.svg" width="200"><img src="circle.svg" alt="Circle" width="200"/></object>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("data-uri", func() {
+
+		It("block image embedded as a data URI", func() {
+			source := `:data-uri:
+
+image::../../../../test/images/dot.png[Dot]`
+			expected := `<div class="imageblock">
+<div class="content">
+<img src="data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAIAAACQd1PeAAAADElEQVR4nGP4z8AAAAMBAQDJ/pLvAAAAAElFTkSuQmCC" alt="Dot"/>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source, configuration.WithFilename("test.adoc"))).To(MatchHTML(expected))
+		})
+	})
 })
@@ -221,4 +221,34 @@ a link to {scheme}:{path}[] and https://foo.baz`
 			})
 		})
 	})
+
+	Context("bare links and email links", func() {
+
+		It("external links followed by punctuation and within angle brackets", func() {
+			source := `see https://foo.bar, or <https://foo.bar?a=b&c=d>.`
+			expected := `<div class="paragraph">
+<p>see <a href="https://foo.bar" class="bare">https://foo.bar</a>, or <a href="https://foo.bar?a=b&amp;c=d" class="bare">https://foo.bar?a=b&amp;c=d</a>.</p>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("external link with caret window shorthand", func() {
+			source := `https://foo.bar[foo^]`
+			expected := `<div class="paragraph">
+<p><a href="https://foo.bar" target="_blank" rel="noopener">foo</a></p>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("email links", func() {
+			source := `foo@example.com or mailto:foo@example.com[Subscribe,Subscribe me]`
+			expected := `<div class="paragraph">
+<p><a href="mailto:foo@example.com">foo@example.com</a> or <a href="mailto:foo@example.com?subject=Subscribe%20me">Subscribe</a></p>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
 })
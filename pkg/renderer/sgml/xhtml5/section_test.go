@@ -276,6 +276,65 @@ Listing block content is commonly used to preserve code input.</pre>
 		})
 	})
 
+	Context("discrete headings", func() {
+
+		It("discrete heading within section", func() {
+			source := `== section 1
+
+[discrete]
+=== a discrete *heading*
+
+content
+
+[float#custom.role]
+== a floating heading`
+			expected := `<div class="sect1">
+<h2 id="_section_1">section 1</h2>
+<div class="sectionbody">
+<h3 id="_a_discrete_heading" class="discrete">a discrete <strong>heading</strong></h3>
+<div class="paragraph">
+<p>content</p>
+</div>
+<h2 id="custom" class="discrete role">a floating heading</h2>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("discrete heading not in table of contents", func() {
+			source := `= A title
+:toc:
+
+== section 1
+
+[discrete]
+=== a discrete heading
+
+== section 2`
+			expected := `<div id="toc" class="toc">
+<div id="toctitle">Table of Contents</div>
+<ul class="sectlevel1">
+<li><a href="#_section_1">section 1</a></li>
+<li><a href="#_section_2">section 2</a></li>
+</ul>
+</div>
+<div class="sect1">
+<h2 id="_section_1">section 1</h2>
+<div class="sectionbody">
+<h3 id="_a_discrete_heading" class="discrete">a discrete heading</h3>
+</div>
+</div>
+<div class="sect1">
+<h2 id="_section_2">section 2</h2>
+<div class="sectionbody">
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
 	Context("preambles", func() {
 
 		It("should include preamble wrapper", func() {
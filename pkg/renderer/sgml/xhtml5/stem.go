@@ -0,0 +1,13 @@
+package xhtml5
+
+// AsciiMath expressions are converted into MathML, so they can be displayed without JavaScript
+const (
+	inlineStemTmpl = `{{ if eq .Notation "latexmath" }}\({{ escape .Content }}\){{ else }}{{ asciimath .Content false }}{{ end }}`
+
+	stemBlockTmpl = "<div {{ if .ID }}id=\"{{ .ID }}\" {{ end }}class=\"stemblock{{ if .Roles }} {{ .Roles }}{{ end }}\">\n" +
+		"{{ if .Title }}<div class=\"title\">{{ .Title }}</div>\n{{ end }}" +
+		"<div class=\"content\">\n" +
+		`{{ if eq .Notation "latexmath" }}\[{{ escape .Content }}\]{{ else }}{{ asciimath .Content true }}{{ end }}` + "\n" +
+		"</div>\n" +
+		"</div>\n"
+)
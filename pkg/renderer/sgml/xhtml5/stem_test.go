@@ -0,0 +1,72 @@
+package xhtml5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("stem", func() {
+
+	Context("inline macros", func() {
+
+		It("with default notation", func() {
+			source := `:stem:
+
+see stem:[sqrt(4) = 2] and asciimath:[x < y]`
+			expected := `<div class="paragraph">
+<p>see <math xmlns="http://www.w3.org/1998/Math/MathML"><msqrt><mrow><mn>4</mn></mrow></msqrt><mo>=</mo><mn>2</mn></math> and <math xmlns="http://www.w3.org/1998/Math/MathML"><mi>x</mi><mo>&lt;</mo><mi>y</mi></math></p>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with latexmath notation", func() {
+			source := `:stem: latexmath
+
+see stem:[\sqrt{4} = 2] and latexmath:[C = \alpha + \beta Y^{\gamma}]`
+			expected := `<div class="paragraph">
+<p>see \(\sqrt{4} = 2\) and \(C = \alpha + \beta Y^{\gamma}\)</p>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+
+	Context("delimited blocks", func() {
+
+		It("with default notation", func() {
+			source := `:stem:
+
+[stem]
+++++
+sqrt(4) < 3
+++++`
+			expected := `<div class="stemblock">
+<div class="content">
+<math xmlns="http://www.w3.org/1998/Math/MathML" display="block"><msqrt><mrow><mn>4</mn></mrow></msqrt><mo>&lt;</mo><mn>3</mn></math>
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+
+		It("with latexmath notation, ID and title", func() {
+			source := `[[equation]]
+.an equation
+[latexmath]
+++++
+\frac{a}{b}
+++++`
+			expected := `<div id="equation" class="stemblock">
+<div class="title">an equation</div>
+<div class="content">
+\[\frac{a}{b}\]
+</div>
+</div>
+`
+			Expect(RenderXHTML(source)).To(MatchHTML(expected))
+		})
+	})
+})
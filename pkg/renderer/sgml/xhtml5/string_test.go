@@ -53,6 +53,24 @@ var _ = Describe("strings", func() {
 <div class="sectionbody">
 </div>
 </div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with em-dashes and arrows", func() {
+		source := `foo--bar -- a -> b <= c`
+		expected := `<div class="paragraph">
+<p>foo&#8212;&#8203;bar&#8201;&#8212;&#8201;a &#8594; b &#8656; c</p>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("text with character references", func() {
+		source := `&#169; &#x2022; and \&amp;`
+		expected := `<div class="paragraph">
+<p>&#169; &#x2022; and &amp;amp;</p>
+</div>
 `
 		Expect(RenderXHTML(source)).To(MatchHTML(expected))
 	})
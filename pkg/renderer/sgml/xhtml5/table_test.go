@@ -532,5 +532,116 @@ var _ = Describe("tables", func() {
 		Expect(RenderXHTML(source)).To(MatchHTML(expected))
 	})
 
-	// TODO: Verify styles -- it's verified in the parser for now, but we still need to implement styles.
+	It("cells with styles", func() {
+		source := `[cols="2*"]
+|===
+e|emphasis s|strong
+m|monospace h|header
+l|literal <b>
+  content a|* item
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;"/>
+<col style="width: 50%;"/>
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock"><em>emphasis</em></p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock"><strong>strong</strong></p></td>
+</tr>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock"><code>monospace</code></p></td>
+<th class="tableblock halign-left valign-top"><p class="tableblock">header</p></th>
+</tr>
+<tr>
+<td class="tableblock halign-left valign-top"><div class="literal"><pre>literal &lt;b&gt;
+  content</pre></div></td>
+<td class="tableblock halign-left valign-top"><div class="content"><div class="ulist">
+<ul>
+<li>
+<p>item</p>
+</li>
+</ul>
+</div></div></td>
+</tr>
+</tbody>
+</table>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("asciidoc cell with a nested table", func() {
+		source := `[cols="1,a"]
+|===
+|nested
+|before
+
+!===
+!x !y
+!===
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;"/>
+<col style="width: 50%;"/>
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">nested</p></td>
+<td class="tableblock halign-left valign-top"><div class="content"><div class="paragraph">
+<p>before</p>
+</div>
+<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;"/>
+<col style="width: 50%;"/>
+</colgroup>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">x</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">y</p></td>
+</tr>
+</tbody>
+</table></div></td>
+</tr>
+</tbody>
+</table>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+	It("table with header and footer options", func() {
+		source := `[%header%footer]
+|===
+|h1 |h2
+|a |b
+|f1 |f2
+|===`
+		expected := `<table class="tableblock frame-all grid-all stretch">
+<colgroup>
+<col style="width: 50%;"/>
+<col style="width: 50%;"/>
+</colgroup>
+<thead>
+<tr>
+<th class="tableblock halign-left valign-top">h1</th>
+<th class="tableblock halign-left valign-top">h2</th>
+</tr>
+</thead>
+<tbody>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">a</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">b</p></td>
+</tr>
+</tbody>
+<tfoot>
+<tr>
+<td class="tableblock halign-left valign-top"><p class="tableblock">f1</p></td>
+<td class="tableblock halign-left valign-top"><p class="tableblock">f2</p></td>
+</tr>
+</tfoot>
+</table>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
 })
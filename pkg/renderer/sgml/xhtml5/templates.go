@@ -18,21 +18,29 @@ func init() {
 
 	// XHTML5 overrides of HTML5.
 	templates.Article = articleTmpl
+	templates.AudioBlock = audioBlockTmpl
 	templates.BlankLine = blankLineTmpl
 	templates.BlockImage = blockImageTmpl
+	templates.CalloutListItem = calloutListItemTmpl
+	templates.CalloutRef = calloutRefTmpl
+	templates.ChecklistMarker = checklistMarkerTmpl
+	templates.CollapsibleExampleBlock = collapsibleExampleBlockTmpl
 	templates.LineBreak = lineBreakTmpl
 	templates.DocumentAuthorDetails = documentAuthorDetailsTmpl
 	templates.DocumentDetails = documentDetailsTmpl
 	templates.Footnotes = footnotesTmpl
 	templates.IconImage = iconImageTmpl
 	templates.InlineImage = inlineImageTmpl
+	templates.InlineStem = inlineStemTmpl
 	templates.LabeledListHorizontalItem = labeledListHorizontalItemTmpl
+	templates.StemBlock = stemBlockTmpl
 	templates.Table = tableTmpl
 	templates.ThematicBreak = thematicBreakTmpl
 	templates.QuoteBlock = quoteBlockTmpl
 	templates.QuoteParagraph = quoteParagraphTmpl
 	templates.VerseBlock = verseBlockTmpl
 	templates.VerseParagraph = verseParagraphTmpl
+	templates.VideoBlock = videoBlockTmpl
 
 	// NB: This is fast, and doesn't including parsing.
 	defaultRenderer = sgml.NewRenderer(templates)
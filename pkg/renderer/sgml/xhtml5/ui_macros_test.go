@@ -0,0 +1,34 @@
+package xhtml5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("ui macros", func() {
+
+	It("keyboard, button and menu macros", func() {
+		source := `:experimental:
+
+press kbd:[Ctrl+S] or btn:[Save], then menu:File[Close]`
+		expected := `<div class="paragraph">
+<p>press <span class="keyseq"><kbd>Ctrl</kbd>+<kbd>S</kbd></span> or <b class="button">Save</b>, then <span class="menuseq"><b class="menu">File</b>&#160;<b class="caret">&#8250;</b> <b class="menuitem">Close</b></span></p>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("menu with font icons", func() {
+		source := `:experimental:
+:icons: font
+
+menu:View[Zoom > Reset]`
+		expected := `<div class="paragraph">
+<p><span class="menuseq"><b class="menu">View</b>&#160;<i class="fa fa-angle-right caret"></i> <b class="submenu">Zoom</b>&#160;<i class="fa fa-angle-right caret"></i> <b class="menuitem">Reset</b></span></p>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+})
@@ -0,0 +1,7 @@
+package xhtml5
+
+const (
+	checklistMarkerTmpl = `{{ if .Interactive }}<input type="checkbox" data-item-complete="{{ if .Checked }}1{{ else }}0{{ end }}"{{ if .Checked }} checked="checked"{{ end }}/>` +
+		`{{ else if eq .Icons "font" }}<i class="fa fa-{{ if .Checked }}check-square-o{{ else }}square-o{{ end }}"></i>` +
+		`{{ else if .Checked }}&#10003;{{ else }}&#10063;{{ end }} `
+)
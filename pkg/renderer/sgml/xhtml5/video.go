@@ -0,0 +1,17 @@
+package xhtml5
+
+const (
+	videoBlockTmpl = `<div{{ if .ID }} id="{{ .ID }}"{{ end }} class="videoblock{{ if .Roles }} {{ .Roles }}{{ end }}">
+{{ if .Title }}<div class="title">{{ .Title }}</div>
+{{ end }}<div class="content">
+{{ if .Embedded }}<iframe{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}` +
+		` src="{{ escape .Src }}" frameborder="0"{{ if .Fullscreen }} allowfullscreen="allowfullscreen"{{ end }}></iframe>
+{{ else }}<video src="{{ escape .Src }}"{{ if .Width }} width="{{ .Width }}"{{ end }}{{ if .Height }} height="{{ .Height }}"{{ end }}` +
+		`{{ if .Poster }} poster="{{ escape .Poster }}"{{ end }}{{ if .Autoplay }} autoplay="autoplay"{{ end }}{{ if .Muted }} muted="muted"{{ end }}` +
+		`{{ if .Controls }} controls="controls"{{ end }}{{ if .Loop }} loop="loop"{{ end }}>
+Your browser does not support the video tag.
+</video>
+{{ end }}</div>
+</div>
+`
+)
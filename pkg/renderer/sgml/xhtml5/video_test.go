@@ -0,0 +1,35 @@
+package xhtml5_test
+
+import (
+	. "github.com/bytesparadise/libasciidoc/testsupport"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("video blocks", func() {
+
+	It("with poster and options", func() {
+		source := `video::videos/foo.mp4[foo.png, options="autoplay,loop,muted"]`
+		expected := `<div class="videoblock">
+<div class="content">
+<video src="videos/foo.mp4" poster="foo.png" autoplay="autoplay" muted="muted" controls="controls" loop="loop">
+Your browser does not support the video tag.
+</video>
+</div>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+
+	It("hosted on youtube", func() {
+		source := `video::rPQoq7ThGAU[youtube]`
+		expected := `<div class="videoblock">
+<div class="content">
+<iframe src="https://www.youtube.com/embed/rPQoq7ThGAU?rel=0" frameborder="0" allowfullscreen="allowfullscreen"></iframe>
+</div>
+</div>
+`
+		Expect(RenderXHTML(source)).To(MatchHTML(expected))
+	})
+})
@@ -37,6 +37,24 @@ const (
 	AttrNoHeader = "noheader"
 	// AttrNoFooter attribute to disable the rendering of document footer
 	AttrNoFooter = "nofooter"
+	// AttrFavicon the document attribute which sets the icon of the document (`favicon.ico` if the value is empty)
+	AttrFavicon = "favicon"
+	// AttrDescription the document attribute which sets the `description` meta element
+	AttrDescription = "description"
+	// AttrKeywords the document attribute which sets the `keywords` meta element
+	AttrKeywords = "keywords"
+	// AttrCopyright the document attribute which sets the `copyright` meta element
+	AttrCopyright = "copyright"
+	// AttrAppName the document attribute which sets the `application-name` meta element
+	AttrAppName = "app-name"
+	// AttrLang the document attribute which sets the language of the document (`en` by default)
+	AttrLang = "lang"
+	// AttrNoLang the document attribute to omit the language of the document
+	AttrNoLang = "nolang"
+	// AttrExperimental the document attribute which enables the UI macros (keyboard, button and menu)
+	AttrExperimental = "experimental"
+	// AttrStem the document attribute which enables the STEM support and sets the default notation (`asciimath` or `latexmath`)
+	AttrStem = "stem"
 	// AttrCustomID the key to retrieve the flag that indicates if the element ID is custom or generated
 	AttrCustomID = "@customID"
 	// AttrTitle the key to retrieve the title
@@ -63,8 +81,12 @@ const (
 	AttrLineNums = "linenums"
 	// AttrCheckStyle the attribute to mark the first element of an unordered list itemd as a checked or not
 	AttrCheckStyle = "checkstyle"
-	// AttrStart the `start` attribute in an ordered list
+	// AttrStart the `start` attribute in an ordered list, or the start time of a video or audio
 	AttrStart = "start"
+	// AttrEnd the end time of a video or audio
+	AttrEnd = "end"
+	// AttrPoster the `poster` attribute of a video (or the service hosting the video, such as `youtube` or `vimeo`)
+	AttrPoster = "poster"
 	// AttrLevelOffset the `leveloffset` attribute used in file inclusions
 	AttrLevelOffset = "leveloffset"
 	// AttrLineRanges the `lines` attribute used in file inclusions
@@ -73,12 +95,24 @@ const (
 	AttrTagRanges = "tags"
 	// AttrLastUpdated the "last updated" data in the document, i.e., the output/generation time
 	AttrLastUpdated = "LastUpdated"
+	// AttrImagesDir the document attribute which defines the directory of the images
+	AttrImagesDir = "imagesdir"
 	// AttrImageAlt the image `alt` attribute
 	AttrImageAlt = "alt"
 	// AttrImageHeight the image `height` attribute
 	AttrImageHeight = "height"
-	// AttrImageWindow the `window` attribute, which becomes the target for the link
+	// AttrImageScaledWidth the image `scaledwidth` attribute, ie, its width relative to the available space (eg: `50%`)
+	AttrImageScaledWidth = "scaledwidth"
+	// AttrImagePDFWidth the image `pdfwidth` attribute, ie, its width when the document is printed (eg: `50vw`)
+	AttrImagePDFWidth = "pdfwidth"
+	// AttrImageFallback the image to display when an interactive SVG image cannot be rendered
+	AttrImageFallback = "fallback"
+	// AttrDataURI the document attribute to embed the images in the document as `data:` URIs
+	AttrDataURI = "data-uri"
+	// AttrImageWindow the `window` attribute of an image or a link, which becomes the target for the link
 	AttrImageWindow = "window"
+	// AttrHideURIScheme the document attribute to hide the scheme of the URL in the text of bare links
+	AttrHideURIScheme = "hide-uri-scheme"
 	// AttrImageAlign is for image alignment
 	AttrImageAlign = "align"
 	// AttrIconSize the icon `size`, and can be one of 1x, 2x, 3x, 4x, 5x, lg, fw
@@ -87,6 +121,8 @@ const (
 	AttrIconRotate = "rotate"
 	// AttrIconFlip the icon `flip` attribute, and if set can be "horizontal" or "vertical"
 	AttrIconFlip = "flip"
+	// AttrIcon the `icon` attribute of an admonition, which sets the image to use instead of the default icon
+	AttrIcon = "icon"
 	// AttrUnicode local libasciidoc attribute to encode output as UTF-8 instead of ASCII.
 	AttrUnicode = "unicode"
 	// AttrOptions element options (boolean, comma separated)
@@ -109,6 +145,10 @@ const (
 	AttrFloat = "float"
 	// AttrCols the table columns attribute
 	AttrCols = "cols"
+	// AttrFormat the format of the table data (psv, csv, tsv or dsv)
+	AttrFormat = "format"
+	// AttrSeparator the separator of the cells in the table data
+	AttrSeparator = "separator"
 	// AttrPositional2 positional parameter 2
 	AttrPositional2 = "@2"
 	// AttrPositional3 positional parameter 3
@@ -221,9 +261,19 @@ func NewElementStyle(style interface{}) (Attributes, error) {
 	return Attributes{AttrStyle: style}, nil
 }
 
-// NewAdmonitionAttribute initializes a new attribute map with a single entry for the admonition kind using the given value
-func NewAdmonitionAttribute(k AdmonitionKind) (Attributes, error) {
-	return Attributes{AttrAdmonitionKind: k}, nil
+// NewAdmonitionAttribute initializes a new attribute map with an entry for the admonition kind using the given value,
+// along with the other attributes of the block (eg: `[NOTE,caption=Remember,icon=bulb]`)
+func NewAdmonitionAttribute(k AdmonitionKind, others interface{}) (Attributes, error) {
+	result := Attributes{}
+	if others, ok := others.([]interface{}); ok {
+		attrs, err := NewAttributeGroup(others)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to initialize admonition attributes")
+		}
+		result = attrs
+	}
+	result[AttrAdmonitionKind] = k
+	return result, nil
 }
 
 // NewAttributeGroup initializes a group of attributes from the given generic attributes.
@@ -378,6 +428,24 @@ func (a Attributes) HasOption(key string) bool {
 	return false
 }
 
+// addOptions adds the given options (comma-separated values or map of flags) to the existing options
+func (a Attributes) addOptions(options interface{}) {
+	if !a.Has(AttrOptions) {
+		a[AttrOptions] = map[string]bool{}
+	}
+	m := a[AttrOptions].(map[string]bool)
+	switch options := options.(type) {
+	case string:
+		for _, o := range strings.Split(options, ",") {
+			m[o] = true
+		}
+	case map[string]bool:
+		for o := range options {
+			m[o] = options[o]
+		}
+	}
+}
+
 // sets the value as a singular string value if it did not exist yet,
 // or move the existing value in a slice and append the new one
 func (a Attributes) append(key string, value interface{}) {
@@ -555,20 +623,7 @@ func NewElementAttributes(attributes ...interface{}) (Attributes, error) {
 			case AttrRole:
 				result.append(AttrRole, v)
 			case AttrOptions, AttrOpts:
-				if !result.Has(AttrOptions) {
-					result[AttrOptions] = map[string]bool{}
-				}
-				m := result[AttrOptions].(map[string]bool)
-				switch v := v.(type) {
-				case string:
-					for _, o := range strings.Split(v, ",") {
-						m[o] = true
-					}
-				case map[string]bool:
-					for o := range v {
-						m[o] = v[o]
-					}
-				}
+				result.addOptions(v)
 			default:
 				result[k] = v
 			}
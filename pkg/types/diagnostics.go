@@ -0,0 +1,104 @@
+package types
+
+import (
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Severity the severity of a problem found in a document
+type Severity string
+
+const (
+	// SeverityError the severity level for errors.
+	SeverityError Severity = "Error"
+	// SeverityWarning the severity level for warnings
+	SeverityWarning Severity = "Warning"
+)
+
+// Rank returns the rank of the severity, the higher being the most severe
+func (s Severity) Rank() int {
+	switch s {
+	case SeverityError:
+		return 2
+	case SeverityWarning:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// The codes of the diagnostics reported while processing a document
+const (
+	// UnresolvedAttributeCode the code of the diagnostic reported when a document refers to an unknown attribute
+	UnresolvedAttributeCode string = "unresolved-attribute"
+	// BrokenCrossReferenceCode the code of the diagnostic reported when an internal cross reference has no target
+	BrokenCrossReferenceCode string = "broken-xref"
+	// MissingIncludeCode the code of the diagnostic reported when a file to include could not be found or read
+	MissingIncludeCode string = "missing-include"
+	// MissingImageCode the code of the diagnostic reported when an image to embed in the document could not be found or read
+	MissingImageCode string = "missing-image"
+	// ImageDataURICode the code of the diagnostic reported when an image could not be embedded as a data URI
+	// (eg: unsupported type, size limit exceeded or file outside of the directory of the document in safe mode)
+	ImageDataURICode string = "image-data-uri"
+	// MaxIncludeDepthCode the code of the diagnostic reported when the maximum depth of nested file inclusions is exceeded
+	MaxIncludeDepthCode string = "max-include-depth"
+	// UnclosedTagCode the code of the diagnostic reported when a tagged region of an included file is not closed
+	UnclosedTagCode string = "unclosed-tag"
+	// InvalidTableOfContentsPlacementCode the code of the diagnostic reported when the `toc` attribute has an unsupported value
+	InvalidTableOfContentsPlacementCode string = "invalid-toc-placement"
+	// InvalidDocumentCode the code of the diagnostic reported when a document does not have the structure expected by its doctype
+	InvalidDocumentCode string = "invalid-document"
+	// ParseErrorCode the code of the diagnostic reported when a document could not be parsed
+	ParseErrorCode string = "parse-error"
+	// OutputFileCode the code of the diagnostic reported when an output file could not be created
+	OutputFileCode string = "output-file"
+	// InvalidTableCellsCode the code of the diagnostic reported when the cells of a table do not fit in its columns
+	// (eg: a cell spanning past the last column, or cells of an incomplete last row)
+	InvalidTableCellsCode string = "invalid-table-cells"
+	// InvalidTableColumnWidthsCode the code of the diagnostic reported when the total width of the columns of a table
+	// exceeds 100%, which leaves no room for its autowidth columns
+	InvalidTableColumnWidthsCode string = "invalid-table-column-widths"
+	// InvalidTableSeparatorCode the code of the diagnostic reported when the separator of a table in the CSV or TSV format
+	// is not a single character (in which case the default separator of the format is used)
+	InvalidTableSeparatorCode string = "invalid-table-separator"
+)
+
+// Diagnostic a problem reported while processing a document
+type Diagnostic struct {
+	Severity Severity
+	Code     string
+	Message  string
+	Filename string // the source file in which the problem was found (if known)
+	Line     int    // the line at which the problem was found in the source file, or `0` if unknown
+	Kind     string // the kind of element which caused the problem (eg: `InternalCrossReference`), if any
+}
+
+// String returns the message of the diagnostic, prefixed with the location of the problem (if known)
+func (d Diagnostic) String() string {
+	switch {
+	case d.Filename != "" && d.Line > 0:
+		return fmt.Sprintf("%s:%d: %s", d.Filename, d.Line, d.Message)
+	case d.Filename != "":
+		return fmt.Sprintf("%s: %s", d.Filename, d.Message)
+	default:
+		return d.Message
+	}
+}
+
+// DiagnosticSink receives the diagnostics reported while processing a document
+type DiagnosticSink func(d Diagnostic)
+
+// Report sends the given diagnostic to the sink, or logs its message if the sink is `nil`
+func (s DiagnosticSink) Report(d Diagnostic) {
+	if s != nil {
+		s(d)
+		return
+	}
+	switch d.Severity {
+	case SeverityError:
+		log.Error(d.Message)
+	default:
+		log.Warn(d.Message)
+	}
+}
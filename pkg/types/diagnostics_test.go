@@ -0,0 +1,26 @@
+package types_test
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = DescribeTable("diagnostic messages",
+	func(d types.Diagnostic, expected string) {
+		Expect(d.String()).To(Equal(expected))
+	},
+	Entry("without location", types.Diagnostic{Message: "a problem"}, "a problem"),
+	Entry("with filename", types.Diagnostic{Message: "a problem", Filename: "test.adoc"}, "test.adoc: a problem"),
+	Entry("with filename and line", types.Diagnostic{Message: "a problem", Filename: "test.adoc", Line: 3}, "test.adoc:3: a problem"),
+)
+
+var _ = DescribeTable("severity ranks",
+	func(s types.Severity, expected int) {
+		Expect(s.Rank()).To(Equal(expected))
+	},
+	Entry("error", types.SeverityError, 2),
+	Entry("warning", types.SeverityWarning, 1),
+	Entry("unknown", types.Severity("info"), 0),
+)
@@ -1,10 +1,14 @@
 package types
 
+import "strings"
+
 // AttributesWithOverrides the document attributes with some overrides provided by the CLI (for example)
 type AttributesWithOverrides struct {
 	Content   map[string]interface{}
 	Overrides map[string]string
 	Counters  map[string]interface{}
+	// Diagnostics the sink to which the problems found during the substitutions are reported
+	Diagnostics DiagnosticSink
 }
 
 // All returns all attributes, or `nil` if there is none
@@ -17,11 +21,27 @@ func (a AttributesWithOverrides) All() Attributes {
 		result[k] = v
 	}
 	for k, v := range a.Overrides {
+		if strings.HasPrefix(k, "!") {
+			// attribute is reset
+			result[strings.TrimPrefix(k, "!")] = nil
+			continue
+		}
 		result[k] = v
 	}
 	return result
 }
 
+// Clone returns a copy of the attributes, in which attributes can be set without affecting the current ones.
+// The counters and the overrides are shared.
+func (a AttributesWithOverrides) Clone() AttributesWithOverrides {
+	result := a
+	result.Content = make(map[string]interface{}, len(a.Content))
+	for k, v := range a.Content {
+		result.Content[k] = v
+	}
+	return result
+}
+
 // Set sets the given attribute
 func (a AttributesWithOverrides) Set(key string, value interface{}) {
 	a.Content[key] = value
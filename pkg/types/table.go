@@ -14,6 +14,17 @@ import (
 // Tables
 // ------------------------------------------
 
+const (
+	// PSVTableFormat the default format of table data, with cells prefixed with `|`
+	PSVTableFormat = "psv"
+	// CSVTableFormat the format of table data with comma-separated values
+	CSVTableFormat = "csv"
+	// TSVTableFormat the format of table data with tab-separated values
+	TSVTableFormat = "tsv"
+	// DSVTableFormat the format of table data with delimiter-separated values (`:` by default)
+	DSVTableFormat = "dsv"
+)
+
 // TableColumn a table column
 type TableColumn struct {
 	widthVal float64 // internally used number, will be 0 for automatic, cleared post processing
@@ -35,6 +46,7 @@ type Table struct {
 	Header     TableLine
 	Columns    []TableColumn
 	Lines      []TableLine
+	Footer     TableLine
 }
 
 // parseNum like atoi, but stops on non-digit character (and only unsigned)
@@ -136,7 +148,7 @@ func (t Table) parseColumnsAttr() ([]TableColumn, error) {
 	return cols, nil
 }
 
-func (t Table) processColumnWidths() ([]TableColumn, error) {
+func (t Table) processColumnWidths(diagnostics DiagnosticSink) ([]TableColumn, error) {
 
 	widths := make([]float64, len(t.Columns))
 	cols := make([]TableColumn, 0, len(t.Columns))
@@ -154,30 +166,22 @@ func (t Table) processColumnWidths() ([]TableColumn, error) {
 			total += c.widthVal
 		}
 
-		if percent {
+		switch {
+		case percent && total <= 100:
 			// Zero or more fixed percentages.
 			//  At least one column automatically expanding to fill remainder.
-			if total > 100 {
-				return nil, fmt.Errorf("total widths (%f) cannot exceed 100%%", total)
-			}
-		} else {
-			// Relative widths, we have to calculate as percentages.
-			used := 0.0
-			for i, v := range widths {
-				if i == len(widths)-1 {
-					// Last column uses remainder -- addresses rounding errors.
-					// (Also, faster, simpler math.)
-					v = 100 - used
-				} else {
-					// This rounds to nearest .001 percent.  This allows us to
-					// use %.6g format in templates without precision loss.
-					v = v * 1000000 / total
-					v = math.Round(v)
-					v /= 10000
-				}
-				used += v
-				widths[i] = v
-			}
+		case percent:
+			// Fixed percentages exceeding 100%, which leave no room for the autowidth columns.
+			// Like Asciidoctor, we consider the widths of the other columns as relative widths instead.
+			diagnostics.Report(Diagnostic{
+				Severity: SeverityWarning,
+				Code:     InvalidTableColumnWidthsCode,
+				Message:  fmt.Sprintf("total column widths (%g) cannot exceed 100%% when using autowidth columns", total),
+				Kind:     "Table",
+			})
+			relativeWidths(widths, total)
+		default:
+			relativeWidths(widths, total)
 		}
 	}
 
@@ -192,8 +196,41 @@ func (t Table) processColumnWidths() ([]TableColumn, error) {
 	return cols, nil
 }
 
-// NewTable initializes a new table with the given lines and attributes
-func NewTable(header interface{}, lines []interface{}, attributes interface{}) (Table, error) {
+// relativeWidths converts the given relative widths into percentages, ignoring the autowidth columns (with a `0` width)
+func relativeWidths(widths []float64, total float64) {
+	last := -1
+	for i, v := range widths {
+		if v != 0 {
+			last = i
+		}
+	}
+	used := 0.0
+	for i, v := range widths {
+		if v == 0 {
+			continue
+		}
+		if i == last {
+			// Last column uses remainder -- addresses rounding errors.
+			// (Also, faster, simpler math.)
+			v = 100 - used
+		} else {
+			// This rounds to nearest .001 percent.  This allows us to
+			// use %.6g format in templates without precision loss.
+			v = v * 1000000 / total
+			v = math.Round(v)
+			v /= 10000
+		}
+		used += v
+		widths[i] = v
+	}
+}
+
+// NewTable initializes a new table with the given lines and attributes.
+// The first row is the header if it was given by a line followed by a blank line (implicit header),
+// or if the `header` option is set, unless the `noheader` option is set. The last row is the footer if
+// the `footer` option is set. The problems with the widths of the columns and with the layout of the cells are reported
+// to the given diagnostics sink.
+func NewTable(header interface{}, lines []interface{}, attributes interface{}, diagnostics DiagnosticSink) (Table, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return Table{}, errors.Wrap(err, "failed to initialize a Table element")
@@ -206,69 +243,203 @@ func NewTable(header interface{}, lines []interface{}, attributes interface{}) (
 		return Table{}, errors.Wrap(err, "failed to initialize a Table element")
 	}
 
-	if header, ok := header.(TableLine); ok {
-		t.Header = header
+	// need to regroup columns of all lines, they dispatch on lines
+	cells := make([]TableCell, 0)
+	h, implicitHeader := header.(TableLine)
+	if implicitHeader {
 		if t.Columns == nil {
 			// columns determined by our cell count here
-			for i := 0; i < len(header.Cells); i++ {
-				t.Columns = append(t.Columns, defaultColumn)
-			}
+			t.Columns = defaultColumns(h)
 		}
+		cells = append(cells, h.Cells...)
 	}
-	// need to regroup columns of all lines, they dispatch on lines
-	cells := make([][]interface{}, 0)
 	for _, l := range lines {
 		if l, ok := l.(TableLine); ok {
 			// if no header line was set, inspect the first line to determine the number of columns per line
 			if t.Columns == nil {
-				for i := 0; i < len(l.Cells); i++ {
-					t.Columns = append(t.Columns, defaultColumn)
-				}
+				t.Columns = defaultColumns(l)
 			}
 			cells = append(cells, l.Cells...)
 		}
 	}
 
 	// Calculate the actual widths now
-	if t.Columns, err = t.processColumnWidths(); err != nil {
+	if t.Columns, err = t.processColumnWidths(diagnostics); err != nil {
 		return Table{}, errors.Wrap(err, "failed to initialize a Table element")
 	}
 
-	t.Lines = make([]TableLine, 0, len(cells))
-	if len(lines) > 0 {
-		log.Debugf("buffered %d columns for the table", len(cells))
-		l := TableLine{
-			Cells: make([][]interface{}, len(t.Columns)),
+	t.Lines = make([]TableLine, 0, len(lines))
+	if len(cells) > 0 && len(t.Columns) > 0 {
+		log.Debugf("buffered %d cells for the table", len(cells))
+		t.Lines = reflow(cells, len(t.Columns), diagnostics)
+	}
+	if (implicitHeader || attrs.HasOption("header")) && !attrs.HasOption("noheader") && len(t.Lines) > 0 {
+		t.Header = t.Lines[0]
+		t.Lines = t.Lines[1:]
+	}
+	if attrs.HasOption("footer") && len(t.Lines) > 0 {
+		t.Footer = t.Lines[len(t.Lines)-1]
+		t.Lines = t.Lines[:len(t.Lines)-1]
+	}
+	// log.Debugf("initialized a new table with %d line(s)", len(lines))
+	return t, nil
+}
+
+// defaultColumns returns the default columns for the given line, taking the cells spanning over multiple columns into account
+func defaultColumns(l TableLine) []TableColumn {
+	cols := []TableColumn{}
+	for _, c := range l.Cells {
+		for i := 0; i < c.colspan(); i++ {
+			cols = append(cols, defaultColumn)
 		}
-		for i, c := range cells {
-			log.Debugf("adding cell with content '%v' in table line at offset %d", c, i%len(t.Columns))
-			l.Cells[i%len(t.Columns)] = c
-			if (i+1)%len(t.Columns) == 0 { // switch to next line
-				log.Debugf("adding line with content '%v' in table", l)
-				t.Lines = append(t.Lines, l)
-				l = TableLine{
-					Cells: make([][]interface{}, len(t.Columns)),
-				}
+	}
+	return cols
+}
+
+// reflow dispatches the given cells in lines of the given number of columns, regardless of how the cells were
+// laid out in the source document, taking into account the cells which span over multiple columns and/or rows.
+// Cells of an incomplete last line are dropped.
+func reflow(cells []TableCell, columns int, diagnostics DiagnosticSink) []TableLine {
+	lines := []TableLine{}
+	layout := newTableLayout(columns)
+	line := TableLine{
+		Cells: []TableCell{},
+	}
+	for _, c := range cells {
+		for _, d := range c.diagnostics {
+			diagnostics.Report(d)
+		}
+		c.diagnostics = nil
+		col := layout.next()
+		for col == -1 { // current line is complete (or fully covered by cells of previous lines)
+			lines = append(lines, line)
+			line = TableLine{
+				Cells: []TableCell{},
 			}
+			layout.newLine()
+			col = layout.next()
+		}
+		if col+c.colspan() > columns {
+			diagnostics.Report(Diagnostic{
+				Severity: SeverityWarning,
+				Code:     InvalidTableCellsCode,
+				Message:  fmt.Sprintf("cell in table line at offset %d spans over %d column(s), exceeding the number of columns", col, c.colspan()),
+				Kind:     "Table",
+			})
+			c.ColSpan = columns - col
 		}
+		layout.place(col, c)
+		log.Debugf("adding cell with content '%v' in table line at offset %d", c.Elements, col)
+		line.Cells = append(line.Cells, c)
 	}
-	// log.Debugf("initialized a new table with %d line(s)", len(lines))
-	return t, nil
+	if len(line.Cells) > 0 {
+		if layout.next() == -1 {
+			lines = append(lines, line)
+		} else {
+			diagnostics.Report(Diagnostic{
+				Severity: SeverityWarning,
+				Code:     InvalidTableCellsCode,
+				Message:  fmt.Sprintf("dropping %d cell(s) from incomplete table line", len(line.Cells)),
+				Kind:     "Table",
+			})
+		}
+	}
+	return lines
 }
 
-// TableLine a table line is made of columns, each column being a group of []interface{} (to support quoted text, etc.)
+// CellColumns returns the offset of the column of each cell in the lines of the table,
+// taking into account the cells which span over multiple columns and/or rows.
+func (t Table) CellColumns() [][]int {
+	result := make([][]int, len(t.Lines))
+	layout := newTableLayout(len(t.Columns))
+	for i, l := range t.Lines {
+		result[i] = make([]int, len(l.Cells))
+		for j, c := range l.Cells {
+			col := layout.next()
+			if col == -1 { // should not happen once the lines were reflowed
+				col = len(t.Columns) - 1
+			}
+			result[i][j] = col
+			layout.place(col, c)
+		}
+		layout.newLine()
+	}
+	return result
+}
+
+// CellColumns returns the offset of the column of each cell in the line
+func (l TableLine) CellColumns() []int {
+	result := make([]int, len(l.Cells))
+	col := 0
+	for i, c := range l.Cells {
+		result[i] = col
+		col += c.colspan()
+	}
+	return result
+}
+
+// tableLayout keeps track of the columns used in the current line of a table,
+// including by the cells of previous lines which span over multiple rows
+type tableLayout struct {
+	spans []int  // number of lines still covered by a cell of a previous line, per column
+	used  []bool // columns used in the current line
+}
+
+func newTableLayout(columns int) *tableLayout {
+	return &tableLayout{
+		spans: make([]int, columns),
+		used:  make([]bool, columns),
+	}
+}
+
+// newLine starts a new line, in which some columns may already be covered by a cell of a previous line
+func (l *tableLayout) newLine() {
+	for i := range l.spans {
+		l.used[i] = l.spans[i] > 0
+		if l.spans[i] > 0 {
+			l.spans[i]--
+		}
+	}
+}
+
+// next returns the next column available in the current line, or `-1` if the line is complete
+func (l *tableLayout) next() int {
+	for i, u := range l.used {
+		if !u {
+			return i
+		}
+	}
+	return -1
+}
+
+// place marks the columns covered by the given cell at the given column offset
+func (l *tableLayout) place(col int, c TableCell) {
+	for i := col; i < col+c.colspan() && i < len(l.used); i++ {
+		l.used[i] = true
+		l.spans[i] = c.rowspan() - 1
+	}
+}
+
+// TableLine a table line is made of cells
 type TableLine struct {
-	Cells [][]interface{}
+	Cells []TableCell
 }
 
-// NewTableLine initializes a new TableLine with the given columns
-func NewTableLine(columns []interface{}) (TableLine, error) {
-	c := make([][]interface{}, 0)
-	for _, column := range columns {
-		if e, ok := column.([]interface{}); ok {
-			c = append(c, e)
-		} else {
-			return TableLine{}, errors.Errorf("unsupported element of type %T", column)
+// NewTableLine initializes a new TableLine with the given cells
+func NewTableLine(cells []interface{}) (TableLine, error) {
+	c := make([]TableCell, 0, len(cells))
+	for _, cell := range cells {
+		switch cell := cell.(type) {
+		case TableCell:
+			c = append(c, cell)
+		case []interface{}: // duplicated cell
+			for _, cell := range cell {
+				if cell, ok := cell.(TableCell); ok {
+					c = append(c, cell)
+				}
+			}
+		default:
+			return TableLine{}, errors.Errorf("unsupported element of type %T", cell)
 		}
 	}
 	// log.Debugf("initialized a new table line with %d columns", len(c))
@@ -276,3 +447,152 @@ func NewTableLine(columns []interface{}) (TableLine, error) {
 		Cells: c,
 	}, nil
 }
+
+// TableCell a table cell, with its content and its optional specifiers.
+// Unless specified, a cell spans over a single column and a single row, and has the alignments and style of its column.
+type TableCell struct {
+	Elements []interface{}
+	ColSpan  int    // number of columns spanned by the cell (0 if unspecified)
+	RowSpan  int    // number of rows spanned by the cell (0 if unspecified)
+	HAlign   string // left, right, or center (empty if unspecified)
+	VAlign   string // top, bottom, or middle (empty if unspecified)
+	Style    string // single character (empty if unspecified)
+
+	diagnostics []Diagnostic // reported when the table is initialized
+}
+
+// TableCellSpecifier the specifiers of a table cell, given before the cell separator (eg: `2.3+^.>s|`)
+type TableCellSpecifier struct {
+	Duplication int // number of times the cell is duplicated (0 if unspecified)
+	ColSpan     int
+	RowSpan     int
+	HAlign      string
+	VAlign      string
+	Style       string
+
+	diagnostics []Diagnostic
+}
+
+// NewTableCellSpecifier initializes a new TableCellSpecifier from the given span or duplication factor, alignments and style
+func NewTableCellSpecifier(factor, halign, valign, style interface{}) (TableCellSpecifier, error) {
+	s, _ := factor.(TableCellSpecifier)
+	if halign, ok := halign.(string); ok {
+		s.HAlign = alignments[halign]
+	}
+	if valign, ok := valign.(string); ok {
+		s.VAlign = verticalAlignments[valign]
+	}
+	if style, ok := style.(string); ok && style != "d" { // leave default unset
+		s.Style = style
+	}
+	return s, nil
+}
+
+// NewTableCellSpan initializes a new TableCellSpecifier with the given (optional) column and row spans.
+// The column span is reduced to the given maximum (if greater than `0`)
+func NewTableCellSpan(colspan, rowspan interface{}, max int) (TableCellSpecifier, error) {
+	s := TableCellSpecifier{}
+	if colspan, ok := colspan.(int); ok {
+		s.ColSpan = s.limit(colspan, max, "column span")
+	}
+	if rowspan, ok := rowspan.(int); ok {
+		s.RowSpan = rowspan
+	}
+	return s, nil
+}
+
+// NewTableCellDuplication initializes a new TableCellSpecifier with the given duplication factor.
+// The factor is reduced to the given maximum (if greater than `0`)
+func NewTableCellDuplication(factor, max int) (TableCellSpecifier, error) {
+	s := TableCellSpecifier{}
+	s.Duplication = s.limit(factor, max, "duplication factor")
+	return s, nil
+}
+
+// limit returns the given factor, or the given maximum if the factor exceeds it (to avoid allocating
+// the memory for millions of cells or columns). In the latter case, a diagnostic is reported when the table
+// is initialized (since the specifiers may be parsed multiple times when the parser backtracks)
+func (s *TableCellSpecifier) limit(factor, max int, name string) int {
+	if max <= 0 || factor <= max {
+		return factor
+	}
+	s.diagnostics = append(s.diagnostics, Diagnostic{
+		Severity: SeverityWarning,
+		Code:     InvalidTableCellsCode,
+		Message:  fmt.Sprintf("table cell %s of %d exceeds the maximum of %d", name, factor, max),
+		Kind:     "Table",
+	})
+	return max
+}
+
+var alignments = map[string]string{
+	"<": "left",
+	">": "right",
+	"^": "center",
+}
+
+var verticalAlignments = map[string]string{
+	"<": "top",
+	">": "bottom",
+	"^": "middle",
+}
+
+// NewTableCells initializes a new TableCell with the given raw content and the given (optional) specifier.
+// The substitutions are applied on the raw content of the cell in a subsequent processing phase, according to the style of the cell.
+// Returns a single TableCell, or a slice of identical TableCells if the specifier has a duplication factor
+func NewTableCells(specifier interface{}, content string) (interface{}, error) {
+	c := TableCell{
+		Elements: []interface{}{},
+	}
+	// trailing blank lines are ignored
+	lines := strings.Split(content, "\n")
+	for len(lines) > 1 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if content = strings.Join(lines, "\n"); content != "" {
+		c.Elements = append(c.Elements, StringElement{
+			Content: content,
+		})
+	}
+	s, ok := specifier.(TableCellSpecifier)
+	if !ok {
+		return c, nil
+	}
+	c.ColSpan = s.ColSpan
+	c.RowSpan = s.RowSpan
+	c.HAlign = s.HAlign
+	c.VAlign = s.VAlign
+	c.Style = s.Style
+	c.diagnostics = s.diagnostics
+	if s.Duplication <= 1 {
+		return c, nil
+	}
+	cells := make([]interface{}, s.Duplication)
+	for i := range cells {
+		cells[i] = c
+		c.diagnostics = nil // only reported once
+	}
+	return cells, nil
+}
+
+// StyleIn returns the style of the cell, or the style of the given column if the cell has no style of its own
+func (c TableCell) StyleIn(col TableColumn) string {
+	if c.Style != "" {
+		return c.Style
+	}
+	return col.Style
+}
+
+func (c TableCell) colspan() int {
+	if c.ColSpan > 1 {
+		return c.ColSpan
+	}
+	return 1
+}
+
+func (c TableCell) rowspan() int {
+	if c.RowSpan > 1 {
+		return c.RowSpan
+	}
+	return 1
+}
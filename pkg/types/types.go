@@ -114,6 +114,16 @@ type Stringer interface {
 	Stringify() string
 }
 
+// ------------------------------------------
+// Positions
+// ------------------------------------------
+
+// Position the position of an element in the source, ie, in the main document or in an included file
+type Position struct {
+	Filename string // the file in which the element was found (empty if the main document has no filename)
+	Line     int    // the line at which the element starts in the file, or `0` if unknown
+}
+
 // ------------------------------------------
 // Draft Document: document in which
 // all substitutions have been applied
@@ -177,6 +187,14 @@ type Metadata struct {
 	TableOfContents TableOfContents
 	Authors         []DocumentAuthor
 	Revision        DocumentRevision
+	Diagnostics     []Diagnostic
+	Checklist       ChecklistProgress
+}
+
+// ChecklistProgress the number of checked and unchecked items in the checklists of a document
+type ChecklistProgress struct {
+	Checked   int
+	Unchecked int
 }
 
 // TableOfContents the table of contents
@@ -331,7 +349,8 @@ func NewAttributeReset(attrName string) (AttributeReset, error) {
 
 // AttributeSubstitution the type for AttributeSubstitution
 type AttributeSubstitution struct {
-	Name string
+	Name     string
+	Position Position
 }
 
 // PredefinedAttribute a special kind of attribute substitution, which
@@ -339,12 +358,12 @@ type AttributeSubstitution struct {
 type PredefinedAttribute AttributeSubstitution
 
 // NewAttributeSubstitution initializes a new Attribute Substitutions
-func NewAttributeSubstitution(name string) (interface{}, error) {
+func NewAttributeSubstitution(name string, position Position) (interface{}, error) {
 	if isPrefedinedAttribute(name) {
-		return PredefinedAttribute{Name: name}, nil
+		return PredefinedAttribute{Name: name, Position: position}, nil
 	}
 	log.Debugf("initialized a new AttributeSubstitution: '%s'", name)
-	return AttributeSubstitution{Name: name}, nil
+	return AttributeSubstitution{Name: name, Position: position}, nil
 }
 
 // CounterSubstitution is a counter, that may increment when it is substituted.
@@ -406,8 +425,43 @@ const (
 	Source BlockKind = "source"
 	// Passthrough a passthrough block
 	Passthrough BlockKind = "passthrough"
+	// Open an open block
+	Open BlockKind = "open"
+	// Abstract an open block with the `abstract` style
+	Abstract BlockKind = "abstract"
+	// PartIntro an open block with the `partintro` style
+	PartIntro BlockKind = "partintro"
+	// Admonition a block with an admonition style (eg: `[NOTE]`)
+	Admonition BlockKind = "admonition"
+	// Stem a STEM block using the notation set in the `stem` document attribute
+	Stem BlockKind = "stem"
+	// LatexMath a STEM block using the LaTeX notation
+	LatexMath BlockKind = "latexmath"
+	// AsciiMath a STEM block using the AsciiMath notation
+	AsciiMath BlockKind = "asciimath"
 )
 
+// the styles which can be set with a generic attribute (eg: `[sidebar]`)
+var genericBlockStyles = []BlockKind{Sidebar, Comment, Open, Abstract, PartIntro, Stem, LatexMath, AsciiMath}
+
+// BlockStyle returns the style set by the first positional attribute of a delimited block (eg: `[source]`, `[sidebar]` or `[NOTE]`),
+// which determines the kind of block to render, regardless of its delimiters.
+// Returns `false` if no style was set.
+func (a Attributes) BlockStyle() (BlockKind, bool) {
+	if _, found := a[AttrAdmonitionKind]; found {
+		return Admonition, true
+	}
+	if kind, ok := a[AttrBlockKind].(BlockKind); ok {
+		return kind, true
+	}
+	for _, kind := range genericBlockStyles {
+		if v, found := a[string(kind)]; found && v == nil {
+			return kind, true
+		}
+	}
+	return "", false
+}
+
 // ------------------------------------------
 // Table of Contents
 // ------------------------------------------
@@ -523,10 +577,11 @@ type Section struct {
 	Attributes Attributes
 	Title      []interface{}
 	Elements   []interface{}
+	Position   Position
 }
 
 // NewSection initializes a new `Section` from the given section title and elements
-func NewSection(level int, title []interface{}, ids []interface{}, attributes interface{}) (Section, error) {
+func NewSection(level int, title []interface{}, ids []interface{}, attributes interface{}, position Position) (Section, error) {
 	attrs, err := NewAttributes(attributes)
 	if err != nil {
 		return Section{}, errors.Wrapf(err, "failed to initialize a Section element")
@@ -540,25 +595,37 @@ func NewSection(level int, title []interface{}, ids []interface{}, attributes in
 		Attributes: attrs,
 		Title:      title,
 		Elements:   []interface{}{},
+		Position:   position,
 	}, nil
 }
 
 // ResolveID resolves/updates the "ID" attribute in the section (in case the title changed after some document attr substitution)
 func (s Section) ResolveID(docAttributes AttributesWithOverrides) (Section, error) {
-	if !s.Attributes.GetAsBool(AttrCustomID) {
-		log.Debugf("resolving section id")
-		separator := docAttributes.GetAsStringWithDefault(AttrIDSeparator, DefaultIDSeparator)
-		replacement, err := ReplaceNonAlphanumerics(s.Title, separator)
-		if err != nil {
-			return s, errors.Wrapf(err, "failed to generate default ID on Section element")
-		}
-		idPrefix := docAttributes.GetAsStringWithDefault(AttrIDPrefix, DefaultIDPrefix)
-		s.Attributes = s.Attributes.Set(AttrID, idPrefix+replacement)
-		log.Debugf("updated section id to '%s'", s.Attributes[AttrID])
+	attrs, err := resolveTitleID(s.Attributes, s.Title, docAttributes)
+	if err != nil {
+		return s, errors.Wrapf(err, "failed to generate default ID on Section element")
 	}
+	s.Attributes = attrs
 	return s, nil
 }
 
+// resolveTitleID sets the "ID" attribute generated from the given title, unless a custom ID was set
+func resolveTitleID(attrs Attributes, title []interface{}, docAttributes AttributesWithOverrides) (Attributes, error) {
+	if attrs.GetAsBool(AttrCustomID) {
+		return attrs, nil
+	}
+	log.Debugf("resolving id from title")
+	separator := docAttributes.GetAsStringWithDefault(AttrIDSeparator, DefaultIDSeparator)
+	replacement, err := ReplaceNonAlphanumerics(title, separator)
+	if err != nil {
+		return attrs, err
+	}
+	idPrefix := docAttributes.GetAsStringWithDefault(AttrIDPrefix, DefaultIDPrefix)
+	attrs = attrs.Set(AttrID, idPrefix+replacement)
+	log.Debugf("updated id to '%s'", attrs[AttrID])
+	return attrs, nil
+}
+
 // AddElement adds the given child element to this section
 func (s *Section) AddElement(e interface{}) {
 	s.Elements = append(s.Elements, e)
@@ -578,9 +645,9 @@ func (s Section) ReplaceFootnotes(notes *Footnotes) interface{} {
 }
 
 // NewDocumentHeader initializes a new Section with level 0 which can have authors and a revision, among other attributes
-func NewDocumentHeader(title []interface{}, authors interface{}, revision interface{}) (Section, error) {
+func NewDocumentHeader(title []interface{}, authors interface{}, revision interface{}, position Position) (Section, error) {
 	// log.Debugf("initializing a new Section level 0 with authors '%v' and revision '%v'", authors, revision)
-	section, err := NewSection(0, title, nil, nil)
+	section, err := NewSection(0, title, nil, nil, position)
 	if err != nil {
 		return Section{}, err
 	}
@@ -692,6 +759,47 @@ func expandRevision(revision DocumentRevision) Attributes {
 	return result
 }
 
+const (
+	// DiscreteStyle the style of a discrete heading
+	DiscreteStyle = "discrete"
+	// FloatStyle the alternative style of a discrete heading
+	FloatStyle = "float"
+)
+
+// DiscreteHeading a heading with the `discrete` (or `float`) style, which is not part of the hierarchy of sections
+type DiscreteHeading struct {
+	Level      int
+	Attributes Attributes
+	Title      []interface{}
+}
+
+// NewDiscreteHeading initializes a new `DiscreteHeading` from the given title
+func NewDiscreteHeading(level int, title []interface{}, ids []interface{}, attributes interface{}) (DiscreteHeading, error) {
+	attrs, err := NewAttributes(attributes)
+	if err != nil {
+		return DiscreteHeading{}, errors.Wrapf(err, "failed to initialize a DiscreteHeading element")
+	}
+	// multiple IDs can be defined (by mistake), but only the last one is used
+	for _, id := range ids {
+		attrs = attrs.Add(id)
+	}
+	return DiscreteHeading{
+		Level:      level,
+		Attributes: attrs,
+		Title:      title,
+	}, nil
+}
+
+// ResolveID resolves/updates the "ID" attribute in the heading (in case the title changed after some document attr substitution)
+func (h DiscreteHeading) ResolveID(docAttributes AttributesWithOverrides) (DiscreteHeading, error) {
+	attrs, err := resolveTitleID(h.Attributes, h.Title, docAttributes)
+	if err != nil {
+		return h, errors.Wrapf(err, "failed to generate default ID on DiscreteHeading element")
+	}
+	h.Attributes = attrs
+	return h, nil
+}
+
 // ------------------------------------------
 // Lists
 // ------------------------------------------
@@ -1257,20 +1365,22 @@ func NewInlineElements(elements ...interface{}) ([]interface{}, error) {
 
 // InternalCrossReference the struct for Cross References
 type InternalCrossReference struct {
-	ID    string
-	Label string
+	ID       string
+	Label    string
+	Position Position
 }
 
 // NewInternalCrossReference initializes a new `InternalCrossReference` from the given ID
-func NewInternalCrossReference(id string, label interface{}) (InternalCrossReference, error) {
+func NewInternalCrossReference(id string, label interface{}, position Position) (InternalCrossReference, error) {
 	log.Debugf("initializing a new InternalCrossReference with ID=%s", id)
 	var l string
 	if label, ok := label.(string); ok {
 		l = Apply(label, strings.TrimSpace)
 	}
 	return InternalCrossReference{
-		ID:    id,
-		Label: l,
+		ID:       id,
+		Label:    l,
+		Position: position,
 	}, nil
 }
 
@@ -1293,6 +1403,109 @@ func NewExternalCrossReference(location Location, attributes Attributes) (Extern
 	}, nil
 }
 
+// ------------------------------------------
+// Bibliography
+// ------------------------------------------
+
+// BibliographyStyle the style of a bibliography section or list
+const BibliographyStyle = "bibliography"
+
+// BibliographyAnchor the anchor at the beginning of an entry in a bibliography list (eg: `[[[id]]]` or `[[[id,label]]]`)
+type BibliographyAnchor struct {
+	ID    string
+	Label string
+}
+
+// NewBibliographyAnchor initializes a new `BibliographyAnchor` from the given ID and optional label.
+// The ID is used as the label if none was given
+func NewBibliographyAnchor(id string, label interface{}) (BibliographyAnchor, error) {
+	log.Debugf("initializing a new BibliographyAnchor with ID=%s", id)
+	l := id
+	if label, ok := label.(string); ok && strings.TrimSpace(label) != "" {
+		l = strings.TrimSpace(label)
+	}
+	return BibliographyAnchor{
+		ID:    id,
+		Label: l,
+	}, nil
+}
+
+// ReferenceText returns the text to display in cross references to this anchor (eg: `[label]`)
+func (a BibliographyAnchor) ReferenceText() []interface{} {
+	return []interface{}{
+		StringElement{
+			Content: "[" + a.Label + "]",
+		},
+	}
+}
+
+// ------------------------------------------
+// UI Macros (keyboard, button and menu)
+// ------------------------------------------
+
+// InlineKeyboard a single key or a combination of keys (eg: `kbd:[Ctrl+T]`)
+type InlineKeyboard struct {
+	Keys [][]interface{}
+}
+
+// NewInlineKeyboard initializes a new `InlineKeyboard` with the given keys
+func NewInlineKeyboard(keys [][]interface{}) (InlineKeyboard, error) {
+	log.Debugf("initializing a new InlineKeyboard with %d key(s)", len(keys))
+	return InlineKeyboard{
+		Keys: keys,
+	}, nil
+}
+
+// InlineButton a button (eg: `btn:[Save]`)
+type InlineButton struct {
+	Label []interface{}
+}
+
+// NewInlineButton initializes a new `InlineButton` with the given label
+func NewInlineButton(label []interface{}) (InlineButton, error) {
+	return InlineButton{
+		Label: label,
+	}, nil
+}
+
+// InlineMenu a menu selection (eg: `menu:File[Save]` or `"File > Save"`), ie, a menu, followed by
+// the optional submenus and menu item
+type InlineMenu struct {
+	Path [][]interface{}
+}
+
+// NewInlineMenu initializes a new `InlineMenu` with the given path
+func NewInlineMenu(path [][]interface{}) (InlineMenu, error) {
+	log.Debugf("initializing a new InlineMenu with %d element(s) in path", len(path))
+	return InlineMenu{
+		Path: path,
+	}, nil
+}
+
+// Menu returns the top-level menu
+func (m InlineMenu) Menu() []interface{} {
+	if len(m.Path) == 0 {
+		return nil
+	}
+	return m.Path[0]
+}
+
+// Submenus returns the submenus between the top-level menu and the menu item
+func (m InlineMenu) Submenus() [][]interface{} {
+	if len(m.Path) < 3 {
+		return nil
+	}
+	return m.Path[1 : len(m.Path)-1]
+}
+
+// MenuItem returns the menu item, or `nil` if the path only contains the top-level menu
+func (m InlineMenu) MenuItem() []interface{} {
+	if len(m.Path) < 2 {
+		return nil
+	}
+	return m.Path[len(m.Path)-1]
+}
+
 // ------------------------------------------
 // Images
 // ------------------------------------------
@@ -1301,23 +1514,19 @@ func NewExternalCrossReference(location Location, attributes Attributes) (Extern
 type ImageBlock struct {
 	Location   Location
 	Attributes Attributes
+	Position   Position
 }
 
 // NewImageBlock initializes a new `ImageBlock`
-func NewImageBlock(location Location, inlineAttributes Attributes, attributes interface{}) (ImageBlock, error) {
-	attrs, err := NewAttributes(attributes)
+func NewImageBlock(location Location, inlineAttributes Attributes, attributes interface{}, position Position) (ImageBlock, error) {
+	attrs, err := newBlockMacroAttributes(inlineAttributes, attributes)
 	if err != nil {
 		return ImageBlock{}, errors.Wrapf(err, "failed to initialize an ImageBlock element")
 	}
-	// inline attributes trump block attributes
-	if attrs == nil && len(inlineAttributes) > 0 {
-		attrs = inlineAttributes
-	} else if len(inlineAttributes) > 0 {
-		attrs = attrs.Add(inlineAttributes)
-	}
 	return ImageBlock{
 		Location:   location,
 		Attributes: attrs,
+		Position:   position,
 	}, nil
 }
 
@@ -1325,17 +1534,96 @@ func NewImageBlock(location Location, inlineAttributes Attributes, attributes in
 type InlineImage struct {
 	Location   Location
 	Attributes Attributes
+	Position   Position
 }
 
 // NewInlineImage initializes a new `InlineImage` (similar to ImageBlock, but without attributes)
-func NewInlineImage(location Location, attributes Attributes, imagesdir interface{}) (InlineImage, error) {
+func NewInlineImage(location Location, attributes Attributes, imagesdir interface{}, position Position) (InlineImage, error) {
 	if !attributes.Has(AttrImageAlt) {
 		attributes = attributes.Set(AttrImageAlt, resolveAlt(location))
 	}
+	if fallback, found := attributes.GetAsString(AttrImageFallback); found && fallback != "" {
+		l, _ := NewLocation("", []interface{}{fallback})
+		attributes = attributes.Set(AttrImageFallback, l.WithPathPrefix(imagesdir).Stringify())
+	}
 	location = location.WithPathPrefix(imagesdir)
 	return InlineImage{
 		Location:   location,
 		Attributes: attributes,
+		Position:   position,
+	}, nil
+}
+
+// newBlockMacroAttributes merges the inline attributes of a block macro (eg: `image::`) with its block attributes
+func newBlockMacroAttributes(inlineAttributes Attributes, attributes interface{}) (Attributes, error) {
+	attrs, err := NewAttributes(attributes)
+	if err != nil {
+		return nil, err
+	}
+	// inline attributes trump block attributes
+	if attrs == nil && len(inlineAttributes) > 0 {
+		attrs = inlineAttributes
+	} else if len(inlineAttributes) > 0 {
+		attrs = attrs.Add(inlineAttributes)
+	}
+	return attrs, nil
+}
+
+// ------------------------------------------
+// Videos and Audios
+// ------------------------------------------
+
+const (
+	// YouTubeVideo the `poster` value of a video hosted on YouTube
+	YouTubeVideo = "youtube"
+	// VimeoVideo the `poster` value of a video hosted on Vimeo
+	VimeoVideo = "vimeo"
+)
+
+// VideoBlock the structure for the video block macros
+type VideoBlock struct {
+	Location   Location
+	Attributes Attributes
+}
+
+// NewVideoBlock initializes a new `VideoBlock`
+func NewVideoBlock(location Location, inlineAttributes Attributes, attributes interface{}) (VideoBlock, error) {
+	attrs, err := newBlockMacroAttributes(inlineAttributes, attributes)
+	if err != nil {
+		return VideoBlock{}, errors.Wrapf(err, "failed to initialize a VideoBlock element")
+	}
+	return VideoBlock{
+		Location:   location,
+		Attributes: attrs,
+	}, nil
+}
+
+// Provider returns the service hosting the video (`youtube` or `vimeo`),
+// or an empty string if the video is a file
+func (b VideoBlock) Provider() string {
+	switch p := b.Attributes.GetAsStringWithDefault(AttrPoster, ""); p {
+	case YouTubeVideo, VimeoVideo:
+		return p
+	default:
+		return ""
+	}
+}
+
+// AudioBlock the structure for the audio block macros
+type AudioBlock struct {
+	Location   Location
+	Attributes Attributes
+}
+
+// NewAudioBlock initializes a new `AudioBlock`
+func NewAudioBlock(location Location, inlineAttributes Attributes, attributes interface{}) (AudioBlock, error) {
+	attrs, err := newBlockMacroAttributes(inlineAttributes, attributes)
+	if err != nil {
+		return AudioBlock{}, errors.Wrapf(err, "failed to initialize an AudioBlock element")
+	}
+	return AudioBlock{
+		Location:   location,
+		Attributes: attrs,
 	}, nil
 }
 
@@ -1605,6 +1893,43 @@ func (b SidebarBlock) ReplaceElements(elements []interface{}) interface{} {
 	return b
 }
 
+// OpenBlock the structure for the open blocks, which can contain any other kind of blocks
+type OpenBlock struct {
+	Attributes Attributes
+	Elements   []interface{}
+}
+
+// NewOpenBlock initializes a new `OpenBlock` with the given elements
+func NewOpenBlock(elements []interface{}, attributes interface{}) (OpenBlock, error) {
+	log.Debugf("initializing a new OpenBlock with %d blocks", len(elements))
+	attrs, err := NewAttributes(attributes)
+	if err != nil {
+		return OpenBlock{}, errors.Wrap(err, "failed to initialize a new open block")
+	}
+	return OpenBlock{
+		Attributes: attrs,
+		Elements:   elements,
+	}, nil
+}
+
+var _ BlockWithElementSubstitution = OpenBlock{}
+
+// SubstitutionsToApply returns the name of the substitutions to apply
+func (b OpenBlock) SubstitutionsToApply() string {
+	return b.Attributes.GetAsStringWithDefault(AttrSubstitutions, "")
+}
+
+// ElementsToSubstitute returns the elements of this OpenBlock so that substitutions can be applied onto them
+func (b OpenBlock) ElementsToSubstitute() []interface{} {
+	return b.Elements
+}
+
+// ReplaceElements replaces the elements in this open block
+func (b OpenBlock) ReplaceElements(elements []interface{}) interface{} {
+	b.Elements = elements
+	return b
+}
+
 // FencedBlock the structure for the fenced blocks
 type FencedBlock struct {
 	Attributes Attributes
@@ -1792,6 +2117,68 @@ func NewPassthroughBlock(lines []interface{}, attributes interface{}) (Passthrou
 	}, nil
 }
 
+// StemBlock the structure for the STEM blocks (eg: `[stem]`, `[latexmath]` or `[asciimath]`)
+type StemBlock struct {
+	Attributes Attributes
+	Lines      [][]interface{}
+}
+
+var _ BlockWithLineSubstitution = StemBlock{}
+
+// SubstitutionsToApply returns the name of the substitutions to apply
+func (b StemBlock) SubstitutionsToApply() string {
+	return b.Attributes.GetAsStringWithDefault(AttrSubstitutions, "")
+}
+
+// LinesToSubstitute returns the lines of this StemBlock so that substitutions can be applied onto them
+func (b StemBlock) LinesToSubstitute() [][]interface{} {
+	return b.Lines
+}
+
+// ReplaceLines replaces the elements in this STEM block
+func (b StemBlock) ReplaceLines(lines [][]interface{}) interface{} {
+	b.Lines = lines
+	return b
+}
+
+// Notation returns the notation of the content of this block, given the `stem` document attribute
+func (b StemBlock) Notation(docAttributes Attributes) BlockKind {
+	style, _ := b.Attributes.BlockStyle()
+	return stemNotation(style, docAttributes)
+}
+
+// NewStemBlock initializes a new `StemBlock` with the given lines
+func NewStemBlock(lines []interface{}, attributes interface{}) (StemBlock, error) {
+	log.Debugf("initializing a new StemBlock with %d lines", len(lines))
+	attrs, err := NewAttributes(attributes)
+	if err != nil {
+		return StemBlock{}, errors.Wrap(err, "failed to initialize a new STEM block")
+	}
+	l, err := toLines(lines)
+	if err != nil {
+		return StemBlock{}, errors.Wrapf(err, "failed to initialize a new STEM block")
+	}
+	return StemBlock{
+		Attributes: attrs,
+		Lines:      l,
+	}, nil
+}
+
+// stemNotation returns the actual notation of a STEM block or macro of the given kind:
+// `latexmath` or `asciimath`, possibly given the `stem` document attribute
+func stemNotation(kind BlockKind, docAttributes Attributes) BlockKind {
+	switch kind {
+	case LatexMath, AsciiMath:
+		return kind
+	}
+	switch docAttributes.GetAsStringWithDefault(AttrStem, "") {
+	case "latexmath", "latex", "tex":
+		return LatexMath
+	default:
+		return AsciiMath
+	}
+}
+
 // CommentBlock the structure for the comment blocks
 type CommentBlock struct {
 	Attributes Attributes
@@ -2178,6 +2565,30 @@ func NewInlinePassthrough(kind PassthroughKind, elements []interface{}) (InlineP
 
 }
 
+// ------------------------------------------
+// InlineStem
+// ------------------------------------------
+
+// InlineStem a STEM expression in the `stem:[]`, `latexmath:[]` or `asciimath:[]` inline macro
+type InlineStem struct {
+	Kind    BlockKind
+	Content string
+}
+
+// NewInlineStem returns a new InlineStem with the given kind and content,
+// in which the escaped closing brackets (`\]`) are replaced
+func NewInlineStem(kind string, content string) (InlineStem, error) {
+	return InlineStem{
+		Kind:    BlockKind(kind),
+		Content: strings.ReplaceAll(content, `\]`, "]"),
+	}, nil
+}
+
+// Notation returns the notation of the content of this macro, given the `stem` document attribute
+func (s InlineStem) Notation(docAttributes Attributes) BlockKind {
+	return stemNotation(s.Kind, docAttributes)
+}
+
 // ------------------------------------------
 // Inline Links
 // ------------------------------------------
@@ -2194,11 +2605,55 @@ func NewInlineLink(url Location, attrs interface{}) (InlineLink, error) {
 		Location: url,
 	}
 	if attrs, ok := attrs.(Attributes); ok && len(attrs) > 0 {
-		result.Attributes = attrs
+		result.Attributes = withBlankWindowShorthand(attrs)
 	}
 	return result, nil
 }
 
+// NewEmailLink initializes a new inline `InlineLink` to the given email address
+func NewEmailLink(address string) (InlineLink, error) {
+	return NewInlineLink(Location{
+		Scheme: "mailto:",
+		Path: []interface{}{
+			StringElement{
+				Content: address,
+			},
+		},
+	}, nil)
+}
+
+// withBlankWindowShorthand removes the trailing `^` of the link text (eg: `link:url[text^]`), which is a shorthand
+// for the `window=_blank` attribute
+func withBlankWindowShorthand(attrs Attributes) Attributes {
+	positionals := attrs.Positionals()
+	if len(positionals) == 0 {
+		return attrs
+	}
+	key := "positional-" + strconv.Itoa(len(positionals))
+	text := positionals[len(positionals)-1]
+	if len(text) == 0 {
+		return attrs
+	}
+	last, ok := text[len(text)-1].(StringElement)
+	if !ok || !strings.HasSuffix(last.Content, "^") {
+		return attrs
+	}
+	last.Content = strings.TrimSuffix(last.Content, "^")
+	text = append(text[:len(text)-1], last)
+	if last.Content == "" {
+		text = text[:len(text)-1]
+	}
+	if len(text) > 0 {
+		attrs[key] = text
+	} else {
+		delete(attrs, key)
+	}
+	if !attrs.Has(AttrImageWindow) {
+		attrs[AttrImageWindow] = "_blank"
+	}
+	return attrs
+}
+
 // AttrInlineLinkText the link `text` attribute
 const AttrInlineLinkText string = "positional-1"
 
@@ -2214,7 +2669,12 @@ func NewInlineLinkAttributes(attributes []interface{}) (Attributes, error) {
 		switch attr := attr.(type) {
 		case Attributes:
 			for k, v := range attr {
-				result[k] = v
+				switch k {
+				case AttrOptions, AttrOpts: // eg: `opts=nofollow`
+					result.addOptions(v)
+				default:
+					result[k] = v
+				}
 			}
 		case []interface{}:
 			result["positional-"+strconv.Itoa(i+1)] = attr
@@ -2547,21 +3007,29 @@ func (l Location) Stringify() string { // (attrs map[string]string) string {
 	result := bytes.NewBuffer(nil)
 	result.WriteString(l.Scheme)
 	for i, e := range l.Path {
-		if s, ok := e.(string); ok {
-			result.WriteString(s) // no need to use `fmt.Sprintf` for elements of type 'string'
-		} else if s, ok := e.(StringElement); ok {
-			result.WriteString(s.Content) // no need to use `fmt.Sprintf` for elements of type 'string'
-		} else {
+		switch e := e.(type) {
+		case string:
+			result.WriteString(e) // no need to use `fmt.Sprintf` for elements of type 'string'
+		case StringElement:
+			result.WriteString(e.Content) // no need to use `fmt.Sprintf` for elements of type 'string'
+		case SpecialCharacter:
+			result.WriteString(e.Name) // eg: `&` in the query string
+		default:
 			result.WriteString(fmt.Sprintf("%s", e))
 		}
-		// include a "/" separator after each path element
-		if i < len(l.Path)-1 {
+		// include a "/" separator after each path element, except around special characters which are part of the path
+		if i < len(l.Path)-1 && !isSpecialCharacter(e) && !isSpecialCharacter(l.Path[i+1]) {
 			result.WriteString("/")
 		}
 	}
 	return result.String()
 }
 
+func isSpecialCharacter(e interface{}) bool {
+	_, ok := e.(SpecialCharacter)
+	return ok
+}
+
 const imagesdir = "imagesdir"
 
 // Resolve resolves the Location by replacing all document attribute substitutions
@@ -2700,6 +3168,19 @@ func NewSpecialCharacter(name string) (SpecialCharacter, error) {
 	}, nil
 }
 
+// CharacterReference a named or numeric character reference (eg: `&loz;`, `&#169;` or `&#x2022;`),
+// which is rendered as-is
+type CharacterReference struct {
+	Name string
+}
+
+// NewCharacterReference returns a new CharacterReference with the given name (eg: `loz`, `#169` or `#x2022`)
+func NewCharacterReference(name string) (CharacterReference, error) {
+	return CharacterReference{
+		Name: name,
+	}, nil
+}
+
 // ------------------------------------------------------------------------------------
 // ElementPlaceHolder
 // They need to be identified as they may have a special treatment during the rendering
@@ -0,0 +1,119 @@
+package validator
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+)
+
+// Rule a rule to verify when linting a document
+type Rule struct {
+	// Name the unique name of the rule (eg: `broken-xref`)
+	Name string
+	// Description a short description of the rule
+	Description string
+	// Check verifies the given target and returns the problems that were found
+	Check func(target LintTarget) []Problem
+}
+
+// LintTarget the document to lint, along with its raw source and the configuration used to parse it
+type LintTarget struct {
+	Config configuration.Configuration
+	Source []byte
+	// Document the parsed document, or `nil` if the source could not be parsed
+	Document *types.Document
+	// Diagnostics the diagnostics reported while parsing the document
+	Diagnostics []types.Diagnostic
+}
+
+// ParseErrorRule the name of the rule used to report a document which could not be parsed
+const ParseErrorRule = "parse-error"
+
+// Lint parses the document read from the given reader and verifies it against the given rules.
+// Rules which require a parsed document are skipped if the source could not be parsed.
+// The diagnostics reported by the parser are also returned as problems, unless their code
+// is the name of a rule, in which case they are left to this rule (if it was given).
+func Lint(r io.Reader, config configuration.Configuration, rules ...Rule) ([]Problem, error) {
+	source, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read document to lint")
+	}
+	var diagnostics []types.Diagnostic
+	config.Diagnostics = func(d types.Diagnostic) {
+		diagnostics = append(diagnostics, d)
+	}
+	target := LintTarget{
+		Config: config,
+		Source: source,
+	}
+	doc, parseErr := parser.ParseDocument(bytes.NewReader(source), config)
+	if parseErr == nil {
+		target.Document = &doc
+	}
+	target.Diagnostics = diagnostics
+	problems := []Problem{}
+	for _, rule := range rules {
+		log.Debugf("applying lint rule '%s'", rule.Name)
+		for _, p := range rule.Check(target) {
+			p.Rule = rule.Name
+			problems = append(problems, p)
+		}
+	}
+	names := map[string]bool{}
+	for _, rule := range append(DefaultRules(), rules...) {
+		names[rule.Name] = true
+	}
+	var parseErrLine int
+	for _, d := range target.Diagnostics {
+		switch {
+		case d.Code == types.ParseErrorCode:
+			parseErrLine = d.Line
+		case !names[d.Code]:
+			problems = append(problems, Problem{
+				Severity: d.Severity,
+				Message:  d.Message,
+				Rule:     d.Code,
+				Filename: d.Filename,
+				Line:     d.Line,
+			})
+		}
+	}
+	// report the parse error, unless a rule already reported it (eg: a missing file to include)
+	if parseErr != nil && !reported(problems, parseErr.Error()) {
+		problems = append(problems, Problem{
+			Severity: Error,
+			Message:  parseErr.Error(),
+			Rule:     ParseErrorRule,
+			Filename: config.Filename,
+			Line:     parseErrLine,
+		})
+	}
+	return problems, nil
+}
+
+func reported(problems []Problem, msg string) bool {
+	for _, p := range problems {
+		if p.Message == msg {
+			return true
+		}
+	}
+	return false
+}
+
+// AtLeast returns the problems whose severity is at least the given one
+func AtLeast(problems []Problem, severity Severity) []Problem {
+	result := []Problem{}
+	for _, p := range problems {
+		if p.Severity.Rank() >= severity.Rank() {
+			result = append(result, p)
+		}
+	}
+	return result
+}
@@ -0,0 +1,443 @@
+package validator
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/parser"
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// DefaultRules returns all the built-in lint rules
+func DefaultRules() []Rule {
+	return []Rule{
+		BrokenCrossReferencesRule,
+		DuplicateIDsRule,
+		SkippedSectionLevelsRule,
+		UnresolvedAttributesRule,
+		MissingImagesRule,
+		UnusedCalloutsRule,
+		EmptySectionsRule,
+		MissingIncludesRule,
+	}
+}
+
+// BrokenCrossReferencesRule reports the internal cross references to unknown elements
+var BrokenCrossReferencesRule = Rule{
+	Name:        "broken-xref",
+	Description: "internal cross references must target an existing element",
+	Check: func(target LintTarget) []Problem {
+		if target.Document == nil {
+			return nil
+		}
+		ids := map[string]bool{}
+		for id := range target.Document.ElementReferences {
+			ids[id] = true
+		}
+		walkDocument(target.Document, func(element interface{}) bool {
+			if attrs, ok := attributesOf(element); ok {
+				if id, found := attrs.GetAsString(types.AttrID); found {
+					ids[id] = true
+				}
+			}
+			return true
+		})
+		problems := []Problem{}
+		walkDocument(target.Document, func(element interface{}) bool {
+			if xref, ok := element.(types.InternalCrossReference); ok && !ids[xref.ID] {
+				problems = append(problems, Problem{
+					Severity: Warning,
+					Message:  fmt.Sprintf("cross reference to unknown element '%s'", xref.ID),
+				}.at(xref.Position))
+			}
+			return true
+		})
+		return problems
+	},
+}
+
+// matches the IDs of the sections (and discrete headings) which were renamed because of a conflict with another section
+var renamedSectionID = regexp.MustCompile(`^(.+)_([0-9]+)$`)
+
+// DuplicateIDsRule reports the elements which share the same ID
+var DuplicateIDsRule = Rule{
+	Name:        "duplicate-id",
+	Description: "element IDs must be unique",
+	Check: func(target LintTarget) []Problem {
+		if target.Document == nil {
+			return nil
+		}
+		problems := []Problem{}
+		ids := map[string]bool{}
+		walkDocument(target.Document, func(element interface{}) bool {
+			attrs, ok := attributesOf(element)
+			if !ok {
+				return true
+			}
+			id, found := attrs.GetAsString(types.AttrID)
+			if !found {
+				return true
+			}
+			switch element.(type) {
+			case types.Section, types.DiscreteHeading:
+				// sections with conflicting IDs are renamed during the parsing (eg: `foo` becomes `foo_2`),
+				// but this is only a problem when the IDs are custom, not generated from the title
+				if !attrs.GetAsBool(types.AttrCustomID) {
+					return true
+				}
+				if m := renamedSectionID.FindStringSubmatch(id); m != nil && ids[m[1]] {
+					id = m[1]
+				}
+			}
+			if ids[id] {
+				problems = append(problems, Problem{
+					Severity: Error,
+					Message:  fmt.Sprintf("duplicate ID '%s'", id),
+				}.at(positionOf(element, types.Position{})))
+			}
+			ids[id] = true
+			return true
+		})
+		return problems
+	},
+}
+
+// SkippedSectionLevelsRule reports the sections whose level is not immediately below the level of their parent
+var SkippedSectionLevelsRule = Rule{
+	Name:        "skipped-section-level",
+	Description: "section levels must not be skipped",
+	Check: func(target LintTarget) []Problem {
+		if target.Document == nil {
+			return nil
+		}
+		return checkSectionLevels(target.Document.Elements, 0)
+	},
+}
+
+func checkSectionLevels(elements []interface{}, expected int) []Problem {
+	problems := []Problem{}
+	for _, element := range elements {
+		s, ok := element.(types.Section)
+		if !ok {
+			continue
+		}
+		if s.Level > expected && !(expected == 0 && s.Level == 1) {
+			problems = append(problems, Problem{
+				Severity: Warning,
+				Message:  fmt.Sprintf("section '%s' has level %d but level %d was expected", plainText(s.Title), s.Level, max(expected, 1)),
+			}.at(s.Position))
+		}
+		problems = append(problems, checkSectionLevels(s.Elements, s.Level+1)...)
+	}
+	return problems
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// matches the attribute references in the location of the files to include
+var attributeReference = regexp.MustCompile(`\{([\w][\w-]*)\}`)
+
+// UnresolvedAttributesRule reports the references to undefined attributes
+var UnresolvedAttributesRule = Rule{
+	Name:        "unresolved-attribute",
+	Description: "attribute references must refer to a defined attribute",
+	Check: func(target LintTarget) []Problem {
+		problems := []Problem{}
+		for _, d := range target.Diagnostics {
+			if d.Code != types.UnresolvedAttributeCode {
+				continue
+			}
+			problems = append(problems, Problem{
+				Severity: Warning,
+				Message:  d.Message,
+				Filename: d.Filename,
+				Line:     d.Line,
+			})
+		}
+		return problems
+	},
+}
+
+// MissingImagesRule reports the local images which do not exist
+var MissingImagesRule = Rule{
+	Name:        "missing-image",
+	Description: "local images must exist",
+	Check: func(target LintTarget) []Problem {
+		if target.Document == nil {
+			return nil
+		}
+		problems := []Problem{}
+		dir := filepath.Dir(target.Config.Filename)
+		check := func(location types.Location, position types.Position) {
+			if location.Scheme != "" {
+				return
+			}
+			path := location.Stringify()
+			if strings.Contains(path, "://") || strings.HasPrefix(path, "data:") {
+				return
+			}
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(dir, path)
+			}
+			if _, err := os.Stat(path); err != nil {
+				log.Debugf("unable to stat image '%s': %v", path, err)
+				problems = append(problems, Problem{
+					Severity: Warning,
+					Message:  fmt.Sprintf("image '%s' does not exist", location.Stringify()),
+				}.at(position))
+			}
+		}
+		walkDocument(target.Document, func(element interface{}) bool {
+			switch e := element.(type) {
+			case types.ImageBlock:
+				check(e.Location, e.Position)
+			case types.InlineImage:
+				check(e.Location, e.Position)
+			}
+			return true
+		})
+		return problems
+	},
+}
+
+// UnusedCalloutsRule reports the callouts without a matching item in the callout list which follows the block,
+// and vice-versa
+var UnusedCalloutsRule = Rule{
+	Name:        "unused-callout",
+	Description: "callouts and callout list items must match",
+	Check: func(target LintTarget) []Problem {
+		if target.Document == nil {
+			return nil
+		}
+		problems := []Problem{}
+		walkDocument(target.Document, func(element interface{}) bool {
+			if elements, ok := childElements(element); ok {
+				problems = append(problems, checkCallouts(elements)...)
+			}
+			return true
+		})
+		problems = append(problems, checkCallouts(target.Document.Elements)...)
+		return problems
+	},
+}
+
+// childElements returns the blocks contained in the given element
+func childElements(element interface{}) ([]interface{}, bool) {
+	switch e := element.(type) {
+	case types.Section:
+		return e.Elements, true
+	case types.Preamble:
+		return e.Elements, true
+	case types.ExampleBlock:
+		return e.Elements, true
+	case types.QuoteBlock:
+		return e.Elements, true
+	case types.SidebarBlock:
+		return e.Elements, true
+	case types.OpenBlock:
+		return e.Elements, true
+	case types.OrderedListItem:
+		return e.Elements, true
+	case types.UnorderedListItem:
+		return e.Elements, true
+	case types.LabeledListItem:
+		return e.Elements, true
+	default:
+		return nil, false
+	}
+}
+
+func checkCallouts(elements []interface{}) []Problem {
+	problems := []Problem{}
+	var refs []int
+	for _, element := range elements {
+		if list, ok := element.(types.CalloutList); ok {
+			items := map[int]bool{}
+			for _, item := range list.Items {
+				items[item.Ref] = true
+				if !contains(refs, item.Ref) {
+					problems = append(problems, Problem{
+						Severity: Warning,
+						Message:  fmt.Sprintf("callout list item <%d> has no matching callout", item.Ref),
+					})
+				}
+			}
+			for _, ref := range refs {
+				if !items[ref] {
+					problems = append(problems, Problem{
+						Severity: Warning,
+						Message:  fmt.Sprintf("callout <%d> has no matching callout list item", ref),
+					})
+				}
+			}
+			refs = nil
+			continue
+		}
+		if callouts := calloutsIn(element); len(callouts) > 0 {
+			refs = append(refs, callouts...)
+		}
+	}
+	for _, ref := range refs {
+		problems = append(problems, Problem{
+			Severity: Warning,
+			Message:  fmt.Sprintf("callout <%d> has no matching callout list item", ref),
+		})
+	}
+	return problems
+}
+
+func calloutsIn(element interface{}) []int {
+	var lines [][]interface{}
+	switch e := element.(type) {
+	case types.ListingBlock:
+		lines = e.Lines
+	case types.FencedBlock:
+		lines = e.Lines
+	case types.LiteralBlock:
+		lines = e.Lines
+	default:
+		return nil
+	}
+	refs := []int{}
+	walkLines(lines, func(element interface{}) bool {
+		if c, ok := element.(types.Callout); ok {
+			refs = append(refs, c.Ref)
+		}
+		return true
+	})
+	return refs
+}
+
+func contains(refs []int, ref int) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// EmptySectionsRule reports the sections without any content
+var EmptySectionsRule = Rule{
+	Name:        "empty-section",
+	Description: "sections must not be empty",
+	Check: func(target LintTarget) []Problem {
+		if target.Document == nil {
+			return nil
+		}
+		problems := []Problem{}
+		walkDocument(target.Document, func(element interface{}) bool {
+			if s, ok := element.(types.Section); ok && s.Level > 0 && len(s.Elements) == 0 {
+				problems = append(problems, Problem{
+					Severity: Warning,
+					Message:  fmt.Sprintf("section '%s' is empty", plainText(s.Title)),
+				}.at(s.Position))
+			}
+			return true
+		})
+		return problems
+	},
+}
+
+// MissingIncludesRule reports the file inclusions whose target does not exist,
+// in the linted document and in the Asciidoc files that it includes
+var MissingIncludesRule = Rule{
+	Name:        "missing-include",
+	Description: "files to include must exist",
+	Check: func(target LintTarget) []Problem {
+		attrs := map[string]string{}
+		for k, v := range target.Config.AttributeOverrides {
+			attrs[k] = v
+		}
+		return checkIncludes(target.Config.Filename, target.Source, attrs, map[string]bool{}, target.Config.MaxIncludeDepth)
+	},
+}
+
+// checkIncludes verifies the file inclusions of the given source, then the ones of the Asciidoc files that it includes,
+// until the given maximum depth is reached (`0` means no limit). The files which are already being verified
+// (ie, the files which include themselves, directly or not) are skipped.
+func checkIncludes(filename string, source []byte, attrs map[string]string, including map[string]bool, maxDepth int) []Problem {
+	if maxDepth > 0 && len(including) >= maxDepth {
+		return nil // reported by the parser
+	}
+	lines, err := parser.ParseReader(filename, bytes.NewReader(source), parser.Entrypoint("RawSource"))
+	if err != nil {
+		return nil // will be reported as a parse error
+	}
+	l, ok := lines.([]interface{})
+	if !ok {
+		return nil
+	}
+	including[filename] = true
+	defer delete(including, filename)
+	problems := []Problem{}
+	dir := filepath.Dir(filename)
+	for i, line := range l {
+		switch line := line.(type) {
+		case types.AttributeDeclaration:
+			attrs[line.Name] = line.Value
+		case types.FileInclusion:
+			path := attributeReference.ReplaceAllStringFunc(line.Location.Stringify(), func(ref string) string {
+				if value, found := attrs[ref[1:len(ref)-1]]; found {
+					return value
+				}
+				return ref
+			})
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(dir, path)
+			}
+			content, err := ioutil.ReadFile(path)
+			if err != nil {
+				problems = append(problems, Problem{
+					Severity: Error,
+					Message:  parser.UnresolvedDirectiveError{Filename: filename, RawText: line.RawText}.Error(),
+					Filename: filename,
+					Line:     i + 1, // each raw line is parsed into a single element
+				})
+				continue
+			}
+			if path, err = filepath.Abs(path); err == nil && parser.IsAsciidoc(path) && !including[path] {
+				problems = append(problems, checkIncludes(path, content, attrs, including, maxDepth)...)
+			}
+		}
+	}
+	return problems
+}
+
+// walkDocument traverses the elements and the footnotes of the given document
+func walkDocument(doc *types.Document, visit visitor) {
+	walk(doc.Elements, visit)
+	for _, f := range doc.Footnotes {
+		walkElement(f, visit)
+	}
+}
+
+// plainText returns the text content of the given elements, without any formatting
+func plainText(elements []interface{}) string {
+	result := &strings.Builder{}
+	walk(elements, func(element interface{}) bool {
+		switch e := element.(type) {
+		case types.StringElement:
+			result.WriteString(e.Content)
+		case types.SpecialCharacter:
+			result.WriteString(e.Name)
+		case types.Callout:
+			result.WriteString("<" + strconv.Itoa(e.Ref) + ">")
+		}
+		return true
+	})
+	return strings.TrimSpace(result.String())
+}
@@ -0,0 +1,375 @@
+package validator_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bytesparadise/libasciidoc/pkg/configuration"
+	"github.com/bytesparadise/libasciidoc/pkg/validator"
+
+	. "github.com/onsi/ginkgo" //nolint golint
+	. "github.com/onsi/gomega" //nolint golint
+)
+
+var _ = Describe("document linter", func() {
+
+	lint := func(source string, rules ...validator.Rule) []validator.Problem {
+		config := configuration.NewConfiguration(configuration.WithFilename("test.adoc"))
+		problems, err := validator.Lint(strings.NewReader(source), config, rules...)
+		Expect(err).NotTo(HaveOccurred())
+		return problems
+	}
+
+	It("should not report problems", func() {
+		source := `= Title
+
+== Section A
+
+see <<_section_b>> and <<anchor>>
+
+[[anchor]]
+a paragraph
+
+== Section B
+
+[source]
+----
+foo <1>
+----
+<1> a callout`
+		Expect(lint(source, validator.DefaultRules()...)).To(BeEmpty())
+	})
+
+	It("should report broken internal cross references", func() {
+		source := `see <<unknown>>`
+		Expect(lint(source, validator.BrokenCrossReferencesRule)).To(Equal([]validator.Problem{
+			{
+				Severity: validator.Warning,
+				Message:  "cross reference to unknown element 'unknown'",
+				Rule:     "broken-xref",
+				Filename: "test.adoc",
+				Line:     1,
+			},
+		}))
+	})
+
+	It("should report duplicate IDs", func() {
+		source := `[[foo]]
+== Section A
+
+content
+
+[[foo]]
+== Section B
+
+content
+
+[#bar]
+a paragraph
+
+[#bar]
+another paragraph`
+		Expect(lint(source, validator.DuplicateIDsRule)).To(Equal([]validator.Problem{
+			{
+				Severity: validator.Error,
+				Message:  "duplicate ID 'foo'",
+				Rule:     "duplicate-id",
+				Filename: "test.adoc",
+				Line:     7,
+			},
+			{
+				Severity: validator.Error,
+				Message:  "duplicate ID 'bar'",
+				Rule:     "duplicate-id",
+			},
+		}))
+	})
+
+	It("should not report sections with the same title", func() {
+		source := `== Section
+
+content
+
+== Section
+
+content`
+		Expect(lint(source, validator.DuplicateIDsRule)).To(BeEmpty())
+	})
+
+	It("should report skipped section levels", func() {
+		source := `= Title
+
+=== Section A
+
+content
+
+== Section B
+
+==== Section B.1
+
+content`
+		Expect(lint(source, validator.SkippedSectionLevelsRule)).To(Equal([]validator.Problem{
+			{
+				Severity: validator.Warning,
+				Message:  "section 'Section A' has level 2 but level 1 was expected",
+				Rule:     "skipped-section-level",
+				Filename: "test.adoc",
+				Line:     3,
+			},
+			{
+				Severity: validator.Warning,
+				Message:  "section 'Section B.1' has level 3 but level 2 was expected",
+				Rule:     "skipped-section-level",
+				Filename: "test.adoc",
+				Line:     9,
+			},
+		}))
+	})
+
+	It("should report duplicate IDs on discrete headings", func() {
+		source := `[[foo]]
+== Section A
+
+[discrete#foo]
+=== Heading`
+		Expect(lint(source, validator.DuplicateIDsRule)).To(Equal([]validator.Problem{
+			{
+				Severity: validator.Error,
+				Message:  "duplicate ID 'foo'",
+				Rule:     "duplicate-id",
+			},
+		}))
+	})
+
+	It("should not report skipped section levels on discrete headings", func() {
+		source := `= Title
+
+== Section A
+
+[discrete]
+==== Heading
+
+content
+
+=== Section A.1
+
+content`
+		Expect(lint(source, validator.SkippedSectionLevelsRule)).To(BeEmpty())
+	})
+
+	It("should report unresolved attributes", func() {
+		source := `:foo: bar
+
+{foo} and {unknown}
+
+----
+{ignored}
+----`
+		Expect(lint(source, validator.UnresolvedAttributesRule)).To(Equal([]validator.Problem{
+			{
+				Severity: validator.Warning,
+				Message:  "unable to find attribute 'unknown'",
+				Rule:     "unresolved-attribute",
+				Filename: "test.adoc",
+				Line:     3,
+			},
+		}))
+	})
+
+	It("should report unused callouts", func() {
+		source := `----
+foo <1>
+bar <2>
+----
+<1> first
+<3> third`
+		Expect(lint(source, validator.UnusedCalloutsRule)).To(Equal([]validator.Problem{
+			{
+				Severity: validator.Warning,
+				Message:  "callout list item <3> has no matching callout",
+				Rule:     "unused-callout",
+			},
+			{
+				Severity: validator.Warning,
+				Message:  "callout <2> has no matching callout list item",
+				Rule:     "unused-callout",
+			},
+		}))
+	})
+
+	It("should report empty sections", func() {
+		source := `= Title
+
+== Section A
+
+== Section B
+
+content`
+		Expect(lint(source, validator.EmptySectionsRule)).To(Equal([]validator.Problem{
+			{
+				Severity: validator.Warning,
+				Message:  "section 'Section A' is empty",
+				Rule:     "empty-section",
+				Filename: "test.adoc",
+				Line:     3,
+			},
+		}))
+	})
+
+	It("should report parser diagnostics", func() {
+		source := `= Title
+
+{unknown}
+
+[cols="1,1"]
+|===
+|a |b |c
+|===`
+		// unresolved attributes are only reported by their own rule
+		Expect(lint(source, validator.BrokenCrossReferencesRule)).To(Equal([]validator.Problem{
+			{
+				Severity: validator.Warning,
+				Message:  "dropping 1 cell(s) from incomplete table line",
+				Rule:     "invalid-table-cells",
+				Filename: "test.adoc",
+				Line:     5,
+			},
+		}))
+	})
+
+	Context("with files", func() {
+
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "libasciidoc-lint")
+			Expect(err).NotTo(HaveOccurred())
+			err = ioutil.WriteFile(filepath.Join(dir, "existing.png"), []byte{}, 0644)
+			Expect(err).NotTo(HaveOccurred())
+			err = ioutil.WriteFile(filepath.Join(dir, "existing.adoc"), []byte("included content"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		lintFile := func(source string, rules ...validator.Rule) []validator.Problem {
+			config := configuration.NewConfiguration(configuration.WithFilename(filepath.Join(dir, "test.adoc")))
+			problems, err := validator.Lint(strings.NewReader(source), config, rules...)
+			Expect(err).NotTo(HaveOccurred())
+			return problems
+		}
+
+		It("should report missing images", func() {
+			source := `image::existing.png[]
+
+image::missing.png[]
+
+an image:missing-inline.png[] and an image:https://example.com/remote.png[]`
+			Expect(lintFile(source, validator.MissingImagesRule)).To(Equal([]validator.Problem{
+				{
+					Severity: validator.Warning,
+					Message:  "image 'missing.png' does not exist",
+					Rule:     "missing-image",
+					Filename: filepath.Join(dir, "test.adoc"),
+					Line:     3,
+				},
+				{
+					Severity: validator.Warning,
+					Message:  "image 'missing-inline.png' does not exist",
+					Rule:     "missing-image",
+					Filename: filepath.Join(dir, "test.adoc"),
+					Line:     5,
+				},
+			}))
+		})
+
+		It("should report missing includes only once", func() {
+			source := `:includedir: .
+
+include::{includedir}/existing.adoc[]
+
+include::{includedir}/missing.adoc[]`
+			Expect(lintFile(source, validator.MissingIncludesRule)).To(Equal([]validator.Problem{
+				{
+					Severity: validator.Error,
+					Message:  "Unresolved directive in " + filepath.Join(dir, "test.adoc") + " - include::{includedir}/missing.adoc[]",
+					Rule:     "missing-include",
+					Filename: filepath.Join(dir, "test.adoc"),
+					Line:     5,
+				},
+			}))
+		})
+
+		It("should report missing includes in delimited blocks and in included files", func() {
+			err := ioutil.WriteFile(filepath.Join(dir, "nested.adoc"), []byte("nested content\n\ninclude::missing.adoc[]"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+			source := `----
+include::missing.go[]
+----
+
+include::nested.adoc[]`
+			Expect(lintFile(source, validator.MissingIncludesRule)).To(Equal([]validator.Problem{
+				{
+					Severity: validator.Error,
+					Message:  "Unresolved directive in " + filepath.Join(dir, "test.adoc") + " - include::missing.go[]",
+					Rule:     "missing-include",
+					Filename: filepath.Join(dir, "test.adoc"),
+					Line:     2,
+				},
+				{
+					Severity: validator.Error,
+					Message:  "Unresolved directive in " + filepath.Join(dir, "nested.adoc") + " - include::missing.adoc[]",
+					Rule:     "missing-include",
+					Filename: filepath.Join(dir, "nested.adoc"),
+					Line:     3,
+				},
+			}))
+		})
+
+		It("should report parse error", func() {
+			source := `include::{includedir}/missing.adoc[]`
+			Expect(lintFile(source)).To(Equal([]validator.Problem{
+				{
+					Severity: validator.Error,
+					Message:  "Unresolved directive in " + filepath.Join(dir, "test.adoc") + " - include::{includedir}/missing.adoc[]",
+					Rule:     validator.ParseErrorRule,
+					Filename: filepath.Join(dir, "test.adoc"),
+				},
+			}))
+		})
+	})
+
+	Context("severities", func() {
+
+		It("should filter problems by severity", func() {
+			problems := []validator.Problem{
+				{Severity: validator.Warning, Message: "a warning"},
+				{Severity: validator.Error, Message: "an error"},
+			}
+			Expect(validator.AtLeast(problems, validator.Error)).To(Equal([]validator.Problem{
+				{Severity: validator.Error, Message: "an error"},
+			}))
+			Expect(validator.AtLeast(problems, validator.Warning)).To(Equal(problems))
+		})
+
+		It("should report problems as an error", func() {
+			problems := validator.Problems{
+				{Severity: validator.Warning, Message: "a warning"},
+				{Severity: validator.Error, Message: "an error"},
+			}
+			Expect(problems).To(MatchError("2 problem(s) found: warning: a warning; error: an error"))
+		})
+
+		It("should parse severities", func() {
+			Expect(validator.ParseSeverity("WARN")).To(Equal(validator.Warning))
+			Expect(validator.ParseSeverity("error")).To(Equal(validator.Error))
+			_, err := validator.ParseSeverity("fatal")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
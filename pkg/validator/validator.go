@@ -1,9 +1,11 @@
 package validator
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/bytesparadise/libasciidoc/pkg/types"
+	"github.com/pkg/errors"
 )
 
 // Validate validates the given document
@@ -13,27 +15,60 @@ func Validate(doc *types.Document) []Problem {
 	if doctype, found := doc.Attributes.GetAsString(types.AttrDocType); found && doctype == "manpage" {
 		problems = append(problems, validateManpage(doc)...)
 	}
+	problems = append(problems, validateCallouts(doc)...)
 	return problems
 }
 
 // Problem a problem detected during validation
 // Must have a severity and an associated message
-// TODO: include element position once available in the AST.
 type Problem struct {
 	Severity Severity
 	Message  string
+	Rule     string // the name of the rule which reported the problem (optional)
+	Filename string // the source file in which the problem was found (optional)
+	Line     int    // the line at which the problem was found in the source file, or `0` if unknown
+}
+
+// at returns a copy of this problem with the given position in the source
+func (p Problem) at(position types.Position) Problem {
+	p.Filename = position.Filename
+	p.Line = position.Line
+	return p
+}
+
+// Problems the problems found in a document, which caused its conversion to fail
+type Problems []Problem
+
+func (p Problems) Error() string {
+	msgs := make([]string, len(p))
+	for i, problem := range p {
+		msgs[i] = fmt.Sprintf("%s: %s", strings.ToLower(string(problem.Severity)), problem.Message)
+	}
+	return fmt.Sprintf("%d problem(s) found: %s", len(p), strings.Join(msgs, "; "))
 }
 
 // Severity the problem severity
-type Severity string
+type Severity = types.Severity
 
 const (
 	// Error the severity level for errors.
-	Error Severity = "Error"
+	Error Severity = types.SeverityError
 	// Warning the severity level for warning
-	Warning Severity = "Warning"
+	Warning Severity = types.SeverityWarning
 )
 
+// ParseSeverity returns the severity matching the given (case insensitive) value
+func ParseSeverity(value string) (Severity, error) {
+	switch strings.ToLower(value) {
+	case "error":
+		return Error, nil
+	case "warning", "warn":
+		return Warning, nil
+	default:
+		return "", errors.Errorf("unknown severity '%s'", value)
+	}
+}
+
 // validateManpage checks that the document has the expected structure, ie:
 // A document header
 // a section named `Name` (case insensitive) with a single paragraph
@@ -43,26 +78,27 @@ const (
 func validateManpage(doc *types.Document) []Problem {
 	problems := []Problem{}
 	// checks the presence of a header
+	// (problems are reported at the position of the unexpected section, or of the enclosing section)
 	if header, ok := assertThatElement(doc.Elements[0]).isHeader(); !ok {
 		problems = append(problems, Problem{
 			Severity: Error,
 			Message:  "manpage document is missing a header",
-		})
+		}.at(positionOf(doc.Elements[0], types.Position{})))
 	} else if nameSection, ok := assertThatElement(header.Elements[0]).isSection(withLevel(1), withTitle("name")); !ok {
 		problems = append(problems, Problem{
 			Severity: Error,
 			Message:  "manpage document is missing the 'Name' section'",
-		})
+		}.at(positionOf(header.Elements[0], header.Position)))
 	} else if ok := assertThatElements(nameSection.Elements).haveCount(1); !ok {
 		problems = append(problems, Problem{
 			Severity: Error,
 			Message:  "'Name' section' should contain a single paragraph",
-		})
+		}.at(nameSection.Position))
 	} else if _, ok := assertThatElement(header.Elements[1]).isSection(withLevel(1), withTitle("synopsis")); !ok {
 		problems = append(problems, Problem{
 			Severity: Error,
 			Message:  "manpage document is missing the 'Synopsis' section'",
-		})
+		}.at(positionOf(header.Elements[1], header.Position)))
 	}
 	// if any problem found, change the doctype to render the document as a regular article
 	if len(problems) > 0 {
@@ -71,6 +107,25 @@ func validateManpage(doc *types.Document) []Problem {
 	return problems
 }
 
+// validateCallouts checks that every callout in a listing has a matching item in the callout list
+// which follows the block, and vice-versa
+func validateCallouts(doc *types.Document) []Problem {
+	problems := []Problem{}
+	for _, p := range UnusedCalloutsRule.Check(LintTarget{Document: doc}) {
+		p.Rule = UnusedCalloutsRule.Name
+		problems = append(problems, p)
+	}
+	return problems
+}
+
+// positionOf returns the position of the given element if it is a section, or the given default position otherwise
+func positionOf(element interface{}, defaultPosition types.Position) types.Position {
+	if s, ok := element.(types.Section); ok {
+		return s.Position
+	}
+	return defaultPosition
+}
+
 // assert performs a set of assertions on a given element
 func assertThatElement(element interface{}) elementAssertion {
 	return elementAssertion{
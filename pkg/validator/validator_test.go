@@ -38,6 +38,68 @@ var _ = Describe("document validator", func() {
 		})
 	})
 
+	Context("callouts", func() {
+
+		It("should report callout without matching list item", func() {
+			// given
+			doc := types.Document{
+				Attributes:        types.Attributes{},
+				ElementReferences: types.ElementReferences{},
+				Footnotes:         []types.Footnote{},
+				Elements: []interface{}{
+					types.ListingBlock{
+						Lines: [][]interface{}{
+							{
+								types.StringElement{
+									Content: "import java.util.List; ",
+								},
+								types.Callout{
+									Ref: 1,
+								},
+							},
+							{
+								types.StringElement{
+									Content: "List<String> items; ",
+								},
+								types.Callout{
+									Ref: 2,
+								},
+							},
+						},
+					},
+					types.CalloutList{
+						Items: []types.CalloutListItem{
+							{
+								Ref: 1,
+								Elements: []interface{}{
+									types.Paragraph{
+										Lines: [][]interface{}{
+											{
+												types.StringElement{
+													Content: "the import",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			// when
+			problems := Validate(&doc)
+
+			// then
+			Expect(problems).To(ConsistOf(Problem{
+				Severity: Warning,
+				Message:  "callout <2> has no matching callout list item",
+				Rule:     "unused-callout",
+			}))
+		})
+	})
+
 	Context("manpage", func() {
 
 		It("should not report problems", func() {
@@ -0,0 +1,166 @@
+package validator
+
+import (
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+// visitor a func called on each element of the document.
+// Returns `false` if the children of the given element should be skipped.
+type visitor func(element interface{}) bool
+
+// walk traverses the given elements (depth-first) and calls the visitor on each one of them and on their children
+func walk(elements []interface{}, visit visitor) {
+	for _, element := range elements {
+		walkElement(element, visit)
+	}
+}
+
+func walkLines(lines [][]interface{}, visit visitor) {
+	for _, line := range lines {
+		walk(line, visit)
+	}
+}
+
+func walkCells(cells []types.TableCell, visit visitor) {
+	for _, cell := range cells {
+		walk(cell.Elements, visit)
+	}
+}
+
+func walkElement(element interface{}, visit visitor) {
+	if !visit(element) {
+		return
+	}
+	switch e := element.(type) {
+	case []interface{}:
+		walk(e, visit)
+	case types.Section:
+		walk(e.Title, visit)
+		walk(e.Elements, visit)
+	case types.DiscreteHeading:
+		walk(e.Title, visit)
+	case types.Preamble:
+		walk(e.Elements, visit)
+	case types.Paragraph:
+		walkLines(e.Lines, visit)
+	case types.ExampleBlock:
+		walk(e.Elements, visit)
+	case types.QuoteBlock:
+		walk(e.Elements, visit)
+	case types.SidebarBlock:
+		walk(e.Elements, visit)
+	case types.OpenBlock:
+		walk(e.Elements, visit)
+	case types.ListingBlock:
+		walkLines(e.Lines, visit)
+	case types.FencedBlock:
+		walkLines(e.Lines, visit)
+	case types.LiteralBlock:
+		walkLines(e.Lines, visit)
+	case types.VerseBlock:
+		walkLines(e.Lines, visit)
+	case types.MarkdownQuoteBlock:
+		walkLines(e.Lines, visit)
+	case types.PassthroughBlock:
+		walkLines(e.Lines, visit)
+	case types.StemBlock:
+		walkLines(e.Lines, visit)
+	case types.CommentBlock:
+		walkLines(e.Lines, visit)
+	case types.OrderedList:
+		for _, item := range e.Items {
+			walkElement(item, visit)
+		}
+	case types.OrderedListItem:
+		walk(e.Elements, visit)
+	case types.UnorderedList:
+		for _, item := range e.Items {
+			walkElement(item, visit)
+		}
+	case types.UnorderedListItem:
+		walk(e.Elements, visit)
+	case types.LabeledList:
+		for _, item := range e.Items {
+			walkElement(item, visit)
+		}
+	case types.LabeledListItem:
+		walk(e.Term, visit)
+		walk(e.Elements, visit)
+	case types.CalloutList:
+		for _, item := range e.Items {
+			walkElement(item, visit)
+		}
+	case types.CalloutListItem:
+		walk(e.Elements, visit)
+	case types.ContinuedListItemElement:
+		walkElement(e.Element, visit)
+	case types.Table:
+		walkCells(e.Header.Cells, visit)
+		for _, l := range e.Lines {
+			walkCells(l.Cells, visit)
+		}
+		walkCells(e.Footer.Cells, visit)
+	case types.QuotedText:
+		walk(e.Elements, visit)
+	case types.QuotedString:
+		walk(e.Elements, visit)
+	case types.Footnote:
+		walk(e.Elements, visit)
+	}
+}
+
+// attributesOf returns the attributes of the given element, if applicable
+func attributesOf(element interface{}) (types.Attributes, bool) {
+	switch e := element.(type) {
+	case types.Attributes: // inline element ID
+		return e, true
+	case types.Section:
+		return e.Attributes, true
+	case types.DiscreteHeading:
+		return e.Attributes, true
+	case types.Paragraph:
+		return e.Attributes, true
+	case types.ExampleBlock:
+		return e.Attributes, true
+	case types.QuoteBlock:
+		return e.Attributes, true
+	case types.SidebarBlock:
+		return e.Attributes, true
+	case types.OpenBlock:
+		return e.Attributes, true
+	case types.ListingBlock:
+		return e.Attributes, true
+	case types.FencedBlock:
+		return e.Attributes, true
+	case types.LiteralBlock:
+		return e.Attributes, true
+	case types.VerseBlock:
+		return e.Attributes, true
+	case types.MarkdownQuoteBlock:
+		return e.Attributes, true
+	case types.PassthroughBlock:
+		return e.Attributes, true
+	case types.StemBlock:
+		return e.Attributes, true
+	case types.ImageBlock:
+		return e.Attributes, true
+	case types.VideoBlock:
+		return e.Attributes, true
+	case types.AudioBlock:
+		return e.Attributes, true
+	case types.Table:
+		return e.Attributes, true
+	case types.OrderedList:
+		return e.Attributes, true
+	case types.UnorderedList:
+		return e.Attributes, true
+	case types.LabeledList:
+		return e.Attributes, true
+	case types.CalloutList:
+		return e.Attributes, true
+	case types.DocumentElement:
+		return e.GetAttributes(), true
+	default:
+		return nil, false
+	}
+}
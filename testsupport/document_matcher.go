@@ -16,7 +16,7 @@ import (
 // Similar to the standard `Equal` matcher, but display a diff when the values don't match
 func MatchDocument(expected types.Document) gomegatypes.GomegaMatcher {
 	return &documentMatcher{
-		expected: expected,
+		expected: withoutPositions(expected).(types.Document), // positions are not verified by this matcher
 	}
 }
 
@@ -29,6 +29,7 @@ func (m *documentMatcher) Match(actual interface{}) (success bool, err error) {
 	if _, ok := actual.(types.Document); !ok {
 		return false, errors.Errorf("MatchDocument matcher expects a Document (actual: %T)", actual)
 	}
+	actual = withoutPositions(actual)
 	if !reflect.DeepEqual(m.expected, actual) {
 		dmp := diffmatchpatch.New()
 		diffs := dmp.DiffMain(spew.Sdump(actual), spew.Sdump(m.expected), true)
@@ -16,7 +16,7 @@ import (
 // Similar to the standard `Equal` matcher, but display a diff when the values don't match
 func MatchDraftDocument(expected types.DraftDocument) gomegatypes.GomegaMatcher {
 	return &draftDocumentMatcher{
-		expected: expected,
+		expected: withoutPositions(expected).(types.DraftDocument), // positions are not verified by this matcher
 	}
 }
 
@@ -29,6 +29,7 @@ func (m *draftDocumentMatcher) Match(actual interface{}) (success bool, err erro
 	if _, ok := actual.(types.DraftDocument); !ok {
 		return false, errors.Errorf("MatchDraftDocument matcher expects a DraftDocument (actual: %T)", actual)
 	}
+	actual = withoutPositions(actual)
 	if !reflect.DeepEqual(m.expected, actual) {
 		dmp := diffmatchpatch.New()
 		diffs := dmp.DiffMain(spew.Sdump(actual), spew.Sdump(m.expected), true)
@@ -15,15 +15,18 @@ func ParseDraftDocument(actual string, options ...interface{}) (types.DraftDocum
 		filename: "test.adoc",
 	}
 	parserOptions := []parser.Option{}
+	settings := []configuration.Setting{}
 	for _, o := range options {
 		switch set := o.(type) {
 		case FilenameOption:
 			set(c)
 		case parser.Option:
 			parserOptions = append(parserOptions, set)
+		case configuration.Setting:
+			settings = append(settings, set)
 		}
 	}
-	config := configuration.NewConfiguration(configuration.WithFilename(c.filename))
+	config := configuration.NewConfiguration(append(settings, configuration.WithFilename(c.filename))...)
 	rawDoc, err := parser.ParseRawDocument(r, config, parserOptions...)
 	if err != nil {
 		return types.DraftDocument{}, err
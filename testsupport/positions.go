@@ -0,0 +1,69 @@
+package testsupport
+
+import (
+	"reflect"
+
+	"github.com/bytesparadise/libasciidoc/pkg/types"
+)
+
+var positionType = reflect.TypeOf(types.Position{})
+
+// withoutPositions returns a copy of the given value in which all the positions of the elements in the source
+// are reset, so that the expectations in the tests don't need to specify them
+func withoutPositions(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	return resetPositions(reflect.ValueOf(value)).Interface()
+}
+
+func resetPositions(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == positionType {
+			return reflect.Zero(positionType)
+		}
+		result := reflect.New(v.Type()).Elem()
+		result.Set(v) // also copies the unexported fields
+		for i := 0; i < v.NumField(); i++ {
+			if result.Field(i).CanSet() {
+				result.Field(i).Set(resetPositions(v.Field(i)))
+			}
+		}
+		return result
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			result.Index(i).Set(resetPositions(v.Index(i)))
+		}
+		return result
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.MakeMapWithSize(v.Type(), v.Len())
+		for _, k := range v.MapKeys() {
+			result.SetMapIndex(k, resetPositions(v.MapIndex(k)))
+		}
+		return result
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.New(v.Type()).Elem()
+		result.Set(resetPositions(v.Elem()))
+		return result
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		result := reflect.New(v.Type().Elem())
+		result.Elem().Set(resetPositions(v.Elem()))
+		return result
+	default:
+		return v
+	}
+}
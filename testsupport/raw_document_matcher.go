@@ -16,7 +16,7 @@ import (
 // Similar to the standard `Equal` matcher, but display a diff when the values don't match
 func MatchRawDocument(expected types.RawDocument) gomegatypes.GomegaMatcher {
 	return &rawDocumentMatcher{
-		expected: expected,
+		expected: withoutPositions(expected).(types.RawDocument), // positions are not verified by this matcher
 	}
 }
 
@@ -29,6 +29,7 @@ func (m *rawDocumentMatcher) Match(actual interface{}) (success bool, err error)
 	if _, ok := actual.(types.RawDocument); !ok {
 		return false, errors.Errorf("MatchRawDocument matcher expects a RawDocument (actual: %T)", actual)
 	}
+	actual = withoutPositions(actual)
 	if !reflect.DeepEqual(m.expected, actual) {
 		if log.IsLevelEnabled(log.DebugLevel) {
 			log.Debug("actual raw document:")